@@ -0,0 +1,192 @@
+package cmd
+
+import (
+	"fmt"
+	"net/netip"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/status"
+
+	"github.com/netbirdio/netbird/client/proto"
+	"github.com/netbirdio/netbird/shared/management/client/rest"
+	"github.com/netbirdio/netbird/shared/management/http/api"
+)
+
+// accessTokenEnv is used as personal access token when the --token flag isn't set
+const accessTokenEnv = "NB_API_TOKEN"
+
+var accessCmd = &cobra.Command{
+	Use:   "access",
+	Short: "Inspect the access granted by the account policies",
+	Long:  `Commands to inspect the access granted by the policies of the account on the management service.`,
+}
+
+var accessCheckCmd = &cobra.Command{
+	Use:   "check <source> <destination>",
+	Short: "Check whether the policies allow a connection and why",
+	Long: `Evaluates the policies, posture checks, network resources and network routes of the account on the management service.
+
+The source is an overlay IP, "self", "peer:<id>" or "user:<id>".
+The destination is an IP, "self", "peer:<id>" or "resource:<id>".`,
+	Example: `
+  netbird access check self 100.64.0.2 -p tcp --dport 443
+  netbird access check user:google-oauth2|1234 resource:chacdk86lnnboviihd7g -p tcp --dport 22
+  netbird access check peer:chacbco6lnnbn6cg5s90 10.10.0.5 -p icmp`,
+	Args: cobra.ExactArgs(2),
+	RunE: accessCheck,
+}
+
+func init() {
+	rootCmd.AddCommand(accessCmd)
+	accessCmd.AddCommand(accessCheckCmd)
+
+	accessCheckCmd.Flags().StringP("protocol", "p", "tcp", "Protocol (tcp/udp/icmp)")
+	accessCheckCmd.Flags().Uint16("dport", 0, "Destination port, required for tcp and udp")
+	accessCheckCmd.Flags().String("token", "", "Personal access token used to authenticate, defaults to the "+accessTokenEnv+" environment variable")
+}
+
+func accessCheck(cmd *cobra.Command, args []string) error {
+	protocol := cmd.Flag("protocol").Value.String()
+	if protocol != "tcp" && protocol != "udp" && protocol != "icmp" {
+		return fmt.Errorf("invalid protocol: use tcp/udp/icmp")
+	}
+
+	dport, err := cmd.Flags().GetUint16("dport")
+	if err != nil {
+		return fmt.Errorf("invalid destination port: %v", err)
+	}
+	if protocol != "icmp" && dport == 0 {
+		return fmt.Errorf("destination port is required for protocol %s", protocol)
+	}
+
+	token, _ := cmd.Flags().GetString("token")
+	if token == "" {
+		token = os.Getenv(accessTokenEnv)
+	}
+	if token == "" {
+		return fmt.Errorf("a personal access token is required, set --token or %s", accessTokenEnv)
+	}
+
+	req := api.AccessCheckRequest{Protocol: api.AccessCheckRequestProtocol(protocol)}
+	if protocol != "icmp" {
+		port := int(dport)
+		req.Port = &port
+	}
+
+	mgmURL := managementURL
+	selfIP := ""
+	if mgmURL == "" || args[0] == "self" || args[1] == "self" {
+		mgmURL, selfIP, err = accessDaemonStatus(cmd)
+		if err != nil {
+			return err
+		}
+		if managementURL != "" {
+			mgmURL = managementURL
+		}
+	}
+
+	if err := setAccessSource(&req, args[0], selfIP); err != nil {
+		return err
+	}
+	if err := setAccessDestination(&req, args[1], selfIP); err != nil {
+		return err
+	}
+
+	resp, err := rest.New(mgmURL, token).Policies.CheckAccess(cmd.Context(), req)
+	if err != nil {
+		return fmt.Errorf("access check failed: %v", err)
+	}
+
+	printAccessCheck(cmd, args[0], args[1], protocol, dport, resp)
+	return nil
+}
+
+// accessDaemonStatus returns the management URL and the overlay IP of the local peer
+func accessDaemonStatus(cmd *cobra.Command) (string, string, error) {
+	conn, err := getClient(cmd)
+	if err != nil {
+		return "", "", err
+	}
+	defer conn.Close()
+
+	resp, err := proto.NewDaemonServiceClient(conn).Status(cmd.Context(), &proto.StatusRequest{GetFullPeerStatus: true})
+	if err != nil {
+		return "", "", fmt.Errorf("status failed: %v", status.Convert(err).Message())
+	}
+
+	fullStatus := resp.GetFullStatus()
+	prefix, err := netip.ParsePrefix(fullStatus.GetLocalPeerState().GetIP())
+	if err != nil {
+		return fullStatus.GetManagementState().GetURL(), "", nil
+	}
+	return fullStatus.GetManagementState().GetURL(), prefix.Addr().String(), nil
+}
+
+func setAccessSource(req *api.AccessCheckRequest, source, selfIP string) error {
+	kind, id, found := strings.Cut(source, ":")
+	switch {
+	case source == "self":
+		if selfIP == "" {
+			return fmt.Errorf("the local peer has no IP, check that the daemon is connected")
+		}
+		req.SourceIp = &selfIP
+	case found && kind == "peer":
+		req.SourcePeerId = &id
+	case found && kind == "user":
+		req.SourceUserId = &id
+	default:
+		if _, err := netip.ParseAddr(source); err != nil {
+			return fmt.Errorf("invalid source %s: use an IP, self, peer:<id> or user:<id>", source)
+		}
+		req.SourceIp = &source
+	}
+	return nil
+}
+
+func setAccessDestination(req *api.AccessCheckRequest, destination, selfIP string) error {
+	kind, id, found := strings.Cut(destination, ":")
+	switch {
+	case destination == "self":
+		if selfIP == "" {
+			return fmt.Errorf("the local peer has no IP, check that the daemon is connected")
+		}
+		req.DestinationIp = &selfIP
+	case found && kind == "peer":
+		req.DestinationPeerId = &id
+	case found && kind == "resource":
+		req.DestinationResourceId = &id
+	default:
+		if _, err := netip.ParseAddr(destination); err != nil {
+			return fmt.Errorf("invalid destination %s: use an IP, self, peer:<id> or resource:<id>", destination)
+		}
+		req.DestinationIp = &destination
+	}
+	return nil
+}
+
+func printAccessCheck(cmd *cobra.Command, src, dst, protocol string, dport uint16, resp *api.AccessCheckResponse) {
+	traffic := strings.ToUpper(protocol)
+	if dport != 0 {
+		traffic = fmt.Sprintf("%s/%d", traffic, dport)
+	}
+	cmd.Printf("Access check %s → %s (%s)\n", src, dst, traffic)
+
+	disposition := map[bool]string{
+		true:  "\033[32mALLOWED\033[0m", // Green
+		false: "\033[31mDENIED\033[0m",  // Red
+	}
+
+	for _, result := range resp.Results {
+		cmd.Printf("\n%s → %s %s (%s): %s\n", result.SourcePeerName, result.DestinationType, result.DestinationName, result.DestinationId, disposition[result.Allowed])
+		for _, rule := range result.Rules {
+			cmd.Printf("  %s by rule %s (%s) of policy %s (%s)\n", rule.Action, rule.RuleName, rule.RuleId, rule.PolicyName, rule.PolicyId)
+		}
+		if result.Reason != nil {
+			cmd.Printf("  %s\n", *result.Reason)
+		}
+	}
+
+	cmd.Printf("\nFinal disposition: %s\n", disposition[resp.Allowed])
+}
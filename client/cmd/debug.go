@@ -12,6 +12,7 @@ import (
 
 	"github.com/netbirdio/netbird/client/internal"
 	"github.com/netbirdio/netbird/client/internal/debug"
+	"github.com/netbirdio/netbird/client/internal/dns"
 	"github.com/netbirdio/netbird/client/internal/peer"
 	"github.com/netbirdio/netbird/client/internal/profilemanager"
 	"github.com/netbirdio/netbird/client/proto"
@@ -358,6 +359,7 @@ func formatDuration(d time.Duration) string {
 
 func generateDebugBundle(config *profilemanager.Config, recorder *peer.Status, connectClient *internal.ConnectClient, logFilePath string) {
 	var syncResponse *mgmProto.SyncResponse
+	var dnsQueryLog []dns.QueryLogEntry
 	var err error
 
 	if connectClient != nil {
@@ -365,6 +367,11 @@ func generateDebugBundle(config *profilemanager.Config, recorder *peer.Status, c
 		if err != nil {
 			log.Warnf("Failed to get latest sync response: %v", err)
 		}
+
+		dnsQueryLog, err = connectClient.GetDNSQueryLog()
+		if err != nil {
+			log.Warnf("Failed to get DNS query log: %v", err)
+		}
 	}
 
 	bundleGenerator := debug.NewBundleGenerator(
@@ -372,6 +379,7 @@ func generateDebugBundle(config *profilemanager.Config, recorder *peer.Status, c
 			InternalConfig: config,
 			StatusRecorder: recorder,
 			SyncResponse:   syncResponse,
+			DNSQueryLog:    dnsQueryLog,
 			LogFile:        logFilePath,
 		},
 		debug.BundleConfig{
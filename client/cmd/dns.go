@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/status"
+
+	"github.com/netbirdio/netbird/client/proto"
+)
+
+var (
+	dnsLogLimit  uint32
+	dnsLogDomain string
+)
+
+var dnsCmd = &cobra.Command{
+	Use:   "dns",
+	Short: "Inspect the client DNS server",
+	Long:  "Provides commands for inspecting the DNS server of the NetBird client.",
+}
+
+var dnsLogCmd = &cobra.Command{
+	Use:   "log",
+	Short: "Show the DNS query log",
+	Long: `Shows the most recent queries served by the client DNS server, with the handler that answered them,
+the upstream nameserver used, the response code and the latency.`,
+	Example: `  netbird dns log
+  netbird dns log --domain internal.example.com --limit 20`,
+	RunE: dnsLog,
+}
+
+func init() {
+	rootCmd.AddCommand(dnsCmd)
+	dnsCmd.AddCommand(dnsLogCmd)
+
+	dnsLogCmd.Flags().Uint32Var(&dnsLogLimit, "limit", 100, "Maximum number of the most recent queries to show, 0 shows all queries")
+	dnsLogCmd.Flags().StringVarP(&dnsLogDomain, "domain", "d", "", "Only show queries for names containing the domain")
+}
+
+func dnsLog(cmd *cobra.Command, _ []string) error {
+	conn, err := getClient(cmd)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := proto.NewDaemonServiceClient(conn)
+	resp, err := client.GetDNSQueryLog(cmd.Context(), &proto.GetDNSQueryLogRequest{
+		Limit:  dnsLogLimit,
+		Domain: dnsLogDomain,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get DNS query log: %v", status.Convert(err).Message())
+	}
+
+	if len(resp.GetEntries()) == 0 {
+		cmd.Println("No DNS queries logged.")
+		return nil
+	}
+
+	for _, entry := range resp.GetEntries() {
+		cmd.Println(formatDNSQueryLogEntry(entry))
+	}
+
+	return nil
+}
+
+func formatDNSQueryLogEntry(entry *proto.DNSQueryLogEntry) string {
+	line := fmt.Sprintf("%s %s %s %s handler=%s",
+		entry.GetTime().AsTime().Local().Format("2006-01-02 15:04:05.000"),
+		entry.GetName(),
+		entry.GetType(),
+		entry.GetRcode(),
+		entry.GetHandler(),
+	)
+	if entry.GetPattern() != "" {
+		line += fmt.Sprintf(" pattern=%s", entry.GetPattern())
+	}
+	if entry.GetUpstream() != "" {
+		line += fmt.Sprintf(" upstream=%s", entry.GetUpstream())
+	}
+	return line + fmt.Sprintf(" latency=%s", entry.GetLatency().AsDuration())
+}
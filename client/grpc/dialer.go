@@ -21,12 +21,15 @@ import (
 	"google.golang.org/grpc/keepalive"
 
 	nbnet "github.com/netbirdio/netbird/client/net"
+	"github.com/netbirdio/netbird/client/net/proxy"
 
 	"github.com/netbirdio/netbird/util/embeddedroots"
 )
 
+// WithCustomDialer dials through the proxy configured for the client, see the proxy package
 func WithCustomDialer() grpc.DialOption {
 	return grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+		var forward proxy.ContextDialer = nbnet.NewDialer()
 		if runtime.GOOS == "linux" {
 			currentUser, err := user.Current()
 			if err != nil {
@@ -36,15 +39,14 @@ func WithCustomDialer() grpc.DialOption {
 			// the custom dialer requires root permissions which are not required for use cases run as non-root
 			if currentUser.Uid != "0" {
 				log.Debug("Not running as root, using standard dialer")
-				dialer := &net.Dialer{}
-				return dialer.DialContext(ctx, "tcp", addr)
+				forward = &net.Dialer{}
 			}
 		}
 
-		conn, err := nbnet.NewDialer().DialContext(ctx, "tcp", addr)
+		conn, err := proxy.NewDialer(forward).DialContext(ctx, "tcp", addr)
 		if err != nil {
 			log.Errorf("Failed to dial: %s", err)
-			return nil, fmt.Errorf("nbnet.NewDialer().DialContext: %w", err)
+			return nil, fmt.Errorf("dial %s: %w", addr, err)
 		}
 		return conn, nil
 	})
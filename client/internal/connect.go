@@ -368,6 +368,16 @@ func (c *ConnectClient) GetLatestSyncResponse() (*mgmProto.SyncResponse, error)
 	return syncResponse, nil
 }
 
+// GetDNSQueryLog returns the most recent queries served by the DNS server of the engine.
+func (c *ConnectClient) GetDNSQueryLog() ([]dns.QueryLogEntry, error) {
+	engine := c.Engine()
+	if engine == nil {
+		return nil, errors.New("engine is not initialized")
+	}
+
+	return engine.GetDNSQueryLog()
+}
+
 // Status returns the current client status
 func (c *ConnectClient) Status() StatusType {
 	if c == nil {
@@ -25,6 +25,7 @@ import (
 	"google.golang.org/protobuf/encoding/protojson"
 
 	"github.com/netbirdio/netbird/client/anonymize"
+	"github.com/netbirdio/netbird/client/internal/dns"
 	"github.com/netbirdio/netbird/client/internal/peer"
 	"github.com/netbirdio/netbird/client/internal/profilemanager"
 	mgmProto "github.com/netbirdio/netbird/shared/management/proto"
@@ -45,6 +46,7 @@ ip_rules.txt: Detailed IP routing rules in tabular format including priority, so
 iptables.txt: Anonymized iptables rules with packet counters, if --system-info flag was provided.
 nftables.txt: Anonymized nftables rules with packet counters, if --system-info flag was provided.
 resolved_domains.txt: Anonymized resolved domain IP addresses from the status recorder.
+dns_queries.txt: Anonymized log of the most recent queries served by the client DNS server, including the handler that answered, the upstream used, the response code and the latency.
 config.txt: Anonymized configuration information of the NetBird client.
 network_map.json: Anonymized sync response containing peer configurations, routes, DNS settings, and firewall rules.
 state.json: Anonymized client state dump containing netbird states.
@@ -202,6 +204,7 @@ type BundleGenerator struct {
 	internalConfig *profilemanager.Config
 	statusRecorder *peer.Status
 	syncResponse   *mgmProto.SyncResponse
+	dnsQueryLog    []dns.QueryLogEntry
 	logFile        string
 
 	anonymize         bool
@@ -223,6 +226,7 @@ type GeneratorDependencies struct {
 	InternalConfig *profilemanager.Config
 	StatusRecorder *peer.Status
 	SyncResponse   *mgmProto.SyncResponse
+	DNSQueryLog    []dns.QueryLogEntry
 	LogFile        string
 }
 
@@ -239,6 +243,7 @@ func NewBundleGenerator(deps GeneratorDependencies, cfg BundleConfig) *BundleGen
 		internalConfig: deps.InternalConfig,
 		statusRecorder: deps.StatusRecorder,
 		syncResponse:   deps.SyncResponse,
+		dnsQueryLog:    deps.DNSQueryLog,
 		logFile:        deps.LogFile,
 
 		anonymize:         cfg.Anonymize,
@@ -303,6 +308,10 @@ func (g *BundleGenerator) createArchive() error {
 		log.Errorf("failed to add resolved domains to debug bundle: %v", err)
 	}
 
+	if err := g.addDNSQueryLog(); err != nil {
+		log.Errorf("failed to add DNS query log to debug bundle: %v", err)
+	}
+
 	if g.includeSystemInfo {
 		g.addSystemInfo()
 	}
@@ -526,6 +535,20 @@ func (g *BundleGenerator) addResolvedDomains() error {
 	return nil
 }
 
+func (g *BundleGenerator) addDNSQueryLog() error {
+	if len(g.dnsQueryLog) == 0 {
+		log.Debugf("skipping DNS query log in debug bundle: no queries")
+		return nil
+	}
+
+	dnsQueryLogContent := formatDNSQueryLog(g.dnsQueryLog, g.anonymize, g.anonymizer)
+	if err := g.addFileToZip(strings.NewReader(dnsQueryLogContent), "dns_queries.txt"); err != nil {
+		return fmt.Errorf("add DNS query log file to zip: %w", err)
+	}
+
+	return nil
+}
+
 func (g *BundleGenerator) addSyncResponse() error {
 	if g.syncResponse == nil {
 		log.Debugf("skipping empty sync response in debug bundle")
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/netbirdio/netbird/client/anonymize"
+	"github.com/netbirdio/netbird/client/internal/dns"
 	"github.com/netbirdio/netbird/client/internal/peer"
 	"github.com/netbirdio/netbird/client/internal/routemanager/systemops"
 	"github.com/netbirdio/netbird/shared/management/domain"
@@ -97,6 +98,62 @@ func formatResolvedDomains(resolvedDomains map[domain.Domain]peer.ResolvedDomain
 	return builder.String()
 }
 
+func formatDNSQueryLog(entries []dns.QueryLogEntry, anonymize bool, anonymizer *anonymize.Anonymizer) string {
+	if len(entries) == 0 {
+		return "No DNS queries found.\n"
+	}
+
+	var builder strings.Builder
+	builder.WriteString("DNS Queries:\n")
+	builder.WriteString("============\n\n")
+	builder.WriteString(fmt.Sprintf("%-24s %-40s %-6s %-9s %-10s %-30s %-30s %s\n",
+		"Time", "Name", "Type", "Rcode", "Handler", "Pattern", "Upstream", "Latency"))
+
+	for _, entry := range entries {
+		name := entry.Name
+		pattern := entry.Pattern
+		upstream := entry.Upstream
+		if anonymize {
+			name = anonymizer.AnonymizeDomain(name)
+			pattern = anonymizer.AnonymizeDomain(pattern)
+			upstream = anonymizeDNSUpstream(upstream, anonymizer)
+		}
+
+		builder.WriteString(fmt.Sprintf("%-24s %-40s %-6s %-9s %-10s %-30s %-30s %s\n",
+			entry.Time.UTC().Format("2006-01-02T15:04:05.000Z"),
+			name,
+			entry.Type,
+			entry.Rcode,
+			entry.Handler,
+			formatOptional(pattern),
+			formatOptional(upstream),
+			entry.Latency,
+		))
+	}
+
+	return builder.String()
+}
+
+// anonymizeDNSUpstream anonymizes upstreams in the ip:port form and the url form of encrypted upstreams
+func anonymizeDNSUpstream(upstream string, anonymizer *anonymize.Anonymizer) string {
+	if upstream == "" {
+		return upstream
+	}
+
+	if addrPort, err := netip.ParseAddrPort(upstream); err == nil {
+		return netip.AddrPortFrom(anonymizer.AnonymizeIP(addrPort.Addr()), addrPort.Port()).String()
+	}
+
+	return anonymizer.AnonymizeURI(upstream)
+}
+
+func formatOptional(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
+
 func formatRoutesTable(detailedRoutes []systemops.DetailedRoute, anonymize bool, anonymizer *anonymize.Anonymizer) string {
 	if len(detailedRoutes) == 0 {
 		return "No routes found.\n"
@@ -3,19 +3,22 @@ package internal
 import (
 	"fmt"
 	"net/netip"
-	"slices"
 	"strings"
 
 	"github.com/miekg/dns"
 	log "github.com/sirupsen/logrus"
 
 	nbdns "github.com/netbirdio/netbird/dns"
+	mgmProto "github.com/netbirdio/netbird/shared/management/proto"
 )
 
-func createPTRRecord(aRecord nbdns.SimpleRecord, prefix netip.Prefix) (nbdns.SimpleRecord, bool) {
-	ip, err := netip.ParseAddr(aRecord.RData)
+// peerRecordTTL is the TTL of the PTR records of the peers that aren't in a custom zone
+const peerRecordTTL = 300
+
+func createPTRRecord(record nbdns.SimpleRecord, prefix netip.Prefix) (nbdns.SimpleRecord, bool) {
+	ip, err := netip.ParseAddr(record.RData)
 	if err != nil {
-		log.Warnf("failed to parse IP address %s: %v", aRecord.RData, err)
+		log.Warnf("failed to parse IP address %s: %v", record.RData, err)
 		return nbdns.SimpleRecord{}, false
 	}
 
@@ -23,93 +26,196 @@ func createPTRRecord(aRecord nbdns.SimpleRecord, prefix netip.Prefix) (nbdns.Sim
 		return nbdns.SimpleRecord{}, false
 	}
 
-	ipOctets := strings.Split(ip.String(), ".")
-	slices.Reverse(ipOctets)
-	rdnsName := dns.Fqdn(strings.Join(ipOctets, ".") + ".in-addr.arpa")
+	rdnsName, err := dns.ReverseAddr(ip.String())
+	if err != nil {
+		log.Warnf("failed to generate reverse name of %s: %v", ip, err)
+		return nbdns.SimpleRecord{}, false
+	}
 
 	return nbdns.SimpleRecord{
 		Name:  rdnsName,
 		Type:  int(dns.TypePTR),
-		Class: aRecord.Class,
-		TTL:   aRecord.TTL,
-		RData: dns.Fqdn(aRecord.Name),
+		Class: record.Class,
+		TTL:   record.TTL,
+		RData: dns.Fqdn(record.Name),
 	}, true
 }
 
-// generateReverseZoneName creates the reverse DNS zone name for a given network
-func generateReverseZoneName(network netip.Prefix) (string, error) {
-	networkIP := network.Masked().Addr()
+// generateReverseZoneNames returns the reverse DNS zones covering the network. Reverse zones are delegated on octet
+// boundaries for IPv4 and on nibble boundaries for IPv6, so a network that isn't aligned is covered by all the zones
+// of the next boundary, e.g. 100.64.0.0/10 is covered by 64.100.in-addr.arpa to 127.100.in-addr.arpa.
+func generateReverseZoneNames(network netip.Prefix) ([]string, error) {
+	network = network.Masked()
+	networkIP := network.Addr()
+
+	labelBits := 8
+	if networkIP.Is6() {
+		labelBits = 4
+	}
 
-	if !networkIP.Is4() {
-		return "", fmt.Errorf("reverse DNS is only supported for IPv4 networks, got: %s", networkIP)
+	if network.Bits() == 0 {
+		return nil, fmt.Errorf("invalid network mask size for reverse DNS: %d", network.Bits())
 	}
 
-	// round up to nearest byte
-	octetsToUse := (network.Bits() + 7) / 8
+	// round up to nearest label
+	zoneBits := (network.Bits() + labelBits - 1) / labelBits * labelBits
 
-	octets := strings.Split(networkIP.String(), ".")
-	if octetsToUse > len(octets) {
-		return "", fmt.Errorf("invalid network mask size for reverse DNS: %d", network.Bits())
+	zoneCount := 1 << (zoneBits - network.Bits())
+	zoneNames := make([]string, 0, zoneCount)
+	for i, addr := 0, networkIP; i < zoneCount; i, addr = i+1, nextSubnet(addr, zoneBits) {
+		zoneName, err := reverseZoneName(addr, zoneBits/labelBits)
+		if err != nil {
+			return nil, err
+		}
+		zoneNames = append(zoneNames, zoneName)
 	}
 
-	reverseOctets := make([]string, octetsToUse)
-	for i := 0; i < octetsToUse; i++ {
-		reverseOctets[octetsToUse-1-i] = octets[i]
+	return zoneNames, nil
+}
+
+// reverseZoneName returns the reverse DNS zone of the first labels of the address
+func reverseZoneName(addr netip.Addr, labels int) (string, error) {
+	name, err := dns.ReverseAddr(addr.String())
+	if err != nil {
+		return "", fmt.Errorf("generate reverse name of %s: %w", addr, err)
 	}
 
-	return dns.Fqdn(strings.Join(reverseOctets, ".") + ".in-addr.arpa"), nil
+	// the name has a label per address octet or nibble, followed by the in-addr.arpa or ip6.arpa suffix
+	nameLabels := dns.SplitDomainName(name)
+	addrLabels := len(nameLabels) - 2
+	return dns.Fqdn(strings.Join(nameLabels[addrLabels-labels:], ".")), nil
+}
+
+// nextSubnet returns the address of the next subnet of the given size
+func nextSubnet(addr netip.Addr, bits int) netip.Addr {
+	b := addr.AsSlice()
+	carry := uint16(1) << (7 - (bits-1)%8)
+	for i := (bits - 1) / 8; i >= 0 && carry > 0; i-- {
+		sum := uint16(b[i]) + carry
+		b[i] = byte(sum)
+		carry = sum >> 8
+	}
+
+	next, _ := netip.AddrFromSlice(b)
+	return next
 }
 
 // zoneExists checks if a zone with the given name already exists in the configuration
 func zoneExists(config *nbdns.Config, zoneName string) bool {
 	for _, zone := range config.CustomZones {
 		if zone.Domain == zoneName {
-			log.Debugf("reverse DNS zone %s already exists", zoneName)
 			return true
 		}
 	}
 	return false
 }
 
-// collectPTRRecords gathers all PTR records for the given network from A records
-func collectPTRRecords(config *nbdns.Config, prefix netip.Prefix) []nbdns.SimpleRecord {
+// collectPTRRecords gathers the PTR records for the given network from the A and AAAA records of the custom zones
+// and of the peers. The first record of an address is used.
+func collectPTRRecords(config *nbdns.Config, prefix netip.Prefix, peerRecords []nbdns.SimpleRecord) []nbdns.SimpleRecord {
 	var records []nbdns.SimpleRecord
+	seen := make(map[string]struct{})
+
+	addRecord := func(record nbdns.SimpleRecord) {
+		if record.Type != int(dns.TypeA) && record.Type != int(dns.TypeAAAA) {
+			return
+		}
+
+		ptrRecord, ok := createPTRRecord(record, prefix)
+		if !ok {
+			return
+		}
+		if _, exists := seen[ptrRecord.Name]; exists {
+			return
+		}
+		seen[ptrRecord.Name] = struct{}{}
+		records = append(records, ptrRecord)
+	}
 
 	for _, zone := range config.CustomZones {
 		for _, record := range zone.Records {
-			if record.Type != int(dns.TypeA) {
-				continue
-			}
-
-			if ptrRecord, ok := createPTRRecord(record, prefix); ok {
-				records = append(records, ptrRecord)
-			}
+			addRecord(record)
 		}
 	}
+	for _, record := range peerRecords {
+		addRecord(record)
+	}
 
 	return records
 }
 
-// addReverseZone adds a reverse DNS zone to the configuration for the given network
-func addReverseZone(config *nbdns.Config, network netip.Prefix) {
-	zoneName, err := generateReverseZoneName(network)
+// addReverseZones adds the reverse DNS zones of the network that have PTR records for the peers and the custom zone records
+func addReverseZones(config *nbdns.Config, network netip.Prefix, peerRecords []nbdns.SimpleRecord) {
+	zoneNames, err := generateReverseZoneNames(network)
 	if err != nil {
 		log.Warn(err)
 		return
 	}
 
-	if zoneExists(config, zoneName) {
-		log.Debugf("reverse DNS zone %s already exists", zoneName)
-		return
+	records := collectPTRRecords(config, network, peerRecords)
+
+	for _, zoneName := range zoneNames {
+		if zoneExists(config, zoneName) {
+			log.Debugf("reverse DNS zone %s already exists", zoneName)
+			continue
+		}
+
+		var zoneRecords []nbdns.SimpleRecord
+		for _, record := range records {
+			if dns.IsSubDomain(zoneName, record.Name) {
+				zoneRecords = append(zoneRecords, record)
+			}
+		}
+		if len(zoneRecords) == 0 {
+			continue
+		}
+
+		config.CustomZones = append(config.CustomZones, nbdns.CustomZone{
+			Domain:  zoneName,
+			Records: zoneRecords,
+		})
+		log.Debugf("added reverse DNS zone: %s with %d records", zoneName, len(zoneRecords))
 	}
+}
 
-	records := collectPTRRecords(config, network)
+// toPeerRecords returns the address records of the local and remote peers of the network map
+func toPeerRecords(networkMap *mgmProto.NetworkMap) []nbdns.SimpleRecord {
+	var records []nbdns.SimpleRecord
+
+	addRecord := func(fqdn string, addr netip.Addr) {
+		if fqdn == "" {
+			return
+		}
+
+		recordType := dns.TypeA
+		if addr.Is6() {
+			recordType = dns.TypeAAAA
+		}
+		records = append(records, nbdns.SimpleRecord{
+			Name:  dns.Fqdn(fqdn),
+			Type:  int(recordType),
+			Class: nbdns.DefaultClass,
+			TTL:   peerRecordTTL,
+			RData: addr.String(),
+		})
+	}
 
-	reverseZone := nbdns.CustomZone{
-		Domain:  zoneName,
-		Records: records,
+	if prefix, err := netip.ParsePrefix(networkMap.GetPeerConfig().GetAddress()); err == nil {
+		addRecord(networkMap.GetPeerConfig().GetFqdn(), prefix.Addr())
 	}
 
-	config.CustomZones = append(config.CustomZones, reverseZone)
-	log.Debugf("added reverse DNS zone: %s with %d records", zoneName, len(records))
+	for _, remotePeers := range [][]*mgmProto.RemotePeerConfig{networkMap.GetRemotePeers(), networkMap.GetOfflinePeers()} {
+		for _, remotePeer := range remotePeers {
+			for _, allowedIP := range remotePeer.GetAllowedIps() {
+				prefix, err := netip.ParsePrefix(allowedIP)
+				// routed networks are allowed IPs as well, only the host addresses in the overlay network get a record
+				if err != nil || !prefix.IsSingleIP() {
+					continue
+				}
+				addRecord(remotePeer.GetFqdn(), prefix.Addr())
+			}
+		}
+	}
+
+	return records
 }
@@ -0,0 +1,161 @@
+package blocklist
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+
+	"github.com/miekg/dns"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/client/internal/dns/types"
+	"github.com/netbirdio/netbird/client/internal/peer"
+	nbdns "github.com/netbirdio/netbird/dns"
+)
+
+// sinkholeTTL is the TTL of the sinkhole answers and bounds how long clients cache a blocked answer
+const sinkholeTTL = 60
+
+// blockedDomain is the blocklist that a domain and its subdomains are blocked by
+type blockedDomain struct {
+	blocklistID string
+	sinkhole    bool
+}
+
+// Resolver answers the queries for the blocked domains and their subdomains
+// and passes the other queries on to the next handler of the chain.
+type Resolver struct {
+	mu      sync.RWMutex
+	domains map[string]blockedDomain
+
+	statusRecorder *peer.Status
+}
+
+func NewResolver(statusRecorder *peer.Status) *Resolver {
+	return &Resolver{
+		domains:        make(map[string]blockedDomain),
+		statusRecorder: statusRecorder,
+	}
+}
+
+func (r *Resolver) MatchSubdomains() bool {
+	return true
+}
+
+// String returns a string representation of the blocklist resolver
+func (r *Resolver) String() string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return fmt.Sprintf("BlocklistResolver [%d domains]", len(r.domains))
+}
+
+func (r *Resolver) Stop() {}
+
+// ID returns the unique handler ID
+func (r *Resolver) ID() types.HandlerID {
+	return "blocklist-resolver"
+}
+
+func (r *Resolver) ProbeAvailability() {}
+
+// ServeDNS answers blocked queries with NXDOMAIN or the sinkhole address
+func (r *Resolver) ServeDNS(w dns.ResponseWriter, req *dns.Msg) {
+	if len(req.Question) == 0 {
+		log.Debugf("received blocklist resolver request with no question")
+		return
+	}
+	question := req.Question[0]
+
+	blocked, ok := r.lookup(question.Name)
+	if !ok {
+		continueToNext(w, req)
+		return
+	}
+
+	log.Tracef("blocked DNS query for %s by blocklist %s", question.Name, blocked.blocklistID)
+	if r.statusRecorder != nil {
+		r.statusRecorder.AddDNSBlocklistHit(blocked.blocklistID)
+	}
+
+	resp := &dns.Msg{}
+	if !blocked.sinkhole {
+		resp.SetRcode(req, dns.RcodeNameError)
+	} else {
+		resp.SetReply(req)
+		if rr := sinkholeRecord(question); rr != nil {
+			resp.Answer = append(resp.Answer, rr)
+		}
+	}
+	resp.RecursionAvailable = true
+
+	if err := w.WriteMsg(resp); err != nil {
+		log.Errorf("failed to write blocklist response: %v", err)
+	}
+}
+
+// Update replaces the enforced blocklists
+func (r *Resolver) Update(blocklists []nbdns.Blocklist) {
+	domains := make(map[string]blockedDomain)
+	states := make([]peer.DNSBlocklistState, 0, len(blocklists))
+	for _, blocklist := range blocklists {
+		for _, domain := range blocklist.Domains {
+			name := strings.ToLower(dns.Fqdn(domain))
+			if _, exists := domains[name]; exists {
+				continue
+			}
+			domains[name] = blockedDomain{blocklistID: blocklist.ID, sinkhole: blocklist.Sinkhole}
+		}
+		states = append(states, peer.DNSBlocklistState{
+			ID:      blocklist.ID,
+			Name:    blocklist.Name,
+			Domains: len(blocklist.Domains),
+		})
+	}
+
+	r.mu.Lock()
+	r.domains = domains
+	r.mu.Unlock()
+
+	if r.statusRecorder != nil {
+		r.statusRecorder.UpdateDNSBlocklistStates(states)
+	}
+}
+
+// lookup returns the blocklist of the name or of its closest blocked parent domain
+func (r *Resolver) lookup(name string) (blockedDomain, bool) {
+	name = strings.ToLower(dns.Fqdn(name))
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for off, end := 0, false; !end; off, end = dns.NextLabel(name, off) {
+		if blocked, ok := r.domains[name[off:]]; ok {
+			return blocked, true
+		}
+	}
+	return blockedDomain{}, false
+}
+
+// sinkholeRecord returns the unspecified address for A and AAAA questions, other types are answered with no data
+func sinkholeRecord(question dns.Question) dns.RR {
+	header := dns.RR_Header{Name: question.Name, Rrtype: question.Qtype, Class: question.Qclass, Ttl: sinkholeTTL}
+	switch question.Qtype {
+	case dns.TypeA:
+		return &dns.A{Hdr: header, A: net.IPv4zero}
+	case dns.TypeAAAA:
+		return &dns.AAAA{Hdr: header, AAAA: net.IPv6zero}
+	default:
+		return nil
+	}
+}
+
+// continueToNext signals the handler chain to continue to the next handler.
+func continueToNext(w dns.ResponseWriter, r *dns.Msg) {
+	resp := &dns.Msg{}
+	resp.SetRcode(r, dns.RcodeNameError)
+	resp.MsgHdr.Zero = true
+	if err := w.WriteMsg(resp); err != nil {
+		log.Errorf("failed to write continue signal: %v", err)
+	}
+}
@@ -0,0 +1,84 @@
+package blocklist
+
+import (
+	"net"
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/client/internal/dns/test"
+	"github.com/netbirdio/netbird/client/internal/peer"
+	nbdns "github.com/netbirdio/netbird/dns"
+)
+
+func TestResolver_ServeDNS(t *testing.T) {
+	statusRecorder := peer.NewRecorder("mgm")
+	resolver := NewResolver(statusRecorder)
+	resolver.Update([]nbdns.Blocklist{
+		{ID: "ads", Name: "ads", Domains: []string{"ads.example.com"}},
+		{ID: "malware", Name: "malware", Sinkhole: true, Domains: []string{"Malware.Example.com", "ads.example.com"}},
+	})
+
+	testCases := []struct {
+		name           string
+		qname          string
+		qtype          uint16
+		expectedRcode  int
+		expectedAnswer dns.RR
+		shouldContinue bool
+	}{
+		{name: "blocked domain", qname: "ads.example.com.", qtype: dns.TypeA, expectedRcode: dns.RcodeNameError},
+		{name: "blocked subdomain", qname: "cdn.ads.example.com.", qtype: dns.TypeAAAA, expectedRcode: dns.RcodeNameError},
+		{
+			name: "sinkhole A", qname: "malware.example.com.", qtype: dns.TypeA, expectedRcode: dns.RcodeSuccess,
+			expectedAnswer: &dns.A{Hdr: dns.RR_Header{Name: "malware.example.com.", Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: sinkholeTTL}, A: net.IPv4zero},
+		},
+		{
+			name: "sinkhole AAAA", qname: "x.MALWARE.example.com.", qtype: dns.TypeAAAA, expectedRcode: dns.RcodeSuccess,
+			expectedAnswer: &dns.AAAA{Hdr: dns.RR_Header{Name: "x.MALWARE.example.com.", Rrtype: dns.TypeAAAA, Class: dns.ClassINET, Ttl: sinkholeTTL}, AAAA: net.IPv6zero},
+		},
+		{name: "sinkhole other type", qname: "malware.example.com.", qtype: dns.TypeTXT, expectedRcode: dns.RcodeSuccess},
+		{name: "parent domain", qname: "example.com.", qtype: dns.TypeA, shouldContinue: true},
+		{name: "similar domain", qname: "badads.example.com.", qtype: dns.TypeA, shouldContinue: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var resp *dns.Msg
+			writer := &test.MockResponseWriter{
+				WriteMsgFunc: func(m *dns.Msg) error {
+					resp = m
+					return nil
+				},
+			}
+
+			resolver.ServeDNS(writer, new(dns.Msg).SetQuestion(tc.qname, tc.qtype))
+
+			require.NotNil(t, resp)
+			if tc.shouldContinue {
+				assert.True(t, resp.MsgHdr.Zero, "query should continue to the next handler")
+				return
+			}
+			assert.False(t, resp.MsgHdr.Zero)
+			assert.Equal(t, tc.expectedRcode, resp.Rcode)
+			if tc.expectedAnswer == nil {
+				assert.Empty(t, resp.Answer)
+				return
+			}
+			require.Len(t, resp.Answer, 1)
+			assert.Equal(t, tc.expectedAnswer.String(), resp.Answer[0].String())
+		})
+	}
+
+	states := statusRecorder.GetDNSBlocklistStates()
+	require.Len(t, states, 2)
+	assert.Equal(t, peer.DNSBlocklistState{ID: "ads", Name: "ads", Domains: 1, Hits: 2}, states[0])
+	assert.Equal(t, peer.DNSBlocklistState{ID: "malware", Name: "malware", Domains: 2, Hits: 3}, states[1])
+
+	resolver.Update([]nbdns.Blocklist{{ID: "malware", Name: "malware", Domains: []string{"malware.example.com"}}})
+	states = statusRecorder.GetDNSBlocklistStates()
+	require.Len(t, states, 1)
+	assert.Equal(t, uint64(3), states[0].Hits, "hits of the kept blocklist should be preserved")
+}
@@ -5,6 +5,7 @@ import (
 	"slices"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/miekg/dns"
 	log "github.com/sirupsen/logrus"
@@ -13,6 +14,7 @@ import (
 const (
 	PriorityMgmtCache = 150
 	PriorityLocal     = 100
+	PriorityBlocklist = 90
 	PriorityDNSRoute  = 75
 	PriorityUpstream  = 50
 	PriorityDefault   = 1
@@ -37,6 +39,7 @@ type HandlerEntry struct {
 type HandlerChain struct {
 	mu       sync.RWMutex
 	handlers []HandlerEntry
+	queryLog *QueryLog
 }
 
 // ResponseWriterChain wraps a dns.ResponseWriter to track if handler wants to continue chain
@@ -44,6 +47,8 @@ type ResponseWriterChain struct {
 	dns.ResponseWriter
 	origPattern    string
 	shouldContinue bool
+	rcode          int
+	upstream       string
 }
 
 func (w *ResponseWriterChain) WriteMsg(m *dns.Msg) error {
@@ -52,12 +57,14 @@ func (w *ResponseWriterChain) WriteMsg(m *dns.Msg) error {
 		w.shouldContinue = true
 		return nil
 	}
+	w.rcode = m.Rcode
 	return w.ResponseWriter.WriteMsg(m)
 }
 
 func NewHandlerChain() *HandlerChain {
 	return &HandlerChain{
 		handlers: make([]HandlerEntry, 0),
+		queryLog: NewQueryLog(DefaultQueryLogSize),
 	}
 }
 
@@ -66,6 +73,16 @@ func (w *ResponseWriterChain) GetOrigPattern() string {
 	return w.origPattern
 }
 
+// SetUpstream records the nameserver the handler forwarded the query to
+func (w *ResponseWriterChain) SetUpstream(upstream string) {
+	w.upstream = upstream
+}
+
+// QueryLog returns the most recent queries served by the chain, from the oldest to the newest
+func (c *HandlerChain) QueryLog() []QueryLogEntry {
+	return c.queryLog.Entries()
+}
+
 // AddHandler adds a new handler to the chain, replacing any existing handler with the same pattern and priority
 func (c *HandlerChain) AddHandler(pattern string, handler dns.Handler, priority int) {
 	c.mu.Lock()
@@ -152,6 +169,7 @@ func (c *HandlerChain) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
 	}
 
 	qname := strings.ToLower(r.Question[0].Name)
+	logEntry := newQueryLogEntry(r, time.Now())
 
 	c.mu.RLock()
 	handlers := slices.Clone(c.handlers)
@@ -189,6 +207,12 @@ func (c *HandlerChain) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
 				}
 				continue
 			}
+
+			logEntry.Handler = handlerName(entry.Priority)
+			logEntry.Pattern = entry.OrigPattern
+			logEntry.Upstream = chainWriter.upstream
+			logEntry.Rcode = dns.RcodeToString[chainWriter.rcode]
+			c.addQueryLogEntry(logEntry)
 			return
 		}
 	}
@@ -200,6 +224,15 @@ func (c *HandlerChain) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
 	if err := w.WriteMsg(resp); err != nil {
 		log.Errorf("failed to write DNS response: %v", err)
 	}
+
+	logEntry.Handler = "none"
+	logEntry.Rcode = dns.RcodeToString[dns.RcodeRefused]
+	c.addQueryLogEntry(logEntry)
+}
+
+func (c *HandlerChain) addQueryLogEntry(entry QueryLogEntry) {
+	entry.Latency = time.Since(entry.Time)
+	c.queryLog.Add(entry)
 }
 
 func (c *HandlerChain) isHandlerMatch(qname string, entry HandlerEntry) bool {
@@ -6,6 +6,7 @@ import (
 	"github.com/miekg/dns"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	nbdns "github.com/netbirdio/netbird/client/internal/dns"
 	"github.com/netbirdio/netbird/client/internal/dns/test"
@@ -994,3 +995,53 @@ func TestHandlerChain_AddRemoveRoundtrip(t *testing.T) {
 		})
 	}
 }
+
+// TestHandlerChain_QueryLog tests that served queries are recorded with the handler that answered them
+func TestHandlerChain_QueryLog(t *testing.T) {
+	chain := nbdns.NewHandlerChain()
+
+	routeHandler := &nbdns.MockHandler{}
+	upstreamHandler := &nbdns.MockHandler{}
+	chain.AddHandler("example.com.", routeHandler, nbdns.PriorityDNSRoute)
+	chain.AddHandler("example.com.", upstreamHandler, nbdns.PriorityUpstream)
+
+	r := new(dns.Msg)
+	r.SetQuestion("example.com.", dns.TypeAAAA)
+
+	routeHandler.On("ServeDNS", mock.Anything, r).Run(func(args mock.Arguments) {
+		w := args.Get(0).(*nbdns.ResponseWriterChain)
+		resp := new(dns.Msg)
+		resp.SetRcode(r, dns.RcodeNameError)
+		resp.MsgHdr.Zero = true
+		assert.NoError(t, w.WriteMsg(resp))
+	}).Once()
+
+	upstreamHandler.On("ServeDNS", mock.Anything, r).Run(func(args mock.Arguments) {
+		w := args.Get(0).(*nbdns.ResponseWriterChain)
+		w.SetUpstream("10.0.0.53:53")
+		resp := new(dns.Msg)
+		resp.SetRcode(r, dns.RcodeNameError)
+		assert.NoError(t, w.WriteMsg(resp))
+	}).Once()
+
+	chain.ServeDNS(&test.MockResponseWriter{}, r)
+
+	unmatched := new(dns.Msg)
+	unmatched.SetQuestion("other.com.", dns.TypeA)
+	chain.ServeDNS(&test.MockResponseWriter{}, unmatched)
+
+	entries := chain.QueryLog()
+	require.Len(t, entries, 2)
+
+	assert.Equal(t, "example.com.", entries[0].Name)
+	assert.Equal(t, "AAAA", entries[0].Type)
+	assert.Equal(t, "upstream", entries[0].Handler)
+	assert.Equal(t, "example.com.", entries[0].Pattern)
+	assert.Equal(t, "10.0.0.53:53", entries[0].Upstream)
+	assert.Equal(t, "NXDOMAIN", entries[0].Rcode)
+
+	assert.Equal(t, "other.com.", entries[1].Name)
+	assert.Equal(t, "none", entries[1].Handler)
+	assert.Empty(t, entries[1].Upstream)
+	assert.Equal(t, "REFUSED", entries[1].Rcode)
+}
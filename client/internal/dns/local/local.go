@@ -5,6 +5,7 @@ import (
 	"slices"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/miekg/dns"
 	log "github.com/sirupsen/logrus"
@@ -15,16 +16,28 @@ import (
 	"github.com/netbirdio/netbird/shared/management/domain"
 )
 
+const (
+	// negativeTTL is the SOA minimum and TTL that bounds how long resolvers cache NXDOMAIN and NODATA responses
+	negativeTTL = 60
+	soaRefresh  = 7200
+	soaRetry    = 3600
+	soaExpire   = 1209600
+)
+
 type Resolver struct {
 	mu      sync.RWMutex
 	records map[dns.Question][]dns.RR
 	domains map[domain.Domain]struct{}
+	// zones are the apexes of the custom zones the resolver is authoritative for
+	zones  map[domain.Domain]struct{}
+	serial uint32
 }
 
 func NewResolver() *Resolver {
 	return &Resolver{
 		records: make(map[dns.Question][]dns.RR),
 		domains: make(map[domain.Domain]struct{}),
+		zones:   make(map[domain.Domain]struct{}),
 	}
 }
 
@@ -61,8 +74,15 @@ func (d *Resolver) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
 	replyMessage.SetReply(r)
 	replyMessage.RecursionAvailable = true
 
+	zone, inZone := d.findZone(question.Name)
+	replyMessage.Authoritative = inZone
+
 	// lookup all records matching the question
 	records := d.lookupRecords(question)
+	if len(records) == 0 && inZone && question.Qtype == dns.TypeSOA && question.Name == zone {
+		records = []dns.RR{d.soaRecord(zone)}
+	}
+
 	if len(records) > 0 {
 		replyMessage.Rcode = dns.RcodeSuccess
 		replyMessage.Answer = append(replyMessage.Answer, records...)
@@ -73,6 +93,11 @@ func (d *Resolver) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
 		} else {
 			replyMessage.Rcode = dns.RcodeNameError // NXDOMAIN
 		}
+
+		// negative responses carry the zone SOA so resolvers know how long to cache them, RFC 2308
+		if inZone {
+			replyMessage.Ns = append(replyMessage.Ns, d.soaRecord(zone))
+		}
 	}
 
 	if err := w.WriteMsg(replyMessage); err != nil {
@@ -89,6 +114,43 @@ func (d *Resolver) hasRecordsForDomain(domainName domain.Domain) bool {
 	return exists
 }
 
+// findZone returns the most specific zone that contains the name, the zones are stored in lowercase
+func (d *Resolver) findZone(name string) (string, bool) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	for labels := dns.SplitDomainName(strings.ToLower(dns.Fqdn(name))); len(labels) > 0; labels = labels[1:] {
+		candidate := dns.Fqdn(strings.Join(labels, "."))
+		if _, ok := d.zones[domain.Domain(candidate)]; ok {
+			return candidate, true
+		}
+	}
+	return "", false
+}
+
+// soaRecord synthesizes the SOA record of a zone, the serial changes with every update
+func (d *Resolver) soaRecord(zone string) *dns.SOA {
+	d.mu.RLock()
+	serial := d.serial
+	d.mu.RUnlock()
+
+	return &dns.SOA{
+		Hdr: dns.RR_Header{
+			Name:   zone,
+			Rrtype: dns.TypeSOA,
+			Class:  dns.ClassINET,
+			Ttl:    negativeTTL,
+		},
+		Ns:      zone,
+		Mbox:    "hostmaster." + zone,
+		Serial:  serial,
+		Refresh: soaRefresh,
+		Retry:   soaRetry,
+		Expire:  soaExpire,
+		Minttl:  negativeTTL,
+	}
+}
+
 // lookupRecords fetches *all* DNS records matching the first question in r.
 func (d *Resolver) lookupRecords(question dns.Question) []dns.RR {
 	d.mu.RLock()
@@ -122,18 +184,52 @@ func (d *Resolver) lookupRecords(question dns.Question) []dns.RR {
 	return recordsCopy
 }
 
+// Update replaces all records, the resolver isn't authoritative for any zone afterward
 func (d *Resolver) Update(update []nbdns.SimpleRecord) {
+	d.UpdateZones([]nbdns.CustomZone{{Records: update}})
+}
+
+// UpdateZones replaces all records with the records of the zones.
+// Negative responses for names in the zones are authoritative and include the zone SOA.
+func (d *Resolver) UpdateZones(customZones []nbdns.CustomZone) {
 	d.mu.Lock()
 	defer d.mu.Unlock()
 
 	maps.Clear(d.records)
 	maps.Clear(d.domains)
+	maps.Clear(d.zones)
+	d.serial = uint32(time.Now().Unix())
 
-	for _, rec := range update {
-		if err := d.registerRecord(rec); err != nil {
-			log.Warnf("failed to register the record (%s): %v", rec, err)
-			continue
+	for _, customZone := range customZones {
+		zone := strings.ToLower(dns.Fqdn(customZone.Domain))
+		if customZone.Domain != "" {
+			d.zones[domain.Domain(zone)] = struct{}{}
+			d.domains[domain.Domain(zone)] = struct{}{}
 		}
+
+		for _, rec := range customZone.Records {
+			if err := d.registerRecord(rec); err != nil {
+				log.Warnf("failed to register the record (%s): %v", rec, err)
+				continue
+			}
+			if customZone.Domain != "" {
+				d.registerEmptyNonTerminals(strings.ToLower(dns.Fqdn(rec.Name)), zone)
+			}
+		}
+	}
+}
+
+// registerEmptyNonTerminals registers the names between a record and its zone apex,
+// so that they get NODATA instead of NXDOMAIN responses, RFC 8020
+func (d *Resolver) registerEmptyNonTerminals(name, zone string) {
+	if !dns.IsSubDomain(zone, name) {
+		return
+	}
+
+	labels := dns.SplitDomainName(name)
+	zoneLabels := dns.CountLabel(zone)
+	for i := 1; len(labels)-i > zoneLabels; i++ {
+		d.domains[domain.Domain(dns.Fqdn(strings.Join(labels[i:], ".")))] = struct{}{}
 	}
 }
 
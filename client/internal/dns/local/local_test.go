@@ -582,3 +582,170 @@ func TestLocalResolver_NoErrorWithDifferentRecordType(t *testing.T) {
 		})
 	}
 }
+
+func TestLocalResolver_Zones(t *testing.T) {
+	resolver := NewResolver()
+	resolver.UpdateZones([]nbdns.CustomZone{
+		{
+			Domain: "corp.internal.",
+			Records: []nbdns.SimpleRecord{
+				{Name: "corp.internal.", Type: int(dns.TypeTXT), Class: nbdns.DefaultClass, TTL: 300, RData: `"v=spf1 -all"`},
+				{Name: "corp.internal.", Type: int(dns.TypeMX), Class: nbdns.DefaultClass, TTL: 300, RData: "10 mail.corp.internal."},
+				{Name: "_sip._tcp.corp.internal.", Type: int(dns.TypeSRV), Class: nbdns.DefaultClass, TTL: 300, RData: "10 5 5060 sip.corp.internal."},
+				{Name: "www.dev.corp.internal.", Type: int(dns.TypeA), Class: nbdns.DefaultClass, TTL: 300, RData: "100.64.0.10"},
+			},
+		},
+		{
+			Domain: "64.100.in-addr.arpa.",
+			Records: []nbdns.SimpleRecord{
+				{Name: "10.0.64.100.in-addr.arpa.", Type: int(dns.TypePTR), Class: nbdns.DefaultClass, TTL: 300, RData: "www.dev.corp.internal."},
+			},
+		},
+	})
+
+	testCases := []struct {
+		name          string
+		queryName     string
+		queryType     uint16
+		expectedRcode int
+		expectedType  uint16
+		expectedSOA   string
+	}{
+		{
+			name:          "TXT at the apex",
+			queryName:     "corp.internal.",
+			queryType:     dns.TypeTXT,
+			expectedRcode: dns.RcodeSuccess,
+			expectedType:  dns.TypeTXT,
+		},
+		{
+			name:          "MX at the apex",
+			queryName:     "CORP.internal",
+			queryType:     dns.TypeMX,
+			expectedRcode: dns.RcodeSuccess,
+			expectedType:  dns.TypeMX,
+		},
+		{
+			name:          "SRV",
+			queryName:     "_sip._tcp.corp.internal.",
+			queryType:     dns.TypeSRV,
+			expectedRcode: dns.RcodeSuccess,
+			expectedType:  dns.TypeSRV,
+		},
+		{
+			name:          "PTR",
+			queryName:     "10.0.64.100.in-addr.arpa.",
+			queryType:     dns.TypePTR,
+			expectedRcode: dns.RcodeSuccess,
+			expectedType:  dns.TypePTR,
+		},
+		{
+			name:          "SOA of the zone",
+			queryName:     "corp.internal.",
+			queryType:     dns.TypeSOA,
+			expectedRcode: dns.RcodeSuccess,
+			expectedType:  dns.TypeSOA,
+		},
+		{
+			name:          "NODATA for an existing name",
+			queryName:     "www.dev.corp.internal.",
+			queryType:     dns.TypeAAAA,
+			expectedRcode: dns.RcodeSuccess,
+			expectedSOA:   "corp.internal.",
+		},
+		{
+			name:          "NODATA for an empty non-terminal",
+			queryName:     "dev.corp.internal.",
+			queryType:     dns.TypeA,
+			expectedRcode: dns.RcodeSuccess,
+			expectedSOA:   "corp.internal.",
+		},
+		{
+			name:          "NXDOMAIN for a missing name",
+			queryName:     "missing.corp.internal.",
+			queryType:     dns.TypeA,
+			expectedRcode: dns.RcodeNameError,
+			expectedSOA:   "corp.internal.",
+		},
+		{
+			name:          "NXDOMAIN for a mixed-case name",
+			queryName:     "Missing.Corp.Internal.",
+			queryType:     dns.TypeA,
+			expectedRcode: dns.RcodeNameError,
+			expectedSOA:   "corp.internal.",
+		},
+		{
+			name:          "NXDOMAIN in the reverse zone",
+			queryName:     "11.0.64.100.in-addr.arpa.",
+			queryType:     dns.TypePTR,
+			expectedRcode: dns.RcodeNameError,
+			expectedSOA:   "64.100.in-addr.arpa.",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var responseMSG *dns.Msg
+			responseWriter := &test.MockResponseWriter{
+				WriteMsgFunc: func(m *dns.Msg) error {
+					responseMSG = m
+					return nil
+				},
+			}
+
+			resolver.ServeDNS(responseWriter, new(dns.Msg).SetQuestion(tc.queryName, tc.queryType))
+
+			require.NotNil(t, responseMSG)
+			assert.Equal(t, tc.expectedRcode, responseMSG.Rcode)
+			assert.True(t, responseMSG.Authoritative, "responses in custom zones should be authoritative")
+
+			if tc.expectedType != 0 {
+				require.NotEmpty(t, responseMSG.Answer)
+				assert.Equal(t, tc.expectedType, responseMSG.Answer[0].Header().Rrtype)
+				assert.Empty(t, responseMSG.Ns)
+				return
+			}
+
+			assert.Empty(t, responseMSG.Answer)
+			require.Len(t, responseMSG.Ns, 1)
+			soa, ok := responseMSG.Ns[0].(*dns.SOA)
+			require.True(t, ok, "authority section should contain the zone SOA")
+			assert.Equal(t, tc.expectedSOA, soa.Hdr.Name)
+			assert.Equal(t, uint32(negativeTTL), soa.Minttl)
+
+			_, err := responseMSG.Pack()
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestLocalResolver_FindZoneIgnoresCase(t *testing.T) {
+	resolver := NewResolver()
+	resolver.UpdateZones([]nbdns.CustomZone{{Domain: "Corp.Internal"}})
+
+	zone, ok := resolver.findZone("WWW.Dev.CORP.internal")
+	require.True(t, ok)
+	assert.Equal(t, "corp.internal.", zone)
+}
+
+func TestLocalResolver_UpdateWithoutZones(t *testing.T) {
+	resolver := NewResolver()
+	resolver.UpdateZones([]nbdns.CustomZone{{Domain: "corp.internal."}})
+	resolver.Update([]nbdns.SimpleRecord{
+		{Name: "peer.netbird.cloud.", Type: int(dns.TypeA), Class: nbdns.DefaultClass, TTL: 300, RData: "100.64.0.10"},
+	})
+
+	var responseMSG *dns.Msg
+	responseWriter := &test.MockResponseWriter{
+		WriteMsgFunc: func(m *dns.Msg) error {
+			responseMSG = m
+			return nil
+		},
+	}
+	resolver.ServeDNS(responseWriter, new(dns.Msg).SetQuestion("missing.corp.internal.", dns.TypeA))
+
+	require.NotNil(t, responseMSG)
+	assert.Equal(t, dns.RcodeNameError, responseMSG.Rcode)
+	assert.False(t, responseMSG.Authoritative, "zones should be replaced on update")
+	assert.Empty(t, responseMSG.Ns)
+}
@@ -0,0 +1,104 @@
+package dns
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+// DefaultQueryLogSize is the number of queries kept in the query log of the handler chain
+const DefaultQueryLogSize = 1000
+
+// QueryLogEntry describes a query served by the handler chain
+type QueryLogEntry struct {
+	Time time.Time
+	Name string
+	Type string
+	// Handler is the name of the handler priority that answered the query, e.g. local or upstream
+	Handler string
+	// Pattern is the domain pattern of the handler that answered the query
+	Pattern string
+	// Upstream is the nameserver that answered the query, if the handler forwarded it
+	Upstream string
+	Rcode    string
+	Latency  time.Duration
+}
+
+// QueryLog is a bounded ring of the most recent queries
+type QueryLog struct {
+	mu      sync.Mutex
+	entries []QueryLogEntry
+	next    int
+	full    bool
+}
+
+// NewQueryLog returns a query log that keeps the given number of queries
+func NewQueryLog(size int) *QueryLog {
+	if size <= 0 {
+		size = DefaultQueryLogSize
+	}
+	return &QueryLog{
+		entries: make([]QueryLogEntry, size),
+	}
+}
+
+// Add records a query, overwriting the oldest one when the log is full
+func (l *QueryLog) Add(entry QueryLogEntry) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.entries[l.next] = entry
+	l.next = (l.next + 1) % len(l.entries)
+	if l.next == 0 {
+		l.full = true
+	}
+}
+
+// Entries returns the recorded queries from the oldest to the newest
+func (l *QueryLog) Entries() []QueryLogEntry {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if !l.full {
+		entries := make([]QueryLogEntry, l.next)
+		copy(entries, l.entries[:l.next])
+		return entries
+	}
+
+	entries := make([]QueryLogEntry, 0, len(l.entries))
+	entries = append(entries, l.entries[l.next:]...)
+	return append(entries, l.entries[:l.next]...)
+}
+
+// handlerName returns a human-readable name of the handler priority
+func handlerName(priority int) string {
+	switch priority {
+	case PriorityMgmtCache:
+		return "management"
+	case PriorityLocal:
+		return "local"
+	case PriorityBlocklist:
+		return "blocklist"
+	case PriorityDNSRoute:
+		return "route"
+	case PriorityUpstream:
+		return "upstream"
+	case PriorityDefault:
+		return "default"
+	case PriorityFallback:
+		return "fallback"
+	default:
+		return fmt.Sprintf("priority %d", priority)
+	}
+}
+
+func newQueryLogEntry(r *dns.Msg, start time.Time) QueryLogEntry {
+	question := r.Question[0]
+	return QueryLogEntry{
+		Time: start,
+		Name: question.Name,
+		Type: dns.Type(question.Qtype).String(),
+	}
+}
@@ -0,0 +1,32 @@
+package dns
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueryLog_Entries(t *testing.T) {
+	queryLog := NewQueryLog(3)
+	assert.Empty(t, queryLog.Entries())
+
+	for i := 0; i < 2; i++ {
+		queryLog.Add(QueryLogEntry{Name: fmt.Sprintf("q%d.example.com.", i)})
+	}
+	assert.Equal(t, []string{"q0.example.com.", "q1.example.com."}, queryLogNames(queryLog.Entries()))
+
+	for i := 2; i < 7; i++ {
+		queryLog.Add(QueryLogEntry{Name: fmt.Sprintf("q%d.example.com.", i)})
+	}
+	assert.Equal(t, []string{"q4.example.com.", "q5.example.com.", "q6.example.com."}, queryLogNames(queryLog.Entries()),
+		"the oldest queries should be overwritten")
+}
+
+func queryLogNames(entries []QueryLogEntry) []string {
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		names = append(names, entry.Name)
+	}
+	return names
+}
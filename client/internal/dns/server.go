@@ -16,6 +16,7 @@ import (
 	"golang.org/x/exp/maps"
 
 	"github.com/netbirdio/netbird/client/iface/netstack"
+	"github.com/netbirdio/netbird/client/internal/dns/blocklist"
 	dnsconfig "github.com/netbirdio/netbird/client/internal/dns/config"
 	"github.com/netbirdio/netbird/client/internal/dns/local"
 	"github.com/netbirdio/netbird/client/internal/dns/mgmt"
@@ -50,6 +51,7 @@ type Server interface {
 	ProbeAvailability()
 	UpdateServerConfig(domains dnsconfig.ServerDomains) error
 	PopulateManagementDomain(mgmtURL *url.URL) error
+	QueryLog() []QueryLogEntry
 }
 
 type nsGroupsByDomain struct {
@@ -83,6 +85,7 @@ type DefaultServer struct {
 	extraDomains       map[domain.Domain]int
 
 	mgmtCacheResolver *mgmt.Resolver
+	blocklistResolver *blocklist.Resolver
 
 	// permanent related properties
 	permanent      bool
@@ -206,6 +209,7 @@ func newDefaultServer(
 		hostsDNSHolder:    newHostsDNSHolder(),
 		hostManager:       &noopHostConfigurator{},
 		mgmtCacheResolver: mgmtCacheResolver,
+		blocklistResolver: blocklist.NewResolver(statusRecorder),
 	}
 
 	// register with root zone, handler chain takes care of the routing
@@ -417,6 +421,11 @@ func (s *DefaultServer) UpdateDNSServer(serial uint64, update nbdns.Config) erro
 	return nil
 }
 
+// QueryLog returns the most recent queries served by the DNS server
+func (s *DefaultServer) QueryLog() []QueryLogEntry {
+	return s.handlerChain.QueryLog()
+}
+
 func (s *DefaultServer) SearchDomains() []string {
 	var searchDomains []string
 
@@ -481,7 +490,7 @@ func (s *DefaultServer) applyConfiguration(update nbdns.Config) error {
 		}
 	}
 
-	localMuxUpdates, localRecords, err := s.buildLocalHandlerUpdate(update.CustomZones)
+	localMuxUpdates, localZones, err := s.buildLocalHandlerUpdate(update.CustomZones)
 	if err != nil {
 		return fmt.Errorf("local handler updater: %w", err)
 	}
@@ -495,7 +504,9 @@ func (s *DefaultServer) applyConfiguration(update nbdns.Config) error {
 	s.updateMux(muxUpdates)
 
 	// register local records
-	s.localResolver.Update(localRecords)
+	s.localResolver.UpdateZones(localZones)
+
+	s.updateBlocklists(update.Blocklists)
 
 	s.currentConfig = dnsConfigToHostDNSConfig(update, s.service.RuntimeIP(), s.service.RuntimePort())
 
@@ -523,6 +534,20 @@ func (s *DefaultServer) applyConfiguration(update nbdns.Config) error {
 	return nil
 }
 
+// updateBlocklists enforces the blocklists for all queries served by the handler chain
+func (s *DefaultServer) updateBlocklists(blocklists []nbdns.Blocklist) {
+	if s.blocklistResolver == nil {
+		return
+	}
+	s.blocklistResolver.Update(blocklists)
+
+	if len(blocklists) == 0 {
+		s.handlerChain.RemoveHandler(nbdns.RootZone, PriorityBlocklist)
+		return
+	}
+	s.handlerChain.AddHandler(nbdns.RootZone, s.blocklistResolver, PriorityBlocklist)
+}
+
 func (s *DefaultServer) isUsingNoopHostManager() bool {
 	_, isNoop := s.hostManager.(*noopHostConfigurator)
 	return isNoop
@@ -633,9 +658,9 @@ func (s *DefaultServer) registerFallback(config HostDNSConfig) {
 	s.registerHandler([]string{nbdns.RootZone}, handler, PriorityFallback)
 }
 
-func (s *DefaultServer) buildLocalHandlerUpdate(customZones []nbdns.CustomZone) ([]handlerWrapper, []nbdns.SimpleRecord, error) {
+func (s *DefaultServer) buildLocalHandlerUpdate(customZones []nbdns.CustomZone) ([]handlerWrapper, []nbdns.CustomZone, error) {
 	var muxUpdates []handlerWrapper
-	var localRecords []nbdns.SimpleRecord
+	var localZones []nbdns.CustomZone
 
 	for _, customZone := range customZones {
 		if len(customZone.Records) == 0 {
@@ -649,17 +674,19 @@ func (s *DefaultServer) buildLocalHandlerUpdate(customZones []nbdns.CustomZone)
 			priority: PriorityLocal,
 		})
 
+		localZone := nbdns.CustomZone{Domain: customZone.Domain}
 		for _, record := range customZone.Records {
 			if record.Class != nbdns.DefaultClass {
 				log.Warnf("received an invalid class type: %s", record.Class)
 				continue
 			}
-			// zone records contain the fqdn, so we can just flatten them
-			localRecords = append(localRecords, record)
+			// zone records contain the fqdn
+			localZone.Records = append(localZone.Records, record)
 		}
+		localZones = append(localZones, localZone)
 	}
 
-	return muxUpdates, localRecords, nil
+	return muxUpdates, localZones, nil
 }
 
 func (s *DefaultServer) buildUpstreamHandlerUpdate(nameServerGroups []*nbdns.NameServerGroup) ([]handlerWrapper, error) {
@@ -727,9 +754,12 @@ func (s *DefaultServer) createHandlersForDomainGroup(domainGroup nsGroupsByDomai
 		}
 
 		for _, ns := range nsGroup.NameServers {
-			if ns.NSType != nbdns.UDPNameServerType {
-				log.Warnf("skipping nameserver %s with type %s, this peer supports only %s",
-					ns.IP.String(), ns.NSType.String(), nbdns.UDPNameServerType.String())
+			switch ns.NSType {
+			case nbdns.UDPNameServerType, nbdns.DoTNameServerType, nbdns.DoHNameServerType:
+			default:
+				log.Warnf("skipping nameserver %s with type %s, this peer supports only %s, %s and %s",
+					ns.IP.String(), ns.NSType.String(), nbdns.UDPNameServerType.String(),
+					nbdns.DoTNameServerType.String(), nbdns.DoHNameServerType.String())
 				continue
 			}
 
@@ -738,7 +768,10 @@ func (s *DefaultServer) createHandlersForDomainGroup(domainGroup nsGroupsByDomai
 				continue
 			}
 
-			handler.upstreamServers = append(handler.upstreamServers, ns.AddrPort())
+			if err := handler.addUpstream(ns); err != nil {
+				log.Warnf("skipping nameserver %s: %v", ns.String(), err)
+				continue
+			}
 		}
 
 		if len(handler.upstreamServers) == 0 {
@@ -24,6 +24,7 @@ import (
 	"github.com/netbirdio/netbird/client/internal/dns/types"
 	"github.com/netbirdio/netbird/client/internal/peer"
 	"github.com/netbirdio/netbird/client/proto"
+	nbdns "github.com/netbirdio/netbird/dns"
 )
 
 var currentMTU uint16 = iface.DefaultMTU
@@ -48,6 +49,12 @@ type upstreamClient interface {
 	exchange(ctx context.Context, upstream string, r *dns.Msg) (*dns.Msg, time.Duration, error)
 }
 
+// upstreamDialer is implemented by the upstream clients of platforms that need specific sockets for upstreams,
+// the connections of encrypted upstreams are dialed with it
+type upstreamDialer interface {
+	dialUpstream(ctx context.Context, network string, upstream netip.AddrPort) (net.Conn, error)
+}
+
 type UpstreamResolver interface {
 	serveDNS(r *dns.Msg) (*dns.Msg, time.Duration, error)
 	upstreamExchange(upstream string, r *dns.Msg) (*dns.Msg, time.Duration, error)
@@ -68,6 +75,9 @@ type upstreamResolverBase struct {
 	deactivate     func(error)
 	reactivate     func()
 	statusRecorder *peer.Status
+
+	// transports holds the encrypted upstream servers, the others are queried with the upstream client
+	transports map[netip.AddrPort]upstreamTransport
 }
 
 func newUpstreamResolverBase(ctx context.Context, statusRecorder *peer.Status, domain string) *upstreamResolverBase {
@@ -96,7 +106,7 @@ func (u *upstreamResolverBase) ID() types.HandlerID {
 	hash := sha256.New()
 	hash.Write([]byte(u.domain + ":"))
 	for _, s := range servers {
-		hash.Write([]byte(s.String()))
+		hash.Write([]byte(u.upstreamString(s)))
 		hash.Write([]byte("|"))
 	}
 	return types.HandlerID("upstream-" + hex.EncodeToString(hash.Sum(nil)[:8]))
@@ -109,6 +119,10 @@ func (u *upstreamResolverBase) MatchSubdomains() bool {
 func (u *upstreamResolverBase) Stop() {
 	log.Debugf("stopping serving DNS for upstreams %s", u.upstreamServers)
 	u.cancel()
+
+	for _, transport := range u.transports {
+		transport.close()
+	}
 }
 
 // ServeDNS handles a DNS request
@@ -169,7 +183,7 @@ func (u *upstreamResolverBase) queryUpstream(w dns.ResponseWriter, r *dns.Msg, u
 		ctx, cancel := context.WithTimeout(u.ctx, timeout)
 		defer cancel()
 		startTime = time.Now()
-		rm, t, err = u.upstreamClient.exchange(ctx, upstream.String(), r)
+		rm, t, err = u.exchange(ctx, upstream, r)
 	}()
 
 	if err != nil {
@@ -204,6 +218,10 @@ func (u *upstreamResolverBase) writeSuccessResponse(w dns.ResponseWriter, rm *dn
 	u.successCount.Add(1)
 	logger.Tracef("took %s to query the upstream %s for question domain=%s", t, upstream, domain)
 
+	if chainWriter, ok := w.(*ResponseWriterChain); ok {
+		chainWriter.SetUpstream(u.upstreamString(upstream))
+	}
+
 	if err := w.WriteMsg(rm); err != nil {
 		logger.Errorf("failed to write DNS response for question domain=%s: %s", domain, err)
 	}
@@ -351,7 +369,7 @@ func (u *upstreamResolverBase) disable(err error) {
 func (u *upstreamResolverBase) upstreamServersString() string {
 	var servers []string
 	for _, server := range u.upstreamServers {
-		servers = append(servers, server.String())
+		servers = append(servers, u.upstreamString(server))
 	}
 	return strings.Join(servers, ", ")
 }
@@ -362,10 +380,51 @@ func (u *upstreamResolverBase) testNameserver(server netip.AddrPort, timeout tim
 
 	r := new(dns.Msg).SetQuestion(testRecord, dns.TypeSOA)
 
-	_, _, err := u.upstreamClient.exchange(ctx, server.String(), r)
+	_, _, err := u.exchange(ctx, server, r)
 	return err
 }
 
+// addUpstream adds a nameserver to the upstream servers, encrypted nameservers are queried with their transport
+func (u *upstreamResolverBase) addUpstream(ns nbdns.NameServer) error {
+	transport, err := newUpstreamTransport(ns, u.dialUpstream)
+	if err != nil {
+		return err
+	}
+
+	if transport != nil {
+		if u.transports == nil {
+			u.transports = make(map[netip.AddrPort]upstreamTransport)
+		}
+		u.transports[ns.AddrPort()] = transport
+	}
+	u.upstreamServers = append(u.upstreamServers, ns.AddrPort())
+
+	return nil
+}
+
+// dialUpstream dials encrypted upstreams with the dialer of the platform upstream client, if it has one
+func (u *upstreamResolverBase) dialUpstream(ctx context.Context, network string, upstream netip.AddrPort) (net.Conn, error) {
+	if dialer, ok := u.upstreamClient.(upstreamDialer); ok {
+		return dialer.dialUpstream(ctx, network, upstream)
+	}
+	return defaultUpstreamDial(ctx, network, upstream)
+}
+
+func (u *upstreamResolverBase) exchange(ctx context.Context, upstream netip.AddrPort, r *dns.Msg) (*dns.Msg, time.Duration, error) {
+	if transport, ok := u.transports[upstream]; ok {
+		return transport.exchange(ctx, r)
+	}
+	return u.upstreamClient.exchange(ctx, upstream.String(), r)
+}
+
+// upstreamString returns the url of encrypted upstream servers and the address of the others
+func (u *upstreamResolverBase) upstreamString(upstream netip.AddrPort) string {
+	if transport, ok := u.transports[upstream]; ok {
+		return transport.String()
+	}
+	return upstream.String()
+}
+
 // ExchangeWithFallback exchanges a DNS message with the upstream server.
 // It first tries to use UDP, and if it is truncated, it falls back to TCP.
 // If the passed context is nil, this will use Exchange instead of ExchangeContext.
@@ -62,23 +62,34 @@ func (u *upstreamResolver) exchangeWithoutVPN(ctx context.Context, upstream stri
 	if deadline, ok := ctx.Deadline(); ok {
 		timeout = time.Until(deadline)
 	}
-	dialTimeout := timeout
 
+	upstreamExchangeClient := &dns.Client{
+		Dialer:  protectedDialer(timeout),
+		Timeout: timeout,
+	}
+
+	return upstreamExchangeClient.ExchangeContext(ctx, r, upstream)
+}
+
+// dialUpstream dials encrypted upstreams, like exchange the connections to local resolvers are protected to avoid
+// going through the VPN
+func (u *upstreamResolver) dialUpstream(ctx context.Context, network string, upstream netip.AddrPort) (net.Conn, error) {
+	if !u.isLocalResolver(upstream.String()) {
+		return defaultUpstreamDial(ctx, network, upstream)
+	}
+	return protectedDialer(ClientTimeout).DialContext(ctx, network, upstream.String())
+}
+
+// protectedDialer returns a dialer whose sockets are protected by Android SDK
+func protectedDialer(timeout time.Duration) *net.Dialer {
 	nbDialer := nbnet.NewDialer()
 
-	dialer := &net.Dialer{
+	return &net.Dialer{
 		Control: func(network, address string, c syscall.RawConn) error {
 			return nbDialer.Control(network, address, c)
 		},
-		Timeout: dialTimeout,
-	}
-
-	upstreamExchangeClient := &dns.Client{
-		Dialer:  dialer,
 		Timeout: timeout,
 	}
-
-	return upstreamExchangeClient.ExchangeContext(ctx, r, upstream)
 }
 
 func (u *upstreamResolver) isLocalResolver(upstream string) bool {
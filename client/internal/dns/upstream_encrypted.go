@@ -0,0 +1,321 @@
+package dns
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/miekg/dns"
+
+	nbdns "github.com/netbirdio/netbird/dns"
+)
+
+const (
+	dohContentType = "application/dns-message"
+
+	// dotMaxIdleConns is the number of idle connections kept per DoT upstream
+	dotMaxIdleConns = 2
+	// dotIdleConnTimeout is shorter than the idle timeout of common DoT servers, RFC 7766 recommends a few seconds
+	dotIdleConnTimeout = 10 * time.Second
+)
+
+// upstreamDialFunc dials a connection to an encrypted upstream, platforms use it to keep connections out of the tunnel
+type upstreamDialFunc func(ctx context.Context, network string, upstream netip.AddrPort) (net.Conn, error)
+
+// defaultUpstreamDial dials upstreams without any platform specific socket options
+func defaultUpstreamDial(ctx context.Context, network string, upstream netip.AddrPort) (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: ClientTimeout}
+	return dialer.DialContext(ctx, network, upstream.String())
+}
+
+// upstreamTransport exchanges DNS messages with a single encrypted upstream
+type upstreamTransport interface {
+	exchange(ctx context.Context, r *dns.Msg) (*dns.Msg, time.Duration, error)
+	// close releases idle connections of the transport
+	close()
+	String() string
+}
+
+// newUpstreamTransport returns the transport of an encrypted nameserver, nil is returned for plain nameservers
+func newUpstreamTransport(ns nbdns.NameServer, dial upstreamDialFunc) (upstreamTransport, error) {
+	if !ns.NSType.IsEncrypted() {
+		return nil, nil //nolint:nilnil
+	}
+
+	if err := ns.Validate(); err != nil {
+		return nil, err
+	}
+
+	tlsConfig, err := upstreamTLSConfig(ns)
+	if err != nil {
+		return nil, err
+	}
+
+	switch ns.NSType {
+	case nbdns.DoTNameServerType:
+		return newDoTTransport(ns, tlsConfig, dial), nil
+	case nbdns.DoHNameServerType:
+		return newDoHTransport(ns, tlsConfig, dial), nil
+	default:
+		return nil, fmt.Errorf("unsupported nameserver type %s", ns.NSType)
+	}
+}
+
+// upstreamTLSConfig verifies the certificate against the server name, or the IP if there is none.
+// Pinned nameservers are trusted if one of the presented certificates has the pinned public key instead.
+func upstreamTLSConfig(ns nbdns.NameServer) (*tls.Config, error) {
+	serverName := ns.ServerName
+	if serverName == "" {
+		serverName = ns.IP.String()
+	}
+
+	tlsConfig := &tls.Config{
+		ServerName: serverName,
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if ns.Pin == "" {
+		return tlsConfig, nil
+	}
+
+	pin, err := base64.StdEncoding.DecodeString(ns.Pin)
+	if err != nil {
+		return nil, fmt.Errorf("decode pin: %w", err)
+	}
+
+	// certificates are verified by pin only, which allows self-signed certificates
+	tlsConfig.InsecureSkipVerify = true
+	tlsConfig.VerifyConnection = func(state tls.ConnectionState) error {
+		return verifyPin(state.PeerCertificates, pin)
+	}
+
+	return tlsConfig, nil
+}
+
+func verifyPin(certificates []*x509.Certificate, pin []byte) error {
+	for _, certificate := range certificates {
+		hash := sha256.Sum256(certificate.RawSubjectPublicKeyInfo)
+		if bytes.Equal(hash[:], pin) {
+			return nil
+		}
+	}
+	return errors.New("no certificate of the nameserver matches the pinned public key")
+}
+
+// dotTransport sends queries over DNS-over-TLS, RFC 7858.
+// Connections are reused for subsequent queries as long as they are idle for less than dotIdleConnTimeout.
+type dotTransport struct {
+	upstream  netip.AddrPort
+	tlsConfig *tls.Config
+	dial      upstreamDialFunc
+	client    *dns.Client
+	url       string
+
+	mu     sync.Mutex
+	idle   []*dotConn
+	closed bool
+}
+
+type dotConn struct {
+	*dns.Conn
+	idleSince time.Time
+}
+
+func newDoTTransport(ns nbdns.NameServer, tlsConfig *tls.Config, dial upstreamDialFunc) *dotTransport {
+	return &dotTransport{
+		upstream:  ns.AddrPort(),
+		tlsConfig: tlsConfig,
+		dial:      dial,
+		client: &dns.Client{
+			Net:     "tcp-tls",
+			Timeout: ClientTimeout,
+		},
+		url: ns.String(),
+	}
+}
+
+func (t *dotTransport) exchange(ctx context.Context, r *dns.Msg) (*dns.Msg, time.Duration, error) {
+	conn := t.getConn()
+	reused := conn != nil
+	if !reused {
+		var err error
+		if conn, err = t.dialConn(ctx); err != nil {
+			return nil, 0, fmt.Errorf("with tls: %w", err)
+		}
+	}
+
+	rm, rtt, err := t.client.ExchangeWithConnContext(ctx, r, conn.Conn)
+	if err != nil && reused && ctx.Err() == nil {
+		// the server may have closed the idle connection, retry once with a new one
+		_ = conn.Close()
+		if conn, err = t.dialConn(ctx); err != nil {
+			return nil, rtt, fmt.Errorf("with tls: %w", err)
+		}
+		rm, rtt, err = t.client.ExchangeWithConnContext(ctx, r, conn.Conn)
+	}
+	if err != nil {
+		_ = conn.Close()
+		return nil, rtt, fmt.Errorf("with tls: %w", err)
+	}
+
+	t.putConn(conn)
+	return rm, rtt, nil
+}
+
+func (t *dotTransport) dialConn(ctx context.Context) (*dotConn, error) {
+	rawConn, err := t.dial(ctx, "tcp", t.upstream)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConn := tls.Client(rawConn, t.tlsConfig)
+	if err := tlsConn.HandshakeContext(ctx); err != nil {
+		_ = rawConn.Close()
+		return nil, err
+	}
+
+	return &dotConn{Conn: &dns.Conn{Conn: tlsConn}}, nil
+}
+
+// getConn returns the most recently used idle connection, expired connections are closed
+func (t *dotTransport) getConn() *dotConn {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for len(t.idle) > 0 {
+		conn := t.idle[len(t.idle)-1]
+		t.idle = t.idle[:len(t.idle)-1]
+		if time.Since(conn.idleSince) < dotIdleConnTimeout {
+			return conn
+		}
+		_ = conn.Close()
+	}
+	return nil
+}
+
+func (t *dotTransport) putConn(conn *dotConn) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.closed || len(t.idle) >= dotMaxIdleConns {
+		_ = conn.Close()
+		return
+	}
+	conn.idleSince = time.Now()
+	t.idle = append(t.idle, conn)
+}
+
+func (t *dotTransport) close() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.closed = true
+	for _, conn := range t.idle {
+		_ = conn.Close()
+	}
+	t.idle = nil
+}
+
+func (t *dotTransport) String() string {
+	return t.url
+}
+
+// dohTransport sends queries over DNS-over-HTTPS, RFC 8484.
+// Connections always go to the nameserver IP, the server name is only used for the URL and TLS.
+type dohTransport struct {
+	client *http.Client
+	url    string
+}
+
+func newDoHTransport(ns nbdns.NameServer, tlsConfig *tls.Config, dial upstreamDialFunc) *dohTransport {
+	upstream := ns.AddrPort()
+	transport := &http.Transport{
+		DialContext: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			return dial(ctx, network, upstream)
+		},
+		TLSClientConfig:     tlsConfig,
+		ForceAttemptHTTP2:   true,
+		TLSHandshakeTimeout: ClientTimeout,
+		IdleConnTimeout:     reactivatePeriod,
+		MaxIdleConnsPerHost: 2,
+	}
+
+	queryURL := url.URL{
+		Scheme: "https",
+		Host:   net.JoinHostPort(tlsConfig.ServerName, strconv.Itoa(ns.Port)),
+		Path:   ns.Path,
+	}
+
+	return &dohTransport{
+		client: &http.Client{
+			Transport: transport,
+			Timeout:   ClientTimeout,
+		},
+		url: queryURL.String(),
+	}
+}
+
+func (t *dohTransport) exchange(ctx context.Context, r *dns.Msg) (*dns.Msg, time.Duration, error) {
+	// the message ID should be 0 to make responses cacheable by HTTP caches
+	query := r.Copy()
+	query.Id = 0
+	packed, err := query.Pack()
+	if err != nil {
+		return nil, 0, fmt.Errorf("pack query: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.url, bytes.NewReader(packed))
+	if err != nil {
+		return nil, 0, fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Content-Type", dohContentType)
+	req.Header.Set("Accept", dohContentType)
+
+	start := time.Now()
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return nil, time.Since(start), fmt.Errorf("with https: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, time.Since(start), fmt.Errorf("with https: unexpected status %s", resp.Status)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, dns.MaxMsgSize))
+	if err != nil {
+		return nil, time.Since(start), fmt.Errorf("read response: %w", err)
+	}
+	rtt := time.Since(start)
+
+	rm := new(dns.Msg)
+	if err := rm.Unpack(body); err != nil {
+		return nil, rtt, fmt.Errorf("unpack response: %w", err)
+	}
+	rm.Id = r.Id
+
+	return rm, rtt, nil
+}
+
+func (t *dohTransport) close() {
+	t.client.CloseIdleConnections()
+}
+
+func (t *dohTransport) String() string {
+	return t.url
+}
@@ -0,0 +1,267 @@
+package dns
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	nbdns "github.com/netbirdio/netbird/dns"
+)
+
+const encryptedTestServerName = "dns.example.com"
+
+// newTestCertificate returns a self-signed certificate for the test server name and the pin of its public key
+func newTestCertificate(t *testing.T) (tls.Certificate, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: encryptedTestServerName},
+		DNSNames:     []string{encryptedTestServerName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	certificate, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	pin := sha256.Sum256(certificate.RawSubjectPublicKeyInfo)
+
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, base64.StdEncoding.EncodeToString(pin[:])
+}
+
+func answerTestQuery(r *dns.Msg) *dns.Msg {
+	m := new(dns.Msg)
+	m.SetReply(r)
+	rr, _ := dns.NewRR(r.Question[0].Name + " 60 IN A 10.0.0.1")
+	m.Answer = append(m.Answer, rr)
+	return m
+}
+
+func startDoTServer(t *testing.T, certificate tls.Certificate) netip.AddrPort {
+	t.Helper()
+
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{Certificates: []tls.Certificate{certificate}})
+	require.NoError(t, err)
+
+	server := &dns.Server{
+		Listener: listener,
+		Net:      "tcp-tls",
+		Handler: dns.HandlerFunc(func(w dns.ResponseWriter, r *dns.Msg) {
+			_ = w.WriteMsg(answerTestQuery(r))
+		}),
+	}
+	go func() {
+		_ = server.ActivateAndServe()
+	}()
+	t.Cleanup(func() {
+		_ = server.Shutdown()
+	})
+
+	return netip.MustParseAddrPort(listener.Addr().String())
+}
+
+func startDoHServer(t *testing.T, certificate tls.Certificate) netip.AddrPort {
+	t.Helper()
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host, _, _ := net.SplitHostPort(r.Host)
+		if r.URL.Path != nbdns.DefaultDoHPath || r.Header.Get("Content-Type") != dohContentType || host != encryptedTestServerName {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		query := new(dns.Msg)
+		if err := query.Unpack(body); err != nil || query.Id != 0 {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		packed, err := answerTestQuery(query).Pack()
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", dohContentType)
+		_, _ = w.Write(packed)
+	}))
+	server.TLS = &tls.Config{Certificates: []tls.Certificate{certificate}}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	return netip.MustParseAddrPort(server.Listener.Addr().String())
+}
+
+func TestUpstreamTransport_Exchange(t *testing.T) {
+	certificate, pin := newTestCertificate(t)
+	_, otherPin := newTestCertificate(t)
+
+	dotServer := startDoTServer(t, certificate)
+	dohServer := startDoHServer(t, certificate)
+
+	testCases := []struct {
+		name    string
+		ns      nbdns.NameServer
+		wantErr bool
+	}{
+		{
+			name: "DoT with pin",
+			ns: nbdns.NameServer{
+				IP: dotServer.Addr(), Port: int(dotServer.Port()), NSType: nbdns.DoTNameServerType,
+				ServerName: encryptedTestServerName, Pin: pin,
+			},
+		},
+		{
+			name: "DoT with other pin",
+			ns: nbdns.NameServer{
+				IP: dotServer.Addr(), Port: int(dotServer.Port()), NSType: nbdns.DoTNameServerType,
+				ServerName: encryptedTestServerName, Pin: otherPin,
+			},
+			wantErr: true,
+		},
+		{
+			name: "DoT with untrusted certificate",
+			ns: nbdns.NameServer{
+				IP: dotServer.Addr(), Port: int(dotServer.Port()), NSType: nbdns.DoTNameServerType,
+				ServerName: encryptedTestServerName,
+			},
+			wantErr: true,
+		},
+		{
+			name: "DoH with pin",
+			ns: nbdns.NameServer{
+				IP: dohServer.Addr(), Port: int(dohServer.Port()), NSType: nbdns.DoHNameServerType,
+				ServerName: encryptedTestServerName, Path: nbdns.DefaultDoHPath, Pin: pin,
+			},
+		},
+		{
+			name: "DoH with other pin",
+			ns: nbdns.NameServer{
+				IP: dohServer.Addr(), Port: int(dohServer.Port()), NSType: nbdns.DoHNameServerType,
+				ServerName: encryptedTestServerName, Path: nbdns.DefaultDoHPath, Pin: otherPin,
+			},
+			wantErr: true,
+		},
+		{
+			name: "DoH with wrong path",
+			ns: nbdns.NameServer{
+				IP: dohServer.Addr(), Port: int(dohServer.Port()), NSType: nbdns.DoHNameServerType,
+				ServerName: encryptedTestServerName, Path: "/resolve", Pin: pin,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			transport, err := newUpstreamTransport(tc.ns, defaultUpstreamDial)
+			require.NoError(t, err)
+			require.NotNil(t, transport)
+			defer transport.close()
+
+			ctx, cancel := context.WithTimeout(context.Background(), UpstreamTimeout)
+			defer cancel()
+
+			query := new(dns.Msg).SetQuestion("netbird.example.", dns.TypeA)
+			rm, _, err := transport.exchange(ctx, query)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, query.Id, rm.Id)
+			require.Len(t, rm.Answer, 1)
+			assert.Equal(t, "10.0.0.1", rm.Answer[0].(*dns.A).A.String())
+		})
+	}
+}
+
+func TestDoTTransport_ReusesConnections(t *testing.T) {
+	certificate, pin := newTestCertificate(t)
+	dotServer := startDoTServer(t, certificate)
+
+	var dials atomic.Int32
+	dial := func(ctx context.Context, network string, upstream netip.AddrPort) (net.Conn, error) {
+		dials.Add(1)
+		return defaultUpstreamDial(ctx, network, upstream)
+	}
+
+	transport, err := newUpstreamTransport(nbdns.NameServer{
+		IP: dotServer.Addr(), Port: int(dotServer.Port()), NSType: nbdns.DoTNameServerType,
+		ServerName: encryptedTestServerName, Pin: pin,
+	}, dial)
+	require.NoError(t, err)
+	defer transport.close()
+
+	for i := 0; i < 3; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), UpstreamTimeout)
+		_, _, err := transport.exchange(ctx, new(dns.Msg).SetQuestion("netbird.example.", dns.TypeA))
+		cancel()
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int32(1), dials.Load(), "sequential queries should reuse the connection")
+
+	// connections closed by the server are replaced transparently
+	for _, conn := range transport.(*dotTransport).idle {
+		_ = conn.Close()
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), UpstreamTimeout)
+	defer cancel()
+	_, _, err = transport.exchange(ctx, new(dns.Msg).SetQuestion("netbird.example.", dns.TypeA))
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), dials.Load())
+}
+
+func TestUpstreamResolver_EncryptedUpstream(t *testing.T) {
+	certificate, pin := newTestCertificate(t)
+	dotServer := startDoTServer(t, certificate)
+
+	resolver := newUpstreamResolverBase(context.Background(), nil, ".")
+	resolver.upstreamClient = &mockUpstreamResolver{err: context.DeadlineExceeded}
+
+	plain := nbdns.NameServer{IP: netip.MustParseAddr("127.0.0.1"), Port: 53, NSType: nbdns.UDPNameServerType}
+	encrypted := nbdns.NameServer{
+		IP: dotServer.Addr(), Port: int(dotServer.Port()), NSType: nbdns.DoTNameServerType,
+		ServerName: encryptedTestServerName, Pin: pin,
+	}
+	require.NoError(t, resolver.addUpstream(plain))
+	require.NoError(t, resolver.addUpstream(encrypted))
+	defer resolver.Stop()
+
+	assert.Error(t, resolver.testNameserver(plain.AddrPort(), probeTimeout), "plain upstream should use the upstream client")
+	assert.NoError(t, resolver.testNameserver(encrypted.AddrPort(), probeTimeout), "encrypted upstream should use its transport")
+	assert.Contains(t, resolver.upstreamServersString(), "dot://")
+
+	invalid := encrypted
+	invalid.Pin = "invalid"
+	assert.Error(t, resolver.addUpstream(invalid))
+}
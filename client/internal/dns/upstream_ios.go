@@ -79,9 +79,42 @@ func (u *upstreamResolverIOS) exchange(ctx context.Context, upstream string, r *
 	return ExchangeWithFallback(nil, client, r, upstream)
 }
 
+// dialUpstream dials encrypted upstreams, like exchange the connections to private upstreams are bound to the interface
+func (u *upstreamResolverIOS) dialUpstream(ctx context.Context, network string, upstream netip.AddrPort) (net.Conn, error) {
+	upstreamIP := upstream.Addr().Unmap()
+	if !u.lNet.Contains(upstreamIP) && !upstreamIP.IsPrivate() {
+		return defaultUpstreamDial(ctx, network, upstream)
+	}
+
+	log.Debugf("using private dialer to connect to upstream: %s", upstream)
+	dialer, err := getPrivateDialer(u.interfaceName, ClientTimeout, &net.TCPAddr{IP: u.lIP.AsSlice()})
+	if err != nil {
+		return nil, fmt.Errorf("error while creating private dialer: %s", err)
+	}
+	return dialer.DialContext(ctx, network, upstream.String())
+}
+
 // GetClientPrivate returns a new DNS client bound to the local IP address of the Netbird interface
 // This method is needed for iOS
 func GetClientPrivate(ip netip.Addr, interfaceName string, dialTimeout time.Duration) (*dns.Client, error) {
+	localAddr := &net.UDPAddr{
+		IP:   ip.AsSlice(),
+		Port: 0, // Let the OS pick a free port
+	}
+	dialer, err := getPrivateDialer(interfaceName, dialTimeout, localAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	client := &dns.Client{
+		Dialer:  dialer,
+		Timeout: dialTimeout,
+	}
+	return client, nil
+}
+
+// getPrivateDialer returns a dialer bound to the local address and the Netbird interface
+func getPrivateDialer(interfaceName string, dialTimeout time.Duration, localAddr net.Addr) (*net.Dialer, error) {
 	index, err := getInterfaceIndex(interfaceName)
 	if err != nil {
 		log.Debugf("unable to get interface index for %s: %s", interfaceName, err)
@@ -89,11 +122,8 @@ func GetClientPrivate(ip netip.Addr, interfaceName string, dialTimeout time.Dura
 	}
 
 	dialer := &net.Dialer{
-		LocalAddr: &net.UDPAddr{
-			IP:   ip.AsSlice(),
-			Port: 0, // Let the OS pick a free port
-		},
-		Timeout: dialTimeout,
+		LocalAddr: localAddr,
+		Timeout:   dialTimeout,
 		Control: func(network, address string, c syscall.RawConn) error {
 			var operr error
 			fn := func(s uintptr) {
@@ -111,11 +141,7 @@ func GetClientPrivate(ip netip.Addr, interfaceName string, dialTimeout time.Dura
 			return operr
 		},
 	}
-	client := &dns.Client{
-		Dialer:  dialer,
-		Timeout: dialTimeout,
-	}
-	return client, nil
+	return dialer, nil
 }
 
 func getInterfaceIndex(interfaceName string) (int, error) {
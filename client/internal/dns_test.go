@@ -0,0 +1,99 @@
+package internal
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	nbdns "github.com/netbirdio/netbird/dns"
+	mgmProto "github.com/netbirdio/netbird/shared/management/proto"
+)
+
+func TestGenerateReverseZoneNames(t *testing.T) {
+	testCases := []struct {
+		network  string
+		expected []string
+	}{
+		{network: "100.64.0.0/16", expected: []string{"64.100.in-addr.arpa."}},
+		{network: "100.64.5.0/24", expected: []string{"5.64.100.in-addr.arpa."}},
+		{network: "100.64.0.0/15", expected: []string{"64.100.in-addr.arpa.", "65.100.in-addr.arpa."}},
+		{network: "10.0.0.0/8", expected: []string{"10.in-addr.arpa."}},
+		{network: "10.255.0.0/7", expected: []string{"10.in-addr.arpa.", "11.in-addr.arpa."}},
+		{network: "fd00:1234::/30", expected: []string{
+			"4.3.2.1.0.0.d.f.ip6.arpa.", "5.3.2.1.0.0.d.f.ip6.arpa.", "6.3.2.1.0.0.d.f.ip6.arpa.", "7.3.2.1.0.0.d.f.ip6.arpa.",
+		}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.network, func(t *testing.T) {
+			zones, err := generateReverseZoneNames(netip.MustParsePrefix(tc.network))
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, zones)
+		})
+	}
+
+	zones, err := generateReverseZoneNames(netip.MustParsePrefix("100.64.0.0/10"))
+	require.NoError(t, err)
+	require.Len(t, zones, 64)
+	assert.Equal(t, "64.100.in-addr.arpa.", zones[0])
+	assert.Equal(t, "127.100.in-addr.arpa.", zones[63])
+}
+
+func TestAddReverseZones(t *testing.T) {
+	networkMap := &mgmProto.NetworkMap{
+		PeerConfig: &mgmProto.PeerConfig{Address: "100.64.0.1/10", Fqdn: "local.netbird.cloud"},
+		RemotePeers: []*mgmProto.RemotePeerConfig{
+			{Fqdn: "peer-a.netbird.cloud", AllowedIps: []string{"100.64.0.2/32", "10.0.0.0/24"}},
+			{Fqdn: "peer-b.netbird.cloud", AllowedIps: []string{"100.65.1.2/32"}},
+			{AllowedIps: []string{"100.64.0.4/32"}},
+		},
+		OfflinePeers: []*mgmProto.RemotePeerConfig{
+			{Fqdn: "peer-c.netbird.cloud", AllowedIps: []string{"100.64.0.3/32"}},
+		},
+	}
+
+	config := nbdns.Config{
+		CustomZones: []nbdns.CustomZone{
+			{
+				Domain: "netbird.cloud.",
+				Records: []nbdns.SimpleRecord{
+					{Name: "peer-a.netbird.cloud.", Type: int(dns.TypeA), Class: nbdns.DefaultClass, TTL: 60, RData: "100.64.0.2"},
+				},
+			},
+		},
+	}
+
+	addReverseZones(&config, netip.MustParsePrefix("100.64.0.0/10"), toPeerRecords(networkMap))
+
+	require.Len(t, config.CustomZones, 3)
+	assert.Equal(t, nbdns.CustomZone{
+		Domain: "64.100.in-addr.arpa.",
+		Records: []nbdns.SimpleRecord{
+			{Name: "2.0.64.100.in-addr.arpa.", Type: int(dns.TypePTR), Class: nbdns.DefaultClass, TTL: 60, RData: "peer-a.netbird.cloud."},
+			{Name: "1.0.64.100.in-addr.arpa.", Type: int(dns.TypePTR), Class: nbdns.DefaultClass, TTL: peerRecordTTL, RData: "local.netbird.cloud."},
+			{Name: "3.0.64.100.in-addr.arpa.", Type: int(dns.TypePTR), Class: nbdns.DefaultClass, TTL: peerRecordTTL, RData: "peer-c.netbird.cloud."},
+		},
+	}, config.CustomZones[1])
+	assert.Equal(t, nbdns.CustomZone{
+		Domain: "65.100.in-addr.arpa.",
+		Records: []nbdns.SimpleRecord{
+			{Name: "2.1.65.100.in-addr.arpa.", Type: int(dns.TypePTR), Class: nbdns.DefaultClass, TTL: peerRecordTTL, RData: "peer-b.netbird.cloud."},
+		},
+	}, config.CustomZones[2])
+}
+
+func TestAddReverseZones_ExistingZone(t *testing.T) {
+	config := nbdns.Config{
+		CustomZones: []nbdns.CustomZone{{Domain: "64.100.in-addr.arpa."}},
+	}
+	peerRecords := []nbdns.SimpleRecord{
+		{Name: "peer-a.netbird.cloud.", Type: int(dns.TypeA), Class: nbdns.DefaultClass, TTL: 300, RData: "100.64.0.2"},
+	}
+
+	addReverseZones(&config, netip.MustParsePrefix("100.64.0.0/16"), peerRecords)
+
+	assert.Equal(t, []nbdns.CustomZone{{Domain: "64.100.in-addr.arpa."}}, config.CustomZones)
+}
@@ -188,10 +188,16 @@ type Engine struct {
 
 	// checks are the client-applied posture checks that need to be evaluated on the client
 	checks []*mgmProto.Checks
+	// postureCheckResults are the client-side posture checks results last synced with management
+	postureCheckResults []system.PostureCheckResult
 
 	relayManager *relayClient.Manager
-	stateManager *statemanager.Manager
-	srWatcher    *guard.SRWatcher
+	// relayLatencies are the latencies to the relay servers last synced with management
+	relayLatencies    []relayClient.ServerLatency
+	relayLatenciesMu  sync.Mutex
+	relayProbeRequest chan struct{}
+	stateManager      *statemanager.Manager
+	srWatcher         *guard.SRWatcher
 
 	// Sync response persistence
 	persistSyncResponse bool
@@ -227,23 +233,24 @@ func NewEngine(
 	checks []*mgmProto.Checks,
 ) *Engine {
 	engine := &Engine{
-		clientCtx:      clientCtx,
-		clientCancel:   clientCancel,
-		signal:         signalClient,
-		signaler:       peer.NewSignaler(signalClient, config.WgPrivateKey),
-		mgmClient:      mgmClient,
-		relayManager:   relayManager,
-		peerStore:      peerstore.NewConnStore(),
-		syncMsgMux:     &sync.Mutex{},
-		config:         config,
-		mobileDep:      mobileDep,
-		STUNs:          []*stun.URI{},
-		TURNs:          []*stun.URI{},
-		networkSerial:  0,
-		sshServerFunc:  nbssh.DefaultSSHServer,
-		statusRecorder: statusRecorder,
-		checks:         checks,
-		connSemaphore:  semaphoregroup.NewSemaphoreGroup(connInitLimit),
+		clientCtx:         clientCtx,
+		clientCancel:      clientCancel,
+		signal:            signalClient,
+		signaler:          peer.NewSignaler(signalClient, config.WgPrivateKey),
+		mgmClient:         mgmClient,
+		relayManager:      relayManager,
+		relayProbeRequest: make(chan struct{}, 1),
+		peerStore:         peerstore.NewConnStore(),
+		syncMsgMux:        &sync.Mutex{},
+		config:            config,
+		mobileDep:         mobileDep,
+		STUNs:             []*stun.URI{},
+		TURNs:             []*stun.URI{},
+		networkSerial:     0,
+		sshServerFunc:     nbssh.DefaultSSHServer,
+		statusRecorder:    statusRecorder,
+		checks:            checks,
+		connSemaphore:     semaphoregroup.NewSemaphoreGroup(connInitLimit),
 	}
 
 	sm := profilemanager.NewServiceManager("")
@@ -453,8 +460,6 @@ func (e *Engine) Start(netbirdConfig *mgmProto.NetbirdConfig, mgmtURL *url.URL)
 		return fmt.Errorf("up wg interface: %w", err)
 	}
 
-
-
 	// if inbound conns are blocked there is no need to create the ACL manager
 	if e.firewall != nil && !e.config.BlockInbound {
 		e.acl = acl.NewDefaultManager(e.firewall)
@@ -483,6 +488,8 @@ func (e *Engine) Start(netbirdConfig *mgmProto.NetbirdConfig, mgmtURL *url.URL)
 
 	e.receiveSignalEvents()
 	e.receiveManagementEvents()
+	e.startPostureChecksEvaluation()
+	e.startRelayProbing()
 
 	// starting network monitor at the very last to avoid disruptions
 	e.startNetworkMonitor()
@@ -608,6 +615,7 @@ func (e *Engine) modifyPeers(peersUpdate []*mgmProto.RemotePeerConfig) error {
 		if !ok {
 			continue
 		}
+		currentPeer.UpdateRemoteRelayLatencies(toRelayLatencies(p.GetRelayLatencies()))
 
 		if currentPeer.AgentVersionString() != p.AgentVersion {
 			modified = append(modified, p)
@@ -791,7 +799,12 @@ func (e *Engine) handleRelayUpdate(update *mgmProto.RelayConfig) error {
 			return fmt.Errorf("update relay token: %w", err)
 		}
 
-		e.relayManager.UpdateServerURLs(update.Urls)
+		servers := toRelayServers(update.GetUrls(), update.GetServers())
+		serversChanged := !slices.Equal(e.relayManager.ServerURLs(), update.GetUrls())
+		e.relayManager.UpdateServers(servers)
+		if serversChanged {
+			e.requestRelayProbe()
+		}
 
 		// Just in case the agent started with an MGM server where the relay was disabled but was later enabled.
 		// We can ignore all errors because the guard will manage the reconnection retries.
@@ -839,28 +852,12 @@ func (e *Engine) updateChecksIfNew(checks []*mgmProto.Checks) error {
 	}
 	e.checks = checks
 
-	info, err := system.GetInfoWithChecks(e.ctx, checks)
-	if err != nil {
-		log.Warnf("failed to get system info with checks: %v", err)
-		info = system.GetInfo(e.ctx)
-	}
-	info.SetFlags(
-		e.config.RosenpassEnabled,
-		e.config.RosenpassPermissive,
-		&e.config.ServerSSHAllowed,
-		e.config.DisableClientRoutes,
-		e.config.DisableServerRoutes,
-		e.config.DisableDNS,
-		e.config.DisableFirewall,
-		e.config.BlockLANAccess,
-		e.config.BlockInbound,
-		e.config.LazyConnectionEnabled,
-	)
-
+	info := e.getSystemInfoWithChecks(e.ctx, checks)
 	if err := e.mgmClient.SyncMeta(info); err != nil {
 		log.Errorf("could not sync meta: error %s", err)
 		return err
 	}
+	e.postureCheckResults = info.PostureCheckResults
 	return nil
 }
 
@@ -956,25 +953,8 @@ func (e *Engine) updateConfig(conf *mgmProto.PeerConfig) error {
 // E.g. when a new peer has been registered and we are allowed to connect to it.
 func (e *Engine) receiveManagementEvents() {
 	go func() {
-		info, err := system.GetInfoWithChecks(e.ctx, e.checks)
-		if err != nil {
-			log.Warnf("failed to get system info with checks: %v", err)
-			info = system.GetInfo(e.ctx)
-		}
-		info.SetFlags(
-			e.config.RosenpassEnabled,
-			e.config.RosenpassPermissive,
-			&e.config.ServerSSHAllowed,
-			e.config.DisableClientRoutes,
-			e.config.DisableServerRoutes,
-			e.config.DisableDNS,
-			e.config.DisableFirewall,
-			e.config.BlockLANAccess,
-			e.config.BlockInbound,
-			e.config.LazyConnectionEnabled,
-		)
-
-		err = e.mgmClient.Sync(e.ctx, info, e.handleSync)
+		info := e.getSystemInfoWithChecks(e.ctx, e.checks)
+		err := e.mgmClient.Sync(e.ctx, info, e.handleSync)
 		if err != nil {
 			// happens if management is unavailable for a long time.
 			// We want to cancel the operation of the whole client
@@ -1065,7 +1045,7 @@ func (e *Engine) updateNetworkMap(networkMap *mgmProto.NetworkMap) error {
 		protoDNSConfig = &mgmProto.DNSConfig{}
 	}
 
-	if err := e.dnsServer.UpdateDNSServer(serial, toDNSConfig(protoDNSConfig, e.wgInterface.Address().Network)); err != nil {
+	if err := e.dnsServer.UpdateDNSServer(serial, toDNSConfig(protoDNSConfig, e.wgInterface.Address().Network, toPeerRecords(networkMap))); err != nil {
 		log.Errorf("failed to update dns server, err: %v", err)
 	}
 
@@ -1212,7 +1192,7 @@ func toRouteDomains(myPubKey string, routes []*route.Route) []*dnsfwd.ForwarderE
 	return entries
 }
 
-func toDNSConfig(protoDNSConfig *mgmProto.DNSConfig, network netip.Prefix) nbdns.Config {
+func toDNSConfig(protoDNSConfig *mgmProto.DNSConfig, network netip.Prefix, peerRecords []nbdns.SimpleRecord) nbdns.Config {
 	dnsUpdate := nbdns.Config{
 		ServiceEnable:    protoDNSConfig.GetServiceEnable(),
 		CustomZones:      make([]nbdns.CustomZone, 0),
@@ -1244,19 +1224,29 @@ func toDNSConfig(protoDNSConfig *mgmProto.DNSConfig, network netip.Prefix) nbdns
 		}
 		for _, ns := range nsGroup.GetNameServers() {
 			dnsNS := nbdns.NameServer{
-				IP:     netip.MustParseAddr(ns.GetIP()),
-				NSType: nbdns.NameServerType(ns.GetNSType()),
-				Port:   int(ns.GetPort()),
+				IP:         netip.MustParseAddr(ns.GetIP()),
+				NSType:     nbdns.NameServerType(ns.GetNSType()),
+				Port:       int(ns.GetPort()),
+				ServerName: ns.GetServerName(),
+				Path:       ns.GetPath(),
+				Pin:        ns.GetPin(),
 			}
 			dnsNSGroup.NameServers = append(dnsNSGroup.NameServers, dnsNS)
 		}
 		dnsUpdate.NameServerGroups = append(dnsUpdate.NameServerGroups, dnsNSGroup)
 	}
 
-	if len(dnsUpdate.CustomZones) > 0 {
-		addReverseZone(&dnsUpdate, network)
+	for _, blocklist := range protoDNSConfig.GetBlocklists() {
+		dnsUpdate.Blocklists = append(dnsUpdate.Blocklists, nbdns.Blocklist{
+			ID:       blocklist.GetID(),
+			Name:     blocklist.GetName(),
+			Sinkhole: blocklist.GetSinkhole(),
+			Domains:  blocklist.GetDomains(),
+		})
 	}
 
+	addReverseZones(&dnsUpdate, network, peerRecords)
+
 	return dnsUpdate
 }
 
@@ -1308,6 +1298,7 @@ func (e *Engine) addNewPeer(peerConfig *mgmProto.RemotePeerConfig) error {
 	if err != nil {
 		return fmt.Errorf("create peer connection: %w", err)
 	}
+	conn.UpdateRemoteRelayLatencies(toRelayLatencies(peerConfig.GetRelayLatencies()))
 
 	err = e.statusRecorder.AddPeer(peerKey, peerConfig.Fqdn, peerIPs[0].Addr().String())
 	if err != nil {
@@ -1541,7 +1532,7 @@ func (e *Engine) readInitialSettings() ([]*route.Route, *nbdns.Config, bool, err
 		return nil, nil, false, err
 	}
 	routes := toRoutes(netMap.GetRoutes())
-	dnsCfg := toDNSConfig(netMap.GetDNSConfig(), e.wgInterface.Address().Network)
+	dnsCfg := toDNSConfig(netMap.GetDNSConfig(), e.wgInterface.Address().Network, toPeerRecords(netMap))
 	dnsFeatureFlag := toDNSFeatureFlag(netMap)
 	return routes, &dnsCfg, dnsFeatureFlag, nil
 }
@@ -1843,6 +1834,18 @@ func (e *Engine) GetLatestSyncResponse() (*mgmProto.SyncResponse, error) {
 	return sr, nil
 }
 
+// GetDNSQueryLog returns the most recent queries served by the DNS server
+func (e *Engine) GetDNSQueryLog() ([]dns.QueryLogEntry, error) {
+	e.syncMsgMux.Lock()
+	defer e.syncMsgMux.Unlock()
+
+	if e.dnsServer == nil {
+		return nil, errors.New("DNS server is not running")
+	}
+
+	return e.dnsServer.QueryLog(), nil
+}
+
 // GetWgAddr returns the wireguard address
 func (e *Engine) GetWgAddr() netip.Addr {
 	if e.wgInterface == nil {
@@ -2009,10 +2012,15 @@ func isChecksEqual(checks1, checks2 []*mgmProto.Checks) bool {
 	normalize := func(checks []*mgmProto.Checks) []string {
 		normalized := make([]string, len(checks))
 
+		sorted := func(values []string) string {
+			values = slices.Clone(values)
+			sort.Strings(values)
+			return strings.Join(values, "|")
+		}
+
 		for i, check := range checks {
-			sortedFiles := slices.Clone(check.Files)
-			sort.Strings(sortedFiles)
-			normalized[i] = strings.Join(sortedFiles, "|")
+			normalized[i] = fmt.Sprintf("%s;%s;%s;%t;%s;%t;%s", check.GetID(), sorted(check.GetFiles()), sorted(check.GetProcesses()),
+				check.GetDiskEncryption(), sorted(check.GetDiskEncryptionVolumes()), check.GetHostFirewall(), sorted(check.GetHostFirewalls()))
 		}
 
 		sort.Strings(normalized)
@@ -1355,6 +1355,22 @@ func Test_CheckFilesEqual(t *testing.T) {
 			},
 			expectedBool: true,
 		},
+		{
+			name: "Compared Slices with same files but different requested system checks should return false",
+			inputChecks1: []*mgmtProto.Checks{
+				{
+					Files:          []string{"testfile1"},
+					DiskEncryption: true,
+				},
+			},
+			inputChecks2: []*mgmtProto.Checks{
+				{
+					Files:        []string{"testfile1"},
+					HostFirewall: true,
+				},
+			},
+			expectedBool: false,
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -1583,7 +1599,7 @@ func startManagement(t *testing.T, dataDir, testFile string) (*grpc.Server, stri
 		return nil, "", err
 	}
 
-	secretsManager := server.NewTimeBasedAuthSecretsManager(peersUpdateManager, config.TURNConfig, config.Relay, settingsMockManager, groupsManager)
+	secretsManager := server.NewTimeBasedAuthSecretsManager(peersUpdateManager, config.TURNConfig, config.Relay, config.Flow, settingsMockManager, groupsManager)
 	mgmtServer, err := server.NewServer(context.Background(), config, accountManager, settingsMockManager, peersUpdateManager, secretsManager, nil, nil, nil, &server.MockIntegratedValidator{})
 	if err != nil {
 		return nil, "", err
@@ -1618,3 +1634,54 @@ func getPeers(e *Engine) int {
 
 	return len(e.peerStore.PeersPubKey())
 }
+
+func TestIsRelayLatencyChanged(t *testing.T) {
+	reported := []relayClient.ServerLatency{
+		{URL: "rels://fra.relay", RTT: 40 * time.Millisecond},
+		{URL: "rels://sgp.relay", RTT: 200 * time.Millisecond},
+	}
+
+	tests := []struct {
+		name     string
+		measured []relayClient.ServerLatency
+		expected bool
+	}{
+		{
+			name: "jitter",
+			measured: []relayClient.ServerLatency{
+				{URL: "rels://fra.relay", RTT: 55 * time.Millisecond},
+				{URL: "rels://sgp.relay", RTT: 160 * time.Millisecond},
+			},
+			expected: false,
+		},
+		{
+			name: "latency changed",
+			measured: []relayClient.ServerLatency{
+				{URL: "rels://fra.relay", RTT: 90 * time.Millisecond},
+				{URL: "rels://sgp.relay", RTT: 200 * time.Millisecond},
+			},
+			expected: true,
+		},
+		{
+			name: "server unreachable",
+			measured: []relayClient.ServerLatency{
+				{URL: "rels://fra.relay", RTT: 40 * time.Millisecond},
+			},
+			expected: true,
+		},
+		{
+			name: "server replaced",
+			measured: []relayClient.ServerLatency{
+				{URL: "rels://fra.relay", RTT: 40 * time.Millisecond},
+				{URL: "rels://syd.relay", RTT: 200 * time.Millisecond},
+			},
+			expected: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, isRelayLatencyChanged(reported, tc.measured))
+		})
+	}
+}
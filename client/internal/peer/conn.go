@@ -107,6 +107,10 @@ type Conn struct {
 	// used to store the remote Rosenpass key for Relayed connection in case of connection update from ice
 	rosenpassRemoteKey []byte
 
+	// remoteRelayLatencies are the latencies the remote peer measured to the relay servers
+	remoteRelayLatencies   []relayClient.ServerLatency
+	remoteRelayLatenciesMu sync.Mutex
+
 	wgProxyICE   wgproxy.Proxy
 	wgProxyRelay wgproxy.Proxy
 	handshaker   *Handshaker
@@ -740,6 +744,20 @@ func (conn *Conn) AgentVersionString() string {
 	return conn.config.AgentVersion
 }
 
+// UpdateRemoteRelayLatencies updates the latencies the remote peer reported to the relay servers. The next relayed
+// connection uses the relay server with the lowest combined latency.
+func (conn *Conn) UpdateRemoteRelayLatencies(latencies []relayClient.ServerLatency) {
+	conn.remoteRelayLatenciesMu.Lock()
+	defer conn.remoteRelayLatenciesMu.Unlock()
+	conn.remoteRelayLatencies = latencies
+}
+
+func (conn *Conn) getRemoteRelayLatencies() []relayClient.ServerLatency {
+	conn.remoteRelayLatenciesMu.Lock()
+	defer conn.remoteRelayLatenciesMu.Unlock()
+	return conn.remoteRelayLatencies
+}
+
 func (conn *Conn) presharedKey(remoteRosenpassKey []byte) *wgtypes.Key {
 	if conn.config.RosenpassConfig.PubKey == nil {
 		return conn.config.WgConfig.PreSharedKey
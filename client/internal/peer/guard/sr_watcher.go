@@ -15,6 +15,11 @@ type chNotifier interface {
 	Ready() bool
 }
 
+// limitNotifier is implemented by the relay manager, it reports when a relay server drops the traffic of the peer
+type limitNotifier interface {
+	SetOnLimitedListener(func())
+}
+
 type SRWatcher struct {
 	signalClient chNotifier
 	relayManager chNotifier
@@ -28,7 +33,7 @@ type SRWatcher struct {
 }
 
 // NewSRWatcher creates a new SRWatcher. This watcher will notify the listeners when the ICE candidates change or the
-// Relay connection is reconnected or the Signal client reconnected.
+// Relay connection is reconnected or the Signal client reconnected or the Relay server limits the traffic.
 func NewSRWatcher(signalClient chNotifier, relayManager chNotifier, iFaceDiscover stdnet.ExternalIFaceDiscover, iceConfig ice.Config) *SRWatcher {
 	srw := &SRWatcher{
 		signalClient:  signalClient,
@@ -55,7 +60,9 @@ func (w *SRWatcher) Start() {
 	go iceMonitor.Start(ctx, w.onICEChanged)
 	w.signalClient.SetOnReconnectedListener(w.onReconnected)
 	w.relayManager.SetOnReconnectedListener(w.onReconnected)
-
+	if l, ok := w.relayManager.(limitNotifier); ok {
+		l.SetOnLimitedListener(w.onRelayLimited)
+	}
 }
 
 func (w *SRWatcher) Close() {
@@ -68,6 +75,9 @@ func (w *SRWatcher) Close() {
 	w.cancelIceMonitor()
 	w.signalClient.SetOnReconnectedListener(nil)
 	w.relayManager.SetOnReconnectedListener(nil)
+	if l, ok := w.relayManager.(limitNotifier); ok {
+		l.SetOnLimitedListener(nil)
+	}
 }
 
 func (w *SRWatcher) NewListener() chan struct{} {
@@ -107,6 +117,16 @@ func (w *SRWatcher) onReconnected() {
 	w.notify()
 }
 
+// onRelayLimited retries the P2P connections, the relayed traffic is dropped by the relay server
+func (w *SRWatcher) onRelayLimited() {
+	if !w.signalClient.Ready() {
+		return
+	}
+
+	log.Infof("relay server limits the traffic, retry the P2P connections")
+	w.notify()
+}
+
 func (w *SRWatcher) notify() {
 	w.mu.Lock()
 	defer w.mu.Unlock()
@@ -146,6 +146,14 @@ type NSGroupState struct {
 	Error   error
 }
 
+// DNSBlocklistState represents a DNS blocklist enforced by the local DNS server and the number of queries it blocked
+type DNSBlocklistState struct {
+	ID      string
+	Name    string
+	Domains int
+	Hits    uint64
+}
+
 // FullStatus contains the full state held by the Status instance
 type FullStatus struct {
 	Peers                 []State
@@ -155,6 +163,7 @@ type FullStatus struct {
 	RosenpassState        RosenpassState
 	Relays                []relay.ProbeResult
 	NSGroupStates         []NSGroupState
+	DNSBlocklistStates    []DNSBlocklistState
 	NumOfForwardingRules  int
 	LazyConnectionEnabled bool
 }
@@ -198,6 +207,7 @@ type Status struct {
 	rosenpassEnabled      bool
 	rosenpassPermissive   bool
 	nsGroupStates         []NSGroupState
+	dnsBlocklistStates    []DNSBlocklistState
 	resolvedDomainsStates map[domain.Domain]ResolvedDomainInfo
 	lazyConnectionEnabled bool
 
@@ -788,6 +798,36 @@ func (d *Status) UpdateDNSStates(dnsStates []NSGroupState) {
 	d.nsGroupStates = dnsStates
 }
 
+// UpdateDNSBlocklistStates replaces the enforced DNS blocklists, keeping the hits of the blocklists that are still enforced
+func (d *Status) UpdateDNSBlocklistStates(blocklistStates []DNSBlocklistState) {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+
+	hits := make(map[string]uint64, len(d.dnsBlocklistStates))
+	for _, state := range d.dnsBlocklistStates {
+		hits[state.ID] = state.Hits
+	}
+
+	d.dnsBlocklistStates = make([]DNSBlocklistState, 0, len(blocklistStates))
+	for _, state := range blocklistStates {
+		state.Hits += hits[state.ID]
+		d.dnsBlocklistStates = append(d.dnsBlocklistStates, state)
+	}
+}
+
+// AddDNSBlocklistHit counts a query blocked by the DNS blocklist
+func (d *Status) AddDNSBlocklistHit(blocklistID string) {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+
+	for i := range d.dnsBlocklistStates {
+		if d.dnsBlocklistStates[i].ID == blocklistID {
+			d.dnsBlocklistStates[i].Hits++
+			return
+		}
+	}
+}
+
 func (d *Status) UpdateResolvedDomainsStates(originalDomain domain.Domain, resolvedDomain domain.Domain, prefixes []netip.Prefix, resourceId route.ResID) {
 	d.mux.Lock()
 	defer d.mux.Unlock()
@@ -936,6 +976,13 @@ func (d *Status) GetDNSStates() []NSGroupState {
 	return slices.Clone(d.nsGroupStates)
 }
 
+func (d *Status) GetDNSBlocklistStates() []DNSBlocklistState {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+
+	return slices.Clone(d.dnsBlocklistStates)
+}
+
 func (d *Status) GetResolvedDomainsStates() map[domain.Domain]ResolvedDomainInfo {
 	d.mux.Lock()
 	defer d.mux.Unlock()
@@ -950,6 +997,7 @@ func (d *Status) GetFullStatus() FullStatus {
 		Relays:                d.GetRelayStates(),
 		RosenpassState:        d.GetRosenpassState(),
 		NSGroupStates:         d.GetDNSStates(),
+		DNSBlocklistStates:    d.GetDNSBlocklistStates(),
 		NumOfForwardingRules:  len(d.ForwardingRules()),
 		LazyConnectionEnabled: d.GetLazyConnection(),
 	}
@@ -56,7 +56,7 @@ func (w *WorkerRelay) OnNewOffer(remoteOfferAnswer *OfferAnswer) {
 	w.relaySupportedOnRemotePeer.Store(true)
 
 	// the relayManager will return with error in case if the connection has lost with relay server
-	currentRelayAddress, err := w.relayManager.RelayInstanceAddress()
+	currentRelayAddress, err := w.RelayInstanceAddress()
 	if err != nil {
 		w.log.Errorf("failed to handle new offer: %s", err)
 		return
@@ -101,7 +101,12 @@ func (w *WorkerRelay) DisableWgWatcher() {
 	w.wgWatcher.DisableWgWatcher()
 }
 
+// RelayInstanceAddress returns the address of the relay server offered to the remote peer. It is the relay server with
+// the lowest combined latency if both peers have measured it, otherwise the home relay server.
 func (w *WorkerRelay) RelayInstanceAddress() (string, error) {
+	if address, ok := w.relayManager.PreferredServerAddress(w.conn.getRemoteRelayLatencies()); ok {
+		return address, nil
+	}
 	return w.relayManager.RelayInstanceAddress()
 }
 
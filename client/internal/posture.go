@@ -0,0 +1,89 @@
+package internal
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/client/system"
+	mgmProto "github.com/netbirdio/netbird/shared/management/proto"
+)
+
+// postureChecksInterval is the interval the client-side posture checks are re-evaluated at
+const postureChecksInterval = 15 * time.Second
+
+// startPostureChecksEvaluation periodically re-evaluates the client-side posture checks and syncs the meta with
+// management when a result changes, so that management can revoke the access of a failing peer right away
+func (e *Engine) startPostureChecksEvaluation() {
+	ctx := e.ctx
+	go func() {
+		ticker := time.NewTicker(postureChecksInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				e.evaluatePostureChecks(ctx)
+			}
+		}
+	}()
+}
+
+func (e *Engine) evaluatePostureChecks(ctx context.Context) {
+	e.syncMsgMux.Lock()
+	checks := e.checks
+	e.syncMsgMux.Unlock()
+
+	if len(checks) == 0 {
+		return
+	}
+
+	// gathering the system information might take a while, it is done without blocking the management updates
+	info := e.getSystemInfoWithChecks(ctx, checks)
+
+	e.syncMsgMux.Lock()
+	defer e.syncMsgMux.Unlock()
+
+	if ctx.Err() != nil {
+		return
+	}
+
+	// the meta is synced by the update when the checks changed in the meantime
+	if !isChecksEqual(e.checks, checks) || slices.Equal(e.postureCheckResults, info.PostureCheckResults) {
+		return
+	}
+
+	log.Infof("posture checks results changed, syncing meta with management")
+	if err := e.mgmClient.SyncMeta(info); err != nil {
+		log.Errorf("could not sync meta: error %s", err)
+		return
+	}
+	e.postureCheckResults = info.PostureCheckResults
+}
+
+// getSystemInfoWithChecks gathers the system information with the posture checks evaluated and the engine flags set
+func (e *Engine) getSystemInfoWithChecks(ctx context.Context, checks []*mgmProto.Checks) *system.Info {
+	info, err := system.GetInfoWithChecks(ctx, checks)
+	if err != nil {
+		log.Warnf("failed to get system info with checks: %v", err)
+		info = system.GetInfo(ctx)
+	}
+	info.SetFlags(
+		e.config.RosenpassEnabled,
+		e.config.RosenpassPermissive,
+		&e.config.ServerSSHAllowed,
+		e.config.DisableClientRoutes,
+		e.config.DisableServerRoutes,
+		e.config.DisableDNS,
+		e.config.DisableFirewall,
+		e.config.BlockLANAccess,
+		e.config.BlockInbound,
+		e.config.LazyConnectionEnabled,
+	)
+	info.RelayLatencies = e.reportedRelayLatencies()
+	return info
+}
@@ -0,0 +1,149 @@
+package internal
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/client/system"
+	mgmProto "github.com/netbirdio/netbird/shared/management/proto"
+	relayClient "github.com/netbirdio/netbird/shared/relay/client"
+)
+
+const (
+	// relayProbeInterval is the interval the latencies to the relay servers are measured at
+	relayProbeInterval = 5 * time.Minute
+	// relayLatencyMinChange is the smallest latency change that is synced with management, smaller changes are
+	// considered jitter
+	relayLatencyMinChange = 20 * time.Millisecond
+)
+
+// startRelayProbing periodically measures the latencies to the relay servers and syncs the meta with management when
+// they change, so that the remote peers can choose the relay server with the lowest combined latency
+func (e *Engine) startRelayProbing() {
+	ctx := e.ctx
+	go func() {
+		ticker := time.NewTicker(relayProbeInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-e.relayProbeRequest:
+			case <-ticker.C:
+			}
+			e.probeRelays(ctx)
+		}
+	}()
+}
+
+// requestRelayProbe measures the latencies to the relay servers without waiting for the next interval
+func (e *Engine) requestRelayProbe() {
+	select {
+	case e.relayProbeRequest <- struct{}{}:
+	default:
+	}
+}
+
+func (e *Engine) probeRelays(ctx context.Context) {
+	if !e.relayManager.HasRelayAddress() {
+		return
+	}
+
+	latencies := e.relayManager.ProbeServers(ctx)
+	log.Debugf("measured relay server latencies: %v", latencies)
+
+	e.relayLatenciesMu.Lock()
+	changed := isRelayLatencyChanged(e.relayLatencies, latencies)
+	e.relayLatenciesMu.Unlock()
+	if !changed {
+		return
+	}
+
+	e.syncMsgMux.Lock()
+	defer e.syncMsgMux.Unlock()
+
+	if ctx.Err() != nil {
+		return
+	}
+
+	e.relayLatenciesMu.Lock()
+	e.relayLatencies = latencies
+	e.relayLatenciesMu.Unlock()
+
+	log.Infof("relay server latencies changed, syncing meta with management")
+	info := e.getSystemInfoWithChecks(ctx, e.checks)
+	if err := e.mgmClient.SyncMeta(info); err != nil {
+		log.Errorf("could not sync meta: error %s", err)
+		return
+	}
+	e.postureCheckResults = info.PostureCheckResults
+}
+
+// reportedRelayLatencies returns the relay server latencies in the form they are synced with management
+func (e *Engine) reportedRelayLatencies() []system.RelayLatency {
+	e.relayLatenciesMu.Lock()
+	defer e.relayLatenciesMu.Unlock()
+
+	latencies := make([]system.RelayLatency, 0, len(e.relayLatencies))
+	for _, l := range e.relayLatencies {
+		latencies = append(latencies, system.RelayLatency{URL: l.URL, RTT: l.RTT})
+	}
+	return latencies
+}
+
+// isRelayLatencyChanged returns true if the set of the reachable relay servers changed or a latency changed by more
+// than the jitter. Both lists are sorted by the URL.
+func isRelayLatencyChanged(reported, measured []relayClient.ServerLatency) bool {
+	if len(reported) != len(measured) {
+		return true
+	}
+
+	for i := range reported {
+		if reported[i].URL != measured[i].URL {
+			return true
+		}
+
+		threshold := max(relayLatencyMinChange, reported[i].RTT/4)
+		diff := measured[i].RTT - reported[i].RTT
+		if diff > threshold || -diff > threshold {
+			return true
+		}
+	}
+	return false
+}
+
+// toRelayServers converts the relay servers offered by management. The older management servers send only the URLs.
+func toRelayServers(urls []string, servers []*mgmProto.RelayServer) []relayClient.Server {
+	if len(servers) == 0 {
+		converted := make([]relayClient.Server, 0, len(urls))
+		for _, url := range urls {
+			converted = append(converted, relayClient.Server{URL: url})
+		}
+		return converted
+	}
+
+	converted := make([]relayClient.Server, 0, len(servers))
+	for _, s := range servers {
+		converted = append(converted, relayClient.Server{
+			URL:    s.GetUrl(),
+			Region: s.GetRegion(),
+			Weight: s.GetWeight(),
+		})
+	}
+	return converted
+}
+
+// toRelayLatencies converts the relay server latencies a remote peer reported to management
+func toRelayLatencies(latencies []*mgmProto.RelayLatency) []relayClient.ServerLatency {
+	converted := make([]relayClient.ServerLatency, 0, len(latencies))
+	for _, l := range latencies {
+		converted = append(converted, relayClient.ServerLatency{
+			URL: l.GetUrl(),
+			RTT: time.Duration(l.GetRttMs()) * time.Millisecond,
+		})
+	}
+	return converted
+}
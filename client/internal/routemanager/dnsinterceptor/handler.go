@@ -285,6 +285,10 @@ func (d *DnsInterceptor) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
 
 	logger.Tracef("upstream %s (%s) DNS response for domain=%s answers=%v", upstreamIP.String(), peerKey, r.Question[0].Name, answer)
 
+	if writer, ok := w.(*nbdns.ResponseWriterChain); ok {
+		writer.SetUpstream(upstream)
+	}
+
 	reply.Id = r.Id
 	if err := d.writeMsg(w, reply); err != nil {
 		logger.Errorf("failed writing DNS response: %v", err)
@@ -0,0 +1,66 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/netbirdio/netbird/client/internal/dns"
+	"github.com/netbirdio/netbird/client/proto"
+)
+
+// GetDNSQueryLog returns the most recent queries served by the client DNS server.
+func (s *Server) GetDNSQueryLog(_ context.Context, req *proto.GetDNSQueryLogRequest) (*proto.GetDNSQueryLogResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.connectClient == nil {
+		return nil, fmt.Errorf("not connected")
+	}
+
+	entries, err := s.connectClient.GetDNSQueryLog()
+	if err != nil {
+		return nil, fmt.Errorf("get DNS query log: %w", err)
+	}
+
+	entries = filterDNSQueryLog(entries, req.GetDomain(), int(req.GetLimit()))
+
+	respEntries := make([]*proto.DNSQueryLogEntry, 0, len(entries))
+	for _, entry := range entries {
+		respEntries = append(respEntries, &proto.DNSQueryLogEntry{
+			Time:     timestamppb.New(entry.Time),
+			Name:     entry.Name,
+			Type:     entry.Type,
+			Handler:  entry.Handler,
+			Pattern:  entry.Pattern,
+			Upstream: entry.Upstream,
+			Rcode:    entry.Rcode,
+			Latency:  durationpb.New(entry.Latency),
+		})
+	}
+
+	return &proto.GetDNSQueryLogResponse{Entries: respEntries}, nil
+}
+
+// filterDNSQueryLog keeps the entries whose name contains the domain and returns up to limit of the most recent ones
+func filterDNSQueryLog(entries []dns.QueryLogEntry, domain string, limit int) []dns.QueryLogEntry {
+	if domain != "" {
+		domain = strings.ToLower(domain)
+		filtered := make([]dns.QueryLogEntry, 0, len(entries))
+		for _, entry := range entries {
+			if strings.Contains(strings.ToLower(entry.Name), domain) {
+				filtered = append(filtered, entry)
+			}
+		}
+		entries = filtered
+	}
+
+	if limit > 0 && len(entries) > limit {
+		entries = entries[len(entries)-limit:]
+	}
+
+	return entries
+}
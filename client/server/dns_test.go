@@ -0,0 +1,31 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/netbirdio/netbird/client/internal/dns"
+)
+
+func TestFilterDNSQueryLog(t *testing.T) {
+	entries := []dns.QueryLogEntry{
+		{Name: "a.internal.example."},
+		{Name: "b.example.com."},
+		{Name: "c.Internal.example."},
+		{Name: "d.internal.example."},
+	}
+
+	names := func(entries []dns.QueryLogEntry) []string {
+		var names []string
+		for _, entry := range entries {
+			names = append(names, entry.Name)
+		}
+		return names
+	}
+
+	assert.Equal(t, names(entries), names(filterDNSQueryLog(entries, "", 0)))
+	assert.Equal(t, []string{"c.Internal.example.", "d.internal.example."}, names(filterDNSQueryLog(entries, "", 2)))
+	assert.Equal(t, []string{"a.internal.example.", "c.Internal.example.", "d.internal.example."}, names(filterDNSQueryLog(entries, "INTERNAL", 0)))
+	assert.Equal(t, []string{"d.internal.example."}, names(filterDNSQueryLog(entries, "internal", 1)))
+}
@@ -1412,6 +1412,15 @@ func toProtoFullStatus(fullStatus peer.FullStatus) *proto.FullStatus {
 		pbFullStatus.DnsServers = append(pbFullStatus.DnsServers, pbDnsState)
 	}
 
+	for _, blocklistState := range fullStatus.DNSBlocklistStates {
+		pbFullStatus.DnsBlocklists = append(pbFullStatus.DnsBlocklists, &proto.DNSBlocklistState{
+			Id:      blocklistState.ID,
+			Name:    blocklistState.Name,
+			Domains: int32(blocklistState.Domains),
+			Hits:    blocklistState.Hits,
+		})
+	}
+
 	return &pbFullStatus
 }
 
@@ -80,6 +80,13 @@ type NsServerGroupStateOutput struct {
 	Error   string   `json:"error" yaml:"error"`
 }
 
+type DNSBlocklistStateOutput struct {
+	ID      string `json:"id" yaml:"id"`
+	Name    string `json:"name" yaml:"name"`
+	Domains int    `json:"domains" yaml:"domains"`
+	Hits    uint64 `json:"hits" yaml:"hits"`
+}
+
 type OutputOverview struct {
 	Peers                   PeersStateOutput           `json:"peers" yaml:"peers"`
 	CliVersion              string                     `json:"cliVersion" yaml:"cliVersion"`
@@ -96,6 +103,7 @@ type OutputOverview struct {
 	Networks                []string                   `json:"networks" yaml:"networks"`
 	NumberOfForwardingRules int                        `json:"forwardingRules" yaml:"forwardingRules"`
 	NSServerGroups          []NsServerGroupStateOutput `json:"dnsServers" yaml:"dnsServers"`
+	DNSBlocklists           []DNSBlocklistStateOutput  `json:"dnsBlocklists,omitempty" yaml:"dnsBlocklists,omitempty"`
 	Events                  []SystemEventOutput        `json:"events" yaml:"events"`
 	LazyConnectionEnabled   bool                       `json:"lazyConnectionEnabled" yaml:"lazyConnectionEnabled"`
 	ProfileName             string                     `json:"profileName" yaml:"profileName"`
@@ -137,6 +145,7 @@ func ConvertToStatusOutputOverview(resp *proto.StatusResponse, anon bool, status
 		Networks:                pbFullStatus.GetLocalPeerState().GetNetworks(),
 		NumberOfForwardingRules: int(pbFullStatus.GetNumberOfForwardingRules()),
 		NSServerGroups:          mapNSGroups(pbFullStatus.GetDnsServers()),
+		DNSBlocklists:           mapDNSBlocklists(pbFullStatus.GetDnsBlocklists()),
 		Events:                  mapEvents(pbFullStatus.GetEvents()),
 		LazyConnectionEnabled:   pbFullStatus.GetLazyConnectionEnabled(),
 		ProfileName:             profName,
@@ -189,6 +198,23 @@ func mapNSGroups(servers []*proto.NSGroupState) []NsServerGroupStateOutput {
 	return mappedNSGroups
 }
 
+func mapDNSBlocklists(blocklists []*proto.DNSBlocklistState) []DNSBlocklistStateOutput {
+	if len(blocklists) == 0 {
+		return nil
+	}
+
+	mappedBlocklists := make([]DNSBlocklistStateOutput, 0, len(blocklists))
+	for _, blocklist := range blocklists {
+		mappedBlocklists = append(mappedBlocklists, DNSBlocklistStateOutput{
+			ID:      blocklist.GetId(),
+			Name:    blocklist.GetName(),
+			Domains: int(blocklist.GetDomains()),
+			Hits:    blocklist.GetHits(),
+		})
+	}
+	return mappedBlocklists
+}
+
 func mapPeers(
 	peers []*proto.PeerState,
 	statusFilter string,
@@ -382,6 +408,8 @@ func ParseGeneralSummary(overview OutputOverview, showURL bool, showRelays bool,
 		dnsServersString = fmt.Sprintf("%d/%d Available", countEnabled(overview.NSServerGroups), len(overview.NSServerGroups))
 	}
 
+	dnsBlocklistsString := parseDNSBlocklists(overview.DNSBlocklists, showNameServers)
+
 	rosenpassEnabledStatus := "false"
 	if overview.RosenpassEnabled {
 		rosenpassEnabledStatus = "true"
@@ -413,6 +441,7 @@ func ParseGeneralSummary(overview OutputOverview, showURL bool, showRelays bool,
 			"Signal: %s\n"+
 			"Relays: %s\n"+
 			"Nameservers: %s\n"+
+			"%s"+
 			"FQDN: %s\n"+
 			"NetBird IP: %s\n"+
 			"Interface type: %s\n"+
@@ -429,6 +458,7 @@ func ParseGeneralSummary(overview OutputOverview, showURL bool, showRelays bool,
 		signalConnString,
 		relaysString,
 		dnsServersString,
+		dnsBlocklistsString,
 		domain.Domain(overview.FQDN).SafeString(),
 		interfaceIP,
 		interfaceTypeString,
@@ -441,6 +471,27 @@ func ParseGeneralSummary(overview OutputOverview, showURL bool, showRelays bool,
 	return summary
 }
 
+// parseDNSBlocklists returns the DNS blocklists summary line, or nothing if the peer doesn't enforce blocklists
+func parseDNSBlocklists(blocklists []DNSBlocklistStateOutput, showDetails bool) string {
+	if len(blocklists) == 0 {
+		return ""
+	}
+
+	if !showDetails {
+		var hits uint64
+		for _, blocklist := range blocklists {
+			hits += blocklist.Hits
+		}
+		return fmt.Sprintf("DNS blocklists: %d enforced, %d queries blocked\n", len(blocklists), hits)
+	}
+
+	blocklistsString := "DNS blocklists:"
+	for _, blocklist := range blocklists {
+		blocklistsString += fmt.Sprintf("\n  [%s] %d domains, %d queries blocked", blocklist.Name, blocklist.Domains, blocklist.Hits)
+	}
+	return blocklistsString + "\n"
+}
+
 func ParseToFullDetailSummary(overview OutputOverview) string {
 	parsedPeersString := parsePeers(overview.Peers, overview.RosenpassEnabled, overview.RosenpassPermissive)
 	parsedEventsString := parseEvents(overview.Events)
@@ -613,3 +613,14 @@ func TestTimeAgo(t *testing.T) {
 		})
 	}
 }
+
+func TestParseDNSBlocklists(t *testing.T) {
+	blocklists := mapDNSBlocklists([]*proto.DNSBlocklistState{
+		{Id: "ads", Name: "ads", Domains: 120, Hits: 7},
+		{Id: "malware", Name: "malware", Domains: 3, Hits: 1},
+	})
+
+	assert.Equal(t, "DNS blocklists: 2 enforced, 8 queries blocked\n", parseDNSBlocklists(blocklists, false))
+	assert.Equal(t, "DNS blocklists:\n  [ads] 120 domains, 7 queries blocked\n  [malware] 3 domains, 1 queries blocked\n", parseDNSBlocklists(blocklists, true))
+	assert.Empty(t, parseDNSBlocklists(mapDNSBlocklists(nil), true))
+}
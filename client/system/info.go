@@ -4,11 +4,14 @@ import (
 	"context"
 	"net"
 	"net/netip"
+	"slices"
 	"strings"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/metadata"
 
+	"github.com/netbirdio/netbird/shared/management/posture"
 	"github.com/netbirdio/netbird/shared/management/proto"
 )
 
@@ -40,6 +43,20 @@ type File struct {
 	ProcessIsRunning bool
 }
 
+// DiskEncryption is the encryption state of the mounted volumes
+type DiskEncryption struct {
+	Volumes []EncryptedVolume
+}
+
+// EncryptedVolume is a mounted volume, identified by its mount point or drive letter
+type EncryptedVolume = posture.EncryptedVolume
+
+// HostFirewall is the state of the host firewalls
+type HostFirewall struct {
+	// Active host firewalls filtering incoming traffic, rules managed by NetBird aren't taken into account
+	Active []string
+}
+
 // Info is an object that contains machine information
 // Most of the code is taken from https://github.com/matishsiao/goInfo
 type Info struct {
@@ -59,6 +76,14 @@ type Info struct {
 	SystemManufacturer string
 	Environment        Environment
 	Files              []File // for posture checks
+	DiskEncryption     DiskEncryption
+	HostFirewall       HostFirewall
+
+	// PostureCheckResults are the results of the client-side posture checks evaluation
+	PostureCheckResults []PostureCheckResult
+
+	// RelayLatencies are the round trip times measured to the relay servers
+	RelayLatencies []RelayLatency
 
 	RosenpassEnabled    bool
 	RosenpassPermissive bool
@@ -74,6 +99,12 @@ type Info struct {
 	LazyConnectionEnabled bool
 }
 
+// RelayLatency is the round trip time measured to a relay server
+type RelayLatency struct {
+	URL string
+	RTT time.Duration
+}
+
 func (i *Info) SetFlags(
 	rosenpassEnabled, rosenpassPermissive bool,
 	serverSSHAllowed *bool,
@@ -175,8 +206,16 @@ func isDuplicated(addresses []NetworkAddress, addr NetworkAddress) bool {
 func GetInfoWithChecks(ctx context.Context, checks []*proto.Checks) (*Info, error) {
 	log.Debugf("gathering system information with checks: %d", len(checks))
 	processCheckPaths := make([]string, 0)
+	var diskEncryptionCheck, hostFirewallCheck bool
 	for _, check := range checks {
 		processCheckPaths = append(processCheckPaths, check.GetFiles()...)
+		for _, path := range check.GetProcesses() {
+			if path != "" && !slices.Contains(processCheckPaths, path) {
+				processCheckPaths = append(processCheckPaths, path)
+			}
+		}
+		diskEncryptionCheck = diskEncryptionCheck || check.GetDiskEncryption()
+		hostFirewallCheck = hostFirewallCheck || check.GetHostFirewall()
 	}
 
 	files, err := checkFileAndProcess(processCheckPaths)
@@ -188,6 +227,23 @@ func GetInfoWithChecks(ctx context.Context, checks []*proto.Checks) (*Info, erro
 	info := GetInfo(ctx)
 	info.Files = files
 
+	if diskEncryptionCheck {
+		// a failed detection is reported as missing state, the posture check fails on the management side
+		if info.DiskEncryption, err = checkDiskEncryption(); err != nil {
+			log.Warnf("failed to check disk encryption: %v", err)
+		}
+		log.Debugf("gathering disk encryption information completed")
+	}
+
+	if hostFirewallCheck {
+		if info.HostFirewall, err = checkHostFirewall(); err != nil {
+			log.Warnf("failed to check host firewall: %v", err)
+		}
+		log.Debugf("gathering host firewall information completed")
+	}
+
+	info.PostureCheckResults = evaluateChecks(checks, info)
+
 	log.Debugf("all system information gathered successfully")
 	return info, nil
 }
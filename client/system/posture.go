@@ -0,0 +1,65 @@
+package system
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/netbirdio/netbird/shared/management/posture"
+	"github.com/netbirdio/netbird/shared/management/proto"
+)
+
+// PostureCheckResult is the result of the client-side evaluation of posture checks
+type PostureCheckResult struct {
+	// ID of the evaluated posture checks
+	ID     string
+	Passed bool
+	// Reason describes why the checks failed
+	Reason string
+}
+
+// evaluateChecks evaluates the client-side parts of the posture checks on the gathered system information.
+// Checks without an ID are sent by management versions that don't expect client-side results and are skipped.
+func evaluateChecks(checks []*proto.Checks, info *Info) []PostureCheckResult {
+	results := make([]PostureCheckResult, 0, len(checks))
+	for _, check := range checks {
+		if check.GetID() == "" {
+			continue
+		}
+
+		result := PostureCheckResult{ID: check.GetID(), Passed: true}
+		if reason := evaluateCheck(check, info); reason != "" {
+			result.Passed = false
+			result.Reason = reason
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// evaluateCheck returns the reason the check failed, empty if it passed
+func evaluateCheck(check *proto.Checks, info *Info) string {
+	for _, path := range check.GetProcesses() {
+		if path == "" {
+			return fmt.Sprintf("process check isn't defined for %s", info.GoOS)
+		}
+		if !slices.ContainsFunc(info.Files, func(file File) bool {
+			return file.Path == path && file.ProcessIsRunning
+		}) {
+			return fmt.Sprintf("process %s isn't running", path)
+		}
+	}
+
+	if check.GetDiskEncryption() {
+		if reason := posture.CheckDiskEncryption(info.GoOS, check.GetDiskEncryptionVolumes(), info.DiskEncryption.Volumes); reason != "" {
+			return reason
+		}
+	}
+
+	if check.GetHostFirewall() {
+		if reason := posture.CheckHostFirewall(check.GetHostFirewalls(), info.HostFirewall.Active); reason != "" {
+			return reason
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,41 @@
+//go:build !ios
+// +build !ios
+
+package system
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+const socketFilterFirewall = "/usr/libexec/ApplicationFirewall/socketfilterfw"
+
+// checkDiskEncryption reports the FileVault state of the system volume
+func checkDiskEncryption() (DiskEncryption, error) {
+	out, err := exec.Command("/usr/bin/fdesetup", "status").Output()
+	if err != nil {
+		return DiskEncryption{}, fmt.Errorf("fdesetup status: %w", err)
+	}
+
+	// e.g. "FileVault is On." or "FileVault is Off."
+	encrypted := strings.HasPrefix(strings.TrimSpace(string(out)), "FileVault is On")
+	return DiskEncryption{
+		Volumes: []EncryptedVolume{{Path: "/", Encrypted: encrypted}},
+	}, nil
+}
+
+// checkHostFirewall reports the state of the macOS application firewall
+func checkHostFirewall() (HostFirewall, error) {
+	out, err := exec.Command(socketFilterFirewall, "--getglobalstate").Output()
+	if err != nil {
+		return HostFirewall{}, fmt.Errorf("socketfilterfw --getglobalstate: %w", err)
+	}
+
+	// e.g. "Firewall is enabled. (State = 1)", block all mode reports "State = 2"
+	var hostFirewall HostFirewall
+	if strings.Contains(string(out), "enabled") {
+		hostFirewall.Active = append(hostFirewall.Active, "application firewall")
+	}
+	return hostFirewall, nil
+}
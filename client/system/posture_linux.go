@@ -0,0 +1,246 @@
+//go:build !android
+
+package system
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/coreos/go-iptables/iptables"
+	"github.com/google/nftables"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	mountsFile    = "/proc/mounts"
+	sysBlockDir   = "/sys/class/block"
+	ufwConfigFile = "/etc/ufw/ufw.conf"
+
+	// nbTableName is the nftables table managed by the NetBird firewall, its rules don't count as host firewall
+	nbTableName = "netbird"
+)
+
+// encryptableFilesystems are the filesystems of volumes holding user data
+var encryptableFilesystems = map[string]struct{}{
+	"ext2":     {},
+	"ext3":     {},
+	"ext4":     {},
+	"xfs":      {},
+	"btrfs":    {},
+	"f2fs":     {},
+	"jfs":      {},
+	"reiserfs": {},
+	"bcachefs": {},
+}
+
+// unencryptedMountPoints are the mount points that are expected to be unencrypted to boot the system
+var unencryptedMountPoints = []string{"/boot", "/efi"}
+
+// checkDiskEncryption reports every mounted block device volume and whether it is backed by dm-crypt (LUKS)
+func checkDiskEncryption() (DiskEncryption, error) {
+	f, err := os.Open(mountsFile)
+	if err != nil {
+		return DiskEncryption{}, fmt.Errorf("open %s: %w", mountsFile, err)
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.Debugf("failed to close %s: %v", mountsFile, err)
+		}
+	}()
+
+	var diskEncryption DiskEncryption
+	seen := make(map[string]struct{})
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 3 {
+			continue
+		}
+		device, mountPoint, fsType := fields[0], unescapeMountPoint(fields[1]), fields[2]
+
+		if _, ok := encryptableFilesystems[fsType]; !ok || !strings.HasPrefix(device, "/dev/") {
+			continue
+		}
+		if isBootMountPoint(mountPoint) {
+			continue
+		}
+		if _, ok := seen[mountPoint]; ok {
+			continue
+		}
+		seen[mountPoint] = struct{}{}
+
+		diskEncryption.Volumes = append(diskEncryption.Volumes, EncryptedVolume{
+			Path:      mountPoint,
+			Encrypted: isDeviceEncrypted(device),
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return DiskEncryption{}, fmt.Errorf("read %s: %w", mountsFile, err)
+	}
+
+	return diskEncryption, nil
+}
+
+func isBootMountPoint(mountPoint string) bool {
+	for _, boot := range unencryptedMountPoints {
+		if mountPoint == boot || strings.HasPrefix(mountPoint, boot+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// unescapeMountPoint decodes the octal escapes used for spaces and tabs in /proc/mounts
+func unescapeMountPoint(mountPoint string) string {
+	return strings.NewReplacer(`\040`, " ", `\011`, "\t", `\012`, "\n", `\134`, `\`).Replace(mountPoint)
+}
+
+// isDeviceEncrypted returns true if the device is a dm-crypt mapping or is stacked on top of one, e.g. LVM on LUKS
+func isDeviceEncrypted(device string) bool {
+	resolved, err := filepath.EvalSymlinks(device)
+	if err != nil {
+		log.Debugf("failed to resolve device %s: %v", device, err)
+		return false
+	}
+	return isBlockDeviceEncrypted(filepath.Base(resolved), 0)
+}
+
+func isBlockDeviceEncrypted(name string, depth int) bool {
+	// device mapper stacks are shallow, the limit guards against unexpected loops
+	if depth > 8 {
+		return false
+	}
+
+	uuid, err := os.ReadFile(filepath.Join(sysBlockDir, name, "dm", "uuid"))
+	if err == nil && strings.HasPrefix(strings.TrimSpace(string(uuid)), "CRYPT-") {
+		return true
+	}
+
+	slaves, err := os.ReadDir(filepath.Join(sysBlockDir, name, "slaves"))
+	if err != nil || len(slaves) == 0 {
+		return false
+	}
+	for _, slave := range slaves {
+		if !isBlockDeviceEncrypted(slave.Name(), depth+1) {
+			return false
+		}
+	}
+	return true
+}
+
+// checkHostFirewall reports the firewall frontends that are enabled and the packet filters dropping incoming traffic by default
+func checkHostFirewall() (HostFirewall, error) {
+	var hostFirewall HostFirewall
+	var errs []error
+
+	if isUFWEnabled() {
+		hostFirewall.Active = append(hostFirewall.Active, "ufw")
+	}
+
+	if isFirewalldRunning() {
+		hostFirewall.Active = append(hostFirewall.Active, "firewalld")
+	}
+
+	active, err := isNftablesInputFiltered()
+	if err != nil {
+		errs = append(errs, fmt.Errorf("nftables: %w", err))
+	} else if active {
+		hostFirewall.Active = append(hostFirewall.Active, "nftables")
+	}
+
+	active, err = isIptablesInputFiltered()
+	if err != nil {
+		errs = append(errs, fmt.Errorf("iptables: %w", err))
+	} else if active {
+		hostFirewall.Active = append(hostFirewall.Active, "iptables")
+	}
+
+	// a single working backend is enough, nftables or iptables might not be available on the system
+	if len(hostFirewall.Active) == 0 && len(errs) == 2 {
+		return hostFirewall, errors.Join(errs...)
+	}
+
+	return hostFirewall, nil
+}
+
+func isUFWEnabled() bool {
+	f, err := os.Open(ufwConfigFile)
+	if err != nil {
+		return false
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.Debugf("failed to close %s: %v", ufwConfigFile, err)
+		}
+	}()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if value, ok := strings.CutPrefix(line, "ENABLED="); ok {
+			return strings.EqualFold(strings.Trim(value, `"'`), "yes")
+		}
+	}
+	return false
+}
+
+func isFirewalldRunning() bool {
+	if _, err := exec.LookPath("firewall-cmd"); err != nil {
+		return false
+	}
+	out, err := exec.Command("firewall-cmd", "--state").Output()
+	return err == nil && strings.TrimSpace(string(out)) == "running"
+}
+
+// isNftablesInputFiltered returns true if any input hook chain outside the NetBird table drops traffic by default
+func isNftablesInputFiltered() (bool, error) {
+	conn, err := nftables.New(nftables.AsLasting())
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		if err := conn.CloseLasting(); err != nil {
+			log.Debugf("failed to close nftables connection: %v", err)
+		}
+	}()
+
+	chains, err := conn.ListChains()
+	if err != nil {
+		return false, err
+	}
+
+	for _, chain := range chains {
+		if chain.Table == nil || chain.Table.Name == nbTableName {
+			continue
+		}
+		if chain.Hooknum == nil || *chain.Hooknum != *nftables.ChainHookInput {
+			continue
+		}
+		if chain.Policy != nil && *chain.Policy == nftables.ChainPolicyDrop {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// isIptablesInputFiltered returns true if the INPUT chain of the filter table drops traffic by default
+func isIptablesInputFiltered() (bool, error) {
+	ipt, err := iptables.New()
+	if err != nil {
+		return false, err
+	}
+
+	rules, err := ipt.List("filter", "INPUT")
+	if err != nil {
+		return false, err
+	}
+
+	// the first entry is the chain policy, e.g. "-P INPUT DROP"
+	return len(rules) > 0 && strings.TrimSpace(rules[0]) == "-P INPUT DROP", nil
+}
@@ -0,0 +1,31 @@
+//go:build !android
+
+package system
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_isBootMountPoint(t *testing.T) {
+	assert.True(t, isBootMountPoint("/boot"))
+	assert.True(t, isBootMountPoint("/boot/efi"))
+	assert.True(t, isBootMountPoint("/efi"))
+	assert.False(t, isBootMountPoint("/"))
+	assert.False(t, isBootMountPoint("/bootstrap"))
+}
+
+func Test_unescapeMountPoint(t *testing.T) {
+	assert.Equal(t, "/mnt/my data", unescapeMountPoint(`/mnt/my\040data`))
+	assert.Equal(t, "/home", unescapeMountPoint("/home"))
+}
+
+func Test_checkDiskEncryption(t *testing.T) {
+	diskEncryption, err := checkDiskEncryption()
+	assert.NoError(t, err)
+	for _, volume := range diskEncryption.Volumes {
+		assert.NotEmpty(t, volume.Path)
+		assert.False(t, isBootMountPoint(volume.Path))
+	}
+}
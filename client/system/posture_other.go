@@ -0,0 +1,18 @@
+//go:build !(linux && !android) && !(darwin && !ios) && !windows
+
+package system
+
+import (
+	"fmt"
+	"runtime"
+)
+
+// checkDiskEncryption isn't supported on this platform, the peer fails disk encryption posture checks
+func checkDiskEncryption() (DiskEncryption, error) {
+	return DiskEncryption{}, fmt.Errorf("disk encryption check is not supported on %s", runtime.GOOS)
+}
+
+// checkHostFirewall isn't supported on this platform, the peer fails host firewall posture checks
+func checkHostFirewall() (HostFirewall, error) {
+	return HostFirewall{}, fmt.Errorf("host firewall check is not supported on %s", runtime.GOOS)
+}
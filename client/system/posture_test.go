@@ -0,0 +1,79 @@
+package system
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/netbirdio/netbird/shared/management/proto"
+)
+
+func Test_evaluateChecks(t *testing.T) {
+	info := &Info{
+		GoOS: "linux",
+		Files: []File{
+			{Path: "/usr/bin/agent", Exist: true, ProcessIsRunning: true},
+			{Path: "/usr/bin/stopped", Exist: true},
+		},
+		DiskEncryption: DiskEncryption{
+			Volumes: []EncryptedVolume{
+				{Path: "/", Encrypted: true},
+				{Path: "/data"},
+			},
+		},
+		HostFirewall: HostFirewall{Active: []string{"nftables"}},
+	}
+
+	tests := []struct {
+		name     string
+		check    *proto.Checks
+		expected []PostureCheckResult
+	}{
+		{
+			name:     "checks without id are skipped",
+			check:    &proto.Checks{Files: []string{"/usr/bin/agent"}},
+			expected: []PostureCheckResult{},
+		},
+		{
+			name:     "running process",
+			check:    &proto.Checks{ID: "1", Processes: []string{"/usr/bin/agent"}},
+			expected: []PostureCheckResult{{ID: "1", Passed: true}},
+		},
+		{
+			name:     "stopped process",
+			check:    &proto.Checks{ID: "1", Processes: []string{"/usr/bin/agent", "/usr/bin/stopped"}},
+			expected: []PostureCheckResult{{ID: "1", Reason: "process /usr/bin/stopped isn't running"}},
+		},
+		{
+			name:     "process not defined for the operating system",
+			check:    &proto.Checks{ID: "1", Processes: []string{""}},
+			expected: []PostureCheckResult{{ID: "1", Reason: "process check isn't defined for linux"}},
+		},
+		{
+			name:     "required volume encrypted",
+			check:    &proto.Checks{ID: "1", DiskEncryption: true, DiskEncryptionVolumes: []string{"/"}},
+			expected: []PostureCheckResult{{ID: "1", Passed: true}},
+		},
+		{
+			name:     "unencrypted volume",
+			check:    &proto.Checks{ID: "1", DiskEncryption: true},
+			expected: []PostureCheckResult{{ID: "1", Reason: "volume /data isn't encrypted"}},
+		},
+		{
+			name:     "any host firewall",
+			check:    &proto.Checks{ID: "1", HostFirewall: true},
+			expected: []PostureCheckResult{{ID: "1", Passed: true}},
+		},
+		{
+			name:     "accepted host firewall not active",
+			check:    &proto.Checks{ID: "1", HostFirewall: true, HostFirewalls: []string{"ufw"}},
+			expected: []PostureCheckResult{{ID: "1", Reason: "none of the host firewalls ufw is active"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, evaluateChecks([]*proto.Checks{tt.check}, info))
+		})
+	}
+}
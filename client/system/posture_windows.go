@@ -0,0 +1,99 @@
+package system
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/yusufpapurcu/wmi"
+	"golang.org/x/sys/windows/registry"
+)
+
+const (
+	volumeEncryptionNamespace = `root\CIMV2\Security\MicrosoftVolumeEncryption`
+
+	// bitLockerProtectionOn is the ProtectionStatus of volumes encrypted with active key protectors
+	bitLockerProtectionOn = 1
+
+	firewallPolicyKey      = `SYSTEM\CurrentControlSet\Services\SharedAccess\Parameters\FirewallPolicy`
+	firewallGroupPolicyKey = `SOFTWARE\Policies\Microsoft\WindowsFirewall`
+)
+
+// firewallProfiles maps the firewall profile registry keys to their group policy counterparts
+var firewallProfiles = map[string]string{
+	"DomainProfile":   "DomainProfile",
+	"StandardProfile": "PrivateProfile",
+	"PublicProfile":   "PublicProfile",
+}
+
+type Win32_EncryptableVolume struct {
+	DriveLetter      string
+	ProtectionStatus uint32
+}
+
+// checkDiskEncryption reports the BitLocker protection state of the volumes with a drive letter
+func checkDiskEncryption() (DiskEncryption, error) {
+	var dst []Win32_EncryptableVolume
+	query := wmi.CreateQuery(&dst, "")
+	if err := wmi.QueryNamespace(query, &dst, volumeEncryptionNamespace); err != nil {
+		return DiskEncryption{}, fmt.Errorf("query encryptable volumes: %w", err)
+	}
+
+	var diskEncryption DiskEncryption
+	for _, volume := range dst {
+		if volume.DriveLetter == "" {
+			continue
+		}
+		diskEncryption.Volumes = append(diskEncryption.Volumes, EncryptedVolume{
+			Path:      volume.DriveLetter,
+			Encrypted: volume.ProtectionStatus == bitLockerProtectionOn,
+		})
+	}
+	return diskEncryption, nil
+}
+
+// checkHostFirewall reports the Windows Defender Firewall as active when it is enabled for all network profiles
+func checkHostFirewall() (HostFirewall, error) {
+	for profile, policyProfile := range firewallProfiles {
+		enabled, err := isFirewallProfileEnabled(profile, policyProfile)
+		if err != nil {
+			return HostFirewall{}, err
+		}
+		if !enabled {
+			return HostFirewall{}, nil
+		}
+	}
+
+	return HostFirewall{Active: []string{"windows firewall"}}, nil
+}
+
+// isFirewallProfileEnabled reads the firewall state of a profile, a group policy setting takes precedence over the local one
+func isFirewallProfileEnabled(profile, policyProfile string) (bool, error) {
+	if enabled, err := readEnableFirewall(firewallGroupPolicyKey + `\` + policyProfile); err == nil {
+		return enabled, nil
+	}
+
+	enabled, err := readEnableFirewall(firewallPolicyKey + `\` + profile)
+	if err != nil {
+		return false, fmt.Errorf("read firewall state of %s: %w", profile, err)
+	}
+	return enabled, nil
+}
+
+func readEnableFirewall(path string) (bool, error) {
+	k, err := registry.OpenKey(registry.LOCAL_MACHINE, path, registry.QUERY_VALUE)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		_ = k.Close()
+	}()
+
+	value, _, err := k.GetIntegerValue("EnableFirewall")
+	if err != nil {
+		if errors.Is(err, registry.ErrNotExist) {
+			return false, err
+		}
+		return false, fmt.Errorf("read EnableFirewall: %w", err)
+	}
+	return value == 1, nil
+}
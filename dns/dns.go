@@ -31,6 +31,20 @@ type Config struct {
 	NameServerGroups []*NameServerGroup
 	// CustomZones contains a list of custom zone
 	CustomZones []CustomZone
+	// Blocklists contains the domains blocked by the dns server
+	Blocklists []Blocklist
+}
+
+// Blocklist represents a list of domains for which the dns server doesn't resolve queries
+type Blocklist struct {
+	// ID identifies the blocklist in the account
+	ID string
+	// Name is the human-readable name of the blocklist
+	Name string
+	// Sinkhole indicates whether blocked A and AAAA queries are answered with the unspecified address instead of NXDOMAIN
+	Sinkhole bool
+	// Domains are blocked together with their subdomains
+	Domains []string
 }
 
 // CustomZone represents a custom zone to be resolved by the dns server
@@ -41,17 +55,17 @@ type CustomZone struct {
 	Records []SimpleRecord
 }
 
-// SimpleRecord provides a simple DNS record specification for CNAME, A and AAAA records
+// SimpleRecord provides a simple DNS record specification for A, AAAA, CNAME, TXT, SRV, MX and PTR records
 type SimpleRecord struct {
 	// Name domain name
 	Name string
-	// Type of record, 1 for A, 5 for CNAME, 28 for AAAA. see https://pkg.go.dev/github.com/miekg/dns@v1.1.41#pkg-constants
+	// Type of record, e.g. 1 for A, 5 for CNAME, 28 for AAAA, 16 for TXT. see https://pkg.go.dev/github.com/miekg/dns@v1.1.41#pkg-constants
 	Type int
 	// Class dns class, currently use the DefaultClass for all records
 	Class string
 	// TTL time-to-live for the record
 	TTL int
-	// RData is the actual value resolved in a dns query
+	// RData is the actual value resolved in a dns query, in zone file presentation format
 	RData string
 }
 
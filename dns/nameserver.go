@@ -1,6 +1,7 @@
 package dns
 
 import (
+	"encoding/base64"
 	"fmt"
 	"net/netip"
 	"net/url"
@@ -13,6 +14,10 @@ const (
 	InvalidNameServerType NameServerType = iota
 	// UDPNameServerType udp nameserver type
 	UDPNameServerType
+	// DoTNameServerType DNS-over-TLS nameserver type
+	DoTNameServerType
+	// DoHNameServerType DNS-over-HTTPS nameserver type
+	DoHNameServerType
 )
 
 const (
@@ -22,6 +27,17 @@ const (
 	InvalidNameServerTypeString = "invalid"
 	// UDPNameServerTypeString udp nameserver type as string
 	UDPNameServerTypeString = "udp"
+	// DoTNameServerTypeString DNS-over-TLS nameserver type as string
+	DoTNameServerTypeString = "dot"
+	// DoHNameServerTypeString DNS-over-HTTPS nameserver type as string
+	DoHNameServerTypeString = "doh"
+
+	// DefaultDoTPort default DNS-over-TLS port
+	DefaultDoTPort = 853
+	// DefaultDoHPort default DNS-over-HTTPS port
+	DefaultDoHPort = 443
+	// DefaultDoHPath default DNS-over-HTTPS query path
+	DefaultDoHPath = "/dns-query"
 )
 
 // NameServerType nameserver type
@@ -32,16 +48,29 @@ func (n NameServerType) String() string {
 	switch n {
 	case UDPNameServerType:
 		return UDPNameServerTypeString
+	case DoTNameServerType:
+		return DoTNameServerTypeString
+	case DoHNameServerType:
+		return DoHNameServerTypeString
 	default:
 		return InvalidNameServerTypeString
 	}
 }
 
-// ToNameServerType returns a nameserver type
+// IsEncrypted returns true for nameserver types that don't send DNS queries in cleartext
+func (n NameServerType) IsEncrypted() bool {
+	return n == DoTNameServerType || n == DoHNameServerType
+}
+
+// ToNameServerType returns a nameserver type, the https url scheme is accepted for DNS-over-HTTPS
 func ToNameServerType(typeString string) NameServerType {
 	switch typeString {
 	case UDPNameServerTypeString:
 		return UDPNameServerType
+	case DoTNameServerTypeString:
+		return DoTNameServerType
+	case DoHNameServerTypeString, "https":
+		return DoHNameServerType
 	default:
 		return InvalidNameServerType
 	}
@@ -79,6 +108,14 @@ type NameServer struct {
 	NSType NameServerType
 	// Port nameserver listening port
 	Port int
+	// ServerName is the name the TLS certificate of encrypted nameservers is verified against and sent as SNI,
+	// the IP is used when empty
+	ServerName string `json:",omitempty"`
+	// Path is the DNS-over-HTTPS query path
+	Path string `json:",omitempty"`
+	// Pin is the base64 encoded SHA-256 hash of the public key of encrypted nameservers.
+	// When set the certificate is trusted if the public key of one of the presented certificates matches it.
+	Pin string `json:",omitempty"`
 }
 
 // EventMeta returns activity event meta related to the nameserver group
@@ -89,9 +126,12 @@ func (g *NameServerGroup) EventMeta() map[string]any {
 // Copy copies a nameserver object
 func (n *NameServer) Copy() *NameServer {
 	return &NameServer{
-		IP:     n.IP,
-		NSType: n.NSType,
-		Port:   n.Port,
+		IP:         n.IP,
+		NSType:     n.NSType,
+		Port:       n.Port,
+		ServerName: n.ServerName,
+		Path:       n.Path,
+		Pin:        n.Pin,
 	}
 }
 
@@ -99,7 +139,10 @@ func (n *NameServer) Copy() *NameServer {
 func (n *NameServer) IsEqual(other *NameServer) bool {
 	return other.IP == n.IP &&
 		other.NSType == n.NSType &&
-		other.Port == n.Port
+		other.Port == n.Port &&
+		other.ServerName == n.ServerName &&
+		other.Path == n.Path &&
+		other.Pin == n.Pin
 }
 
 // AddrPort returns the nameserver as a netip.AddrPort
@@ -107,7 +150,60 @@ func (n *NameServer) AddrPort() netip.AddrPort {
 	return netip.AddrPortFrom(n.IP, uint16(n.Port))
 }
 
-// ParseNameServerURL parses a nameserver url in the format <type>://<ip>:<port>, e.g., udp://1.1.1.1:53
+// String returns the nameserver as url
+func (n *NameServer) String() string {
+	u := url.URL{Scheme: n.NSType.String(), Host: n.AddrPort().String()}
+	query := url.Values{}
+	if n.ServerName != "" {
+		query.Set("sni", n.ServerName)
+	}
+	if n.Pin != "" {
+		query.Set("pin", n.Pin)
+	}
+	u.RawQuery = query.Encode()
+	if n.NSType == DoHNameServerType {
+		u.Scheme = "https"
+		u.Path = n.Path
+	}
+	return u.String()
+}
+
+// Validate checks the encryption settings of the nameserver
+func (n *NameServer) Validate() error {
+	if !n.NSType.IsEncrypted() {
+		if n.ServerName != "" || n.Path != "" || n.Pin != "" {
+			return fmt.Errorf("server name, path and pin are only supported by encrypted nameservers")
+		}
+		return nil
+	}
+
+	if n.NSType == DoHNameServerType && !strings.HasPrefix(n.Path, "/") {
+		return fmt.Errorf("invalid DNS-over-HTTPS path %q, it should start with /", n.Path)
+	}
+	if n.NSType == DoTNameServerType && n.Path != "" {
+		return fmt.Errorf("path is only supported by DNS-over-HTTPS nameservers")
+	}
+
+	if n.ServerName != "" {
+		if _, err := netip.ParseAddr(n.ServerName); err == nil || strings.ContainsAny(n.ServerName, "/:@ ") {
+			return fmt.Errorf("invalid nameserver server name %q", n.ServerName)
+		}
+	}
+
+	if n.Pin != "" {
+		pin, err := base64.StdEncoding.DecodeString(n.Pin)
+		if err != nil || len(pin) != 32 {
+			return fmt.Errorf("invalid nameserver pin, expected a base64 encoded SHA-256 hash")
+		}
+	}
+
+	return nil
+}
+
+// ParseNameServerURL parses a nameserver url in the format <type>://<ip>:<port>, e.g., udp://1.1.1.1:53.
+// Encrypted nameservers are given as dot://<host>[:<port>] and https://<host>[:<port>][/<path>], with
+// the optional sni and pin query parameters. A host name is used as server name and requires the ip
+// query parameter, e.g., https://dns.google/dns-query?ip=8.8.8.8
 func ParseNameServerURL(nsURL string) (NameServer, error) {
 	parsedURL, err := url.Parse(nsURL)
 	if err != nil {
@@ -121,19 +217,50 @@ func ParseNameServerURL(nsURL string) (NameServer, error) {
 	}
 	ns.NSType = nsType
 
-	parsedPort, err := strconv.Atoi(parsedURL.Port())
-	if err != nil {
-		return NameServer{}, fmt.Errorf("invalid nameserver url port, got %s", parsedURL.Port())
+	switch {
+	case parsedURL.Port() == "" && nsType == DoTNameServerType:
+		ns.Port = DefaultDoTPort
+	case parsedURL.Port() == "" && nsType == DoHNameServerType:
+		ns.Port = DefaultDoHPort
+	default:
+		parsedPort, err := strconv.Atoi(parsedURL.Port())
+		if err != nil {
+			return NameServer{}, fmt.Errorf("invalid nameserver url port, got %s", parsedURL.Port())
+		}
+		ns.Port = parsedPort
 	}
-	ns.Port = parsedPort
 
-	parsedAddr, err := netip.ParseAddr(parsedURL.Hostname())
+	query := parsedURL.Query()
+	host := parsedURL.Hostname()
+	if nsType.IsEncrypted() {
+		ns.ServerName = query.Get("sni")
+		ns.Pin = query.Get("pin")
+		if _, err := netip.ParseAddr(host); err != nil && query.Has("ip") {
+			if ns.ServerName == "" {
+				ns.ServerName = host
+			}
+			host = query.Get("ip")
+		}
+	}
+
+	parsedAddr, err := netip.ParseAddr(host)
 	if err != nil {
-		return NameServer{}, fmt.Errorf("invalid nameserver url IP, got %s", parsedURL.Hostname())
+		return NameServer{}, fmt.Errorf("invalid nameserver url IP, got %s", host)
 	}
 
 	ns.IP = parsedAddr
 
+	if nsType == DoHNameServerType {
+		ns.Path = parsedURL.Path
+		if ns.Path == "" || ns.Path == "/" {
+			ns.Path = DefaultDoHPath
+		}
+	}
+
+	if err := ns.Validate(); err != nil {
+		return NameServer{}, err
+	}
+
 	return ns, nil
 }
 
@@ -0,0 +1,117 @@
+package dns
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseNameServerURL(t *testing.T) {
+	const pin = "2b6ZqNPHHjGbHpJL0ryHuK8yJzDVrjS43rTo7oIuvoo="
+
+	testCases := []struct {
+		name     string
+		url      string
+		expected NameServer
+		wantErr  bool
+	}{
+		{
+			name:     "udp",
+			url:      "udp://1.1.1.1:53",
+			expected: NameServer{IP: netip.MustParseAddr("1.1.1.1"), NSType: UDPNameServerType, Port: 53},
+		},
+		{
+			name:    "udp without port",
+			url:     "udp://1.1.1.1",
+			wantErr: true,
+		},
+		{
+			name: "udp ignores query parameters",
+			url:  "udp://1.1.1.1:53?sni=one.one.one.one",
+			expected: NameServer{
+				IP: netip.MustParseAddr("1.1.1.1"), NSType: UDPNameServerType, Port: 53,
+			},
+		},
+		{
+			name: "dot with default port",
+			url:  "dot://1.1.1.1?sni=one.one.one.one",
+			expected: NameServer{
+				IP: netip.MustParseAddr("1.1.1.1"), NSType: DoTNameServerType, Port: DefaultDoTPort, ServerName: "one.one.one.one",
+			},
+		},
+		{
+			name: "dot with host name and pin",
+			url:  "dot://dns.internal:8853?ip=10.0.0.53&pin=" + pin,
+			expected: NameServer{
+				IP: netip.MustParseAddr("10.0.0.53"), NSType: DoTNameServerType, Port: 8853, ServerName: "dns.internal", Pin: pin,
+			},
+		},
+		{
+			name:    "dot with host name without ip",
+			url:     "dot://dns.internal",
+			wantErr: true,
+		},
+		{
+			name:    "dot with invalid pin",
+			url:     "dot://1.1.1.1?pin=abc",
+			wantErr: true,
+		},
+		{
+			name: "https with default path",
+			url:  "https://dns.google?ip=8.8.8.8",
+			expected: NameServer{
+				IP: netip.MustParseAddr("8.8.8.8"), NSType: DoHNameServerType, Port: DefaultDoHPort, ServerName: "dns.google", Path: DefaultDoHPath,
+			},
+		},
+		{
+			name: "doh with path",
+			url:  "doh://9.9.9.9:5443/custom?sni=dns.quad9.net",
+			expected: NameServer{
+				IP: netip.MustParseAddr("9.9.9.9"), NSType: DoHNameServerType, Port: 5443, ServerName: "dns.quad9.net", Path: "/custom",
+			},
+		},
+		{
+			name:    "unknown type",
+			url:     "tcp://1.1.1.1:53",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ns, err := ParseNameServerURL(tc.url)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, ns)
+
+			if tc.expected.NSType.IsEncrypted() {
+				parsed, err := ParseNameServerURL(ns.String())
+				require.NoError(t, err)
+				assert.Equal(t, ns, parsed, "String should return a parsable url")
+			}
+		})
+	}
+}
+
+func TestNameServer_Validate(t *testing.T) {
+	udp := NameServer{IP: netip.MustParseAddr("1.1.1.1"), NSType: UDPNameServerType, Port: 53, ServerName: "one.one.one.one"}
+	assert.Error(t, udp.Validate(), "udp nameservers don't support a server name")
+
+	dot := NameServer{IP: netip.MustParseAddr("1.1.1.1"), NSType: DoTNameServerType, Port: 853, Path: "/dns-query"}
+	assert.Error(t, dot.Validate(), "dot nameservers don't support a path")
+
+	doh := NameServer{IP: netip.MustParseAddr("1.1.1.1"), NSType: DoHNameServerType, Port: 443, Path: "dns-query"}
+	assert.Error(t, doh.Validate(), "doh path should be absolute")
+
+	doh.Path = DefaultDoHPath
+	doh.ServerName = "1.1.1.1"
+	assert.Error(t, doh.Validate(), "server name shouldn't be an IP")
+
+	doh.ServerName = "cloudflare-dns.com"
+	assert.NoError(t, doh.Validate())
+}
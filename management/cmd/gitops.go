@@ -0,0 +1,259 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/netbirdio/netbird/shared/management/client/rest"
+	"github.com/netbirdio/netbird/shared/management/http/api"
+)
+
+const (
+	formatYAML = "yaml"
+	formatJSON = "json"
+
+	// apiTokenEnv is used as personal access token when the --token flag isn't set
+	apiTokenEnv = "NB_API_TOKEN"
+)
+
+var (
+	gitopsManagementURL string
+	gitopsToken         string
+	gitopsAccountID     string
+	gitopsFormat        string
+	gitopsFile          string
+	gitopsDryRun        bool
+
+	gitopsCmd = &cobra.Command{
+		Use:          "gitops",
+		Short:        "Export an account to a document and apply documents to an account",
+		Long:         "Contains sub-commands to export groups, policies, posture checks, routes, networks, nameserver groups, DNS settings and setup keys of an account to a YAML or JSON document referencing objects by name, and to reconcile an account with such a document through the management API.",
+		SilenceUsage: true,
+	}
+
+	gitopsExportCmd = &cobra.Command{
+		Use:   "export [--file document.yaml]",
+		Short: "Export the account to a document",
+		Long:  "Export the account to a document. The document is written to the standard output unless --file is set, the format is taken from the file extension when --format isn't set.",
+		RunE:  gitopsExport,
+	}
+
+	gitopsApplyCmd = &cobra.Command{
+		Use:   "apply --file document.yaml [--dry-run]",
+		Short: "Reconcile the account with a document",
+		Long: "Reconcile the account with a document. The planned creates, updates and deletes are printed before the account is changed. " +
+			"Objects missing from a section of the document are deleted, sections omitted from the document are left unchanged. Use --dry-run to only print the plan.",
+		RunE: gitopsApply,
+	}
+)
+
+func init() {
+	gitopsCmd.PersistentFlags().StringVar(&gitopsManagementURL, "management-url", "", "management API URL, e.g. https://netbird.example.com")
+	gitopsCmd.PersistentFlags().StringVar(&gitopsToken, "token", "", "personal access token used to authenticate, defaults to the "+apiTokenEnv+" environment variable")
+	gitopsCmd.PersistentFlags().StringVar(&gitopsAccountID, "account", "", "account ID, defaults to the account of the token")
+	gitopsCmd.PersistentFlags().StringVar(&gitopsFormat, "format", "", "document format, yaml or json. Defaults to the file extension or yaml")
+	gitopsCmd.PersistentFlags().StringVar(&gitopsFile, "file", "", "document file")
+	gitopsCmd.MarkPersistentFlagRequired("management-url") //nolint
+
+	gitopsApplyCmd.Flags().BoolVar(&gitopsDryRun, "dry-run", false, "only print the planned changes")
+	gitopsApplyCmd.MarkFlagRequired("file") //nolint
+
+	gitopsCmd.AddCommand(gitopsExportCmd)
+	gitopsCmd.AddCommand(gitopsApplyCmd)
+
+	rootCmd.AddCommand(gitopsCmd)
+}
+
+func gitopsExport(cmd *cobra.Command, _ []string) error {
+	client, accountID, err := newGitopsClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	document, err := client.Accounts.Export(cmd.Context(), accountID)
+	if err != nil {
+		return fmt.Errorf("export account: %w", err)
+	}
+
+	data, err := marshalDocument(document, documentFormat())
+	if err != nil {
+		return err
+	}
+
+	if gitopsFile == "" {
+		_, err = cmd.OutOrStdout().Write(data)
+		return err
+	}
+	if err = os.WriteFile(gitopsFile, data, 0600); err != nil {
+		return fmt.Errorf("write %s: %w", gitopsFile, err)
+	}
+	cmd.Printf("Account %s exported to %s\n", accountID, gitopsFile)
+	return nil
+}
+
+func gitopsApply(cmd *cobra.Command, _ []string) error {
+	data, err := os.ReadFile(gitopsFile)
+	if err != nil {
+		return fmt.Errorf("read %s: %w", gitopsFile, err)
+	}
+
+	document, err := unmarshalDocument(data, documentFormat())
+	if err != nil {
+		return fmt.Errorf("parse %s: %w", gitopsFile, err)
+	}
+
+	client, accountID, err := newGitopsClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	plan, err := client.Accounts.Apply(cmd.Context(), accountID, *document, true, "")
+	if err != nil {
+		return fmt.Errorf("plan changes: %w", err)
+	}
+
+	printPlan(cmd.OutOrStdout(), plan)
+	if gitopsDryRun || len(plan.Changes) == 0 {
+		return nil
+	}
+
+	// the plan hash makes sure only the printed changes are applied, even if the account is modified meanwhile
+	applied, err := client.Accounts.Apply(cmd.Context(), accountID, *document, false, plan.PlanHash)
+	if err != nil {
+		return fmt.Errorf("apply changes: %w", err)
+	}
+	cmd.Printf("Applied %d changes to account %s\n", len(applied.Changes), accountID)
+
+	return nil
+}
+
+func newGitopsClient(cmd *cobra.Command) (*rest.Client, string, error) {
+	token := gitopsToken
+	if token == "" {
+		token = os.Getenv(apiTokenEnv)
+	}
+	if token == "" {
+		return nil, "", fmt.Errorf("a personal access token is required, set --token or %s", apiTokenEnv)
+	}
+
+	client := rest.New(strings.TrimSuffix(gitopsManagementURL, "/"), token)
+	if gitopsAccountID != "" {
+		return client, gitopsAccountID, nil
+	}
+
+	accounts, err := client.Accounts.List(cmd.Context())
+	if err != nil {
+		return nil, "", fmt.Errorf("get account: %w", err)
+	}
+	if len(accounts) == 0 {
+		return nil, "", fmt.Errorf("no account found for the token")
+	}
+	return client, accounts[0].Id, nil
+}
+
+// documentFormat returns the format set by flag, taken from the file extension or yaml by default
+func documentFormat() string {
+	if gitopsFormat != "" {
+		return strings.ToLower(gitopsFormat)
+	}
+	if strings.EqualFold(filepath.Ext(gitopsFile), ".json") {
+		return formatJSON
+	}
+	return formatYAML
+}
+
+// printPlan prints the changes prefixed with + for creates, ~ for updates and - for deletes
+func printPlan(w io.Writer, plan *api.AccountPlan) {
+	if len(plan.Changes) == 0 {
+		_, _ = fmt.Fprintln(w, "No changes, the account matches the document")
+		return
+	}
+
+	var creates, updates, deletes int
+	for _, change := range plan.Changes {
+		prefix := "~"
+		switch change.Action {
+		case api.AccountPlanChangeActionCreate:
+			prefix = "+"
+			creates++
+		case api.AccountPlanChangeActionDelete:
+			prefix = "-"
+			deletes++
+		default:
+			updates++
+		}
+		_, _ = fmt.Fprintf(w, "%s %s %s\n", prefix, change.Kind, change.Name)
+	}
+	_, _ = fmt.Fprintf(w, "Plan: %d to create, %d to update, %d to delete\n", creates, updates, deletes)
+}
+
+func marshalDocument(document *api.AccountDocument, format string) ([]byte, error) {
+	data, err := json.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal document: %w", err)
+	}
+
+	switch format {
+	case formatJSON:
+		return append(data, '\n'), nil
+	case formatYAML:
+		// YAML is a superset of JSON, decoding the JSON into a node keeps the order of the keys
+		var node yaml.Node
+		if err = yaml.Unmarshal(data, &node); err != nil {
+			return nil, fmt.Errorf("convert document to yaml: %w", err)
+		}
+		resetStyle(&node)
+
+		var buf bytes.Buffer
+		encoder := yaml.NewEncoder(&buf)
+		encoder.SetIndent(2)
+		if err = encoder.Encode(&node); err != nil {
+			return nil, fmt.Errorf("convert document to yaml: %w", err)
+		}
+		return buf.Bytes(), encoder.Close()
+	default:
+		return nil, fmt.Errorf("unsupported format %s", format)
+	}
+}
+
+func unmarshalDocument(data []byte, format string) (*api.AccountDocument, error) {
+	switch format {
+	case formatJSON:
+	case formatYAML:
+		var value any
+		if err := yaml.Unmarshal(data, &value); err != nil {
+			return nil, err
+		}
+		var err error
+		if data, err = json.Marshal(value); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported format %s", format)
+	}
+
+	// unknown fields are most likely typos that would silently change the meaning of the document
+	var document api.AccountDocument
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&document); err != nil {
+		return nil, err
+	}
+	return &document, nil
+}
+
+// resetStyle drops the JSON flow and quoting styles so the node is encoded in block style
+func resetStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetStyle(child)
+	}
+}
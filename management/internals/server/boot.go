@@ -20,12 +20,17 @@ import (
 
 	"github.com/netbirdio/management-integrations/integrations"
 	"github.com/netbirdio/netbird/encryption"
+	flowProto "github.com/netbirdio/netbird/flow/proto"
 	"github.com/netbirdio/netbird/formatter/hook"
 	nbconfig "github.com/netbirdio/netbird/management/internals/server/config"
 	"github.com/netbirdio/netbird/management/server"
 	"github.com/netbirdio/netbird/management/server/activity"
+	activitystore "github.com/netbirdio/netbird/management/server/activity/store"
 	nbContext "github.com/netbirdio/netbird/management/server/context"
+	"github.com/netbirdio/netbird/management/server/eventsinks"
+	sinktypes "github.com/netbirdio/netbird/management/server/eventsinks/types"
 	nbhttp "github.com/netbirdio/netbird/management/server/http"
+	"github.com/netbirdio/netbird/management/server/posture"
 	"github.com/netbirdio/netbird/management/server/store"
 	"github.com/netbirdio/netbird/management/server/telemetry"
 	mgmtProto "github.com/netbirdio/netbird/shared/management/proto"
@@ -87,13 +92,22 @@ func (s *BaseServer) EventStore() activity.Store {
 			}
 		}
 
-		return eventStore
+		if key != "" {
+			crypt, err := activitystore.NewFieldEncrypt(key)
+			if err != nil {
+				log.Fatalf("failed to create the store secrets cipher: %v", err)
+			}
+			posture.SetTokenCipher(crypt)
+			sinktypes.SetSecretCipher(crypt)
+		}
+
+		return eventsinks.NewEventStore(eventStore, s.EventSinksDispatcher())
 	})
 }
 
 func (s *BaseServer) APIHandler() http.Handler {
 	return Create(s, func() http.Handler {
-		httpAPIHandler, err := nbhttp.NewAPIHandler(context.Background(), s.AccountManager(), s.NetworksManager(), s.ResourcesManager(), s.RoutesManager(), s.GroupsManager(), s.GeoLocationManager(), s.AuthManager(), s.Metrics(), s.IntegratedValidator(), s.ProxyController(), s.PermissionsManager(), s.PeersManager(), s.SettingsManager())
+		httpAPIHandler, err := nbhttp.NewAPIHandler(context.Background(), s.AccountManager(), s.NetworksManager(), s.ResourcesManager(), s.RoutesManager(), s.GroupsManager(), s.GeoLocationManager(), s.AuthManager(), s.Metrics(), s.IntegratedValidator(), s.ProxyController(), s.PermissionsManager(), s.PeersManager(), s.SettingsManager(), s.RolesManager(), s.DNSBlocklistsManager(), s.IngressManager(), s.EventSinksManager())
 		if err != nil {
 			log.Fatalf("failed to create API handler: %v", err)
 		}
@@ -150,6 +164,9 @@ func (s *BaseServer) GRPCServer() *grpc.Server {
 			log.Fatalf("failed to create management server: %v", err)
 		}
 		mgmtProto.RegisterManagementServiceServer(gRPCAPIHandler, srv)
+		if s.config.Flow != nil {
+			flowProto.RegisterFlowServiceServer(gRPCAPIHandler, s.FlowReceiver())
+		}
 
 		return gRPCAPIHandler
 	})
@@ -55,6 +55,12 @@ type Config struct {
 
 	ReverseProxy ReverseProxy
 
+	// EventRetention configures the purge of the activity events older than the retention of their account
+	EventRetention *EventRetention
+
+	// Flow enables the built-in receiver of the network traffic events of the peers
+	Flow *Flow
+
 	// disable default all-to-all policy
 	DisableDefaultPolicy bool
 }
@@ -84,9 +90,58 @@ type TURNConfig struct {
 
 // Relay configuration type
 type Relay struct {
-	Addresses      []string
+	Addresses []string
+	// Servers attach the placement metadata to the relay addresses. The addresses without an entry belong to no
+	// region and have the default weight.
+	Servers        []RelayServer
+	CredentialsTTL util.Duration
+	Secret         string
+	// AccountScopedTokens adds the account of the peer to the relay tokens, so the relays can apply the account
+	// limits. The relays must support the account scoped tokens, the older ones reject them.
+	AccountScopedTokens bool
+}
+
+// RelayServer is the placement metadata of a relay address
+type RelayServer struct {
+	Address string
+	Region  string
+	// Weight is the preference of the relay relative to the default weight of 100. The peers divide the measured
+	// latency by the weight, so a relay with a higher weight is preferred over the ones with a similar latency.
+	Weight uint32
+}
+
+// EventRetention configuration type
+type EventRetention struct {
+	// Interval between the purges, defaults to 24 hours
+	Interval util.Duration
+	// ArchiveDir is the directory the events are archived to before they are purged. Relative paths are resolved
+	// against the data directory. The events of the accounts with archiving enabled are not purged when neither
+	// ArchiveDir nor S3Bucket is set.
+	ArchiveDir string
+	// S3Bucket archives the events to the S3 bucket instead of ArchiveDir. The credentials are loaded from the AWS
+	// environment as done by the upload server.
+	S3Bucket string
+	// S3Region of the bucket, the AWS_REGION environment variable is used when it is empty
+	S3Region string
+}
+
+// Flow configuration type. The receiver is served on the management gRPC port and the peers are told to send their
+// network traffic events to URL with a token signed with Secret.
+type Flow struct {
+	// URL the peers send the events to, e.g. https://netbird.example.com:443
+	URL            string
 	CredentialsTTL util.Duration
 	Secret         string
+	// Interval between the event batches sent by the peers, defaults to 1 minute
+	Interval util.Duration
+	// Counters enables the packet and byte counters of the flows
+	Counters bool
+	// ExitNodeCollection enables the collection of the events on the exit nodes
+	ExitNodeCollection bool
+	// DNSCollection enables the collection of the DNS events
+	DNSCollection bool
+	// Retention of the stored events, defaults to 7 days
+	Retention util.Duration
 }
 
 // HttpServerConfig is a config of the HTTP Management service server
@@ -8,6 +8,7 @@ import (
 	"github.com/netbirdio/management-integrations/integrations"
 	"github.com/netbirdio/netbird/management/server"
 	"github.com/netbirdio/netbird/management/server/auth"
+	"github.com/netbirdio/netbird/management/server/ingress"
 	"github.com/netbirdio/netbird/management/server/integrations/integrated_validator"
 	"github.com/netbirdio/netbird/management/server/integrations/port_forwarding"
 )
@@ -34,13 +35,13 @@ func (s *BaseServer) IntegratedValidator() integrated_validator.IntegratedValida
 
 func (s *BaseServer) ProxyController() port_forwarding.Controller {
 	return Create(s, func() port_forwarding.Controller {
-		return integrations.NewController(s.Store())
+		return ingress.NewController(s.Store())
 	})
 }
 
 func (s *BaseServer) SecretsManager() *server.TimeBasedAuthSecretsManager {
 	return Create(s, func() *server.TimeBasedAuthSecretsManager {
-		return server.NewTimeBasedAuthSecretsManager(s.PeersUpdateManager(), s.config.TURNConfig, s.config.Relay, s.SettingsManager(), s.GroupsManager())
+		return server.NewTimeBasedAuthSecretsManager(s.PeersUpdateManager(), s.config.TURNConfig, s.config.Relay, s.config.Flow, s.SettingsManager(), s.GroupsManager())
 	})
 }
 
@@ -2,20 +2,28 @@ package server
 
 import (
 	"context"
+	"path/filepath"
 
 	log "github.com/sirupsen/logrus"
 
 	"github.com/netbirdio/management-integrations/integrations"
+	nbconfig "github.com/netbirdio/netbird/management/internals/server/config"
 	"github.com/netbirdio/netbird/management/server"
 	"github.com/netbirdio/netbird/management/server/account"
+	"github.com/netbirdio/netbird/management/server/blocklists"
+	"github.com/netbirdio/netbird/management/server/eventretention"
+	"github.com/netbirdio/netbird/management/server/eventsinks"
+	"github.com/netbirdio/netbird/management/server/flows"
 	"github.com/netbirdio/netbird/management/server/geolocation"
 	"github.com/netbirdio/netbird/management/server/groups"
 	"github.com/netbirdio/netbird/management/server/idp"
+	"github.com/netbirdio/netbird/management/server/ingress"
 	"github.com/netbirdio/netbird/management/server/networks"
 	"github.com/netbirdio/netbird/management/server/networks/resources"
 	"github.com/netbirdio/netbird/management/server/networks/routers"
 	"github.com/netbirdio/netbird/management/server/peers"
 	"github.com/netbirdio/netbird/management/server/permissions"
+	"github.com/netbirdio/netbird/management/server/roles"
 	"github.com/netbirdio/netbird/management/server/settings"
 	"github.com/netbirdio/netbird/management/server/users"
 )
@@ -101,6 +109,79 @@ func (s *BaseServer) RoutesManager() routers.Manager {
 	})
 }
 
+func (s *BaseServer) RolesManager() roles.Manager {
+	return Create(s, func() roles.Manager {
+		return roles.NewManager(s.Store(), s.PermissionsManager(), s.AccountManager())
+	})
+}
+
+func (s *BaseServer) DNSBlocklistsManager() blocklists.Manager {
+	return Create(s, func() blocklists.Manager {
+		return blocklists.NewManager(s.Store(), s.AccountManager(), s.PermissionsManager())
+	})
+}
+
+func (s *BaseServer) IngressManager() ingress.Manager {
+	return Create(s, func() ingress.Manager {
+		return ingress.NewManager(s.Store(), s.AccountManager(), s.PermissionsManager())
+	})
+}
+
+func (s *BaseServer) EventSinksDispatcher() *eventsinks.Dispatcher {
+	return Create(s, func() *eventsinks.Dispatcher {
+		dispatcher, err := eventsinks.NewDispatcher(context.Background(), s.Store(), s.config.Datadir, s.Metrics().GetMeter())
+		if err != nil {
+			log.Fatalf("failed to create event sinks dispatcher: %v", err)
+		}
+		return dispatcher
+	})
+}
+
+func (s *BaseServer) EventSinksManager() eventsinks.Manager {
+	return Create(s, func() eventsinks.Manager {
+		return eventsinks.NewManager(s.Store(), s.AccountManager(), s.PermissionsManager(), s.EventSinksDispatcher())
+	})
+}
+
+func (s *BaseServer) EventRetentionEnforcer() *eventretention.Enforcer {
+	return Create(s, func() *eventretention.Enforcer {
+		retentionConfig := s.config.EventRetention
+		if retentionConfig == nil {
+			retentionConfig = &nbconfig.EventRetention{}
+		}
+
+		var archiver eventretention.Archiver
+		switch {
+		case retentionConfig.S3Bucket != "":
+			var err error
+			archiver, err = eventretention.NewS3Archiver(context.Background(), retentionConfig.S3Bucket, retentionConfig.S3Region)
+			if err != nil {
+				log.Fatalf("failed to create event archiver: %v", err)
+			}
+		case retentionConfig.ArchiveDir != "":
+			archiveDir := retentionConfig.ArchiveDir
+			if !filepath.IsAbs(archiveDir) {
+				archiveDir = filepath.Join(s.config.Datadir, archiveDir)
+			}
+			archiver = eventretention.NewLocalArchiver(archiveDir)
+		}
+
+		return eventretention.NewEnforcer(s.Store(), s.EventStore(), s.AccountManager(), archiver, retentionConfig.Interval.Duration)
+	})
+}
+
+func (s *BaseServer) FlowReceiver() *flows.Receiver {
+	return Create(s, func() *flows.Receiver {
+		return flows.NewReceiver(context.Background(), s.Store(), s.config.Flow.Secret)
+	})
+}
+
+func (s *BaseServer) FlowRetention() *flows.Retention {
+	return Create(s, func() *flows.Retention {
+		return flows.NewRetention(s.Store(), s.config.Flow.Retention.Duration)
+	})
+}
+
 func (s *BaseServer) NetworksManager() networks.Manager {
 	return Create(s, func() networks.Manager {
 		return networks.NewManager(s.Store(), s.PermissionsManager(), s.ResourcesManager(), s.RoutesManager(), s.AccountManager())
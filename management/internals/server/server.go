@@ -103,6 +103,11 @@ func (s *BaseServer) Start(ctx context.Context) error {
 		return fmt.Errorf("failed to expose metrics: %v", err)
 	}
 	s.EphemeralManager().LoadInitialPeers(srvCtx)
+	go s.DNSBlocklistsManager().RefreshSources(srvCtx)
+	go s.EventRetentionEnforcer().Run(srvCtx)
+	if s.config.Flow != nil {
+		go s.FlowRetention().Run(srvCtx)
+	}
 
 	var tlsConfig *tls.Config
 	tlsEnabled := false
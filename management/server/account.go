@@ -92,6 +92,9 @@ type DefaultAccountManager struct {
 
 	peerInactivityExpiry Scheduler
 
+	// policyRuleScheduler updates account peers when scheduled policy rules start or stop being applied
+	policyRuleScheduler Scheduler
+
 	// userDeleteFromIDPEnabled allows to delete user from IDP when user is deleted from account
 	userDeleteFromIDPEnabled bool
 
@@ -206,6 +209,7 @@ func BuildManager(
 		eventStore:               eventStore,
 		peerLoginExpiry:          NewDefaultScheduler(),
 		peerInactivityExpiry:     NewDefaultScheduler(),
+		policyRuleScheduler:      NewDefaultScheduler(),
 		userDeleteFromIDPEnabled: userDeleteFromIDPEnabled,
 		integratedPeerValidator:  integratedPeerValidator,
 		metrics:                  metrics,
@@ -258,6 +262,12 @@ func BuildManager(
 		am.onPeersInvalidated(ctx, accountID, peerIDs)
 	})
 
+	posture.SetExternalVerdictListener(func(accountID string) {
+		am.BufferUpdateAccountPeers(ctx, accountID)
+	})
+
+	go am.schedulePolicyRuleEvaluations(ctx)
+
 	return am, nil
 }
 
@@ -368,6 +378,8 @@ func (am *DefaultAccountManager) UpdateAccountSettings(ctx context.Context, acco
 
 	am.handleRoutingPeerDNSResolutionSettings(ctx, oldSettings, newSettings, userID, accountID)
 	am.handleLazyConnectionSettings(ctx, oldSettings, newSettings, userID, accountID)
+	am.handleRelayRegionPinsSettings(ctx, oldSettings, newSettings, userID, accountID)
+	am.handleEventRetentionSettings(ctx, oldSettings, newSettings, userID, accountID)
 	am.handlePeerLoginExpirationSettings(ctx, oldSettings, newSettings, userID, accountID)
 	am.handleGroupsPropagationSettings(ctx, oldSettings, newSettings, userID, accountID)
 	if err = am.handleInactivityExpirationSettings(ctx, oldSettings, newSettings, userID, accountID); err != nil {
@@ -409,6 +421,14 @@ func (am *DefaultAccountManager) validateSettingsUpdate(ctx context.Context, tra
 		return status.Errorf(status.InvalidArgument, "invalid domain \"%s\" provided for DNS domain", newSettings.DNSDomain)
 	}
 
+	if newSettings.EventRetentionDays < 0 {
+		return status.Errorf(status.InvalidArgument, "event retention days can't be negative")
+	}
+
+	if err := validateRelayRegionPins(ctx, transaction, accountID, newSettings.RelayRegionPins); err != nil {
+		return err
+	}
+
 	peers, err := transaction.GetAccountPeers(ctx, store.LockingStrengthNone, accountID, "", "")
 	if err != nil {
 		return err
@@ -422,6 +442,46 @@ func (am *DefaultAccountManager) validateSettingsUpdate(ctx context.Context, tra
 	return am.integratedPeerValidator.ValidateExtraSettings(ctx, newSettings.Extra, oldSettings.Extra, peersMap, userID, accountID)
 }
 
+// validateRelayRegionPins validates that the pins have a region and reference existing groups
+func validateRelayRegionPins(ctx context.Context, transaction store.Store, accountID string, pins []types.RelayRegionPin) error {
+	var groupIDs []string
+	for _, pin := range pins {
+		if pin.Region == "" {
+			return status.Errorf(status.InvalidArgument, "relay region pin must have a region")
+		}
+		if len(pin.Groups) == 0 {
+			return status.Errorf(status.InvalidArgument, "relay region pin %s must have at least one group", pin.Region)
+		}
+		groupIDs = append(groupIDs, pin.Groups...)
+	}
+	if len(groupIDs) == 0 {
+		return nil
+	}
+
+	groups, err := transaction.GetGroupsByIDs(ctx, store.LockingStrengthNone, accountID, groupIDs)
+	if err != nil {
+		return err
+	}
+	return validateGroups(groupIDs, groups)
+}
+
+func (am *DefaultAccountManager) handleRelayRegionPinsSettings(ctx context.Context, oldSettings, newSettings *types.Settings, userID, accountID string) {
+	if !reflect.DeepEqual(oldSettings.RelayRegionPins, newSettings.RelayRegionPins) {
+		am.StoreEvent(ctx, userID, accountID, accountID, activity.AccountRelayRegionPinsUpdated, nil)
+	}
+}
+
+func (am *DefaultAccountManager) handleEventRetentionSettings(ctx context.Context, oldSettings, newSettings *types.Settings, userID, accountID string) {
+	if oldSettings.EventRetentionDays != newSettings.EventRetentionDays || oldSettings.EventArchiveEnabled != newSettings.EventArchiveEnabled {
+		eventMeta := map[string]any{
+			"old_retention_days": oldSettings.EventRetentionDays,
+			"new_retention_days": newSettings.EventRetentionDays,
+			"archive_enabled":    newSettings.EventArchiveEnabled,
+		}
+		am.StoreEvent(ctx, userID, accountID, accountID, activity.AccountEventRetentionUpdated, eventMeta)
+	}
+}
+
 func (am *DefaultAccountManager) handleRoutingPeerDNSResolutionSettings(ctx context.Context, oldSettings, newSettings *types.Settings, userID, accountID string) {
 	if oldSettings.RoutingPeerDNSResolutionEnabled != newSettings.RoutingPeerDNSResolutionEnabled {
 		if newSettings.RoutingPeerDNSResolutionEnabled {
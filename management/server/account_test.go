@@ -24,6 +24,7 @@ import (
 	nbdns "github.com/netbirdio/netbird/dns"
 	nbAccount "github.com/netbirdio/netbird/management/server/account"
 	"github.com/netbirdio/netbird/management/server/activity"
+	blocklistTypes "github.com/netbirdio/netbird/management/server/blocklists/types"
 	"github.com/netbirdio/netbird/management/server/cache"
 	nbcontext "github.com/netbirdio/netbird/management/server/context"
 	"github.com/netbirdio/netbird/management/server/http/testing/testing_tools"
@@ -41,6 +42,7 @@ import (
 	"github.com/netbirdio/netbird/management/server/testutil"
 	"github.com/netbirdio/netbird/management/server/types"
 	"github.com/netbirdio/netbird/management/server/util"
+	zoneTypes "github.com/netbirdio/netbird/management/server/zones/types"
 	"github.com/netbirdio/netbird/route"
 )
 
@@ -1736,6 +1738,24 @@ func TestAccount_Copy(t *testing.T) {
 				Address:   "172.12.6.1/24",
 			},
 		},
+		DNSZones: []*zoneTypes.Zone{
+			{
+				ID:      "zone1",
+				Domain:  "corp.internal",
+				Groups:  []string{"group1"},
+				Records: []zoneTypes.Record{{Name: "www", Type: "A", TTL: 300, Value: "100.64.0.10"}},
+			},
+		},
+		DNSBlocklists: []*blocklistTypes.Blocklist{
+			{
+				ID:           "blocklist1",
+				Name:         "ads",
+				Enabled:      true,
+				Action:       blocklistTypes.ActionNXDomain,
+				Domains:      []string{"ads.example.com"},
+				ExemptGroups: []string{"group1"},
+			},
+		},
 	}
 	err := hasNilField(account)
 	if err != nil {
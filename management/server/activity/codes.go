@@ -180,6 +180,61 @@ const (
 	UserApproved               Activity = 89
 	UserRejected               Activity = 90
 
+	CustomRoleCreated Activity = 91
+	CustomRoleUpdated Activity = 92
+	CustomRoleDeleted Activity = 93
+
+	// PolicyRuleExpired indicates that a policy rule was disabled because its schedule expired
+	PolicyRuleExpired Activity = 94
+
+	// PeerPostureCheckFailed indicates that a peer started failing posture checks
+	PeerPostureCheckFailed Activity = 95
+	// PeerPostureCheckRecovered indicates that a peer passes posture checks again after failing them
+	PeerPostureCheckRecovered Activity = 96
+
+	// SCIMTokenCreated indicates that a user created a SCIM provisioning token
+	SCIMTokenCreated Activity = 97
+	// SCIMTokenDeleted indicates that a user deleted a SCIM provisioning token
+	SCIMTokenDeleted Activity = 98
+
+	// DNSZoneCreated indicates that a user created a DNS zone
+	DNSZoneCreated Activity = 99
+	// DNSZoneUpdated indicates that a user updated a DNS zone
+	DNSZoneUpdated Activity = 100
+	// DNSZoneDeleted indicates that a user deleted a DNS zone
+	DNSZoneDeleted Activity = 101
+	// DNSBlocklistCreated indicates that a user created a DNS blocklist
+	DNSBlocklistCreated Activity = 102
+	// DNSBlocklistUpdated indicates that a user updated a DNS blocklist
+	DNSBlocklistUpdated Activity = 103
+	// DNSBlocklistDeleted indicates that a user deleted a DNS blocklist
+	DNSBlocklistDeleted Activity = 104
+
+	// AccountRelayRegionPinsUpdated indicates that a user updated the relay regions the groups are pinned to
+	AccountRelayRegionPinsUpdated Activity = 105
+	// IngressPeerCreated indicates that a user made a peer an ingress peer
+	IngressPeerCreated Activity = 106
+	// IngressPeerUpdated indicates that a user updated an ingress peer
+	IngressPeerUpdated Activity = 107
+	// IngressPeerDeleted indicates that a user deleted an ingress peer
+	IngressPeerDeleted Activity = 108
+	// IngressPortAllocationCreated indicates that a user created an ingress port allocation for a peer
+	IngressPortAllocationCreated Activity = 109
+	// IngressPortAllocationUpdated indicates that a user updated an ingress port allocation
+	IngressPortAllocationUpdated Activity = 110
+	// IngressPortAllocationDeleted indicates that a user deleted an ingress port allocation
+	IngressPortAllocationDeleted Activity = 111
+	// EventSinkCreated indicates that a user created an event sink
+	EventSinkCreated Activity = 112
+	// EventSinkUpdated indicates that a user updated an event sink
+	EventSinkUpdated Activity = 113
+	// EventSinkDeleted indicates that a user deleted an event sink
+	EventSinkDeleted Activity = 114
+	// AccountEventRetentionUpdated indicates that a user updated the retention of the activity events
+	AccountEventRetentionUpdated Activity = 115
+	// AccountEventsPurged indicates that the activity events older than the retention were purged
+	AccountEventsPurged Activity = 116
+
 	AccountDeleted Activity = 99999
 )
 
@@ -288,6 +343,43 @@ var activityMap = map[Activity]Code{
 	PeerIPUpdated: {"Peer IP updated", "peer.ip.update"},
 	UserApproved:  {"User approved", "user.approve"},
 	UserRejected:  {"User rejected", "user.reject"},
+
+	CustomRoleCreated: {"Custom role created", "role.create"},
+	CustomRoleUpdated: {"Custom role updated", "role.update"},
+	CustomRoleDeleted: {"Custom role deleted", "role.delete"},
+
+	PolicyRuleExpired: {"Policy rule expired", "policy.rule.expire"},
+
+	PeerPostureCheckFailed:    {"Peer posture check failed", "peer.posture.check.fail"},
+	PeerPostureCheckRecovered: {"Peer posture check recovered", "peer.posture.check.recover"},
+
+	SCIMTokenCreated: {"SCIM token created", "scim.token.create"},
+	SCIMTokenDeleted: {"SCIM token deleted", "scim.token.delete"},
+
+	DNSZoneCreated: {"DNS zone created", "dns.zone.create"},
+	DNSZoneUpdated: {"DNS zone updated", "dns.zone.update"},
+	DNSZoneDeleted: {"DNS zone deleted", "dns.zone.delete"},
+
+	DNSBlocklistCreated: {"DNS blocklist created", "dns.blocklist.create"},
+	DNSBlocklistUpdated: {"DNS blocklist updated", "dns.blocklist.update"},
+	DNSBlocklistDeleted: {"DNS blocklist deleted", "dns.blocklist.delete"},
+
+	AccountRelayRegionPinsUpdated: {"Account relay region pins updated", "account.setting.relay.region.pins.update"},
+
+	IngressPeerCreated: {"Ingress peer created", "ingress.peer.create"},
+	IngressPeerUpdated: {"Ingress peer updated", "ingress.peer.update"},
+	IngressPeerDeleted: {"Ingress peer deleted", "ingress.peer.delete"},
+
+	IngressPortAllocationCreated: {"Ingress port allocation created", "ingress.port.allocation.create"},
+	IngressPortAllocationUpdated: {"Ingress port allocation updated", "ingress.port.allocation.update"},
+	IngressPortAllocationDeleted: {"Ingress port allocation deleted", "ingress.port.allocation.delete"},
+
+	EventSinkCreated: {"Event sink created", "event.sink.create"},
+	EventSinkUpdated: {"Event sink updated", "event.sink.update"},
+	EventSinkDeleted: {"Event sink deleted", "event.sink.delete"},
+
+	AccountEventRetentionUpdated: {"Account event retention updated", "account.setting.event.retention.update"},
+	AccountEventsPurged:          {"Account events purged", "account.events.purge"},
 }
 
 // StringCode returns a string code of the activity
@@ -310,3 +402,13 @@ func (a Activity) Message() string {
 func RegisterActivityMap(codes map[Activity]Code) {
 	maps.Copy(activityMap, codes)
 }
+
+// ActivityFromStringCode returns the activity of a string code
+func ActivityFromStringCode(code string) (Activity, bool) {
+	for activity, c := range activityMap {
+		if c.Code == code {
+			return activity, true
+		}
+	}
+	return 0, false
+}
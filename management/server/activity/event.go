@@ -17,21 +17,21 @@ type ActivityDescriber interface { //nolint:revive
 // Event represents a network/system activity event.
 type Event struct {
 	// Timestamp of the event
-	Timestamp time.Time
+	Timestamp time.Time `gorm:"index:idx_events_account_timestamp,priority:2"`
 	// Activity that was performed during the event
-	Activity Activity `gorm:"type:integer"`
+	Activity Activity `gorm:"type:integer;index:idx_events_account_activity,priority:2"`
 	// ID of the event (can be empty, meaning that it wasn't yet generated)
-	ID uint64 `gorm:"primaryKey;autoIncrement"`
+	ID uint64 `gorm:"primaryKey;autoIncrement;index:idx_events_account_timestamp,priority:3"`
 	// InitiatorID is the ID of an object that initiated the event (e.g., a user)
-	InitiatorID string
+	InitiatorID string `gorm:"index"`
 	// InitiatorName is the name of an object that initiated the event.
 	InitiatorName string `gorm:"-"`
 	// InitiatorEmail is the email address of an object that initiated the event.
 	InitiatorEmail string `gorm:"-"`
 	// TargetID is the ID of an object that was effected by the event (e.g., a peer)
-	TargetID string
+	TargetID string `gorm:"index"`
 	// AccountID is the ID of an account where the event happened
-	AccountID string `gorm:"index"`
+	AccountID string `gorm:"index;index:idx_events_account_timestamp,priority:1;index:idx_events_account_activity,priority:1"`
 
 	// Meta of the event, e.g. deleted peer information like name, IP, etc
 	Meta map[string]any `gorm:"serializer:json"`
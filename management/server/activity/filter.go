@@ -0,0 +1,139 @@
+package activity
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultSearchLimit is the number of events returned by a search without a limit
+	DefaultSearchLimit = 10000
+	// MaxSearchLimit is the maximum number of events returned by a search
+	MaxSearchLimit = 10000
+)
+
+// EventFilter defines the events returned by Store.Search. Empty fields don't filter.
+type EventFilter struct {
+	// Activities to include
+	Activities []Activity
+	// InitiatorID of the events
+	InitiatorID string
+	// TargetID of the events
+	TargetID string
+	// From is the inclusive start of the time range
+	From time.Time
+	// To is the inclusive end of the time range
+	To time.Time
+	// Search is a case-insensitive partial match on the event meta and the names and emails of deleted users
+	Search string
+	// Cursor is the position after which the events are returned, nil starts from the first event
+	Cursor *EventCursor
+	// Limit is the maximum number of events to return
+	Limit int
+	// Descending orders the events from the newest to the oldest
+	Descending bool
+}
+
+// EventCursor is the position of an event in the order of the events, used for keyset pagination
+type EventCursor struct {
+	Timestamp time.Time
+	ID        uint64
+}
+
+// CursorOf returns the cursor that points to the event
+func CursorOf(event *Event) *EventCursor {
+	return &EventCursor{Timestamp: event.Timestamp, ID: event.ID}
+}
+
+// String encodes the cursor to an opaque token
+func (c *EventCursor) String() string {
+	raw := strconv.FormatInt(c.Timestamp.UnixNano(), 10) + "." + strconv.FormatUint(c.ID, 10)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// ParseEventCursor decodes a cursor token created by EventCursor.String
+func ParseEventCursor(token string) (*EventCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("decode cursor: %w", err)
+	}
+
+	timestamp, id, ok := strings.Cut(string(raw), ".")
+	if !ok {
+		return nil, errors.New("invalid cursor format")
+	}
+
+	nanos, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse cursor timestamp: %w", err)
+	}
+
+	eventID, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse cursor ID: %w", err)
+	}
+
+	return &EventCursor{Timestamp: time.Unix(0, nanos).UTC(), ID: eventID}, nil
+}
+
+// Match checks whether the event passes the filter, the free-text search is matched against the event meta only
+func (f *EventFilter) Match(event *Event) bool {
+	if len(f.Activities) > 0 && !containsActivity(f.Activities, event.Activity) {
+		return false
+	}
+	if f.InitiatorID != "" && event.InitiatorID != f.InitiatorID {
+		return false
+	}
+	if f.TargetID != "" && event.TargetID != f.TargetID {
+		return false
+	}
+	if !f.From.IsZero() && event.Timestamp.Before(f.From) {
+		return false
+	}
+	if !f.To.IsZero() && event.Timestamp.After(f.To) {
+		return false
+	}
+	if f.Cursor != nil && !f.isAfterCursor(event) {
+		return false
+	}
+	if f.Search != "" && !metaContains(event.Meta, f.Search) {
+		return false
+	}
+	return true
+}
+
+func (f *EventFilter) isAfterCursor(event *Event) bool {
+	if event.Timestamp.Equal(f.Cursor.Timestamp) {
+		if f.Descending {
+			return event.ID < f.Cursor.ID
+		}
+		return event.ID > f.Cursor.ID
+	}
+	if f.Descending {
+		return event.Timestamp.Before(f.Cursor.Timestamp)
+	}
+	return event.Timestamp.After(f.Cursor.Timestamp)
+}
+
+func containsActivity(activities []Activity, activity Activity) bool {
+	for _, a := range activities {
+		if a == activity {
+			return true
+		}
+	}
+	return false
+}
+
+func metaContains(meta map[string]any, search string) bool {
+	search = strings.ToLower(search)
+	for key, value := range meta {
+		if strings.Contains(strings.ToLower(key), search) || strings.Contains(strings.ToLower(fmt.Sprint(value)), search) {
+			return true
+		}
+	}
+	return false
+}
@@ -1,8 +1,11 @@
 package activity
 
 import (
+	"cmp"
 	"context"
+	"slices"
 	"sync"
+	"time"
 )
 
 // Store provides an interface to store or stream events.
@@ -11,6 +14,10 @@ type Store interface {
 	Save(ctx context.Context, event *Event) (*Event, error)
 	// Get returns "limit" number of events from the "offset" index ordered descending or ascending by a timestamp
 	Get(ctx context.Context, accountID string, offset, limit int, descending bool) ([]*Event, error)
+	// Search returns the events of the account that match the filter, starting after the filter cursor
+	Search(ctx context.Context, accountID string, filter EventFilter) ([]*Event, error)
+	// DeleteBefore deletes the events of the account that are older than the given time and returns their number
+	DeleteBefore(ctx context.Context, accountID string, before time.Time) (int64, error)
 	// Close the sink flushing events if necessary
 	Close(ctx context.Context) error
 }
@@ -48,6 +55,48 @@ func (store *InMemoryEventStore) Get(_ context.Context, accountID string, offset
 	return events, nil
 }
 
+// Search returns the events that belong to the given accountID and match the filter in the order of the filter
+func (store *InMemoryEventStore) Search(_ context.Context, accountID string, filter EventFilter) ([]*Event, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	events := make([]*Event, 0)
+	for _, event := range store.events {
+		if event.AccountID == accountID && filter.Match(event) {
+			events = append(events, event)
+		}
+	}
+
+	slices.SortStableFunc(events, func(a, b *Event) int {
+		if c := a.Timestamp.Compare(b.Timestamp); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.ID, b.ID)
+	})
+	if filter.Descending {
+		slices.Reverse(events)
+	}
+
+	if filter.Limit > 0 && len(events) > filter.Limit {
+		events = events[:filter.Limit]
+	}
+	return events, nil
+}
+
+// DeleteBefore deletes the events that belong to the given accountID and are older than the given time
+func (store *InMemoryEventStore) DeleteBefore(_ context.Context, accountID string, before time.Time) (int64, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	var deleted int64
+	store.events = slices.DeleteFunc(store.events, func(event *Event) bool {
+		if event.AccountID == accountID && event.Timestamp.Before(before) {
+			deleted++
+			return true
+		}
+		return false
+	})
+	return deleted, nil
+}
+
 // Close cleans up the event list
 func (store *InMemoryEventStore) Close(_ context.Context) error {
 	store.mu.Lock()
@@ -7,6 +7,8 @@ import (
 	"path/filepath"
 	"runtime"
 	"strconv"
+	"strings"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"gorm.io/driver/postgres"
@@ -137,24 +139,13 @@ func (store *Store) processResult(ctx context.Context, events []*eventWithNames)
 
 // Get returns "limit" number of events from index ordered descending or ascending by a timestamp
 func (store *Store) Get(ctx context.Context, accountID string, offset, limit int, descending bool) ([]*activity.Event, error) {
-	baseQuery := store.db.Model(&activity.Event{}).
-		Select(`
-      events.*,
-      u.name  AS initiator_name,
-      u.email AS initiator_email,
-      t.name  AS target_name,
-      t.email AS target_email
-    `).
-		Joins(`LEFT JOIN deleted_users u ON u.id = events.initiator_id`).
-		Joins(`LEFT JOIN deleted_users t ON t.id = events.target_id`)
-
 	orderDir := "DESC"
 	if !descending {
 		orderDir = "ASC"
 	}
 
 	var events []*eventWithNames
-	err := baseQuery.Order("events.timestamp "+orderDir).Offset(offset).Limit(limit).
+	err := store.eventsWithNames().Order("events.timestamp "+orderDir).Offset(offset).Limit(limit).
 		Find(&events, "account_id = ?", accountID).Error
 	if err != nil {
 		return nil, err
@@ -163,6 +154,124 @@ func (store *Store) Get(ctx context.Context, accountID string, offset, limit int
 	return store.processResult(ctx, events)
 }
 
+// Search returns the events of the account that match the filter ordered by the timestamp and ID. The pagination is
+// keyset based, the events after the filter cursor are returned.
+func (store *Store) Search(ctx context.Context, accountID string, filter activity.EventFilter) ([]*activity.Event, error) {
+	query := store.eventsWithNames().Where("events.account_id = ?", accountID)
+
+	if len(filter.Activities) > 0 {
+		query = query.Where("events.activity IN ?", filter.Activities)
+	}
+	if filter.InitiatorID != "" {
+		query = query.Where("events.initiator_id = ?", filter.InitiatorID)
+	}
+	if filter.TargetID != "" {
+		query = query.Where("events.target_id = ?", filter.TargetID)
+	}
+	if !filter.From.IsZero() {
+		query = query.Where("events.timestamp >= ?", filter.From.UTC())
+	}
+	if !filter.To.IsZero() {
+		query = query.Where("events.timestamp <= ?", filter.To.UTC())
+	}
+
+	if filter.Search != "" {
+		condition, err := store.searchCondition(ctx, accountID, filter.Search)
+		if err != nil {
+			return nil, err
+		}
+		query = query.Where(condition)
+	}
+
+	orderDir, cursorOp := "ASC", ">"
+	if filter.Descending {
+		orderDir, cursorOp = "DESC", "<"
+	}
+
+	if filter.Cursor != nil {
+		timestamp := filter.Cursor.Timestamp.UTC()
+		query = query.Where(
+			"events.timestamp "+cursorOp+" ? OR (events.timestamp = ? AND events.id "+cursorOp+" ?)",
+			timestamp, timestamp, filter.Cursor.ID,
+		)
+	}
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = activity.DefaultSearchLimit
+	}
+
+	var events []*eventWithNames
+	err := query.Order("events.timestamp " + orderDir).Order("events.id " + orderDir).Limit(limit).Find(&events).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return store.processResult(ctx, events)
+}
+
+// searchCondition matches the search text against the event meta. The names and emails of deleted users are stored
+// encrypted, so they are decrypted and matched here and the events that target the matching users are included.
+func (store *Store) searchCondition(ctx context.Context, accountID, search string) (*gorm.DB, error) {
+	search = strings.ToLower(search)
+	condition := store.db.Where("LOWER(events.meta) LIKE ? ESCAPE '\\'", "%"+escapeLike(search)+"%")
+
+	var deletedUsers []*activity.DeletedUser
+	err := store.db.Model(&activity.DeletedUser{}).
+		Where("id IN (?)", store.db.Model(&activity.Event{}).Select("target_id").Where("account_id = ?", accountID)).
+		Find(&deletedUsers).Error
+	if err != nil {
+		return nil, fmt.Errorf("get deleted users: %w", err)
+	}
+
+	var userIDs []string
+	for _, user := range deletedUsers {
+		if store.deletedUserMatches(ctx, user, search) {
+			userIDs = append(userIDs, user.ID)
+		}
+	}
+
+	if len(userIDs) > 0 {
+		condition = condition.Or("events.target_id IN ?", userIDs).Or("events.initiator_id IN ?", userIDs)
+	}
+	return condition, nil
+}
+
+func (store *Store) deletedUserMatches(ctx context.Context, user *activity.DeletedUser, search string) bool {
+	for _, field := range []string{user.Name, user.Email} {
+		if field == "" {
+			continue
+		}
+		value, err := store.fieldEncrypt.Decrypt(field)
+		if err != nil {
+			log.WithContext(ctx).Debugf("failed to decrypt deleted user %s: %v", user.ID, err)
+			continue
+		}
+		if strings.Contains(strings.ToLower(value), search) {
+			return true
+		}
+	}
+	return false
+}
+
+func (store *Store) eventsWithNames() *gorm.DB {
+	return store.db.Model(&activity.Event{}).
+		Select(`
+      events.*,
+      u.name  AS initiator_name,
+      u.email AS initiator_email,
+      t.name  AS target_name,
+      t.email AS target_email
+    `).
+		Joins(`LEFT JOIN deleted_users u ON u.id = events.initiator_id`).
+		Joins(`LEFT JOIN deleted_users t ON t.id = events.target_id`)
+}
+
+// escapeLike escapes the wildcards of a LIKE pattern
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+}
+
 // Save an event in the SQLite events table end encrypt the "email" element in meta map
 func (store *Store) Save(_ context.Context, event *activity.Event) (*activity.Event, error) {
 	eventCopy := event.Copy()
@@ -225,6 +334,16 @@ func (store *Store) saveDeletedUserEmailAndNameInEncrypted(event *activity.Event
 	return event.Meta, nil
 }
 
+// DeleteBefore deletes the events of the account that are older than the given time. The deleted users stay in the
+// store because the remaining events may refer to them.
+func (store *Store) DeleteBefore(_ context.Context, accountID string, before time.Time) (int64, error) {
+	result := store.db.Where("account_id = ? AND timestamp < ?", accountID, before.UTC()).Delete(&activity.Event{})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
+
 // Close the Store
 func (store *Store) Close(_ context.Context) error {
 	if store.db != nil {
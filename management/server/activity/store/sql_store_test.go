@@ -55,3 +55,143 @@ func TestNewSqlStore(t *testing.T) {
 	assert.Len(t, result, 5)
 	assert.True(t, result[0].Timestamp.After(result[len(result)-1].Timestamp))
 }
+
+func TestSqlStore_Search(t *testing.T) {
+	key, _ := GenerateKey()
+	store, err := NewSqlStore(context.Background(), t.TempDir(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close(context.Background()) //nolint
+
+	accountID := "account_1"
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for i := 0; i < 10; i++ {
+		event := &activity.Event{
+			// two events per timestamp to check the pagination of the events with the same timestamp
+			Timestamp:   start.Add(time.Duration(i/2) * time.Minute),
+			Activity:    activity.PeerAddedByUser,
+			InitiatorID: "user_" + fmt.Sprint(i%2),
+			TargetID:    "peer_" + fmt.Sprint(i),
+			AccountID:   accountID,
+			Meta:        map[string]any{"name": "peer-" + fmt.Sprint(i), "ip": "100.64_0." + fmt.Sprint(i)},
+		}
+		if i == 9 {
+			event.Activity = activity.UserDeleted
+			event.TargetID = "deleted_user"
+			event.Meta = map[string]any{"email": "alice@example.com", "name": "Alice"}
+		}
+		_, err = store.Save(context.Background(), event)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	_, err = store.Save(context.Background(), &activity.Event{
+		Timestamp: start, Activity: activity.PeerAddedByUser, AccountID: "account_2", Meta: map[string]any{"name": "peer-0"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("filters", func(t *testing.T) {
+		result, err := store.Search(context.Background(), accountID, activity.EventFilter{InitiatorID: "user_1", From: start.Add(time.Minute), To: start.Add(3 * time.Minute)})
+		assert.NoError(t, err)
+		assert.Len(t, result, 3)
+
+		result, err = store.Search(context.Background(), accountID, activity.EventFilter{Activities: []activity.Activity{activity.UserDeleted}})
+		assert.NoError(t, err)
+		if assert.Len(t, result, 1) {
+			assert.Equal(t, "alice@example.com", result[0].Meta["email"])
+		}
+
+		result, err = store.Search(context.Background(), accountID, activity.EventFilter{TargetID: "peer_3"})
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+	})
+
+	t.Run("free text search", func(t *testing.T) {
+		result, err := store.Search(context.Background(), accountID, activity.EventFilter{Search: "PEER-1"})
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+
+		result, err = store.Search(context.Background(), accountID, activity.EventFilter{Search: "64_0.5"})
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+
+		// the LIKE wildcards are matched literally
+		result, err = store.Search(context.Background(), accountID, activity.EventFilter{Search: "64%0"})
+		assert.NoError(t, err)
+		assert.Empty(t, result)
+
+		// the email of a deleted user is stored encrypted
+		result, err = store.Search(context.Background(), accountID, activity.EventFilter{Search: "alice@"})
+		assert.NoError(t, err)
+		if assert.Len(t, result, 1) {
+			assert.Equal(t, "deleted_user", result[0].TargetID)
+		}
+	})
+
+	t.Run("cursor pagination", func(t *testing.T) {
+		for _, descending := range []bool{true, false} {
+			filter := activity.EventFilter{Limit: 3, Descending: descending}
+			var ids []uint64
+			for {
+				page, err := store.Search(context.Background(), accountID, filter)
+				assert.NoError(t, err)
+				if len(page) == 0 {
+					break
+				}
+				for _, event := range page {
+					ids = append(ids, event.ID)
+				}
+				filter.Cursor = activity.CursorOf(page[len(page)-1])
+			}
+
+			assert.Len(t, ids, 10)
+			if descending {
+				assert.IsNonIncreasing(t, ids)
+			} else {
+				assert.IsNonDecreasing(t, ids)
+			}
+		}
+	})
+}
+
+func TestSqlStore_DeleteBefore(t *testing.T) {
+	key, _ := GenerateKey()
+	store, err := NewSqlStore(context.Background(), t.TempDir(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close(context.Background()) //nolint
+
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 4; i++ {
+		for _, accountID := range []string{"account_1", "account_2"} {
+			_, err = store.Save(context.Background(), &activity.Event{
+				Timestamp: start.Add(time.Duration(i) * 24 * time.Hour),
+				Activity:  activity.PeerAddedByUser,
+				AccountID: accountID,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	deleted, err := store.DeleteBefore(context.Background(), "account_1", start.Add(48*time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), deleted)
+
+	events, err := store.Search(context.Background(), "account_1", activity.EventFilter{})
+	assert.NoError(t, err)
+	assert.Len(t, events, 2)
+	for _, event := range events {
+		assert.False(t, event.Timestamp.Before(start.Add(48*time.Hour)))
+	}
+
+	events, err = store.Search(context.Background(), "account_2", activity.EventFilter{})
+	assert.NoError(t, err)
+	assert.Len(t, events, 4)
+}
@@ -0,0 +1,384 @@
+package blocklists
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/rs/xid"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/management/server/account"
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/blocklists/types"
+	"github.com/netbirdio/netbird/management/server/permissions"
+	"github.com/netbirdio/netbird/management/server/permissions/modules"
+	"github.com/netbirdio/netbird/management/server/permissions/operations"
+	"github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/shared/management/status"
+)
+
+const (
+	// SourceRefreshInterval is how often the blocklist sources are fetched again
+	SourceRefreshInterval = 12 * time.Hour
+	// sourceCheckInterval is how often the sources are checked for a pending refresh
+	sourceCheckInterval = 10 * time.Minute
+	// sourceFetchTimeout is the timeout of a single source fetch
+	sourceFetchTimeout = 30 * time.Second
+	// maxSourceSize is the maximum size of a source that is read
+	maxSourceSize = 16 << 20
+)
+
+type Manager interface {
+	GetAllBlocklists(ctx context.Context, accountID, userID string) ([]*types.Blocklist, error)
+	GetBlocklist(ctx context.Context, accountID, userID, blocklistID string) (*types.Blocklist, error)
+	CreateBlocklist(ctx context.Context, userID string, blocklist *types.Blocklist) (*types.Blocklist, error)
+	UpdateBlocklist(ctx context.Context, userID string, blocklist *types.Blocklist) (*types.Blocklist, error)
+	DeleteBlocklist(ctx context.Context, accountID, userID, blocklistID string) error
+	// RefreshSources fetches the outdated blocklist sources periodically until the context is done
+	RefreshSources(ctx context.Context)
+}
+
+type managerImpl struct {
+	store              store.Store
+	accountManager     account.Manager
+	permissionsManager permissions.Manager
+	httpClient         *http.Client
+}
+
+func NewManager(store store.Store, accountManager account.Manager, permissionsManager permissions.Manager) Manager {
+	return &managerImpl{
+		store:              store,
+		accountManager:     accountManager,
+		permissionsManager: permissionsManager,
+		httpClient:         &http.Client{Timeout: sourceFetchTimeout},
+	}
+}
+
+func (m *managerImpl) GetAllBlocklists(ctx context.Context, accountID, userID string) ([]*types.Blocklist, error) {
+	if err := m.validatePermissions(ctx, accountID, userID, operations.Read); err != nil {
+		return nil, err
+	}
+
+	return m.store.GetAccountDNSBlocklists(ctx, store.LockingStrengthNone, accountID)
+}
+
+func (m *managerImpl) GetBlocklist(ctx context.Context, accountID, userID, blocklistID string) (*types.Blocklist, error) {
+	if err := m.validatePermissions(ctx, accountID, userID, operations.Read); err != nil {
+		return nil, err
+	}
+
+	return m.store.GetDNSBlocklistByID(ctx, store.LockingStrengthNone, accountID, blocklistID)
+}
+
+func (m *managerImpl) CreateBlocklist(ctx context.Context, userID string, blocklist *types.Blocklist) (*types.Blocklist, error) {
+	if err := m.validatePermissions(ctx, blocklist.AccountID, userID, operations.Create); err != nil {
+		return nil, err
+	}
+
+	blocklist.ID = xid.New().String()
+	blocklist.Normalize()
+
+	if err := blocklist.Validate(); err != nil {
+		return nil, err
+	}
+
+	if blocklist.SourceURL != "" {
+		m.fetchSource(ctx, blocklist)
+	}
+
+	err := m.store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		if err := validateExemptGroups(ctx, transaction, blocklist); err != nil {
+			return err
+		}
+
+		if err := transaction.SaveDNSBlocklist(ctx, blocklist); err != nil {
+			return fmt.Errorf("failed to save DNS blocklist: %w", err)
+		}
+
+		return transaction.IncrementNetworkSerial(ctx, blocklist.AccountID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	m.accountManager.StoreEvent(ctx, userID, blocklist.ID, blocklist.AccountID, activity.DNSBlocklistCreated, blocklist.EventMeta())
+
+	if blocklist.Enabled {
+		go m.accountManager.UpdateAccountPeers(ctx, blocklist.AccountID)
+	}
+
+	return blocklist, nil
+}
+
+func (m *managerImpl) UpdateBlocklist(ctx context.Context, userID string, blocklist *types.Blocklist) (*types.Blocklist, error) {
+	if err := m.validatePermissions(ctx, blocklist.AccountID, userID, operations.Update); err != nil {
+		return nil, err
+	}
+
+	blocklist.Normalize()
+
+	if err := blocklist.Validate(); err != nil {
+		return nil, err
+	}
+
+	oldBlocklist, err := m.store.GetDNSBlocklistByID(ctx, store.LockingStrengthNone, blocklist.AccountID, blocklist.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if blocklist.SourceURL != "" && blocklist.SourceChanged(oldBlocklist) {
+		m.fetchSource(ctx, blocklist)
+	} else {
+		blocklist.SourceDomains = oldBlocklist.SourceDomains
+		blocklist.SourceUpdatedAt = oldBlocklist.SourceUpdatedAt
+		blocklist.SourceError = oldBlocklist.SourceError
+	}
+	if blocklist.SourceURL == "" {
+		blocklist.SourceDomains = nil
+		blocklist.SourceUpdatedAt = nil
+		blocklist.SourceError = ""
+	}
+
+	var updateAccountPeers bool
+	err = m.store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		current, err := transaction.GetDNSBlocklistByID(ctx, store.LockingStrengthUpdate, blocklist.AccountID, blocklist.ID)
+		if err != nil {
+			return err
+		}
+
+		if err = validateExemptGroups(ctx, transaction, blocklist); err != nil {
+			return err
+		}
+
+		updateAccountPeers = current.Enabled || blocklist.Enabled
+
+		if err = transaction.SaveDNSBlocklist(ctx, blocklist); err != nil {
+			return fmt.Errorf("failed to save DNS blocklist: %w", err)
+		}
+
+		return transaction.IncrementNetworkSerial(ctx, blocklist.AccountID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	m.accountManager.StoreEvent(ctx, userID, blocklist.ID, blocklist.AccountID, activity.DNSBlocklistUpdated, blocklist.EventMeta())
+
+	if updateAccountPeers {
+		go m.accountManager.UpdateAccountPeers(ctx, blocklist.AccountID)
+	}
+
+	return blocklist, nil
+}
+
+func (m *managerImpl) DeleteBlocklist(ctx context.Context, accountID, userID, blocklistID string) error {
+	if err := m.validatePermissions(ctx, accountID, userID, operations.Delete); err != nil {
+		return err
+	}
+
+	var blocklist *types.Blocklist
+	err := m.store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		var err error
+		blocklist, err = transaction.GetDNSBlocklistByID(ctx, store.LockingStrengthUpdate, accountID, blocklistID)
+		if err != nil {
+			return err
+		}
+
+		if err = transaction.DeleteDNSBlocklist(ctx, accountID, blocklistID); err != nil {
+			return err
+		}
+
+		return transaction.IncrementNetworkSerial(ctx, accountID)
+	})
+	if err != nil {
+		return err
+	}
+
+	m.accountManager.StoreEvent(ctx, userID, blocklist.ID, accountID, activity.DNSBlocklistDeleted, blocklist.EventMeta())
+
+	if blocklist.Enabled {
+		go m.accountManager.UpdateAccountPeers(ctx, accountID)
+	}
+
+	return nil
+}
+
+func (m *managerImpl) RefreshSources(ctx context.Context) {
+	ticker := time.NewTicker(sourceCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		m.refreshOutdatedSources(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// refreshOutdatedSources fetches the sources that were never fetched or are older than SourceRefreshInterval
+func (m *managerImpl) refreshOutdatedSources(ctx context.Context) {
+	blocklists, err := m.store.GetDNSBlocklistsWithSource(ctx, store.LockingStrengthNone)
+	if err != nil {
+		log.WithContext(ctx).Errorf("failed to get DNS blocklists to refresh: %v", err)
+		return
+	}
+
+	for _, blocklist := range blocklists {
+		if ctx.Err() != nil {
+			return
+		}
+		if blocklist.SourceUpdatedAt != nil && time.Since(*blocklist.SourceUpdatedAt) < SourceRefreshInterval {
+			continue
+		}
+		if err := m.refreshSource(ctx, blocklist.AccountID, blocklist.ID); err != nil {
+			log.WithContext(ctx).Errorf("failed to refresh DNS blocklist %s source: %v", blocklist.ID, err)
+		}
+	}
+}
+
+// refreshSource fetches the source of a stored blocklist and updates the peers if the domains changed
+func (m *managerImpl) refreshSource(ctx context.Context, accountID, blocklistID string) error {
+	blocklist, err := m.store.GetDNSBlocklistByID(ctx, store.LockingStrengthNone, accountID, blocklistID)
+	if err != nil {
+		return err
+	}
+	if blocklist.SourceURL == "" {
+		return nil
+	}
+
+	oldDomains := blocklist.SourceDomains
+	m.fetchSource(ctx, blocklist)
+	domainsChanged := !slices.Equal(oldDomains, blocklist.SourceDomains)
+
+	err = m.store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		current, err := transaction.GetDNSBlocklistByID(ctx, store.LockingStrengthUpdate, accountID, blocklistID)
+		if err != nil {
+			return err
+		}
+		if current.SourceChanged(blocklist) {
+			// the source was changed while it was fetched, the update has fetched the new one
+			domainsChanged = false
+			return nil
+		}
+
+		current.SourceDomains = blocklist.SourceDomains
+		current.SourceUpdatedAt = blocklist.SourceUpdatedAt
+		current.SourceError = blocklist.SourceError
+		if err = transaction.SaveDNSBlocklist(ctx, current); err != nil {
+			return fmt.Errorf("failed to save DNS blocklist: %w", err)
+		}
+
+		if !domainsChanged {
+			return nil
+		}
+		return transaction.IncrementNetworkSerial(ctx, accountID)
+	})
+	if err != nil {
+		return err
+	}
+
+	if domainsChanged && blocklist.Enabled {
+		go m.accountManager.UpdateAccountPeers(ctx, accountID)
+	}
+
+	return nil
+}
+
+// fetchSource downloads and parses the blocklist source. The domains of the last successful fetch are kept on failure.
+func (m *managerImpl) fetchSource(ctx context.Context, blocklist *types.Blocklist) {
+	now := time.Now().UTC()
+	blocklist.SourceUpdatedAt = &now
+
+	domains, err := m.downloadSource(ctx, blocklist.SourceURL, blocklist.SourceFormat)
+	if err != nil {
+		log.WithContext(ctx).Warnf("failed to fetch DNS blocklist %s source %s: %v", blocklist.ID, blocklist.SourceURL, err)
+		blocklist.SourceError = err.Error()
+		return
+	}
+
+	blocklist.SourceDomains = domains
+	blocklist.SourceError = ""
+}
+
+func (m *managerImpl) downloadSource(ctx context.Context, sourceURL string, format types.SourceFormat) ([]string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, sourceURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+
+	resp, err := m.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request source: %w", err)
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithContext(ctx).Debugf("failed to close DNS blocklist source body: %v", err)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	body := io.LimitReader(resp.Body, maxSourceSize+1)
+	counter := &countingReader{reader: body}
+	domains, err := types.ParseSource(counter, format)
+	if err != nil {
+		return nil, err
+	}
+	if counter.read > maxSourceSize {
+		return nil, errors.New("source is larger than 16 MB")
+	}
+
+	return domains, nil
+}
+
+func (m *managerImpl) validatePermissions(ctx context.Context, accountID, userID string, operation operations.Operation) error {
+	allowed, err := m.permissionsManager.ValidateUserPermissions(ctx, accountID, userID, modules.Dns, operation)
+	if err != nil {
+		return status.NewPermissionValidationError(err)
+	}
+	if !allowed {
+		return status.NewPermissionDeniedError()
+	}
+	return nil
+}
+
+// validateExemptGroups checks that the exempt groups of the blocklist exist
+func validateExemptGroups(ctx context.Context, transaction store.Store, blocklist *types.Blocklist) error {
+	if len(blocklist.ExemptGroups) == 0 {
+		return nil
+	}
+
+	groups, err := transaction.GetGroupsByIDs(ctx, store.LockingStrengthNone, blocklist.AccountID, blocklist.ExemptGroups)
+	if err != nil {
+		return err
+	}
+	for _, id := range blocklist.ExemptGroups {
+		if _, found := groups[id]; !found {
+			return status.Errorf(status.InvalidArgument, "group id %s not found", id)
+		}
+	}
+
+	return nil
+}
+
+// countingReader counts the bytes read from the underlying reader
+type countingReader struct {
+	reader io.Reader
+	read   int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.read += int64(n)
+	return n, err
+}
@@ -0,0 +1,270 @@
+package types
+
+import (
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/rs/xid"
+
+	nbdns "github.com/netbirdio/netbird/dns"
+	"github.com/netbirdio/netbird/shared/management/http/api"
+	"github.com/netbirdio/netbird/shared/management/status"
+)
+
+// Action defines how the peers answer queries for blocked domains
+type Action string
+
+const (
+	// ActionNXDomain answers blocked queries with NXDOMAIN
+	ActionNXDomain Action = "nxdomain"
+	// ActionSinkhole answers blocked A and AAAA queries with the unspecified address
+	ActionSinkhole Action = "sinkhole"
+)
+
+// SourceFormat is the format of a blocklist source
+type SourceFormat string
+
+const (
+	// SourceFormatHosts is the hosts file format, e.g. "0.0.0.0 ads.example.com"
+	SourceFormatHosts SourceFormat = "hosts"
+	// SourceFormatAdblock is the adblock filter format, only domain rules like "||ads.example.com^" are supported
+	SourceFormatAdblock SourceFormat = "adblock"
+	// SourceFormatDomains is a plain list with a domain per line
+	SourceFormatDomains SourceFormat = "domains"
+)
+
+// MaxInlineDomains is the maximum number of domains that can be defined in a blocklist itself
+const MaxInlineDomains = 10000
+
+// Blocklist is an account managed list of domains that the peers don't resolve
+type Blocklist struct {
+	ID          string `gorm:"primaryKey"`
+	AccountID   string `gorm:"index"`
+	Name        string
+	Description string
+	Enabled     bool
+	Action      Action
+	// Domains are the domains defined in the blocklist itself
+	Domains []string `gorm:"serializer:json"`
+	// SourceURL is a list of domains in SourceFormat that management fetches periodically
+	SourceURL    string
+	SourceFormat SourceFormat
+	// SourceDomains are the domains of the last successful fetch of the source
+	SourceDomains   []string `gorm:"serializer:json"`
+	SourceUpdatedAt *time.Time
+	// SourceError is the error of the last fetch of the source
+	SourceError string
+	// ExemptGroups are the groups of peers that don't enforce the blocklist
+	ExemptGroups []string `gorm:"serializer:json"`
+}
+
+func NewBlocklist(accountID, name, description string, enabled bool, action Action, domains []string, sourceURL string, sourceFormat SourceFormat, exemptGroups []string) *Blocklist {
+	return &Blocklist{
+		ID:           xid.New().String(),
+		AccountID:    accountID,
+		Name:         name,
+		Description:  description,
+		Enabled:      enabled,
+		Action:       action,
+		Domains:      domains,
+		SourceURL:    sourceURL,
+		SourceFormat: sourceFormat,
+		ExemptGroups: exemptGroups,
+	}
+}
+
+// TableName returns the name of the table for the Blocklist model in the database.
+func (*Blocklist) TableName() string {
+	return "dns_blocklists"
+}
+
+func (b *Blocklist) FromAPIRequest(req *api.DNSBlocklistRequest) {
+	b.Name = req.Name
+	b.Description = ""
+	if req.Description != nil {
+		b.Description = *req.Description
+	}
+	b.Enabled = req.Enabled
+	b.Action = Action(req.Action)
+	b.Domains = req.Domains
+	b.SourceURL = ""
+	if req.SourceUrl != nil {
+		b.SourceURL = *req.SourceUrl
+	}
+	b.SourceFormat = ""
+	if req.SourceFormat != nil {
+		b.SourceFormat = SourceFormat(*req.SourceFormat)
+	}
+	b.ExemptGroups = []string{}
+	if req.ExemptGroups != nil {
+		b.ExemptGroups = *req.ExemptGroups
+	}
+}
+
+func (b *Blocklist) ToAPIResponse() *api.DNSBlocklist {
+	resp := &api.DNSBlocklist{
+		Id:                 b.ID,
+		Name:               b.Name,
+		Description:        &b.Description,
+		Enabled:            b.Enabled,
+		Action:             api.DNSBlocklistAction(b.Action),
+		Domains:            b.Domains,
+		ExemptGroups:       &b.ExemptGroups,
+		SourceDomainsCount: len(b.SourceDomains),
+		SourceUpdatedAt:    b.SourceUpdatedAt,
+	}
+	if resp.Domains == nil {
+		resp.Domains = []string{}
+	}
+	if b.SourceURL != "" {
+		resp.SourceUrl = &b.SourceURL
+		sourceFormat := api.DNSBlocklistSourceFormat(b.SourceFormat)
+		resp.SourceFormat = &sourceFormat
+	}
+	if b.SourceError != "" {
+		resp.SourceError = &b.SourceError
+	}
+	return resp
+}
+
+// Copy returns a copy of the blocklist.
+func (b *Blocklist) Copy() *Blocklist {
+	blocklist := &Blocklist{
+		ID:            b.ID,
+		AccountID:     b.AccountID,
+		Name:          b.Name,
+		Description:   b.Description,
+		Enabled:       b.Enabled,
+		Action:        b.Action,
+		Domains:       slices.Clone(b.Domains),
+		SourceURL:     b.SourceURL,
+		SourceFormat:  b.SourceFormat,
+		SourceDomains: slices.Clone(b.SourceDomains),
+		SourceError:   b.SourceError,
+		ExemptGroups:  slices.Clone(b.ExemptGroups),
+	}
+	if b.SourceUpdatedAt != nil {
+		updatedAt := *b.SourceUpdatedAt
+		blocklist.SourceUpdatedAt = &updatedAt
+	}
+	return blocklist
+}
+
+func (b *Blocklist) EventMeta() map[string]any {
+	return map[string]any{"name": b.Name, "action": string(b.Action), "source_url": b.SourceURL}
+}
+
+// Normalize lowercases the domains, drops duplicates and sets the default action and source format
+func (b *Blocklist) Normalize() {
+	b.Domains = normalizeDomains(b.Domains)
+	if b.ExemptGroups == nil {
+		b.ExemptGroups = []string{}
+	}
+	b.SourceURL = strings.TrimSpace(b.SourceURL)
+	if b.Action == "" {
+		b.Action = ActionNXDomain
+	}
+	if b.SourceURL != "" && b.SourceFormat == "" {
+		b.SourceFormat = SourceFormatHosts
+	}
+	if b.SourceURL == "" {
+		b.SourceFormat = ""
+	}
+}
+
+// Validate checks the blocklist action, domains and source
+func (b *Blocklist) Validate() error {
+	if b.Name == "" {
+		return status.Errorf(status.InvalidArgument, "blocklist name shouldn't be empty")
+	}
+
+	if b.Action != ActionNXDomain && b.Action != ActionSinkhole {
+		return status.Errorf(status.InvalidArgument, "invalid blocklist action %q", b.Action)
+	}
+
+	if len(b.Domains) == 0 && b.SourceURL == "" {
+		return status.Errorf(status.InvalidArgument, "blocklist should have domains or a source URL")
+	}
+
+	if len(b.Domains) > MaxInlineDomains {
+		return status.Errorf(status.InvalidArgument, "blocklist can't have more than %d domains, use a source URL instead", MaxInlineDomains)
+	}
+
+	for _, domain := range b.Domains {
+		if !isValidDomain(domain) {
+			return status.Errorf(status.InvalidArgument, "invalid blocklist domain %q", domain)
+		}
+	}
+
+	if b.SourceURL != "" {
+		sourceURL, err := url.Parse(b.SourceURL)
+		if err != nil || (sourceURL.Scheme != "http" && sourceURL.Scheme != "https") || sourceURL.Host == "" {
+			return status.Errorf(status.InvalidArgument, "blocklist source URL should be an absolute http or https URL")
+		}
+
+		switch b.SourceFormat {
+		case SourceFormatHosts, SourceFormatAdblock, SourceFormatDomains:
+		default:
+			return status.Errorf(status.InvalidArgument, "invalid blocklist source format %q", b.SourceFormat)
+		}
+	}
+
+	return nil
+}
+
+// SourceChanged checks if the source of the blocklist differs from the other one and has to be fetched again
+func (b *Blocklist) SourceChanged(other *Blocklist) bool {
+	return b.SourceURL != other.SourceURL || b.SourceFormat != other.SourceFormat
+}
+
+// IsExempt checks if any of the peer groups is exempt from the blocklist
+func (b *Blocklist) IsExempt(peerGroups map[string]struct{}) bool {
+	for _, groupID := range b.ExemptGroups {
+		if _, ok := peerGroups[groupID]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// ToDNSBlocklist returns the blocklist in the format that is sent to peers
+func (b *Blocklist) ToDNSBlocklist() nbdns.Blocklist {
+	domains := make([]string, 0, len(b.Domains)+len(b.SourceDomains))
+	domains = append(domains, b.Domains...)
+	domains = append(domains, b.SourceDomains...)
+	slices.Sort(domains)
+
+	return nbdns.Blocklist{
+		ID:       b.ID,
+		Name:     b.Name,
+		Sinkhole: b.Action == ActionSinkhole,
+		Domains:  slices.Compact(domains),
+	}
+}
+
+// normalizeDomains lowercases the domains, strips the wildcard prefix and the trailing dot and drops duplicates
+func normalizeDomains(domains []string) []string {
+	normalized := make([]string, 0, len(domains))
+	seen := make(map[string]struct{}, len(domains))
+	for _, domain := range domains {
+		domain = strings.TrimPrefix(strings.ToLower(strings.TrimSpace(domain)), "*.")
+		domain = strings.TrimSuffix(domain, ".")
+		if domain == "" {
+			continue
+		}
+		if _, ok := seen[domain]; ok {
+			continue
+		}
+		seen[domain] = struct{}{}
+		normalized = append(normalized, domain)
+	}
+	return normalized
+}
+
+func isValidDomain(domain string) bool {
+	_, ok := dns.IsDomainName(domain)
+	return ok && !strings.ContainsAny(domain, "*/ ")
+}
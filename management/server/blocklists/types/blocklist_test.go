@@ -0,0 +1,58 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlocklist_Validate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		domains   []string
+		sourceURL string
+		action    Action
+		wantErr   bool
+	}{
+		{name: "inline domains", domains: []string{"ads.example.com", "*.Tracker.example.com."}},
+		{name: "top level domain", domains: []string{"zip"}},
+		{name: "source only", sourceURL: "https://example.com/hosts.txt"},
+		{name: "sinkhole", domains: []string{"ads.example.com"}, action: ActionSinkhole},
+		{name: "no domains or source", wantErr: true},
+		{name: "invalid domain", domains: []string{"ads example.com"}, wantErr: true},
+		{name: "wildcard in the middle", domains: []string{"ads.*.example.com"}, wantErr: true},
+		{name: "invalid source scheme", sourceURL: "file:///etc/hosts", wantErr: true},
+		{name: "relative source", sourceURL: "hosts.txt", wantErr: true},
+		{name: "invalid action", domains: []string{"ads.example.com"}, action: "drop", wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			blocklist := NewBlocklist("account", "ads", "", true, tc.action, tc.domains, tc.sourceURL, "", nil)
+			blocklist.Normalize()
+			if tc.wantErr {
+				assert.Error(t, blocklist.Validate())
+				return
+			}
+			assert.NoError(t, blocklist.Validate())
+		})
+	}
+}
+
+func TestBlocklist_Normalize(t *testing.T) {
+	blocklist := NewBlocklist("account", "ads", "", true, "", []string{"*.Ads.Example.com.", "ads.example.com", " "}, " https://example.com/list ", "", nil)
+	blocklist.Normalize()
+
+	assert.Equal(t, []string{"ads.example.com"}, blocklist.Domains)
+	assert.Equal(t, ActionNXDomain, blocklist.Action)
+	assert.Equal(t, "https://example.com/list", blocklist.SourceURL)
+	assert.Equal(t, SourceFormatHosts, blocklist.SourceFormat)
+	assert.Equal(t, []string{}, blocklist.ExemptGroups)
+}
+
+func TestBlocklist_IsExempt(t *testing.T) {
+	blocklist := &Blocklist{ExemptGroups: []string{"admins"}}
+
+	assert.True(t, blocklist.IsExempt(map[string]struct{}{"all": {}, "admins": {}}))
+	assert.False(t, blocklist.IsExempt(map[string]struct{}{"all": {}}))
+}
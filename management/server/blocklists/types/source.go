@@ -0,0 +1,114 @@
+package types
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/netip"
+	"strings"
+)
+
+// MaxSourceDomains is the maximum number of domains taken from a blocklist source
+const MaxSourceDomains = 500000
+
+// hostsIgnoredNames are the names defined by the default hosts files that shouldn't be blocked
+var hostsIgnoredNames = map[string]struct{}{
+	"localhost":             {},
+	"localhost.localdomain": {},
+	"local":                 {},
+	"broadcasthost":         {},
+	"ip6-localhost":         {},
+	"ip6-loopback":          {},
+	"ip6-localnet":          {},
+	"ip6-mcastprefix":       {},
+	"ip6-allnodes":          {},
+	"ip6-allrouters":        {},
+	"ip6-allhosts":          {},
+	"0.0.0.0":               {},
+}
+
+// ParseSource returns the normalized domains of a blocklist source. Lines that aren't domain rules of the format are skipped.
+func ParseSource(r io.Reader, format SourceFormat) ([]string, error) {
+	var parseLine func(string) []string
+	switch format {
+	case SourceFormatHosts:
+		parseLine = parseHostsLine
+	case SourceFormatAdblock:
+		parseLine = parseAdblockLine
+	case SourceFormatDomains:
+		parseLine = parseDomainsLine
+	default:
+		return nil, fmt.Errorf("unsupported source format %q", format)
+	}
+
+	var domains []string
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		for _, domain := range parseLine(strings.TrimSpace(scanner.Text())) {
+			if isValidDomain(domain) {
+				domains = append(domains, domain)
+			}
+		}
+		if len(domains) > MaxSourceDomains {
+			return nil, fmt.Errorf("source has more than %d domains", MaxSourceDomains)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read source: %w", err)
+	}
+
+	return normalizeDomains(domains), nil
+}
+
+// parseHostsLine parses "<address> <name>..." lines
+func parseHostsLine(line string) []string {
+	if i := strings.IndexByte(line, '#'); i >= 0 {
+		line = line[:i]
+	}
+
+	fields := strings.Fields(line)
+	if len(fields) < 2 {
+		return nil
+	}
+	if _, err := netip.ParseAddr(fields[0]); err != nil {
+		return nil
+	}
+
+	var names []string
+	for _, name := range fields[1:] {
+		name = strings.ToLower(name)
+		if _, ignored := hostsIgnoredNames[name]; ignored {
+			continue
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+// parseAdblockLine parses the "||<domain>^" rules that block a domain and its subdomains
+func parseAdblockLine(line string) []string {
+	if !strings.HasPrefix(line, "||") {
+		return nil
+	}
+
+	domain, rest, found := strings.Cut(line[2:], "^")
+	if !found || (rest != "" && rest != "$important") {
+		// rules with paths or options other than $important don't block the whole domain
+		return nil
+	}
+	return []string{domain}
+}
+
+// parseDomainsLine parses lines with a single domain
+func parseDomainsLine(line string) []string {
+	if i := strings.IndexByte(line, '#'); i >= 0 {
+		line = line[:i]
+	}
+
+	fields := strings.Fields(line)
+	if len(fields) != 1 {
+		return nil
+	}
+	return fields
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSource(t *testing.T) {
+	testCases := []struct {
+		name     string
+		format   SourceFormat
+		source   string
+		expected []string
+	}{
+		{
+			name:   "hosts",
+			format: SourceFormatHosts,
+			source: `# comment
+127.0.0.1 localhost
+::1 ip6-localhost ip6-loopback
+0.0.0.0 0.0.0.0
+0.0.0.0 Ads.Example.com tracker.example.com # inline comment
+0.0.0.0 ads.example.com
+not-an-address example.org
+0.0.0.0
+`,
+			expected: []string{"ads.example.com", "tracker.example.com"},
+		},
+		{
+			name:   "adblock",
+			format: SourceFormatAdblock,
+			source: `[Adblock Plus 2.0]
+! comment
+||ads.example.com^
+||tracker.example.com^$important
+||example.org^$third-party
+||example.net/path^
+@@||allowed.example.com^
+##.banner
+`,
+			expected: []string{"ads.example.com", "tracker.example.com"},
+		},
+		{
+			name:   "domains",
+			format: SourceFormatDomains,
+			source: `# comment
+ads.example.com
+tracker.example.com.
+two fields.example.com
+`,
+			expected: []string{"ads.example.com", "tracker.example.com"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			domains, err := ParseSource(strings.NewReader(tc.source), tc.format)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, domains)
+		})
+	}
+}
+
+func TestParseSource_UnsupportedFormat(t *testing.T) {
+	_, err := ParseSource(strings.NewReader("ads.example.com"), "rpz")
+	assert.Error(t, err)
+}
@@ -21,6 +21,7 @@ import (
 // DNSConfigCache is a thread-safe cache for DNS configuration components
 type DNSConfigCache struct {
 	NameServerGroups sync.Map
+	Blocklists       sync.Map
 }
 
 // GetNameServerGroup retrieves a cached name server group
@@ -42,6 +43,25 @@ func (c *DNSConfigCache) SetNameServerGroup(key string, value *proto.NameServerG
 	c.NameServerGroups.Store(key, value)
 }
 
+// GetBlocklist retrieves a cached blocklist
+func (c *DNSConfigCache) GetBlocklist(key string) (*proto.DNSBlocklist, bool) {
+	if c == nil {
+		return nil, false
+	}
+	if value, ok := c.Blocklists.Load(key); ok {
+		return value.(*proto.DNSBlocklist), true
+	}
+	return nil, false
+}
+
+// SetBlocklist stores a blocklist in the cache
+func (c *DNSConfigCache) SetBlocklist(key string, value *proto.DNSBlocklist) {
+	if c == nil {
+		return
+	}
+	c.Blocklists.Store(key, value)
+}
+
 // GetDNSSettings validates a user role and returns the DNS settings for the provided account ID
 func (am *DefaultAccountManager) GetDNSSettings(ctx context.Context, accountID string, userID string) (*types.DNSSettings, error) {
 	allowed, err := am.permissionsManager.ValidateUserPermissions(ctx, accountID, userID, modules.Dns, operations.Read)
@@ -189,6 +209,7 @@ func toProtocolDNSConfig(update nbdns.Config, cache *DNSConfigCache) *proto.DNSC
 		ServiceEnable:    update.ServiceEnable,
 		CustomZones:      make([]*proto.CustomZone, 0, len(update.CustomZones)),
 		NameServerGroups: make([]*proto.NameServerGroup, 0, len(update.NameServerGroups)),
+		Blocklists:       make([]*proto.DNSBlocklist, 0, len(update.Blocklists)),
 	}
 
 	for _, zone := range update.CustomZones {
@@ -207,6 +228,21 @@ func toProtocolDNSConfig(update nbdns.Config, cache *DNSConfigCache) *proto.DNSC
 		}
 	}
 
+	for _, blocklist := range update.Blocklists {
+		if cachedBlocklist, exists := cache.GetBlocklist(blocklist.ID); exists {
+			protoUpdate.Blocklists = append(protoUpdate.Blocklists, cachedBlocklist)
+		} else {
+			protoBlocklist := &proto.DNSBlocklist{
+				ID:       blocklist.ID,
+				Name:     blocklist.Name,
+				Sinkhole: blocklist.Sinkhole,
+				Domains:  blocklist.Domains,
+			}
+			cache.SetBlocklist(blocklist.ID, protoBlocklist)
+			protoUpdate.Blocklists = append(protoUpdate.Blocklists, protoBlocklist)
+		}
+	}
+
 	return protoUpdate
 }
 
@@ -238,9 +274,12 @@ func convertToProtoNameServerGroup(nsGroup *nbdns.NameServerGroup) *proto.NameSe
 	}
 	for _, ns := range nsGroup.NameServers {
 		protoGroup.NameServers = append(protoGroup.NameServers, &proto.NameServer{
-			IP:     ns.IP.String(),
-			Port:   int64(ns.Port),
-			NSType: int64(ns.NSType),
+			IP:         ns.IP.String(),
+			Port:       int64(ns.Port),
+			NSType:     int64(ns.NSType),
+			ServerName: ns.ServerName,
+			Path:       ns.Path,
+			Pin:        ns.Pin,
 		})
 	}
 	return protoGroup
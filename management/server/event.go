@@ -23,12 +23,8 @@ func isEnabled() bool {
 
 // GetEvents returns a list of activity events of an account
 func (am *DefaultAccountManager) GetEvents(ctx context.Context, accountID, userID string) ([]*activity.Event, error) {
-	allowed, err := am.permissionsManager.ValidateUserPermissions(ctx, accountID, userID, modules.Events, operations.Read)
-	if err != nil {
-		return nil, status.NewPermissionValidationError(err)
-	}
-	if !allowed {
-		return nil, status.NewPermissionDeniedError()
+	if err := am.validateEventsReadPermissions(ctx, accountID, userID); err != nil {
+		return nil, err
 	}
 
 	events, err := am.eventStore.Get(ctx, accountID, 0, 10000, true)
@@ -36,6 +32,55 @@ func (am *DefaultAccountManager) GetEvents(ctx context.Context, accountID, userI
 		return nil, err
 	}
 
+	return am.prepareEvents(ctx, accountID, userID, events)
+}
+
+// SearchEvents returns a page of the activity events of an account that match the filter and the cursor of the next
+// page, the cursor is nil when there are no more events
+func (am *DefaultAccountManager) SearchEvents(ctx context.Context, accountID, userID string, filter activity.EventFilter) ([]*activity.Event, *activity.EventCursor, error) {
+	if err := am.validateEventsReadPermissions(ctx, accountID, userID); err != nil {
+		return nil, nil, err
+	}
+
+	if filter.Limit <= 0 || filter.Limit > activity.MaxSearchLimit {
+		filter.Limit = activity.DefaultSearchLimit
+	}
+	limit := filter.Limit
+	// an extra event is requested to know whether there is a next page
+	filter.Limit++
+
+	events, err := am.eventStore.Search(ctx, accountID, filter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var next *activity.EventCursor
+	if len(events) > limit {
+		events = events[:limit]
+		next = activity.CursorOf(events[limit-1])
+	}
+
+	events, err = am.prepareEvents(ctx, accountID, userID, events)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return events, next, nil
+}
+
+func (am *DefaultAccountManager) validateEventsReadPermissions(ctx context.Context, accountID, userID string) error {
+	allowed, err := am.permissionsManager.ValidateUserPermissions(ctx, accountID, userID, modules.Events, operations.Read)
+	if err != nil {
+		return status.NewPermissionValidationError(err)
+	}
+	if !allowed {
+		return status.NewPermissionDeniedError()
+	}
+	return nil
+}
+
+// prepareEvents removes the duplicate events and fills the events with the user info
+func (am *DefaultAccountManager) prepareEvents(ctx context.Context, accountID, userID string, events []*activity.Event) ([]*activity.Event, error) {
 	// this is a workaround for duplicate activity.UserJoined events that might occur when a user redeems invite.
 	// we will need to find a better way to handle this.
 	filtered := make([]*activity.Event, 0)
@@ -53,7 +98,7 @@ func (am *DefaultAccountManager) GetEvents(ctx context.Context, accountID, userI
 		filtered = append(filtered, event)
 	}
 
-	err = am.fillEventsWithUserInfo(ctx, events, accountID, userID)
+	err := am.fillEventsWithUserInfo(ctx, events, accountID, userID)
 	if err != nil {
 		return nil, err
 	}
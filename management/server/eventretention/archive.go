@@ -0,0 +1,218 @@
+package eventretention
+
+import (
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+
+	"github.com/netbirdio/netbird/management/server/activity"
+)
+
+// ChecksumSuffix is appended to the key of an archive to get the key of its checksum file. The checksum file has the
+// format of sha256sum, so the local archives can be verified with "sha256sum -c".
+const ChecksumSuffix = ".sha256"
+
+// Archiver stores the archives of the purged events
+type Archiver interface {
+	// Store stores the archive under the key together with its SHA-256 checksum and returns the archive location
+	Store(ctx context.Context, key string, archive io.ReadSeeker, size int64, checksum []byte) (string, error)
+}
+
+// archivedEvent is the JSON line of an event in an archive
+type archivedEvent struct {
+	ID             uint64         `json:"id"`
+	Timestamp      time.Time      `json:"timestamp"`
+	Activity       string         `json:"activity"`
+	ActivityCode   string         `json:"activity_code"`
+	InitiatorID    string         `json:"initiator_id"`
+	InitiatorName  string         `json:"initiator_name,omitempty"`
+	InitiatorEmail string         `json:"initiator_email,omitempty"`
+	TargetID       string         `json:"target_id"`
+	AccountID      string         `json:"account_id"`
+	Meta           map[string]any `json:"meta,omitempty"`
+}
+
+// archiveWriter writes the events as gzip compressed JSON lines and computes the checksum of the compressed output
+type archiveWriter struct {
+	file   *os.File
+	hash   hash.Hash
+	gzip   *gzip.Writer
+	events int
+}
+
+func newArchiveWriter() (*archiveWriter, error) {
+	file, err := os.CreateTemp("", "netbird-events-*.jsonl.gz")
+	if err != nil {
+		return nil, fmt.Errorf("create archive file: %w", err)
+	}
+
+	h := sha256.New()
+	return &archiveWriter{
+		file: file,
+		hash: h,
+		gzip: gzip.NewWriter(io.MultiWriter(file, h)),
+	}, nil
+}
+
+func (w *archiveWriter) write(event *activity.Event) error {
+	line, err := json.Marshal(&archivedEvent{
+		ID:             event.ID,
+		Timestamp:      event.Timestamp,
+		Activity:       event.Activity.Message(),
+		ActivityCode:   event.Activity.StringCode(),
+		InitiatorID:    event.InitiatorID,
+		InitiatorName:  event.InitiatorName,
+		InitiatorEmail: event.InitiatorEmail,
+		TargetID:       event.TargetID,
+		AccountID:      event.AccountID,
+		Meta:           event.Meta,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal event %d: %w", event.ID, err)
+	}
+
+	if _, err = w.gzip.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("write event %d: %w", event.ID, err)
+	}
+	w.events++
+	return nil
+}
+
+// finish flushes the archive and rewinds it for reading
+func (w *archiveWriter) finish() (size int64, checksum []byte, err error) {
+	if err = w.gzip.Close(); err != nil {
+		return 0, nil, fmt.Errorf("close archive: %w", err)
+	}
+
+	size, err = w.file.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return 0, nil, err
+	}
+	if _, err = w.file.Seek(0, io.SeekStart); err != nil {
+		return 0, nil, err
+	}
+	return size, w.hash.Sum(nil), nil
+}
+
+func (w *archiveWriter) close() {
+	_ = w.file.Close()
+	_ = os.Remove(w.file.Name())
+}
+
+func checksumLine(key string, checksum []byte) string {
+	return hex.EncodeToString(checksum) + "  " + filepath.Base(key) + "\n"
+}
+
+type localArchiver struct {
+	dir string
+}
+
+// NewLocalArchiver returns an archiver that writes the archives to the directory
+func NewLocalArchiver(dir string) Archiver {
+	return &localArchiver{dir: dir}
+}
+
+func (a *localArchiver) Store(_ context.Context, key string, archive io.ReadSeeker, _ int64, checksum []byte) (string, error) {
+	path := filepath.Join(a.dir, filepath.FromSlash(key))
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return "", fmt.Errorf("create archive directory: %w", err)
+	}
+
+	if err := writeFileAtomic(path, archive); err != nil {
+		return "", err
+	}
+	if err := writeFileAtomic(path+ChecksumSuffix, strings.NewReader(checksumLine(key, checksum))); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// writeFileAtomic writes the file under a temporary name and renames it, so a partial archive is never left behind
+func writeFileAtomic(path string, r io.Reader) error {
+	tmp := path + ".tmp"
+	file, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("create %s: %w", tmp, err)
+	}
+
+	if _, err = io.Copy(file, r); err == nil {
+		err = file.Sync()
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("write %s: %w", tmp, err)
+	}
+
+	return os.Rename(tmp, path)
+}
+
+type s3Archiver struct {
+	bucket string
+	client *s3.Client
+}
+
+// NewS3Archiver returns an archiver that uploads the archives to the S3 bucket. The credentials are loaded from the
+// default AWS configuration sources, the region falls back to the AWS_REGION environment variable when it is empty.
+func NewS3Archiver(ctx context.Context, bucket, region string) (Archiver, error) {
+	var opts []func(*config.LoadOptions) error
+	if region != "" {
+		opts = append(opts, config.WithRegion(region))
+	}
+
+	cfg, err := config.LoadDefaultConfig(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load SDK config: %w", err)
+	}
+	if cfg.Region == "" {
+		return nil, fmt.Errorf("S3 region is required, set it in the configuration or the AWS_REGION environment variable")
+	}
+
+	return &s3Archiver{
+		bucket: bucket,
+		client: s3.NewFromConfig(cfg),
+	}, nil
+}
+
+// Store uploads the archive with its checksum, so S3 rejects an upload that was corrupted on the way
+func (a *s3Archiver) Store(ctx context.Context, key string, archive io.ReadSeeker, size int64, checksum []byte) (string, error) {
+	_, err := a.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:         aws.String(a.bucket),
+		Key:            aws.String(key),
+		Body:           archive,
+		ContentLength:  aws.Int64(size),
+		ContentType:    aws.String("application/gzip"),
+		ChecksumSHA256: aws.String(base64.StdEncoding.EncodeToString(checksum)),
+	})
+	if err != nil {
+		return "", fmt.Errorf("upload archive: %w", err)
+	}
+
+	_, err = a.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(a.bucket),
+		Key:         aws.String(key + ChecksumSuffix),
+		Body:        strings.NewReader(checksumLine(key, checksum)),
+		ContentType: aws.String("text/plain"),
+	})
+	if err != nil {
+		return "", fmt.Errorf("upload archive checksum: %w", err)
+	}
+
+	return "s3://" + a.bucket + "/" + key, nil
+}
@@ -0,0 +1,177 @@
+package eventretention
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/management/server/account"
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/store"
+)
+
+// DefaultInterval is how often the retention is enforced when no interval is configured
+const DefaultInterval = 24 * time.Hour
+
+// Enforcer purges the activity events that are older than the retention of their account. The events of the
+// accounts with archiving enabled are archived before they are purged and are kept when the archive fails.
+type Enforcer struct {
+	store          store.Store
+	eventStore     activity.Store
+	accountManager account.Manager
+	archiver       Archiver
+	interval       time.Duration
+}
+
+// NewEnforcer creates an enforcer, the archiver is optional
+func NewEnforcer(store store.Store, eventStore activity.Store, accountManager account.Manager, archiver Archiver, interval time.Duration) *Enforcer {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+
+	return &Enforcer{
+		store:          store,
+		eventStore:     eventStore,
+		accountManager: accountManager,
+		archiver:       archiver,
+		interval:       interval,
+	}
+}
+
+// Run enforces the retention periodically until the context is done
+func (e *Enforcer) Run(ctx context.Context) {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		e.enforce(ctx, time.Now().UTC())
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (e *Enforcer) enforce(ctx context.Context, now time.Time) {
+	accountIDs, err := e.store.GetAccountIDsWithEventRetention(ctx, store.LockingStrengthNone)
+	if err != nil {
+		log.WithContext(ctx).Errorf("failed to get accounts with event retention: %v", err)
+		return
+	}
+
+	for _, accountID := range accountIDs {
+		if ctx.Err() != nil {
+			return
+		}
+		if err := e.enforceAccount(ctx, accountID, now); err != nil {
+			log.WithContext(ctx).Errorf("failed to enforce the event retention of account %s: %v", accountID, err)
+		}
+	}
+}
+
+// enforceAccount archives the events of the account that are older than the retention if archiving is enabled and
+// purges them. The purge is recorded as an activity event of the account.
+func (e *Enforcer) enforceAccount(ctx context.Context, accountID string, now time.Time) error {
+	settings, err := e.store.GetAccountSettings(ctx, store.LockingStrengthNone, accountID)
+	if err != nil {
+		return err
+	}
+	if settings.EventRetentionDays <= 0 {
+		return nil
+	}
+
+	before := now.AddDate(0, 0, -settings.EventRetentionDays)
+	meta := map[string]any{
+		"retention_days": settings.EventRetentionDays,
+		"before":         before.Format(time.RFC3339),
+	}
+
+	if settings.EventArchiveEnabled {
+		if e.archiver == nil {
+			return fmt.Errorf("archiving is enabled but no event archive is configured, the events are kept")
+		}
+
+		location, checksum, err := e.archive(ctx, accountID, before, now)
+		if err != nil {
+			return fmt.Errorf("archive events: %w", err)
+		}
+		if location != "" {
+			meta["archive"] = location
+			meta["sha256"] = checksum
+		}
+	}
+
+	purged, err := e.eventStore.DeleteBefore(ctx, accountID, before)
+	if err != nil {
+		return fmt.Errorf("purge events: %w", err)
+	}
+	if purged == 0 {
+		return nil
+	}
+
+	log.WithContext(ctx).Infof("purged %d activity events of account %s older than %s", purged, accountID, before.Format(time.RFC3339))
+
+	meta["count"] = purged
+	e.accountManager.StoreEvent(ctx, activity.SystemInitiator, accountID, accountID, activity.AccountEventsPurged, meta)
+
+	return nil
+}
+
+// archive stores the events of the account that are older than before and returns the archive location and its
+// checksum. The location is empty when there is nothing to archive.
+func (e *Enforcer) archive(ctx context.Context, accountID string, before, now time.Time) (string, string, error) {
+	writer, err := newArchiveWriter()
+	if err != nil {
+		return "", "", err
+	}
+	defer writer.close()
+
+	filter := activity.EventFilter{
+		To:    before.Add(-time.Nanosecond),
+		Limit: activity.MaxSearchLimit,
+	}
+	for {
+		events, err := e.eventStore.Search(ctx, accountID, filter)
+		if err != nil {
+			return "", "", err
+		}
+		for _, event := range events {
+			if err = writer.write(event); err != nil {
+				return "", "", err
+			}
+		}
+		if len(events) < filter.Limit {
+			break
+		}
+		filter.Cursor = activity.CursorOf(events[len(events)-1])
+	}
+
+	if writer.events == 0 {
+		return "", "", nil
+	}
+
+	size, checksum, err := writer.finish()
+	if err != nil {
+		return "", "", err
+	}
+
+	key := ArchiveKey(accountID, before, now)
+	location, err := e.archiver.Store(ctx, key, writer.file, size, checksum)
+	if err != nil {
+		return "", "", err
+	}
+
+	log.WithContext(ctx).Infof("archived %d activity events of account %s to %s", writer.events, accountID, location)
+
+	return location, hex.EncodeToString(checksum), nil
+}
+
+// ArchiveKey returns the key of the archive with the events of the account that are older than before
+func ArchiveKey(accountID string, before, now time.Time) string {
+	return fmt.Sprintf("%s/events-before-%s-%d.jsonl.gz", accountID, before.UTC().Format("20060102T150405Z"), now.Unix())
+}
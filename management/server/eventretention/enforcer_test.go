@@ -0,0 +1,148 @@
+package eventretention
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/mock_server"
+	"github.com/netbirdio/netbird/management/server/store"
+)
+
+const testAccountID = "bf1c8084-ba50-4ce7-9439-34653001fc3b"
+
+type storedEvent struct {
+	activity activity.ActivityDescriber
+	meta     map[string]any
+}
+
+func newTestEnforcer(t *testing.T, retentionDays int, archiveEnabled bool, archiver Archiver) (*Enforcer, activity.Store, *[]storedEvent) {
+	t.Helper()
+	ctx := context.Background()
+
+	testStore, cleanup, err := store.NewTestStoreFromSQL(ctx, "../testdata/store.sql", t.TempDir())
+	require.NoError(t, err)
+	t.Cleanup(cleanup)
+
+	settings, err := testStore.GetAccountSettings(ctx, store.LockingStrengthNone, testAccountID)
+	require.NoError(t, err)
+	settings.EventRetentionDays = retentionDays
+	settings.EventArchiveEnabled = archiveEnabled
+	require.NoError(t, testStore.SaveAccountSettings(ctx, testAccountID, settings))
+
+	eventStore := &activity.InMemoryEventStore{}
+	stored := &[]storedEvent{}
+	accountManager := &mock_server.MockAccountManager{
+		StoreEventFunc: func(_ context.Context, initiatorID, targetID, accountID string, activityID activity.ActivityDescriber, meta map[string]any) {
+			assert.Equal(t, activity.SystemInitiator, initiatorID)
+			*stored = append(*stored, storedEvent{activity: activityID, meta: meta})
+		},
+	}
+
+	return NewEnforcer(testStore, eventStore, accountManager, archiver, time.Hour), eventStore, stored
+}
+
+func saveEvents(t *testing.T, eventStore activity.Store, now time.Time, ages ...time.Duration) {
+	t.Helper()
+	for i, age := range ages {
+		_, err := eventStore.Save(context.Background(), &activity.Event{
+			Timestamp: now.Add(-age),
+			Activity:  activity.PeerAddedByUser,
+			TargetID:  "peer-" + string(rune('a'+i)),
+			AccountID: testAccountID,
+		})
+		require.NoError(t, err)
+	}
+}
+
+func TestEnforcer_Purge(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	enforcer, eventStore, stored := newTestEnforcer(t, 30, false, nil)
+
+	day := 24 * time.Hour
+	saveEvents(t, eventStore, now, 40*day, 31*day, 29*day, time.Hour)
+
+	enforcer.enforce(context.Background(), now)
+
+	events, err := eventStore.Search(context.Background(), testAccountID, activity.EventFilter{})
+	require.NoError(t, err)
+	assert.Len(t, events, 2)
+
+	require.Len(t, *stored, 1)
+	assert.Equal(t, activity.AccountEventsPurged, (*stored)[0].activity)
+	assert.Equal(t, int64(2), (*stored)[0].meta["count"])
+	assert.Equal(t, 30, (*stored)[0].meta["retention_days"])
+	assert.NotContains(t, (*stored)[0].meta, "archive")
+
+	enforcer.enforce(context.Background(), now)
+	assert.Len(t, *stored, 1, "no purge should be recorded when nothing was purged")
+}
+
+func TestEnforcer_ArchiveBeforePurge(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	archiveDir := t.TempDir()
+	enforcer, eventStore, stored := newTestEnforcer(t, 30, true, NewLocalArchiver(archiveDir))
+
+	day := 24 * time.Hour
+	saveEvents(t, eventStore, now, 60*day, 45*day, time.Hour)
+
+	enforcer.enforce(context.Background(), now)
+
+	events, err := eventStore.Search(context.Background(), testAccountID, activity.EventFilter{})
+	require.NoError(t, err)
+	assert.Len(t, events, 1)
+
+	require.Len(t, *stored, 1)
+	meta := (*stored)[0].meta
+	path := filepath.Join(archiveDir, filepath.FromSlash(ArchiveKey(testAccountID, now.AddDate(0, 0, -30), now)))
+	assert.Equal(t, path, meta["archive"])
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	sum := sha256.Sum256(data)
+	assert.Equal(t, hex.EncodeToString(sum[:]), meta["sha256"])
+
+	checksum, err := os.ReadFile(path + ChecksumSuffix)
+	require.NoError(t, err)
+	assert.Equal(t, hex.EncodeToString(sum[:])+"  "+filepath.Base(path)+"\n", string(checksum))
+
+	reader, err := gzip.NewReader(bytes.NewReader(data))
+	require.NoError(t, err)
+	var archived []archivedEvent
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		var event archivedEvent
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &event))
+		archived = append(archived, event)
+	}
+	require.Len(t, archived, 2)
+	assert.Equal(t, "peer-a", archived[0].TargetID)
+	assert.Equal(t, "peer.user.add", archived[0].ActivityCode)
+	assert.Equal(t, "peer-b", archived[1].TargetID)
+}
+
+func TestEnforcer_KeepsEventsWithoutArchive(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	enforcer, eventStore, stored := newTestEnforcer(t, 30, true, nil)
+
+	saveEvents(t, eventStore, now, 60*24*time.Hour)
+
+	enforcer.enforce(context.Background(), now)
+
+	events, err := eventStore.Search(context.Background(), testAccountID, activity.EventFilter{})
+	require.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.Empty(t, *stored)
+}
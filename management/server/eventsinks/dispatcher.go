@@ -0,0 +1,273 @@
+package eventsinks
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/eventsinks/types"
+	"github.com/netbirdio/netbird/management/server/store"
+)
+
+const (
+	// sinksCacheTTL is how long the sinks of an account are cached before they are loaded from the store again,
+	// it bounds the delay of changes made by other management instances sharing the store
+	sinksCacheTTL = time.Minute
+	// queueSize is the number of events buffered per sink, events are dropped when the queue is full
+	queueSize = 1000
+)
+
+// Dispatcher streams the activity events to the enabled sinks of their account. Each sink has its own queue and
+// worker, so a slow or unreachable destination doesn't delay the other sinks or the event store.
+type Dispatcher struct {
+	ctx     context.Context
+	cancel  context.CancelFunc
+	store   store.Store
+	dataDir string
+	metrics *metrics
+
+	mu       sync.Mutex
+	accounts map[string]*accountSinks
+	workers  map[string]*worker
+	statuses map[string]*types.DeliveryStatus
+	wg       sync.WaitGroup
+}
+
+type accountSinks struct {
+	sinks    []*types.Sink
+	loadedAt time.Time
+}
+
+type worker struct {
+	sink   *types.Sink
+	queue  chan *activity.Event
+	cancel context.CancelFunc
+}
+
+// NewDispatcher creates a dispatcher that writes the jsonl sinks to the data directory. The meter is optional.
+func NewDispatcher(ctx context.Context, store store.Store, dataDir string, meter metric.Meter) (*Dispatcher, error) {
+	var sinkMetrics *metrics
+	if meter != nil {
+		var err error
+		sinkMetrics, err = newMetrics(ctx, meter)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	return &Dispatcher{
+		ctx:      ctx,
+		cancel:   cancel,
+		store:    store,
+		dataDir:  dataDir,
+		metrics:  sinkMetrics,
+		accounts: make(map[string]*accountSinks),
+		workers:  make(map[string]*worker),
+		statuses: make(map[string]*types.DeliveryStatus),
+	}, nil
+}
+
+// Dispatch queues the event to the matching sinks of its account without waiting for the delivery
+func (d *Dispatcher) Dispatch(event *activity.Event) {
+	if d.ctx.Err() != nil {
+		return
+	}
+
+	d.mu.Lock()
+	cached, ok := d.accounts[event.AccountID]
+	d.mu.Unlock()
+
+	if !ok || time.Since(cached.loadedAt) > sinksCacheTTL {
+		if err := d.Reload(d.ctx, event.AccountID); err != nil {
+			log.WithContext(d.ctx).Errorf("failed to load event sinks of account %s: %v", event.AccountID, err)
+			return
+		}
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, sink := range d.accounts[event.AccountID].sinks {
+		if !sink.Match(event) {
+			continue
+		}
+		w, ok := d.workers[sink.ID]
+		if !ok {
+			continue
+		}
+
+		select {
+		case w.queue <- event:
+		default:
+			d.statusOf(sink.ID).Dropped++
+			if d.metrics != nil {
+				d.metrics.countDropped(sink.Type)
+			}
+			log.WithContext(d.ctx).Warnf("dropped activity event %d, the queue of event sink %s is full", event.ID, sink.ID)
+		}
+	}
+}
+
+// Reload loads the sinks of the account from the store and restarts the workers of the changed sinks
+func (d *Dispatcher) Reload(ctx context.Context, accountID string) error {
+	sinks, err := d.store.GetAccountEventSinks(ctx, store.LockingStrengthNone, accountID)
+	if err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.ctx.Err() != nil {
+		return nil
+	}
+
+	current := make(map[string]struct{}, len(sinks))
+	for _, sink := range sinks {
+		current[sink.ID] = struct{}{}
+
+		w, ok := d.workers[sink.ID]
+		if ok && reflect.DeepEqual(w.sink, sink) {
+			continue
+		}
+		if ok {
+			w.cancel()
+			delete(d.workers, sink.ID)
+		}
+		if sink.Enabled {
+			d.startWorker(sink)
+		}
+	}
+
+	if cached, ok := d.accounts[accountID]; ok {
+		for _, sink := range cached.sinks {
+			if _, ok := current[sink.ID]; ok {
+				continue
+			}
+			if w, ok := d.workers[sink.ID]; ok {
+				w.cancel()
+				delete(d.workers, sink.ID)
+			}
+			delete(d.statuses, sink.ID)
+		}
+	}
+
+	d.accounts[accountID] = &accountSinks{sinks: sinks, loadedAt: time.Now()}
+	return nil
+}
+
+// Status returns the delivery status of the sink since the management service started
+func (d *Dispatcher) Status(sinkID string) types.DeliveryStatus {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	deliveryStatus, ok := d.statuses[sinkID]
+	if !ok {
+		return types.DeliveryStatus{}
+	}
+	return *deliveryStatus
+}
+
+// Close stops the workers, the events that are still queued are not delivered
+func (d *Dispatcher) Close() {
+	d.mu.Lock()
+	d.cancel()
+	d.workers = make(map[string]*worker)
+	d.mu.Unlock()
+
+	d.wg.Wait()
+}
+
+// startWorker must be called with the lock held
+func (d *Dispatcher) startWorker(sink *types.Sink) {
+	ctx, cancel := context.WithCancel(d.ctx)
+	w := &worker{
+		sink:   sink.Copy(),
+		queue:  make(chan *activity.Event, queueSize),
+		cancel: cancel,
+	}
+	d.workers[sink.ID] = w
+
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+		d.run(ctx, w)
+	}()
+}
+
+func (d *Dispatcher) run(ctx context.Context, w *worker) {
+	sender, err := newSender(w.sink, d.dataDir)
+	if err != nil {
+		log.WithContext(ctx).Errorf("failed to create the sender of event sink %s: %v", w.sink.ID, err)
+	} else {
+		defer func() {
+			if err := sender.Close(); err != nil {
+				log.WithContext(ctx).Debugf("failed to close the sender of event sink %s: %v", w.sink.ID, err)
+			}
+		}()
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-w.queue:
+			if sender == nil {
+				d.recordDelivery(w.sink, 0, err)
+				continue
+			}
+
+			start := time.Now()
+			sendErr := sender.Send(ctx, event)
+			if ctx.Err() != nil {
+				return
+			}
+			if sendErr != nil {
+				log.WithContext(ctx).Errorf("failed to deliver activity event %d to event sink %s: %v", event.ID, w.sink.ID, sendErr)
+			}
+			d.recordDelivery(w.sink, time.Since(start), sendErr)
+		}
+	}
+}
+
+func (d *Dispatcher) recordDelivery(sink *types.Sink, duration time.Duration, err error) {
+	if d.metrics != nil {
+		d.metrics.countDelivery(sink.Type, duration, err)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	// the sink was deleted while the event was delivered
+	if _, ok := d.workers[sink.ID]; !ok {
+		return
+	}
+
+	deliveryStatus := d.statusOf(sink.ID)
+	if err != nil {
+		deliveryStatus.Failed++
+		deliveryStatus.LastError = err.Error()
+		return
+	}
+
+	now := time.Now().UTC()
+	deliveryStatus.Delivered++
+	deliveryStatus.LastDeliveryAt = &now
+	deliveryStatus.LastError = ""
+}
+
+// statusOf must be called with the lock held
+func (d *Dispatcher) statusOf(sinkID string) *types.DeliveryStatus {
+	deliveryStatus, ok := d.statuses[sinkID]
+	if !ok {
+		deliveryStatus = &types.DeliveryStatus{}
+		d.statuses[sinkID] = deliveryStatus
+	}
+	return deliveryStatus
+}
@@ -0,0 +1,124 @@
+package eventsinks
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/eventsinks/types"
+	"github.com/netbirdio/netbird/management/server/store"
+)
+
+func TestEventStore_DispatchesToMatchingSinks(t *testing.T) {
+	ctx := context.Background()
+	dataDir := t.TempDir()
+
+	testStore, cleanup, err := store.NewTestStoreFromSQL(ctx, "", t.TempDir())
+	require.NoError(t, err)
+	t.Cleanup(cleanup)
+
+	all := types.NewSink("account-1", "all", true, types.TypeJSONL, nil)
+	all.JSONL = &types.JSONLConfig{}
+	users := types.NewSink("account-1", "users", true, types.TypeJSONL, []string{"user.join"})
+	users.JSONL = &types.JSONLConfig{}
+	disabled := types.NewSink("account-1", "disabled", false, types.TypeJSONL, nil)
+	disabled.JSONL = &types.JSONLConfig{}
+	for _, sink := range []*types.Sink{all, users, disabled} {
+		require.NoError(t, testStore.SaveEventSink(ctx, sink))
+	}
+
+	dispatcher, err := NewDispatcher(ctx, testStore, dataDir, nil)
+	require.NoError(t, err)
+	eventStore := NewEventStore(&activity.InMemoryEventStore{}, dispatcher)
+
+	for _, event := range []*activity.Event{
+		{Timestamp: time.Now().UTC(), Activity: activity.UserJoined, AccountID: "account-1", Meta: map[string]any{"email": "alice@example.com"}},
+		{Timestamp: time.Now().UTC(), Activity: activity.PeerAddedByUser, AccountID: "account-1"},
+		{Timestamp: time.Now().UTC(), Activity: activity.UserJoined, AccountID: "account-2"},
+	} {
+		_, err = eventStore.Save(ctx, event)
+		require.NoError(t, err)
+	}
+
+	require.Eventually(t, func() bool {
+		return dispatcher.Status(all.ID).Delivered == 2 && dispatcher.Status(users.ID).Delivered == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, eventStore.Close(ctx))
+
+	allEvents := readEvents(t, filepath.Join(dataDir, all.JSONLPath()))
+	require.Len(t, allEvents, 2)
+	assert.Equal(t, "user.join", allEvents[0].ActivityCode)
+	assert.Equal(t, "alice@example.com", allEvents[0].Meta["email"])
+	assert.Equal(t, "peer.user.add", allEvents[1].ActivityCode)
+
+	userEvents := readEvents(t, filepath.Join(dataDir, users.JSONLPath()))
+	require.Len(t, userEvents, 1)
+	assert.Equal(t, "user.join", userEvents[0].ActivityCode)
+
+	assert.NoFileExists(t, filepath.Join(dataDir, disabled.JSONLPath()))
+	assert.NoDirExists(t, filepath.Join(dataDir, types.SinksDir, "account-2"))
+}
+
+func TestDispatcher_Reload(t *testing.T) {
+	ctx := context.Background()
+	dataDir := t.TempDir()
+
+	testStore, cleanup, err := store.NewTestStoreFromSQL(ctx, "", t.TempDir())
+	require.NoError(t, err)
+	t.Cleanup(cleanup)
+
+	sink := types.NewSink("account-1", "all", true, types.TypeJSONL, nil)
+	sink.JSONL = &types.JSONLConfig{}
+	require.NoError(t, testStore.SaveEventSink(ctx, sink))
+
+	dispatcher, err := NewDispatcher(ctx, testStore, dataDir, nil)
+	require.NoError(t, err)
+	defer dispatcher.Close()
+
+	dispatcher.Dispatch(&activity.Event{ID: 1, Timestamp: time.Now().UTC(), Activity: activity.UserJoined, AccountID: "account-1"})
+	require.Eventually(t, func() bool {
+		return dispatcher.Status(sink.ID).Delivered == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	sink.ActivityCodes = []string{"user.join"}
+	require.NoError(t, testStore.SaveEventSink(ctx, sink))
+	require.NoError(t, dispatcher.Reload(ctx, "account-1"))
+
+	dispatcher.Dispatch(&activity.Event{ID: 2, Timestamp: time.Now().UTC(), Activity: activity.UserJoined, AccountID: "account-1"})
+	require.Eventually(t, func() bool {
+		return dispatcher.Status(sink.ID).Delivered == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	assert.Len(t, readEvents(t, filepath.Join(dataDir, sink.JSONLPath())), 2, "the reloaded sink should append to the same file")
+
+	require.NoError(t, testStore.DeleteEventSink(ctx, "account-1", sink.ID))
+	require.NoError(t, dispatcher.Reload(ctx, "account-1"))
+	assert.Equal(t, types.DeliveryStatus{}, dispatcher.Status(sink.ID))
+}
+
+func readEvents(t *testing.T, path string) []eventPayload {
+	t.Helper()
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	var events []eventPayload
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var event eventPayload
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &event))
+		events = append(events, event)
+	}
+	require.NoError(t, scanner.Err())
+	return events
+}
@@ -0,0 +1,42 @@
+package eventsinks
+
+import (
+	"context"
+
+	"github.com/netbirdio/netbird/management/server/activity"
+)
+
+// EventStore is an activity.Store that streams the saved events to the event sinks
+type EventStore struct {
+	activity.Store
+	dispatcher *Dispatcher
+}
+
+// NewEventStore wraps the store to dispatch the events to the sinks after they are saved
+func NewEventStore(store activity.Store, dispatcher *Dispatcher) *EventStore {
+	return &EventStore{
+		Store:      store,
+		dispatcher: dispatcher,
+	}
+}
+
+// Save saves the event and dispatches it to the sinks with the ID assigned by the store. The sinks receive the
+// event as it was created because the store may move sensitive meta out of the saved event.
+func (s *EventStore) Save(ctx context.Context, event *activity.Event) (*activity.Event, error) {
+	saved, err := s.Store.Save(ctx, event)
+	if err != nil {
+		return nil, err
+	}
+
+	dispatched := event.Copy()
+	dispatched.ID = saved.ID
+	s.dispatcher.Dispatch(dispatched)
+
+	return saved, nil
+}
+
+// Close stops the delivery to the sinks and closes the wrapped store
+func (s *EventStore) Close(ctx context.Context) error {
+	s.dispatcher.Close()
+	return s.Store.Close(ctx)
+}
@@ -0,0 +1,46 @@
+package eventsinks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/netbirdio/netbird/management/server/activity"
+)
+
+// jsonlSender appends the events as JSON lines to a file
+type jsonlSender struct {
+	file *os.File
+}
+
+func newJSONLSender(path string) (*jsonlSender, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, fmt.Errorf("create event sink directory: %w", err)
+	}
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("open event sink file: %w", err)
+	}
+
+	return &jsonlSender{file: file}, nil
+}
+
+// Send writes the event in a single write so the lines of concurrent writers don't interleave
+func (j *jsonlSender) Send(_ context.Context, event *activity.Event) error {
+	line, err := json.Marshal(newEventPayload(event))
+	if err != nil {
+		return fmt.Errorf("marshal event: %w", err)
+	}
+
+	if _, err := j.file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("write event: %w", err)
+	}
+	return nil
+}
+
+func (j *jsonlSender) Close() error {
+	return j.file.Close()
+}
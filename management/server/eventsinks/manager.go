@@ -0,0 +1,168 @@
+package eventsinks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rs/xid"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/management/server/account"
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/eventsinks/types"
+	"github.com/netbirdio/netbird/management/server/permissions"
+	"github.com/netbirdio/netbird/management/server/permissions/modules"
+	"github.com/netbirdio/netbird/management/server/permissions/operations"
+	"github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/shared/management/status"
+)
+
+type Manager interface {
+	GetAllSinks(ctx context.Context, accountID, userID string) ([]*types.Sink, error)
+	GetSink(ctx context.Context, accountID, userID, sinkID string) (*types.Sink, error)
+	CreateSink(ctx context.Context, userID string, sink *types.Sink) (*types.Sink, error)
+	UpdateSink(ctx context.Context, userID string, sink *types.Sink) (*types.Sink, error)
+	DeleteSink(ctx context.Context, accountID, userID, sinkID string) error
+	// GetDeliveryStatus returns the delivery status of the sink since the management service started
+	GetDeliveryStatus(sinkID string) types.DeliveryStatus
+}
+
+type managerImpl struct {
+	store              store.Store
+	accountManager     account.Manager
+	permissionsManager permissions.Manager
+	dispatcher         *Dispatcher
+}
+
+func NewManager(store store.Store, accountManager account.Manager, permissionsManager permissions.Manager, dispatcher *Dispatcher) Manager {
+	return &managerImpl{
+		store:              store,
+		accountManager:     accountManager,
+		permissionsManager: permissionsManager,
+		dispatcher:         dispatcher,
+	}
+}
+
+func (m *managerImpl) GetAllSinks(ctx context.Context, accountID, userID string) ([]*types.Sink, error) {
+	if err := m.validatePermissions(ctx, accountID, userID, operations.Read); err != nil {
+		return nil, err
+	}
+
+	return m.store.GetAccountEventSinks(ctx, store.LockingStrengthNone, accountID)
+}
+
+func (m *managerImpl) GetSink(ctx context.Context, accountID, userID, sinkID string) (*types.Sink, error) {
+	if err := m.validatePermissions(ctx, accountID, userID, operations.Read); err != nil {
+		return nil, err
+	}
+
+	return m.store.GetEventSinkByID(ctx, store.LockingStrengthNone, accountID, sinkID)
+}
+
+func (m *managerImpl) CreateSink(ctx context.Context, userID string, sink *types.Sink) (*types.Sink, error) {
+	if err := m.validatePermissions(ctx, sink.AccountID, userID, operations.Create); err != nil {
+		return nil, err
+	}
+
+	sink.ID = xid.New().String()
+
+	if err := sink.Validate(); err != nil {
+		return nil, err
+	}
+	if err := validateDestination(sink); err != nil {
+		return nil, err
+	}
+
+	if err := m.store.SaveEventSink(ctx, sink); err != nil {
+		return nil, fmt.Errorf("failed to save event sink: %w", err)
+	}
+
+	m.reload(ctx, sink.AccountID)
+	m.accountManager.StoreEvent(ctx, userID, sink.ID, sink.AccountID, activity.EventSinkCreated, sink.EventMeta())
+
+	return sink, nil
+}
+
+// UpdateSink replaces the sink, the webhook secret and the redacted header values are kept when the update doesn't
+// set them
+func (m *managerImpl) UpdateSink(ctx context.Context, userID string, sink *types.Sink) (*types.Sink, error) {
+	if err := m.validatePermissions(ctx, sink.AccountID, userID, operations.Update); err != nil {
+		return nil, err
+	}
+
+	err := m.store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		current, err := transaction.GetEventSinkByID(ctx, store.LockingStrengthUpdate, sink.AccountID, sink.ID)
+		if err != nil {
+			return err
+		}
+
+		sink.Webhook.InheritSecrets(current.Webhook)
+
+		if err = sink.Validate(); err != nil {
+			return err
+		}
+		if err = validateDestination(sink); err != nil {
+			return err
+		}
+
+		if err = transaction.SaveEventSink(ctx, sink); err != nil {
+			return fmt.Errorf("failed to save event sink: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	m.reload(ctx, sink.AccountID)
+	m.accountManager.StoreEvent(ctx, userID, sink.ID, sink.AccountID, activity.EventSinkUpdated, sink.EventMeta())
+
+	return sink, nil
+}
+
+func (m *managerImpl) DeleteSink(ctx context.Context, accountID, userID, sinkID string) error {
+	if err := m.validatePermissions(ctx, accountID, userID, operations.Delete); err != nil {
+		return err
+	}
+
+	var sink *types.Sink
+	err := m.store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		var err error
+		sink, err = transaction.GetEventSinkByID(ctx, store.LockingStrengthUpdate, accountID, sinkID)
+		if err != nil {
+			return err
+		}
+
+		return transaction.DeleteEventSink(ctx, accountID, sinkID)
+	})
+	if err != nil {
+		return err
+	}
+
+	m.reload(ctx, accountID)
+	m.accountManager.StoreEvent(ctx, userID, sinkID, accountID, activity.EventSinkDeleted, sink.EventMeta())
+
+	return nil
+}
+
+func (m *managerImpl) GetDeliveryStatus(sinkID string) types.DeliveryStatus {
+	return m.dispatcher.Status(sinkID)
+}
+
+// reload applies the change to the dispatcher right away instead of waiting for the sinks cache to expire
+func (m *managerImpl) reload(ctx context.Context, accountID string) {
+	if err := m.dispatcher.Reload(ctx, accountID); err != nil {
+		log.WithContext(ctx).Errorf("failed to reload event sinks of account %s: %v", accountID, err)
+	}
+}
+
+func (m *managerImpl) validatePermissions(ctx context.Context, accountID, userID string, operation operations.Operation) error {
+	allowed, err := m.permissionsManager.ValidateUserPermissions(ctx, accountID, userID, modules.Events, operation)
+	if err != nil {
+		return status.NewPermissionValidationError(err)
+	}
+	if !allowed {
+		return status.NewPermissionDeniedError()
+	}
+	return nil
+}
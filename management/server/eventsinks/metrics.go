@@ -0,0 +1,79 @@
+package eventsinks
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/netbirdio/netbird/management/server/eventsinks/types"
+)
+
+// metrics are the delivery metrics of the event sinks
+type metrics struct {
+	ctx              context.Context
+	deliveredCounter metric.Int64Counter
+	failedCounter    metric.Int64Counter
+	droppedCounter   metric.Int64Counter
+	deliveryDuration metric.Float64Histogram
+}
+
+func newMetrics(ctx context.Context, meter metric.Meter) (*metrics, error) {
+	deliveredCounter, err := meter.Int64Counter("management.event.sink.delivered.counter",
+		metric.WithUnit("1"),
+		metric.WithDescription("Number of activity events delivered to the event sinks"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	failedCounter, err := meter.Int64Counter("management.event.sink.failed.counter",
+		metric.WithUnit("1"),
+		metric.WithDescription("Number of activity events that couldn't be delivered to the event sinks after the retries"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	droppedCounter, err := meter.Int64Counter("management.event.sink.dropped.counter",
+		metric.WithUnit("1"),
+		metric.WithDescription("Number of activity events dropped because the delivery queue of the event sink was full"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveryDuration, err := meter.Float64Histogram("management.event.sink.delivery.duration.ms",
+		metric.WithUnit("milliseconds"),
+		metric.WithExplicitBucketBoundaries(
+			1, 5, 10, 25, 50, 100, 250, 500, 1000, 5000, 30000, 300000,
+		),
+		metric.WithDescription("Duration of delivering an activity event to an event sink including the retries"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &metrics{
+		ctx:              ctx,
+		deliveredCounter: deliveredCounter,
+		failedCounter:    failedCounter,
+		droppedCounter:   droppedCounter,
+		deliveryDuration: deliveryDuration,
+	}, nil
+}
+
+func (m *metrics) countDelivery(sinkType types.Type, duration time.Duration, err error) {
+	attrs := metric.WithAttributes(attribute.String("type", string(sinkType)))
+	m.deliveryDuration.Record(m.ctx, float64(duration.Nanoseconds())/1e6, attrs)
+	if err != nil {
+		m.failedCounter.Add(m.ctx, 1, attrs)
+		return
+	}
+	m.deliveredCounter.Add(m.ctx, 1, attrs)
+}
+
+func (m *metrics) countDropped(sinkType types.Type) {
+	m.droppedCounter.Add(m.ctx, 1, metric.WithAttributes(attribute.String("type", string(sinkType))))
+}
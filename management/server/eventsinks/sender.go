@@ -0,0 +1,129 @@
+package eventsinks
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/eventsinks/types"
+	"github.com/netbirdio/netbird/management/server/util"
+	"github.com/netbirdio/netbird/shared/management/status"
+)
+
+const (
+	// maxRetryElapsedTime is how long the delivery of an event is retried before it is counted as failed
+	maxRetryElapsedTime = 5 * time.Minute
+
+	// allowPrivateDestinationsEnv allows the webhook and syslog sinks in private networks when set to true
+	allowPrivateDestinationsEnv = "NB_EVENT_SINKS_ALLOW_PRIVATE_NETWORKS"
+)
+
+var allowPrivateDestinations atomic.Bool
+
+func init() {
+	allow, _ := strconv.ParseBool(os.Getenv(allowPrivateDestinationsEnv))
+	allowPrivateDestinations.Store(allow)
+}
+
+// AllowPrivateDestinations allows the webhook and syslog sinks in loopback, private and link-local networks, they are
+// rejected by default so account admins can't reach the management host and its network through a sink
+func AllowPrivateDestinations(allow bool) {
+	allowPrivateDestinations.Store(allow)
+}
+
+// newDialer returns the dialer of the webhook and syslog sinks, it rejects the private destinations after the name
+// resolution
+func newDialer(timeout time.Duration) *net.Dialer {
+	return &net.Dialer{
+		Timeout: timeout,
+		Control: util.PrivateAddrDialControl(allowPrivateDestinations.Load),
+	}
+}
+
+// validateDestination rejects the webhook URLs and syslog addresses pointing to a private network
+func validateDestination(sink *types.Sink) error {
+	if allowPrivateDestinations.Load() {
+		return nil
+	}
+
+	var host string
+	switch {
+	case sink.Webhook != nil:
+		u, err := url.Parse(sink.Webhook.URL)
+		if err != nil {
+			return status.Errorf(status.InvalidArgument, "invalid webhook URL")
+		}
+		host = u.Hostname()
+	case sink.Syslog != nil:
+		var err error
+		host, _, err = net.SplitHostPort(sink.Syslog.Address)
+		if err != nil {
+			return status.Errorf(status.InvalidArgument, "invalid syslog address")
+		}
+	default:
+		return nil
+	}
+
+	if util.IsPrivateHost(host) {
+		return status.Errorf(status.InvalidArgument, "event sink destination shouldn't point to a private network")
+	}
+	return nil
+}
+
+// sender delivers the events to the destination of a sink
+type sender interface {
+	// Send delivers the event, retrying until it succeeds, the retries are exhausted or the context is done
+	Send(ctx context.Context, event *activity.Event) error
+	Close() error
+}
+
+// eventPayload is the JSON representation of an event sent to the webhook and jsonl sinks
+type eventPayload struct {
+	ID           uint64         `json:"id"`
+	Timestamp    time.Time      `json:"timestamp"`
+	Activity     string         `json:"activity"`
+	ActivityCode string         `json:"activity_code"`
+	InitiatorID  string         `json:"initiator_id"`
+	TargetID     string         `json:"target_id"`
+	AccountID    string         `json:"account_id"`
+	Meta         map[string]any `json:"meta,omitempty"`
+}
+
+func newEventPayload(event *activity.Event) *eventPayload {
+	return &eventPayload{
+		ID:           event.ID,
+		Timestamp:    event.Timestamp,
+		Activity:     event.Activity.Message(),
+		ActivityCode: event.Activity.StringCode(),
+		InitiatorID:  event.InitiatorID,
+		TargetID:     event.TargetID,
+		AccountID:    event.AccountID,
+		Meta:         event.Meta,
+	}
+}
+
+func newSender(sink *types.Sink, dataDir string) (sender, error) {
+	switch sink.Type {
+	case types.TypeWebhook:
+		return newWebhookSender(*sink.Webhook, newRetryBackOff), nil
+	case types.TypeSyslog:
+		return newSyslogSender(*sink.Syslog, newRetryBackOff), nil
+	case types.TypeJSONL:
+		return newJSONLSender(filepath.Join(dataDir, sink.JSONLPath()))
+	default:
+		return nil, fmt.Errorf("unsupported event sink type %q", sink.Type)
+	}
+}
+
+func newRetryBackOff() backoff.BackOff {
+	return backoff.NewExponentialBackOff(backoff.WithMaxElapsedTime(maxRetryElapsedTime))
+}
@@ -0,0 +1,162 @@
+package eventsinks
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/eventsinks/types"
+	"github.com/netbirdio/netbird/version"
+)
+
+const (
+	// syslogPriority is the log audit facility (13) with the notice severity (5)
+	syslogPriority = 13*8 + 5
+	syslogAppName  = "netbird-management"
+	syslogMsgID    = "audit"
+	// cefSeverity is the CEF severity of the activity events, low on the 0-10 scale
+	cefSeverity = 3
+
+	syslogDialTimeout  = 10 * time.Second
+	syslogWriteTimeout = 10 * time.Second
+)
+
+var (
+	cefHeaderEscaper    = strings.NewReplacer(`\`, `\\`, `|`, `\|`, "\r", " ", "\n", " ")
+	cefExtensionEscaper = strings.NewReplacer(`\`, `\\`, `=`, `\=`, "\r", `\r`, "\n", `\n`)
+)
+
+// syslogSender sends the events as RFC5424 messages with a CEF payload over TCP or TLS. The messages are framed
+// with octet counting as described in RFC6587 and RFC5425.
+type syslogSender struct {
+	config     types.SyslogConfig
+	hostname   string
+	newBackOff func() backoff.BackOff
+	conn       net.Conn
+}
+
+func newSyslogSender(config types.SyslogConfig, newBackOff func() backoff.BackOff) *syslogSender {
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = "-"
+	}
+
+	return &syslogSender{
+		config:     config,
+		hostname:   hostname,
+		newBackOff: newBackOff,
+	}
+}
+
+// Send writes the event to the connection, a broken connection is dialed again on the retry
+func (s *syslogSender) Send(ctx context.Context, event *activity.Event) error {
+	message := formatSyslogMessage(event, s.hostname)
+	frame := []byte(strconv.Itoa(len(message)) + " " + message)
+
+	return backoff.Retry(func() error {
+		return s.write(ctx, frame)
+	}, backoff.WithContext(s.newBackOff(), ctx))
+}
+
+func (s *syslogSender) write(ctx context.Context, frame []byte) error {
+	if s.conn == nil {
+		conn, err := s.dial(ctx)
+		if err != nil {
+			return fmt.Errorf("dial syslog server: %w", err)
+		}
+		s.conn = conn
+	}
+
+	if err := s.conn.SetWriteDeadline(time.Now().Add(syslogWriteTimeout)); err != nil {
+		s.resetConn()
+		return err
+	}
+	if _, err := s.conn.Write(frame); err != nil {
+		s.resetConn()
+		return fmt.Errorf("write to syslog server: %w", err)
+	}
+	return nil
+}
+
+func (s *syslogSender) dial(ctx context.Context) (net.Conn, error) {
+	dialer := newDialer(syslogDialTimeout)
+	if !s.config.TLS {
+		return dialer.DialContext(ctx, "tcp", s.config.Address)
+	}
+
+	host, _, err := net.SplitHostPort(s.config.Address)
+	if err != nil {
+		return nil, err
+	}
+	tlsDialer := &tls.Dialer{
+		NetDialer: dialer,
+		Config:    &tls.Config{ServerName: host, MinVersion: tls.VersionTLS12},
+	}
+	return tlsDialer.DialContext(ctx, "tcp", s.config.Address)
+}
+
+func (s *syslogSender) resetConn() {
+	if s.conn != nil {
+		_ = s.conn.Close()
+		s.conn = nil
+	}
+}
+
+func (s *syslogSender) Close() error {
+	if s.conn == nil {
+		return nil
+	}
+	err := s.conn.Close()
+	s.conn = nil
+	return err
+}
+
+// formatSyslogMessage returns the RFC5424 message of the event without structured data
+func formatSyslogMessage(event *activity.Event, hostname string) string {
+	return fmt.Sprintf("<%d>1 %s %s %s - %s - %s",
+		syslogPriority,
+		event.Timestamp.UTC().Format("2006-01-02T15:04:05.000000Z07:00"),
+		hostname,
+		syslogAppName,
+		syslogMsgID,
+		formatCEF(event),
+	)
+}
+
+// formatCEF returns the event in the ArcSight Common Event Format
+func formatCEF(event *activity.Event) string {
+	meta := ""
+	if len(event.Meta) > 0 {
+		if data, err := json.Marshal(event.Meta); err == nil {
+			meta = string(data)
+		}
+	}
+
+	extension := []string{
+		"rt=" + strconv.FormatInt(event.Timestamp.UnixMilli(), 10),
+		"externalId=" + strconv.FormatUint(event.ID, 10),
+		"suid=" + cefExtensionEscaper.Replace(event.InitiatorID),
+		"cs1Label=accountId",
+		"cs1=" + cefExtensionEscaper.Replace(event.AccountID),
+		"cs2Label=targetId",
+		"cs2=" + cefExtensionEscaper.Replace(event.TargetID),
+		"msg=" + cefExtensionEscaper.Replace(meta),
+	}
+
+	return fmt.Sprintf("CEF:0|NetBird|Management|%s|%s|%s|%d|%s",
+		cefHeaderEscaper.Replace(version.NetbirdVersion()),
+		cefHeaderEscaper.Replace(event.Activity.StringCode()),
+		cefHeaderEscaper.Replace(event.Activity.Message()),
+		cefSeverity,
+		strings.Join(extension, " "),
+	)
+}
@@ -0,0 +1,84 @@
+package eventsinks
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/eventsinks/types"
+)
+
+func TestFormatCEF(t *testing.T) {
+	event := testEvent()
+	event.TargetID = "a=b|c"
+	event.Meta = map[string]any{"name": "line\nbreak"}
+
+	cef := formatCEF(event)
+
+	assert.True(t, strings.HasPrefix(cef, "CEF:0|NetBird|Management|"), cef)
+	assert.Contains(t, cef, "|user.join|User joined|3|")
+	assert.Contains(t, cef, "rt=1714559400123 externalId=7 suid=user-1 cs1Label=accountId cs1=account-1")
+	assert.Contains(t, cef, `cs2Label=targetId cs2=a\=b|c`)
+	assert.Contains(t, cef, `msg={"name":"line\\nbreak"}`)
+}
+
+func TestSyslogSender_Send(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	messages := make(chan string, 2)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		reader := bufio.NewReader(conn)
+		for {
+			length, err := reader.ReadString(' ')
+			if err != nil {
+				return
+			}
+			size, err := strconv.Atoi(strings.TrimSpace(length))
+			if err != nil {
+				return
+			}
+			message := make([]byte, size)
+			if _, err := io.ReadFull(reader, message); err != nil {
+				return
+			}
+			messages <- string(message)
+		}
+	}()
+
+	sender := newSyslogSender(types.SyslogConfig{Address: listener.Addr().String()}, testBackOff)
+	sender.hostname = "mgmt"
+	defer sender.Close()
+
+	second := testEvent()
+	second.ID = 8
+	second.Activity = activity.UserBlocked
+	require.NoError(t, sender.Send(context.Background(), testEvent()))
+	require.NoError(t, sender.Send(context.Background(), second))
+
+	for _, want := range []string{"externalId=7", "externalId=8"} {
+		select {
+		case message := <-messages:
+			assert.True(t, strings.HasPrefix(message, "<109>1 2024-05-01T10:30:00.123456Z mgmt netbird-management - audit - CEF:0|"), message)
+			assert.Contains(t, message, want)
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for the syslog message")
+		}
+	}
+}
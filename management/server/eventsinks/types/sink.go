@@ -0,0 +1,282 @@
+package types
+
+import (
+	"maps"
+	"net"
+	"net/url"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/rs/xid"
+
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/shared/management/http/api"
+	"github.com/netbirdio/netbird/shared/management/status"
+)
+
+// Type is the destination type of a sink
+type Type string
+
+const (
+	// TypeWebhook posts the events to an HTTP endpoint signed with a shared secret
+	TypeWebhook Type = "webhook"
+	// TypeSyslog sends the events in CEF format as RFC5424 syslog messages over TCP or TLS
+	TypeSyslog Type = "syslog"
+	// TypeJSONL appends the events as JSON lines to a file in the management data directory
+	TypeJSONL Type = "jsonl"
+
+	// SinksDir is the directory in the management data directory where the jsonl sinks write to
+	SinksDir = "event-sinks"
+)
+
+const (
+	// MinWebhookSecretLength is the minimum length of the secret that signs the webhook requests
+	MinWebhookSecretLength = 16
+	// RedactedHeaderValue replaces the webhook header values in the API responses, updates setting it keep the
+	// stored value of the header
+	RedactedHeaderValue = "********"
+)
+
+// Sink is an account managed destination where the activity events are streamed to
+type Sink struct {
+	ID        string `gorm:"primaryKey"`
+	AccountID string `gorm:"index"`
+	Name      string
+	Enabled   bool
+	Type      Type
+	// ActivityCodes are the codes of the events that are sent to the sink, all events are sent when empty
+	ActivityCodes []string       `gorm:"serializer:json"`
+	Webhook       *WebhookConfig `gorm:"serializer:event_sink_webhook"`
+	Syslog        *SyslogConfig  `gorm:"serializer:json"`
+	JSONL         *JSONLConfig   `gorm:"serializer:json"`
+}
+
+// WebhookConfig is the configuration of a TypeWebhook sink
+type WebhookConfig struct {
+	URL string
+	// Secret signs the requests with HMAC-SHA256, it is never returned by the API
+	Secret string
+	// Headers are added to the requests, e.g. for authentication, their values are never returned by the API
+	Headers map[string]string
+}
+
+// InheritSecrets keeps the stored secret when the update doesn't set one and the stored values of the headers set to
+// RedactedHeaderValue, the API doesn't return them so updates can't include them
+func (c *WebhookConfig) InheritSecrets(current *WebhookConfig) {
+	if c == nil || current == nil {
+		return
+	}
+	if c.Secret == "" {
+		c.Secret = current.Secret
+	}
+	for key, value := range c.Headers {
+		if stored, ok := current.Headers[key]; ok && value == RedactedHeaderValue {
+			c.Headers[key] = stored
+		}
+	}
+}
+
+// SyslogConfig is the configuration of a TypeSyslog sink
+type SyslogConfig struct {
+	// Address is the host:port of the syslog server
+	Address string
+	TLS     bool
+}
+
+// JSONLConfig is the configuration of a TypeJSONL sink. The file is named after the account and the sink by the
+// server, so account admins can't choose where the shared management process writes to.
+type JSONLConfig struct{}
+
+// DeliveryStatus is the delivery status of a sink since the management service started
+type DeliveryStatus struct {
+	Delivered      uint64
+	Failed         uint64
+	Dropped        uint64
+	LastDeliveryAt *time.Time
+	LastError      string
+}
+
+func NewSink(accountID, name string, enabled bool, sinkType Type, activityCodes []string) *Sink {
+	return &Sink{
+		ID:            xid.New().String(),
+		AccountID:     accountID,
+		Name:          name,
+		Enabled:       enabled,
+		Type:          sinkType,
+		ActivityCodes: activityCodes,
+	}
+}
+
+// TableName returns the name of the table for the Sink model in the database.
+func (*Sink) TableName() string {
+	return "event_sinks"
+}
+
+func (s *Sink) FromAPIRequest(req *api.EventSinkRequest) {
+	s.Name = req.Name
+	s.Enabled = req.Enabled
+	s.Type = Type(req.Type)
+	s.ActivityCodes = []string{}
+	if req.ActivityCodes != nil {
+		s.ActivityCodes = *req.ActivityCodes
+	}
+
+	s.Webhook, s.Syslog, s.JSONL = nil, nil, nil
+	if req.Webhook != nil {
+		s.Webhook = &WebhookConfig{URL: req.Webhook.Url}
+		if req.Webhook.Secret != nil {
+			s.Webhook.Secret = *req.Webhook.Secret
+		}
+		if req.Webhook.Headers != nil {
+			s.Webhook.Headers = *req.Webhook.Headers
+		}
+	}
+	if req.Syslog != nil {
+		s.Syslog = &SyslogConfig{Address: req.Syslog.Address}
+		if req.Syslog.Tls != nil {
+			s.Syslog.TLS = *req.Syslog.Tls
+		}
+	}
+	if req.Jsonl != nil {
+		s.JSONL = &JSONLConfig{}
+	}
+}
+
+func (s *Sink) ToAPIResponse(deliveryStatus DeliveryStatus) *api.EventSink {
+	resp := &api.EventSink{
+		Id:            s.ID,
+		Name:          s.Name,
+		Enabled:       s.Enabled,
+		Type:          api.EventSinkType(s.Type),
+		ActivityCodes: s.ActivityCodes,
+		Status: api.EventSinkStatus{
+			Delivered:      int64(deliveryStatus.Delivered),
+			Failed:         int64(deliveryStatus.Failed),
+			Dropped:        int64(deliveryStatus.Dropped),
+			LastDeliveryAt: deliveryStatus.LastDeliveryAt,
+		},
+	}
+	if resp.ActivityCodes == nil {
+		resp.ActivityCodes = []string{}
+	}
+	if deliveryStatus.LastError != "" {
+		resp.Status.LastError = &deliveryStatus.LastError
+	}
+
+	if s.Webhook != nil {
+		headers := make(map[string]string, len(s.Webhook.Headers))
+		for key := range s.Webhook.Headers {
+			headers[key] = RedactedHeaderValue
+		}
+		resp.Webhook = &api.EventSinkWebhook{Url: s.Webhook.URL, Headers: &headers}
+	}
+	if s.Syslog != nil {
+		resp.Syslog = &api.EventSinkSyslog{Address: s.Syslog.Address, Tls: &s.Syslog.TLS}
+	}
+	if s.JSONL != nil {
+		path := s.JSONLPath()
+		resp.Jsonl = &api.EventSinkJSONL{Path: &path}
+	}
+	return resp
+}
+
+// Copy returns a copy of the sink.
+func (s *Sink) Copy() *Sink {
+	sink := &Sink{
+		ID:            s.ID,
+		AccountID:     s.AccountID,
+		Name:          s.Name,
+		Enabled:       s.Enabled,
+		Type:          s.Type,
+		ActivityCodes: slices.Clone(s.ActivityCodes),
+	}
+	if s.Webhook != nil {
+		sink.Webhook = &WebhookConfig{URL: s.Webhook.URL, Secret: s.Webhook.Secret, Headers: maps.Clone(s.Webhook.Headers)}
+	}
+	if s.Syslog != nil {
+		syslog := *s.Syslog
+		sink.Syslog = &syslog
+	}
+	if s.JSONL != nil {
+		jsonl := *s.JSONL
+		sink.JSONL = &jsonl
+	}
+	return sink
+}
+
+// JSONLPath returns the path of the file a TypeJSONL sink writes to relative to the management data directory
+func (s *Sink) JSONLPath() string {
+	return filepath.Join(SinksDir, s.AccountID, s.ID+".jsonl")
+}
+
+func (s *Sink) EventMeta() map[string]any {
+	return map[string]any{"name": s.Name, "type": string(s.Type)}
+}
+
+// Match checks whether the event is sent to the sink
+func (s *Sink) Match(event *activity.Event) bool {
+	return s.Enabled && (len(s.ActivityCodes) == 0 || slices.Contains(s.ActivityCodes, event.Activity.StringCode()))
+}
+
+// Validate checks that the sink has a name, known activity codes and a valid configuration of its type only
+func (s *Sink) Validate() error {
+	if s.Name == "" {
+		return status.Errorf(status.InvalidArgument, "event sink name shouldn't be empty")
+	}
+
+	for _, code := range s.ActivityCodes {
+		if _, ok := activity.ActivityFromStringCode(code); !ok {
+			return status.Errorf(status.InvalidArgument, "unknown activity code %q", code)
+		}
+	}
+
+	configs := 0
+	for _, set := range []bool{s.Webhook != nil, s.Syslog != nil, s.JSONL != nil} {
+		if set {
+			configs++
+		}
+	}
+	if configs > 1 {
+		return status.Errorf(status.InvalidArgument, "event sink should only have the configuration of its type")
+	}
+
+	switch s.Type {
+	case TypeWebhook:
+		if s.Webhook == nil {
+			return status.Errorf(status.InvalidArgument, "webhook event sink requires a webhook configuration")
+		}
+		return s.Webhook.validate()
+	case TypeSyslog:
+		if s.Syslog == nil {
+			return status.Errorf(status.InvalidArgument, "syslog event sink requires a syslog configuration")
+		}
+		return s.Syslog.validate()
+	case TypeJSONL:
+		if s.JSONL == nil {
+			return status.Errorf(status.InvalidArgument, "jsonl event sink requires a jsonl configuration")
+		}
+		return nil
+	default:
+		return status.Errorf(status.InvalidArgument, "invalid event sink type %q", s.Type)
+	}
+}
+
+func (c *WebhookConfig) validate() error {
+	u, err := url.Parse(c.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return status.Errorf(status.InvalidArgument, "webhook URL should be an absolute http or https URL")
+	}
+	if len(c.Secret) < MinWebhookSecretLength {
+		return status.Errorf(status.InvalidArgument, "webhook secret should have at least %d characters", MinWebhookSecretLength)
+	}
+	return nil
+}
+
+func (c *SyslogConfig) validate() error {
+	host, port, err := net.SplitHostPort(c.Address)
+	if err != nil || host == "" || port == "" {
+		return status.Errorf(status.InvalidArgument, "syslog address should be in the host:port format")
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package types
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/shared/management/http/api"
+)
+
+func TestSink_Validate(t *testing.T) {
+	webhook := &WebhookConfig{URL: "https://siem.example.com/hook", Secret: "0123456789abcdef"}
+
+	testCases := []struct {
+		name     string
+		sinkType Type
+		codes    []string
+		webhook  *WebhookConfig
+		syslog   *SyslogConfig
+		jsonl    *JSONLConfig
+		wantErr  bool
+	}{
+		{name: "webhook", sinkType: TypeWebhook, webhook: webhook},
+		{name: "webhook with codes", sinkType: TypeWebhook, codes: []string{"user.join", "peer.ssh.enable"}, webhook: webhook},
+		{name: "syslog", sinkType: TypeSyslog, syslog: &SyslogConfig{Address: "syslog.example.com:6514", TLS: true}},
+		{name: "jsonl", sinkType: TypeJSONL, jsonl: &JSONLConfig{}},
+		{name: "unknown code", sinkType: TypeWebhook, codes: []string{"user.fly"}, webhook: webhook, wantErr: true},
+		{name: "missing configuration", sinkType: TypeWebhook, wantErr: true},
+		{name: "configuration of another type", sinkType: TypeWebhook, webhook: webhook, jsonl: &JSONLConfig{}, wantErr: true},
+		{name: "invalid type", sinkType: "kafka", wantErr: true},
+		{name: "webhook relative URL", sinkType: TypeWebhook, webhook: &WebhookConfig{URL: "/hook", Secret: webhook.Secret}, wantErr: true},
+		{name: "webhook invalid scheme", sinkType: TypeWebhook, webhook: &WebhookConfig{URL: "ftp://example.com", Secret: webhook.Secret}, wantErr: true},
+		{name: "webhook short secret", sinkType: TypeWebhook, webhook: &WebhookConfig{URL: webhook.URL, Secret: "secret"}, wantErr: true},
+		{name: "syslog without port", sinkType: TypeSyslog, syslog: &SyslogConfig{Address: "syslog.example.com"}, wantErr: true},
+		{name: "jsonl missing configuration", sinkType: TypeJSONL, webhook: webhook, wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sink := NewSink("account", "siem", true, tc.sinkType, tc.codes)
+			sink.Webhook, sink.Syslog, sink.JSONL = tc.webhook, tc.syslog, tc.jsonl
+			if tc.wantErr {
+				assert.Error(t, sink.Validate())
+				return
+			}
+			assert.NoError(t, sink.Validate())
+		})
+	}
+}
+
+func TestSink_Match(t *testing.T) {
+	event := &activity.Event{Activity: activity.UserJoined}
+
+	assert.True(t, NewSink("account", "all", true, TypeJSONL, nil).Match(event))
+	assert.True(t, NewSink("account", "users", true, TypeJSONL, []string{"user.join"}).Match(event))
+	assert.False(t, NewSink("account", "peers", true, TypeJSONL, []string{"peer.user.add"}).Match(event))
+	assert.False(t, NewSink("account", "disabled", false, TypeJSONL, nil).Match(event))
+}
+
+func TestSink_ToAPIResponseOmitsSecret(t *testing.T) {
+	sink := NewSink("account", "siem", true, TypeWebhook, nil)
+	sink.Webhook = &WebhookConfig{URL: "https://siem.example.com/hook", Secret: "0123456789abcdef", Headers: map[string]string{"Authorization": "Bearer token"}}
+
+	resp := sink.ToAPIResponse(DeliveryStatus{Delivered: 2, LastError: "timeout"})
+
+	assert.Nil(t, resp.Webhook.Secret)
+	assert.Equal(t, "https://siem.example.com/hook", resp.Webhook.Url)
+	assert.Equal(t, []string{}, resp.ActivityCodes)
+	assert.Equal(t, int64(2), resp.Status.Delivered)
+	assert.Equal(t, "timeout", *resp.Status.LastError)
+}
+
+func TestSink_ToAPIResponseRedactsHeaders(t *testing.T) {
+	sink := NewSink("account", "siem", true, TypeWebhook, nil)
+	sink.Webhook = &WebhookConfig{URL: "https://siem.example.com/hook", Secret: "0123456789abcdef", Headers: map[string]string{"Authorization": "Bearer token"}}
+
+	resp := sink.ToAPIResponse(DeliveryStatus{})
+	assert.Equal(t, map[string]string{"Authorization": RedactedHeaderValue}, *resp.Webhook.Headers)
+
+	update := &WebhookConfig{URL: sink.Webhook.URL, Headers: map[string]string{"Authorization": RedactedHeaderValue, "X-Tenant": "netbird"}}
+	update.InheritSecrets(sink.Webhook)
+	assert.Equal(t, "0123456789abcdef", update.Secret)
+	assert.Equal(t, map[string]string{"Authorization": "Bearer token", "X-Tenant": "netbird"}, update.Headers)
+}
+
+func TestSink_JSONLPath(t *testing.T) {
+	sink := NewSink("account", "audit", true, TypeJSONL, nil)
+	sink.FromAPIRequest(&api.EventSinkRequest{Name: "audit", Enabled: true, Type: api.EventSinkTypeJsonl, Jsonl: &api.EventSinkJSONL{}})
+
+	assert.Equal(t, filepath.Join(SinksDir, "account", sink.ID+".jsonl"), sink.JSONLPath())
+	assert.Equal(t, sink.JSONLPath(), *sink.ToAPIResponse(DeliveryStatus{}).Jsonl.Path)
+}
@@ -0,0 +1,141 @@
+package types
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"strings"
+	"sync/atomic"
+
+	"gorm.io/gorm/schema"
+)
+
+const (
+	// webhookSerializerName is the name of the gorm serializer of the webhook configurations
+	webhookSerializerName = "event_sink_webhook"
+	// encryptedSecretPrefix marks the encrypted values, the values stored before the encryption was added don't have it
+	encryptedSecretPrefix = "enc:"
+)
+
+// SecretCipher encrypts the webhook secrets and header values stored with the sinks
+type SecretCipher interface {
+	Encrypt(payload string) (string, error)
+	Decrypt(data string) (string, error)
+}
+
+var secretCipher atomic.Value
+
+func init() {
+	schema.RegisterSerializer(webhookSerializerName, webhookSerializer{})
+}
+
+// SetSecretCipher sets the cipher the webhook secrets and header values are encrypted with in the store
+func SetSecretCipher(cipher SecretCipher) {
+	secretCipher.Store(&cipher)
+}
+
+func getSecretCipher() SecretCipher {
+	cipher, ok := secretCipher.Load().(*SecretCipher)
+	if !ok {
+		return nil
+	}
+	return *cipher
+}
+
+// webhookSerializer stores the webhook configuration as JSON with the secret and the header values encrypted
+type webhookSerializer struct{}
+
+func (webhookSerializer) Scan(ctx context.Context, field *schema.Field, dst reflect.Value, dbValue any) error {
+	var data []byte
+	switch v := dbValue.(type) {
+	case nil:
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("unsupported webhook configuration value type %T", dbValue)
+	}
+
+	var config *WebhookConfig
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &config); err != nil {
+			return err
+		}
+	}
+	if config == nil {
+		field.ReflectValueOf(ctx, dst).Set(reflect.Zero(field.FieldType))
+		return nil
+	}
+
+	secret, err := decryptSecret(config.Secret)
+	if err != nil {
+		return fmt.Errorf("decrypt webhook secret: %w", err)
+	}
+	config.Secret = secret
+
+	for key, value := range config.Headers {
+		if config.Headers[key], err = decryptSecret(value); err != nil {
+			return fmt.Errorf("decrypt webhook header %s: %w", key, err)
+		}
+	}
+
+	field.ReflectValueOf(ctx, dst).Set(reflect.ValueOf(config))
+	return nil
+}
+
+func (webhookSerializer) Value(_ context.Context, _ *schema.Field, _ reflect.Value, fieldValue any) (any, error) {
+	config, ok := fieldValue.(*WebhookConfig)
+	if !ok {
+		return nil, fmt.Errorf("unsupported webhook configuration type %T", fieldValue)
+	}
+	if config == nil {
+		return nil, nil
+	}
+
+	encrypted := *config
+	if cipher := getSecretCipher(); cipher != nil {
+		var err error
+		if encrypted.Secret, err = encryptSecret(cipher, config.Secret); err != nil {
+			return nil, fmt.Errorf("encrypt webhook secret: %w", err)
+		}
+
+		encrypted.Headers = maps.Clone(config.Headers)
+		for key, value := range encrypted.Headers {
+			if encrypted.Headers[key], err = encryptSecret(cipher, value); err != nil {
+				return nil, fmt.Errorf("encrypt webhook header %s: %w", key, err)
+			}
+		}
+	}
+
+	data, err := json.Marshal(encrypted)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+func encryptSecret(cipher SecretCipher, value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	encrypted, err := cipher.Encrypt(value)
+	if err != nil {
+		return "", err
+	}
+	return encryptedSecretPrefix + encrypted, nil
+}
+
+func decryptSecret(value string) (string, error) {
+	if !strings.HasPrefix(value, encryptedSecretPrefix) {
+		return value, nil
+	}
+	cipher := getSecretCipher()
+	if cipher == nil {
+		return "", errors.New("value is encrypted but no cipher is set")
+	}
+	return cipher.Decrypt(strings.TrimPrefix(value, encryptedSecretPrefix))
+}
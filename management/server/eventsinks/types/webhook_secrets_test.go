@@ -0,0 +1,58 @@
+package types
+
+import (
+	"context"
+	"encoding/base64"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm/schema"
+)
+
+type base64Cipher struct{}
+
+func (base64Cipher) Encrypt(payload string) (string, error) {
+	return base64.StdEncoding.EncodeToString([]byte(payload)), nil
+}
+
+func (base64Cipher) Decrypt(data string) (string, error) {
+	payload, err := base64.StdEncoding.DecodeString(data)
+	return string(payload), err
+}
+
+func TestWebhookSerializer(t *testing.T) {
+	SetSecretCipher(base64Cipher{})
+	t.Cleanup(func() { SetSecretCipher(nil) })
+
+	field := &schema.Field{
+		FieldType: reflect.TypeOf(&WebhookConfig{}),
+		ReflectValueOf: func(_ context.Context, value reflect.Value) reflect.Value {
+			return value.Elem().FieldByName("Webhook")
+		},
+	}
+
+	config := &WebhookConfig{URL: "https://siem.example.com/hook", Secret: "0123456789abcdef", Headers: map[string]string{"Authorization": "Bearer token"}}
+	value, err := webhookSerializer{}.Value(context.Background(), field, reflect.Value{}, config)
+	require.NoError(t, err)
+
+	stored := value.(string)
+	assert.NotContains(t, stored, "0123456789abcdef", "the secret should be encrypted")
+	assert.NotContains(t, stored, "Bearer token", "the header values should be encrypted")
+	assert.Equal(t, "Bearer token", config.Headers["Authorization"], "the stored configuration shouldn't be modified")
+
+	var loaded Sink
+	require.NoError(t, webhookSerializer{}.Scan(context.Background(), field, reflect.ValueOf(&loaded), stored))
+	assert.Equal(t, config, loaded.Webhook)
+
+	// the values stored before the encryption are read as they are
+	var legacy Sink
+	require.NoError(t, webhookSerializer{}.Scan(context.Background(), field, reflect.ValueOf(&legacy), `{"URL":"https://siem.example.com/hook","Secret":"plain","Headers":{"X-Key":"key"}}`))
+	assert.Equal(t, "plain", legacy.Webhook.Secret)
+	assert.Equal(t, "key", legacy.Webhook.Headers["X-Key"])
+
+	var empty Sink
+	require.NoError(t, webhookSerializer{}.Scan(context.Background(), field, reflect.ValueOf(&empty), "null"))
+	assert.Nil(t, empty.Webhook)
+}
@@ -0,0 +1,108 @@
+package eventsinks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/eventsinks/types"
+	"github.com/netbirdio/netbird/version"
+)
+
+const (
+	// SignatureHeader is the header with the HMAC-SHA256 signature of the timestamp and the body of a webhook request
+	SignatureHeader = "X-NetBird-Signature"
+	// TimestampHeader is the header with the unix time when a webhook request was signed
+	TimestampHeader = "X-NetBird-Timestamp"
+
+	webhookTimeout = 10 * time.Second
+)
+
+type webhookSender struct {
+	config     types.WebhookConfig
+	client     *http.Client
+	newBackOff func() backoff.BackOff
+}
+
+func newWebhookSender(config types.WebhookConfig, newBackOff func() backoff.BackOff) *webhookSender {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// a proxy would hide the destination from the dialer
+	transport.Proxy = nil
+	transport.DialContext = newDialer(webhookTimeout).DialContext
+
+	return &webhookSender{
+		config:     config,
+		client:     &http.Client{Timeout: webhookTimeout, Transport: transport},
+		newBackOff: newBackOff,
+	}
+}
+
+// SignWebhook returns the signature of a webhook request, receivers compare it with the SignatureHeader value
+func SignWebhook(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Send posts the event and retries on connection errors, rate limiting and server errors
+func (w *webhookSender) Send(ctx context.Context, event *activity.Event) error {
+	body, err := json.Marshal(newEventPayload(event))
+	if err != nil {
+		return fmt.Errorf("marshal event: %w", err)
+	}
+
+	return backoff.Retry(func() error {
+		return w.post(ctx, body)
+	}, backoff.WithContext(w.newBackOff(), ctx))
+}
+
+func (w *webhookSender) post(ctx context.Context, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.config.URL, bytes.NewReader(body))
+	if err != nil {
+		return backoff.Permanent(fmt.Errorf("create request: %w", err))
+	}
+
+	for key, value := range w.config.Headers {
+		req.Header.Set(key, value)
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "netbird-management/"+version.NetbirdVersion())
+	req.Header.Set(TimestampHeader, timestamp)
+	req.Header.Set(SignatureHeader, SignWebhook(w.config.Secret, timestamp, body))
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return nil
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	default:
+		return backoff.Permanent(fmt.Errorf("unexpected status code %d", resp.StatusCode))
+	}
+}
+
+func (w *webhookSender) Close() error {
+	w.client.CloseIdleConnections()
+	return nil
+}
@@ -0,0 +1,137 @@
+package eventsinks
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/eventsinks/types"
+)
+
+const testSecret = "0123456789abcdef"
+
+func TestMain(m *testing.M) {
+	// the test servers listen on the loopback interface
+	AllowPrivateDestinations(true)
+	os.Exit(m.Run())
+}
+
+func testBackOff() backoff.BackOff {
+	return backoff.WithMaxRetries(&backoff.ZeroBackOff{}, 2)
+}
+
+func testEvent() *activity.Event {
+	return &activity.Event{
+		ID:          7,
+		Timestamp:   time.Date(2024, 5, 1, 10, 30, 0, 123456000, time.UTC),
+		Activity:    activity.UserJoined,
+		InitiatorID: "user-1",
+		TargetID:    "user-2",
+		AccountID:   "account-1",
+		Meta:        map[string]any{"email": "alice@example.com"},
+	}
+}
+
+func TestWebhookSender_Send(t *testing.T) {
+	var received eventPayload
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		assert.Equal(t, SignWebhook(testSecret, r.Header.Get(TimestampHeader), body), r.Header.Get(SignatureHeader))
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.Equal(t, "custom", r.Header.Get("X-Custom"))
+		require.NoError(t, json.Unmarshal(body, &received))
+	}))
+	defer server.Close()
+
+	sender := newWebhookSender(types.WebhookConfig{URL: server.URL, Secret: testSecret, Headers: map[string]string{"X-Custom": "custom"}}, testBackOff)
+	defer sender.Close()
+
+	require.NoError(t, sender.Send(context.Background(), testEvent()))
+	assert.Equal(t, uint64(7), received.ID)
+	assert.Equal(t, "user.join", received.ActivityCode)
+	assert.Equal(t, "account-1", received.AccountID)
+	assert.Equal(t, "alice@example.com", received.Meta["email"])
+}
+
+func TestWebhookSender_Retries(t *testing.T) {
+	testCases := []struct {
+		name         string
+		statusCodes  []int
+		wantAttempts int32
+		wantErr      bool
+	}{
+		{name: "server error is retried", statusCodes: []int{http.StatusBadGateway, http.StatusOK}, wantAttempts: 2},
+		{name: "rate limit is retried", statusCodes: []int{http.StatusTooManyRequests, http.StatusNoContent}, wantAttempts: 2},
+		{name: "client error is not retried", statusCodes: []int{http.StatusUnauthorized}, wantAttempts: 1, wantErr: true},
+		{name: "retries are exhausted", statusCodes: []int{http.StatusInternalServerError, http.StatusInternalServerError, http.StatusInternalServerError}, wantAttempts: 3, wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var attempts atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempt := attempts.Add(1)
+				w.WriteHeader(tc.statusCodes[attempt-1])
+			}))
+			defer server.Close()
+
+			sender := newWebhookSender(types.WebhookConfig{URL: server.URL, Secret: testSecret}, testBackOff)
+			defer sender.Close()
+
+			err := sender.Send(context.Background(), testEvent())
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.wantAttempts, attempts.Load())
+		})
+	}
+}
+
+func TestWebhookSender_RejectsPrivateDestinations(t *testing.T) {
+	AllowPrivateDestinations(false)
+	t.Cleanup(func() { AllowPrivateDestinations(true) })
+
+	for _, endpoint := range []string{
+		"http://127.0.0.1/events",
+		"http://localhost/events",
+		"http://10.0.0.1/events",
+		"http://169.254.169.254/latest/meta-data",
+		"http://[fd00::1]/events",
+	} {
+		sink := &types.Sink{Type: types.TypeWebhook, Webhook: &types.WebhookConfig{URL: endpoint, Secret: testSecret}}
+		assert.Error(t, validateDestination(sink), endpoint)
+	}
+	sink := &types.Sink{Type: types.TypeSyslog, Syslog: &types.SyslogConfig{Address: "169.254.169.254:514"}}
+	assert.Error(t, validateDestination(sink))
+
+	// names resolving to private addresses are rejected when dialing
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+	}))
+	defer server.Close()
+
+	sender := newWebhookSender(types.WebhookConfig{URL: strings.Replace(server.URL, "127.0.0.1", "localhost", 1), Secret: testSecret}, testBackOff)
+	defer sender.Close()
+
+	err := sender.Send(context.Background(), testEvent())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "private network")
+	assert.Zero(t, attempts.Load())
+}
@@ -0,0 +1,93 @@
+package flows
+
+import (
+	"context"
+	"time"
+
+	"github.com/netbirdio/netbird/management/server/flows/types"
+	"github.com/netbirdio/netbird/management/server/store"
+)
+
+const (
+	// flushInterval is the longest time a received flow waits in the batch before it is stored
+	flushInterval = time.Second
+	// maxBatchSize is the number of flows stored with a single insert
+	maxBatchSize = 200
+	// queueSize bounds the flows waiting for the batch, the event streams wait while it is full
+	queueSize = 10 * maxBatchSize
+)
+
+// pendingFlow is a flow waiting in the batch, stored is called with the result of the insert of the batch
+type pendingFlow struct {
+	flow   *types.Flow
+	stored func(err error)
+}
+
+// batcher stores the flows received on all event streams in batches, so the ingestion takes the store for a single
+// insert every flushInterval or maxBatchSize flows instead of an insert for every event
+type batcher struct {
+	ctx   context.Context
+	store store.Store
+	queue chan *pendingFlow
+}
+
+func newBatcher(ctx context.Context, store store.Store) *batcher {
+	b := &batcher{
+		ctx:   ctx,
+		store: store,
+		queue: make(chan *pendingFlow, queueSize),
+	}
+	go b.run()
+	return b
+}
+
+// add queues the flow for the next batch, it waits while the queue is full
+func (b *batcher) add(ctx context.Context, flow *types.Flow, stored func(err error)) error {
+	select {
+	case b.queue <- &pendingFlow{flow: flow, stored: stored}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-b.ctx.Done():
+		return b.ctx.Err()
+	}
+}
+
+func (b *batcher) run() {
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+
+	var batch []*pendingFlow
+	for {
+		select {
+		case <-b.ctx.Done():
+			b.flush(context.WithoutCancel(b.ctx), batch)
+			return
+		case pending := <-b.queue:
+			batch = append(batch, pending)
+			if len(batch) < maxBatchSize {
+				continue
+			}
+		case <-ticker.C:
+		}
+
+		b.flush(b.ctx, batch)
+		batch = nil
+	}
+}
+
+func (b *batcher) flush(ctx context.Context, batch []*pendingFlow) {
+	if len(batch) == 0 {
+		return
+	}
+
+	flows := make([]*types.Flow, 0, len(batch))
+	for _, pending := range batch {
+		flows = append(flows, pending.flow)
+	}
+
+	err := b.store.SaveFlows(ctx, flows)
+	for _, pending := range batch {
+		pending.stored(err)
+	}
+}
@@ -0,0 +1,66 @@
+package flows
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+
+	"github.com/netbirdio/netbird/management/server/flows/types"
+	"github.com/netbirdio/netbird/management/server/store"
+)
+
+// countingStore records the size of the batches stored with SaveFlows
+type countingStore struct {
+	store.Store
+
+	mu      sync.Mutex
+	batches []int
+}
+
+func (s *countingStore) SaveFlows(ctx context.Context, flows []*types.Flow) error {
+	s.mu.Lock()
+	s.batches = append(s.batches, len(flows))
+	s.mu.Unlock()
+	return s.Store.SaveFlows(ctx, flows)
+}
+
+func TestBatcher_StoresFlowsInBatches(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	testStore, cleanup, err := store.NewTestStoreFromSQL(ctx, "../testdata/store.sql", t.TempDir())
+	require.NoError(t, err)
+	t.Cleanup(cleanup)
+
+	counting := &countingStore{Store: testStore}
+	b := newBatcher(ctx, counting)
+
+	peerKey, err := wgtypes.GeneratePrivateKey()
+	require.NoError(t, err)
+
+	const count = 5
+	var stored sync.WaitGroup
+	stored.Add(count)
+	for i := 0; i < count; i++ {
+		flow, err := types.FromProto(testAccountID, "flow-peer", newTestEvent(peerKey.PublicKey()), time.Now())
+		require.NoError(t, err)
+		require.NoError(t, b.add(ctx, flow, func(err error) {
+			assert.NoError(t, err)
+			stored.Done()
+		}))
+	}
+	stored.Wait()
+
+	counting.mu.Lock()
+	assert.Equal(t, []int{count}, counting.batches, "the queued flows should be stored with a single insert")
+	counting.mu.Unlock()
+
+	flows, err := testStore.GetAccountFlows(ctx, store.LockingStrengthNone, testAccountID, types.Filter{Limit: 10})
+	require.NoError(t, err)
+	assert.Len(t, flows, count)
+}
@@ -0,0 +1,60 @@
+package flows
+
+import (
+	"context"
+
+	"github.com/netbirdio/netbird/management/server/flows/types"
+	"github.com/netbirdio/netbird/management/server/permissions"
+	"github.com/netbirdio/netbird/management/server/permissions/modules"
+	"github.com/netbirdio/netbird/management/server/permissions/operations"
+	"github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/shared/management/status"
+)
+
+type Manager interface {
+	// GetFlows returns the flows of the account that match the filter and the cursor of the next page, the cursor is
+	// nil on the last page
+	GetFlows(ctx context.Context, accountID, userID string, filter types.Filter) ([]*types.Flow, *types.Cursor, error)
+}
+
+type managerImpl struct {
+	store              store.Store
+	permissionsManager permissions.Manager
+}
+
+func NewManager(store store.Store, permissionsManager permissions.Manager) Manager {
+	return &managerImpl{
+		store:              store,
+		permissionsManager: permissionsManager,
+	}
+}
+
+func (m *managerImpl) GetFlows(ctx context.Context, accountID, userID string, filter types.Filter) ([]*types.Flow, *types.Cursor, error) {
+	allowed, err := m.permissionsManager.ValidateUserPermissions(ctx, accountID, userID, modules.Events, operations.Read)
+	if err != nil {
+		return nil, nil, status.NewPermissionValidationError(err)
+	}
+	if !allowed {
+		return nil, nil, status.NewPermissionDeniedError()
+	}
+
+	if filter.Limit <= 0 || filter.Limit > types.MaxSearchLimit {
+		filter.Limit = types.DefaultSearchLimit
+	}
+	limit := filter.Limit
+	// an extra flow is requested to know whether there is a next page
+	filter.Limit++
+
+	flows, err := m.store.GetAccountFlows(ctx, store.LockingStrengthNone, accountID, filter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var next *types.Cursor
+	if len(flows) > limit {
+		flows = flows[:limit]
+		next = types.CursorOf(flows[limit-1])
+	}
+
+	return flows, next, nil
+}
@@ -0,0 +1,254 @@
+package flows
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/netbirdio/netbird/flow/proto"
+	"github.com/netbirdio/netbird/management/server/flows/types"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/store"
+	nbstatus "github.com/netbirdio/netbird/shared/management/status"
+	authv2 "github.com/netbirdio/netbird/shared/relay/auth/hmac/v2"
+)
+
+const bearerPrefix = "Bearer "
+
+// Receiver terminates the event streams of the peers and stores the received events in batches. The peers
+// authenticate with the peer scoped flow token issued by the management service, every event is acknowledged once
+// its batch is stored so the peer can drop it. The stream is closed once the token expires, the peer reconnects with
+// its refreshed token.
+type Receiver struct {
+	proto.UnimplementedFlowServiceServer
+	store     store.Store
+	batcher   *batcher
+	validator *authv2.Validator
+}
+
+// storedEvent is the result of storing the event with the ID
+type storedEvent struct {
+	eventID []byte
+	err     error
+}
+
+// storedEvents collects the results of the events of a stream stored by the batcher, so the batcher never waits
+// for the stream
+type storedEvents struct {
+	mu      sync.Mutex
+	results []storedEvent
+	notify  chan struct{}
+}
+
+func newStoredEvents() *storedEvents {
+	return &storedEvents{notify: make(chan struct{}, 1)}
+}
+
+// callback returns the function the batcher reports the result of the event with
+func (s *storedEvents) callback(eventID []byte) func(error) {
+	return func(err error) {
+		s.mu.Lock()
+		s.results = append(s.results, storedEvent{eventID: eventID, err: err})
+		s.mu.Unlock()
+
+		select {
+		case s.notify <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (s *storedEvents) take() []storedEvent {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	results := s.results
+	s.results = nil
+	return results
+}
+
+// NewReceiver creates a receiver that accepts the tokens signed with the secret. The received events are stored
+// until the context is done.
+func NewReceiver(ctx context.Context, store store.Store, secret string) *Receiver {
+	hashedSecret := sha256.Sum256([]byte(secret))
+	return &Receiver{
+		store:     store,
+		batcher:   newBatcher(ctx, store),
+		validator: authv2.NewValidator(hashedSecret[:]),
+	}
+}
+
+// Events receives the events of a peer and acknowledges the stored ones
+func (r *Receiver) Events(stream proto.FlowService_EventsServer) error {
+	ctx := stream.Context()
+
+	claims, err := r.authenticate(ctx)
+	if err != nil {
+		log.WithContext(ctx).Debugf("rejected flow event stream: %v", err)
+		return status.Error(codes.Unauthenticated, "invalid flow token")
+	}
+
+	if err = stream.Send(&proto.FlowEventAck{IsInitiator: true}); err != nil {
+		return err
+	}
+
+	// events are received in the background, so the stream can be closed when the token expires
+	events := make(chan *proto.FlowEvent)
+	recvErr := make(chan error, 1)
+	go func() {
+		for {
+			event, err := stream.Recv()
+			if err != nil {
+				recvErr <- err
+				return
+			}
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	// the events are acknowledged when their batch is stored
+	stored := newStoredEvents()
+
+	expiry := time.NewTimer(time.Until(claims.ExpiresAt))
+	defer expiry.Stop()
+
+	var peer *nbpeer.Peer
+	for {
+		var event *proto.FlowEvent
+		select {
+		case <-expiry.C:
+			log.WithContext(ctx).Debugf("closing flow event stream of peer %s, the token expired", claims.PeerKey)
+			return status.Error(codes.Unauthenticated, "flow token expired")
+		case err = <-recvErr:
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		case <-stored.notify:
+			for _, result := range stored.take() {
+				if result.err != nil {
+					return status.Error(codes.Internal, "failed to store flow event")
+				}
+				if err = stream.Send(&proto.FlowEventAck{EventId: result.eventID}); err != nil {
+					return err
+				}
+			}
+			continue
+		case event = <-events:
+		}
+
+		if event.GetIsInitiator() {
+			continue
+		}
+
+		peerKey := base64.StdEncoding.EncodeToString(event.GetPublicKey())
+		if peerKey != claims.PeerKey {
+			log.WithContext(ctx).Warnf("peer %s sent flow events of peer %s", claims.PeerKey, peerKey)
+			return status.Error(codes.PermissionDenied, "event wasn't sent by the peer of the token")
+		}
+
+		peer, err = r.resolvePeer(ctx, claims.AccountID, peerKey, peer)
+		if err != nil {
+			return err
+		}
+
+		if peer != nil {
+			queued, err := r.queueEvent(ctx, claims.AccountID, peer.ID, event, stored.callback(event.GetEventId()))
+			if err != nil {
+				return err
+			}
+			if queued {
+				continue
+			}
+		}
+
+		if err = stream.Send(&proto.FlowEventAck{EventId: event.GetEventId()}); err != nil {
+			return err
+		}
+	}
+}
+
+// authenticate validates the token of the stream and returns the account and peer it was issued for
+func (r *Receiver) authenticate(ctx context.Context) (*authv2.PeerClaims, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, errors.New("missing metadata")
+	}
+
+	values := md.Get("authorization")
+	if len(values) == 0 || !strings.HasPrefix(values[0], bearerPrefix) {
+		return nil, errors.New("missing bearer token")
+	}
+
+	// the client sends the signature and the payload separated by a dot, the base64 signature never contains a dot
+	signature, payload, ok := strings.Cut(strings.TrimPrefix(values[0], bearerPrefix), ".")
+	if !ok {
+		return nil, errors.New("malformed token")
+	}
+
+	decodedSignature, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return nil, fmt.Errorf("decode signature: %w", err)
+	}
+
+	token := &authv2.Token{
+		AuthAlgo:  authv2.AuthAlgoHMACSHA256,
+		Signature: decodedSignature,
+		Payload:   []byte(payload),
+	}
+	return r.validator.ValidatePeer(token.Marshal())
+}
+
+// resolvePeer returns the peer with the public key, the previous peer is reused when it has the same key. A nil peer
+// is returned for the keys of the deleted peers, their events are acknowledged and dropped.
+func (r *Receiver) resolvePeer(ctx context.Context, accountID string, peerKey string, previous *nbpeer.Peer) (*nbpeer.Peer, error) {
+	if previous != nil && previous.Key == peerKey {
+		return previous, nil
+	}
+
+	peer, err := r.store.GetPeerByPeerPubKey(ctx, store.LockingStrengthNone, peerKey)
+	if err != nil {
+		if e, ok := nbstatus.FromError(err); ok && e.Type() == nbstatus.NotFound {
+			log.WithContext(ctx).Debugf("dropping flow event of unknown peer %s", peerKey)
+			return nil, nil
+		}
+		log.WithContext(ctx).Errorf("failed to get peer %s: %v", peerKey, err)
+		return nil, status.Error(codes.Internal, "failed to get peer")
+	}
+
+	if peer.AccountID != accountID {
+		log.WithContext(ctx).Warnf("peer %s sent flow events with the token of account %s", peer.ID, accountID)
+		return nil, status.Error(codes.PermissionDenied, "peer doesn't belong to the account of the token")
+	}
+
+	return peer, nil
+}
+
+// queueEvent adds the event of the peer to the next batch and returns whether it was queued. The malformed events are
+// dropped as the peer would resend them forever.
+func (r *Receiver) queueEvent(ctx context.Context, accountID, peerID string, event *proto.FlowEvent, stored func(error)) (bool, error) {
+	flow, err := types.FromProto(accountID, peerID, event, time.Now())
+	if err != nil {
+		log.WithContext(ctx).Debugf("dropping malformed flow event of peer %s: %v", peerID, err)
+		return false, nil
+	}
+
+	if err = r.batcher.add(ctx, flow, stored); err != nil {
+		return false, status.Error(codes.Unavailable, "failed to queue flow event")
+	}
+	return true, nil
+}
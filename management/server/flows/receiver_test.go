@@ -0,0 +1,306 @@
+package flows
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"net"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	flowclient "github.com/netbirdio/netbird/flow/client"
+	"github.com/netbirdio/netbird/flow/proto"
+	"github.com/netbirdio/netbird/management/server/flows/types"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/store"
+	authv2 "github.com/netbirdio/netbird/shared/relay/auth/hmac/v2"
+)
+
+const (
+	testAccountID  = "bf1c8084-ba50-4ce7-9439-34653001fc3b"
+	otherAccountID = "9439-34653001fc3b-bf1c8084-ba50-4ce7"
+	testSecret     = "flow-secret"
+)
+
+type testReceiver struct {
+	store   store.Store
+	addr    string
+	peerKey wgtypes.Key
+	peerID  string
+}
+
+func newTestReceiver(t *testing.T) *testReceiver {
+	t.Helper()
+	ctx := context.Background()
+
+	testStore, cleanup, err := store.NewTestStoreFromSQL(ctx, "../testdata/store.sql", t.TempDir())
+	require.NoError(t, err)
+	t.Cleanup(cleanup)
+
+	peerKey, err := wgtypes.GeneratePrivateKey()
+	require.NoError(t, err)
+	peer := &nbpeer.Peer{
+		ID:        "flow-peer",
+		AccountID: testAccountID,
+		Key:       peerKey.PublicKey().String(),
+		IP:        net.IP{100, 64, 0, 10},
+		Status:    &nbpeer.PeerStatus{},
+	}
+	require.NoError(t, testStore.AddPeerToAccount(ctx, peer))
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer()
+	proto.RegisterFlowServiceServer(server, NewReceiver(ctx, testStore, testSecret))
+	go func() {
+		if err := server.Serve(listener); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+			t.Logf("server error: %v", err)
+		}
+	}()
+	t.Cleanup(server.Stop)
+
+	return &testReceiver{
+		store:   testStore,
+		addr:    listener.Addr().String(),
+		peerKey: peerKey.PublicKey(),
+		peerID:  peer.ID,
+	}
+}
+
+func generateToken(t *testing.T, secret, accountID string, peerKey wgtypes.Key, ttl time.Duration) (payload, signature string) {
+	t.Helper()
+
+	hashedSecret := sha256.Sum256([]byte(secret))
+	generator, err := authv2.NewGenerator(authv2.AuthAlgoHMACSHA256, hashedSecret[:], ttl)
+	require.NoError(t, err)
+
+	token, err := generator.GeneratePeerToken(accountID, peerKey.String())
+	if accountID == "" {
+		token, err = generator.GenerateToken()
+	}
+	require.NoError(t, err)
+
+	return string(token.Payload), base64.StdEncoding.EncodeToString(token.Signature)
+}
+
+func newTestEvent(peerKey wgtypes.Key) *proto.FlowEvent {
+	eventID := uuid.New()
+	flowID := uuid.New()
+	return &proto.FlowEvent{
+		EventId:   eventID[:],
+		Timestamp: timestamppb.Now(),
+		PublicKey: peerKey[:],
+		FlowFields: &proto.FlowFields{
+			FlowId:           flowID[:],
+			Type:             proto.Type_TYPE_START,
+			RuleId:           []byte("rule1"),
+			Direction:        proto.Direction_INGRESS,
+			Protocol:         6,
+			SourceIp:         netip.MustParseAddr("100.64.0.11").AsSlice(),
+			DestIp:           netip.MustParseAddr("100.64.0.10").AsSlice(),
+			SourceResourceId: []byte("source-peer"),
+			DestResourceId:   []byte("flow-peer"),
+			ConnectionInfo: &proto.FlowFields_PortInfo{
+				PortInfo: &proto.PortInfo{SourcePort: 40000, DestPort: 443},
+			},
+		},
+	}
+}
+
+// openStream opens an event stream with the token and returns it after the initiator message of the receiver
+func openStream(t *testing.T, addr, payload, signature string) (proto.FlowService_EventsClient, error) {
+	t.Helper()
+
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+	ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+signature+"."+payload)
+
+	stream, err := proto.NewFlowServiceClient(conn).Events(ctx)
+	require.NoError(t, err)
+
+	ack, err := stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+	require.True(t, ack.IsInitiator)
+
+	return stream, nil
+}
+
+func TestReceiver_StoresAndAcknowledgesEvents(t *testing.T) {
+	receiver := newTestReceiver(t)
+	payload, signature := generateToken(t, testSecret, testAccountID, receiver.peerKey, time.Hour)
+
+	client, err := flowclient.NewClient("http://"+receiver.addr, payload, signature, time.Second)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = client.Close() })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	acks := make(chan []byte, 10)
+	go func() {
+		_ = client.Receive(ctx, time.Second, func(ack *proto.FlowEventAck) error {
+			acks <- ack.EventId
+			return nil
+		})
+	}()
+
+	event := newTestEvent(receiver.peerKey)
+	require.Eventually(t, func() bool {
+		return client.Send(event) == nil
+	}, 5*time.Second, 50*time.Millisecond)
+
+	select {
+	case eventID := <-acks:
+		assert.Equal(t, event.EventId, eventID)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for the ack")
+	}
+
+	// a resent event is acknowledged again but stored once
+	require.NoError(t, client.Send(event))
+	select {
+	case eventID := <-acks:
+		assert.Equal(t, event.EventId, eventID)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for the ack of the resent event")
+	}
+
+	flows, err := receiver.store.GetAccountFlows(context.Background(), store.LockingStrengthNone, testAccountID, types.Filter{})
+	require.NoError(t, err)
+	require.Len(t, flows, 1)
+
+	flow := flows[0]
+	assert.Equal(t, uuid.UUID(event.EventId).String(), flow.ID)
+	assert.Equal(t, receiver.peerID, flow.PeerID)
+	assert.Equal(t, proto.Type_TYPE_START, flow.Type)
+	assert.Equal(t, proto.Direction_INGRESS, flow.Direction)
+	assert.Equal(t, uint32(6), flow.Protocol)
+	assert.Equal(t, "rule1", flow.RuleID)
+	assert.Equal(t, "100.64.0.11", flow.SourceIP)
+	assert.Equal(t, uint32(40000), flow.SourcePort)
+	assert.Equal(t, "100.64.0.10", flow.DestIP)
+	assert.Equal(t, uint32(443), flow.DestPort)
+	assert.Equal(t, "source-peer", flow.SourceResourceID)
+	assert.Equal(t, "flow-peer", flow.DestResourceID)
+}
+
+func TestReceiver_RejectsInvalidTokens(t *testing.T) {
+	receiver := newTestReceiver(t)
+
+	tests := []struct {
+		name      string
+		secret    string
+		accountID string
+		ttl       time.Duration
+	}{
+		{name: "wrong secret", secret: "other-secret", accountID: testAccountID, ttl: time.Hour},
+		{name: "expired", secret: testSecret, accountID: testAccountID, ttl: -time.Hour},
+		{name: "without account and peer", secret: testSecret, ttl: time.Hour},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payload, signature := generateToken(t, tt.secret, tt.accountID, receiver.peerKey, tt.ttl)
+			_, err := openStream(t, receiver.addr, payload, signature)
+			require.Error(t, err)
+			assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		})
+	}
+
+	t.Run("malformed", func(t *testing.T) {
+		_, err := openStream(t, receiver.addr, "payload", "not base64!")
+		require.Error(t, err)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
+
+func TestReceiver_RejectsPeersOfOtherAccounts(t *testing.T) {
+	receiver := newTestReceiver(t)
+	payload, signature := generateToken(t, testSecret, otherAccountID, receiver.peerKey, time.Hour)
+
+	stream, err := openStream(t, receiver.addr, payload, signature)
+	require.NoError(t, err)
+
+	require.NoError(t, stream.Send(newTestEvent(receiver.peerKey)))
+	_, err = stream.Recv()
+	require.Error(t, err)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	flows, err := receiver.store.GetAccountFlows(context.Background(), store.LockingStrengthNone, otherAccountID, types.Filter{})
+	require.NoError(t, err)
+	assert.Empty(t, flows)
+}
+
+func TestReceiver_DropsEventsOfUnknownPeers(t *testing.T) {
+	receiver := newTestReceiver(t)
+
+	unknownKey, err := wgtypes.GeneratePrivateKey()
+	require.NoError(t, err)
+	payload, signature := generateToken(t, testSecret, testAccountID, unknownKey.PublicKey(), time.Hour)
+
+	stream, err := openStream(t, receiver.addr, payload, signature)
+	require.NoError(t, err)
+
+	event := newTestEvent(unknownKey.PublicKey())
+
+	require.NoError(t, stream.Send(event))
+	ack, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, event.EventId, ack.EventId)
+
+	flows, err := receiver.store.GetAccountFlows(context.Background(), store.LockingStrengthNone, testAccountID, types.Filter{})
+	require.NoError(t, err)
+	assert.Empty(t, flows)
+}
+
+func TestReceiver_RejectsEventsOfOtherPeers(t *testing.T) {
+	receiver := newTestReceiver(t)
+
+	otherKey, err := wgtypes.GeneratePrivateKey()
+	require.NoError(t, err)
+	payload, signature := generateToken(t, testSecret, testAccountID, otherKey.PublicKey(), time.Hour)
+
+	stream, err := openStream(t, receiver.addr, payload, signature)
+	require.NoError(t, err)
+
+	require.NoError(t, stream.Send(newTestEvent(receiver.peerKey)))
+	_, err = stream.Recv()
+	require.Error(t, err)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	flows, err := receiver.store.GetAccountFlows(context.Background(), store.LockingStrengthNone, testAccountID, types.Filter{})
+	require.NoError(t, err)
+	assert.Empty(t, flows)
+}
+
+func TestReceiver_ClosesStreamOnTokenExpiry(t *testing.T) {
+	receiver := newTestReceiver(t)
+	payload, signature := generateToken(t, testSecret, testAccountID, receiver.peerKey, 2*time.Second)
+
+	stream, err := openStream(t, receiver.addr, payload, signature)
+	require.NoError(t, err)
+
+	_, err = stream.Recv()
+	require.Error(t, err)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
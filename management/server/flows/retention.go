@@ -0,0 +1,64 @@
+package flows
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/management/server/store"
+)
+
+const (
+	// DefaultRetention is how long the flows are kept when no retention is configured
+	DefaultRetention = 7 * 24 * time.Hour
+
+	retentionInterval = time.Hour
+)
+
+// Retention deletes the flows that were received before the retention period
+type Retention struct {
+	store     store.Store
+	retention time.Duration
+}
+
+// NewRetention creates a retention that keeps the flows for the period
+func NewRetention(store store.Store, retention time.Duration) *Retention {
+	if retention <= 0 {
+		retention = DefaultRetention
+	}
+
+	return &Retention{
+		store:     store,
+		retention: retention,
+	}
+}
+
+// Run deletes the expired flows every hour until the context is done
+func (r *Retention) Run(ctx context.Context) {
+	ticker := time.NewTicker(retentionInterval)
+	defer ticker.Stop()
+
+	for {
+		r.enforce(ctx, time.Now().UTC())
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *Retention) enforce(ctx context.Context, now time.Time) {
+	before := now.Add(-r.retention)
+
+	deleted, err := r.store.DeleteFlowsReceivedBefore(ctx, before)
+	if err != nil {
+		log.WithContext(ctx).Errorf("failed to delete expired flows: %v", err)
+		return
+	}
+	if deleted > 0 {
+		log.WithContext(ctx).Infof("deleted %d flows received before %s", deleted, before.Format(time.RFC3339))
+	}
+}
@@ -0,0 +1,210 @@
+package types
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/netbirdio/netbird/flow/proto"
+	"github.com/netbirdio/netbird/shared/management/http/api"
+)
+
+const (
+	// DefaultSearchLimit is the number of flows returned by a search without a limit
+	DefaultSearchLimit = 1000
+	// MaxSearchLimit is the maximum number of flows returned by a search
+	MaxSearchLimit = 10000
+
+	protocolICMP   = 1
+	protocolICMPv6 = 58
+)
+
+// Flow is a network traffic event reported by a peer. The peers report the start and the end of a connection as
+// separate events with the same FlowID.
+type Flow struct {
+	// ID is the event ID assigned by the peer, the peers resend the events until they are acknowledged
+	ID        string    `gorm:"primaryKey"`
+	AccountID string    `gorm:"primaryKey;index:idx_flows_account_timestamp,priority:1"`
+	Timestamp time.Time `gorm:"index:idx_flows_account_timestamp,priority:2"`
+	// ReceivedAt is the time the event was received, the retention is applied to it instead of the peer clock
+	ReceivedAt       time.Time `gorm:"index"`
+	PeerID           string
+	FlowID           string
+	Type             proto.Type
+	Direction        proto.Direction
+	Protocol         uint32
+	RuleID           string
+	SourceIP         string
+	SourcePort       uint32
+	SourceResourceID string
+	DestIP           string
+	DestPort         uint32
+	DestResourceID   string
+	ICMPType         uint32
+	ICMPCode         uint32
+	RxPackets        uint64
+	TxPackets        uint64
+	RxBytes          uint64
+	TxBytes          uint64
+}
+
+// FromProto converts the event reported by the peer of the account
+func FromProto(accountID, peerID string, event *proto.FlowEvent, receivedAt time.Time) (*Flow, error) {
+	eventID, err := uuid.FromBytes(event.GetEventId())
+	if err != nil {
+		return nil, fmt.Errorf("invalid event ID: %w", err)
+	}
+
+	fields := event.GetFlowFields()
+	if fields == nil {
+		return nil, errors.New("flow fields are missing")
+	}
+
+	flowID, err := uuid.FromBytes(fields.GetFlowId())
+	if err != nil {
+		return nil, fmt.Errorf("invalid flow ID: %w", err)
+	}
+
+	flow := &Flow{
+		ID:               eventID.String(),
+		AccountID:        accountID,
+		Timestamp:        event.GetTimestamp().AsTime().UTC(),
+		ReceivedAt:       receivedAt.UTC(),
+		PeerID:           peerID,
+		FlowID:           flowID.String(),
+		Type:             fields.GetType(),
+		Direction:        fields.GetDirection(),
+		Protocol:         fields.GetProtocol(),
+		RuleID:           string(fields.GetRuleId()),
+		SourceIP:         ipString(fields.GetSourceIp()),
+		SourceResourceID: string(fields.GetSourceResourceId()),
+		DestIP:           ipString(fields.GetDestIp()),
+		DestResourceID:   string(fields.GetDestResourceId()),
+		RxPackets:        fields.GetRxPackets(),
+		TxPackets:        fields.GetTxPackets(),
+		RxBytes:          fields.GetRxBytes(),
+		TxBytes:          fields.GetTxBytes(),
+	}
+
+	if icmp := fields.GetIcmpInfo(); icmp != nil {
+		flow.ICMPType = icmp.GetIcmpType()
+		flow.ICMPCode = icmp.GetIcmpCode()
+	}
+	if ports := fields.GetPortInfo(); ports != nil {
+		flow.SourcePort = ports.GetSourcePort()
+		flow.DestPort = ports.GetDestPort()
+	}
+
+	return flow, nil
+}
+
+func ipString(ip []byte) string {
+	addr, ok := netip.AddrFromSlice(ip)
+	if !ok {
+		return ""
+	}
+	return addr.Unmap().String()
+}
+
+func (f *Flow) isICMP() bool {
+	return f.Protocol == protocolICMP || f.Protocol == protocolICMPv6
+}
+
+// ToAPIResponse converts the flow to the API response, the ports are set for the non-ICMP flows and the ICMP type
+// and code for the ICMP flows
+func (f *Flow) ToAPIResponse() *api.FlowEvent {
+	resp := &api.FlowEvent{
+		Id:                    f.ID,
+		FlowId:                f.FlowID,
+		PeerId:                f.PeerID,
+		Timestamp:             f.Timestamp,
+		ReceivedAt:            f.ReceivedAt,
+		Type:                  f.Type.String(),
+		Direction:             f.Direction.String(),
+		Protocol:              int(f.Protocol),
+		RuleId:                f.RuleID,
+		SourceIp:              f.SourceIP,
+		SourceResourceId:      f.SourceResourceID,
+		DestinationIp:         f.DestIP,
+		DestinationResourceId: f.DestResourceID,
+		RxPackets:             int(f.RxPackets),
+		TxPackets:             int(f.TxPackets),
+		RxBytes:               int(f.RxBytes),
+		TxBytes:               int(f.TxBytes),
+	}
+
+	if f.isICMP() {
+		icmpType, icmpCode := int(f.ICMPType), int(f.ICMPCode)
+		resp.IcmpType = &icmpType
+		resp.IcmpCode = &icmpCode
+	} else {
+		sourcePort, destPort := int(f.SourcePort), int(f.DestPort)
+		resp.SourcePort = &sourcePort
+		resp.DestinationPort = &destPort
+	}
+
+	return resp
+}
+
+// Filter defines the flows returned by a search, ordered from the newest to the oldest. Empty fields don't filter.
+type Filter struct {
+	// PeerID of the peer that reported the flows
+	PeerID string
+	// ResourceID is the source or the destination resource of the flows
+	ResourceID string
+	// RuleID of the policy rule that matched the flows
+	RuleID string
+	// Protocol number of the flows
+	Protocol *uint32
+	// From is the inclusive start of the time range
+	From time.Time
+	// To is the inclusive end of the time range
+	To time.Time
+	// Cursor is the position after which the flows are returned, nil starts from the newest flow
+	Cursor *Cursor
+	// Limit is the maximum number of flows to return
+	Limit int
+}
+
+// Cursor is the position of a flow in the order of the flows, used for keyset pagination
+type Cursor struct {
+	Timestamp time.Time
+	ID        string
+}
+
+// CursorOf returns the cursor that points to the flow
+func CursorOf(flow *Flow) *Cursor {
+	return &Cursor{Timestamp: flow.Timestamp, ID: flow.ID}
+}
+
+// String encodes the cursor to an opaque token
+func (c *Cursor) String() string {
+	raw := strconv.FormatInt(c.Timestamp.UnixNano(), 10) + "." + c.ID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// ParseCursor decodes a cursor token created by Cursor.String
+func ParseCursor(token string) (*Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("decode cursor: %w", err)
+	}
+
+	timestamp, id, ok := strings.Cut(string(raw), ".")
+	if !ok || id == "" {
+		return nil, errors.New("invalid cursor format")
+	}
+
+	nanos, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse cursor timestamp: %w", err)
+	}
+
+	return &Cursor{Timestamp: time.Unix(0, nanos).UTC(), ID: id}, nil
+}
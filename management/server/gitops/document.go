@@ -0,0 +1,554 @@
+package gitops
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strings"
+
+	nbdns "github.com/netbirdio/netbird/dns"
+	resourceTypes "github.com/netbirdio/netbird/management/server/networks/resources/types"
+	routerTypes "github.com/netbirdio/netbird/management/server/networks/routers/types"
+	networkTypes "github.com/netbirdio/netbird/management/server/networks/types"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/posture"
+	"github.com/netbirdio/netbird/management/server/types"
+	"github.com/netbirdio/netbird/route"
+	"github.com/netbirdio/netbird/shared/management/http/api"
+	"github.com/netbirdio/netbird/shared/management/status"
+)
+
+// state is a snapshot of the account objects managed by documents
+type state struct {
+	peers         []*nbpeer.Peer
+	groups        []*types.Group
+	postureChecks []*posture.Checks
+	policies      []*types.Policy
+	routes        []*route.Route
+	networks      []*networkTypes.Network
+	resources     []*resourceTypes.NetworkResource
+	routers       map[string][]*routerTypes.NetworkRouter
+	nsGroups      []*nbdns.NameServerGroup
+	dnsSettings   *types.DNSSettings
+	setupKeys     []*types.SetupKey
+
+	// resourceGroups maps the network resource IDs to the IDs of the groups they belong to
+	resourceGroups map[string][]string
+
+	peerIndex         *nameIndex
+	groupIndex        *nameIndex
+	postureCheckIndex *nameIndex
+	networkIndex      *nameIndex
+	resourceIndex     *nameIndex
+}
+
+// loadState reads the account objects through the managers, so the permissions of the user are validated
+func (m *managerImpl) loadState(ctx context.Context, accountID, userID string) (*state, error) {
+	var err error
+	s := &state{}
+
+	if s.peers, err = m.accountManager.GetPeers(ctx, accountID, userID, "", ""); err != nil {
+		return nil, err
+	}
+	if s.groups, err = m.accountManager.GetAllGroups(ctx, accountID, userID); err != nil {
+		return nil, err
+	}
+	if s.postureChecks, err = m.accountManager.ListPostureChecks(ctx, accountID, userID); err != nil {
+		return nil, err
+	}
+	if s.policies, err = m.accountManager.ListPolicies(ctx, accountID, userID); err != nil {
+		return nil, err
+	}
+	if s.routes, err = m.accountManager.ListRoutes(ctx, accountID, userID); err != nil {
+		return nil, err
+	}
+	if s.networks, err = m.networksManager.GetAllNetworks(ctx, accountID, userID); err != nil {
+		return nil, err
+	}
+	if s.resources, err = m.resourcesManager.GetAllResourcesInAccount(ctx, accountID, userID); err != nil {
+		return nil, err
+	}
+	if s.routers, err = m.routersManager.GetAllRoutersInAccount(ctx, accountID, userID); err != nil {
+		return nil, err
+	}
+	if s.nsGroups, err = m.accountManager.ListNameServerGroups(ctx, accountID, userID); err != nil {
+		return nil, err
+	}
+	if s.dnsSettings, err = m.accountManager.GetDNSSettings(ctx, accountID, userID); err != nil {
+		return nil, err
+	}
+	if s.setupKeys, err = m.accountManager.ListSetupKeys(ctx, accountID, userID); err != nil {
+		return nil, err
+	}
+
+	s.buildIndexes()
+	return s, nil
+}
+
+func (s *state) buildIndexes() {
+	s.peerIndex = newNameIndex("peer")
+	for _, peer := range s.peers {
+		s.peerIndex.add(peer.ID, peer.DNSLabel)
+	}
+
+	s.groupIndex = newNameIndex("group")
+	s.resourceGroups = make(map[string][]string)
+	for _, group := range s.groups {
+		s.groupIndex.add(group.ID, group.Name)
+		for _, resource := range group.Resources {
+			s.resourceGroups[resource.ID] = append(s.resourceGroups[resource.ID], group.ID)
+		}
+	}
+
+	s.postureCheckIndex = newNameIndex("posture check")
+	for _, checks := range s.postureChecks {
+		s.postureCheckIndex.add(checks.ID, checks.Name)
+	}
+
+	s.networkIndex = newNameIndex("network")
+	for _, network := range s.networks {
+		s.networkIndex.add(network.ID, network.Name)
+	}
+
+	s.resourceIndex = newNameIndex("network resource")
+	for _, resource := range s.resources {
+		s.resourceIndex.add(resource.ID, resource.Name)
+	}
+}
+
+// validateUniqueNames makes sure that the names of the referenced and exported objects are unambiguous
+func (s *state) validateUniqueNames() error {
+	indexes := []*nameIndex{s.peerIndex, s.groupIndex, s.postureCheckIndex, s.networkIndex, s.resourceIndex}
+	for _, index := range indexes {
+		if err := index.validateUnique(); err != nil {
+			return err
+		}
+	}
+
+	policies := newNameIndex("policy")
+	for _, policy := range s.policies {
+		policies.add(policy.ID, policy.Name)
+	}
+	nsGroups := newNameIndex("nameserver group")
+	for _, nsGroup := range s.nsGroups {
+		nsGroups.add(nsGroup.ID, nsGroup.Name)
+	}
+	setupKeys := newNameIndex("setup key")
+	for _, key := range s.setupKeys {
+		setupKeys.add(key.Id, key.Name)
+	}
+	routes := newNameIndex("route")
+	for _, r := range s.routes {
+		routes.add(string(r.ID), routeKey(string(r.NetID), s.peerIndex.name(r.Peer)))
+	}
+	routers := newNameIndex("network router")
+	for networkID, networkRouters := range s.routers {
+		for _, router := range networkRouters {
+			routers.add(router.ID, networkID+"/"+s.routerKey(router))
+		}
+	}
+
+	for _, index := range []*nameIndex{policies, nsGroups, setupKeys, routes, routers} {
+		if err := index.validateUnique(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// toDocument converts the account objects to a document with sorted entries
+func (s *state) toDocument() *api.AccountDocument {
+	doc := &api.AccountDocument{
+		Groups:           &[]api.AccountDocumentGroup{},
+		PostureChecks:    &[]api.AccountDocumentPostureCheck{},
+		Policies:         &[]api.AccountDocumentPolicy{},
+		Routes:           &[]api.AccountDocumentRoute{},
+		Networks:         &[]api.AccountDocumentNetwork{},
+		NameserverGroups: &[]api.AccountDocumentNameserverGroup{},
+		SetupKeys:        &[]api.AccountDocumentSetupKey{},
+		DnsSettings: &api.AccountDocumentDNSSettings{
+			DisabledManagementGroups: s.groupIndex.names(s.dnsSettings.DisabledManagementGroups),
+		},
+	}
+
+	for _, group := range s.groups {
+		if !isManagedGroup(group) {
+			continue
+		}
+		peers := s.peerIndex.names(group.Peers)
+		*doc.Groups = append(*doc.Groups, api.AccountDocumentGroup{Name: group.Name, Peers: &peers})
+	}
+	sortByName(*doc.Groups, func(g api.AccountDocumentGroup) string { return g.Name })
+
+	for _, checks := range s.postureChecks {
+		*doc.PostureChecks = append(*doc.PostureChecks, toDocumentPostureCheck(checks))
+	}
+	sortByName(*doc.PostureChecks, func(c api.AccountDocumentPostureCheck) string { return c.Name })
+
+	for _, policy := range s.policies {
+		*doc.Policies = append(*doc.Policies, s.toDocumentPolicy(policy))
+	}
+	sortByName(*doc.Policies, func(p api.AccountDocumentPolicy) string { return p.Name })
+
+	for _, r := range s.routes {
+		*doc.Routes = append(*doc.Routes, s.toDocumentRoute(r))
+	}
+	sortByName(*doc.Routes, documentRouteKey)
+
+	for _, network := range s.networks {
+		*doc.Networks = append(*doc.Networks, s.toDocumentNetwork(network))
+	}
+	sortByName(*doc.Networks, func(n api.AccountDocumentNetwork) string { return n.Name })
+
+	for _, nsGroup := range s.nsGroups {
+		*doc.NameserverGroups = append(*doc.NameserverGroups, s.toDocumentNameserverGroup(nsGroup))
+	}
+	sortByName(*doc.NameserverGroups, func(g api.AccountDocumentNameserverGroup) string { return g.Name })
+
+	for _, key := range s.setupKeys {
+		*doc.SetupKeys = append(*doc.SetupKeys, api.AccountDocumentSetupKey{
+			Name:                key.Name,
+			Type:                string(key.Type),
+			Revoked:             key.Revoked,
+			AutoGroups:          s.groupIndex.names(key.AutoGroups),
+			UsageLimit:          key.UsageLimit,
+			Ephemeral:           key.Ephemeral,
+			AllowExtraDnsLabels: key.AllowExtraDNSLabels,
+		})
+	}
+	sortByName(*doc.SetupKeys, func(k api.AccountDocumentSetupKey) string { return k.Name })
+
+	return doc
+}
+
+func toDocumentPostureCheck(checks *posture.Checks) api.AccountDocumentPostureCheck {
+	return api.AccountDocumentPostureCheck{
+		Name:        checks.Name,
+		Description: checks.Description,
+		Checks:      checks.ToAPIResponse().Checks,
+	}
+}
+
+func (s *state) toDocumentPolicy(policy *types.Policy) api.AccountDocumentPolicy {
+	doc := api.AccountDocumentPolicy{
+		Name:                policy.Name,
+		Description:         policy.Description,
+		Enabled:             policy.Enabled,
+		SourcePostureChecks: s.postureCheckIndex.names(policy.SourcePostureChecks),
+		Rules:               make([]api.AccountDocumentPolicyRule, 0, len(policy.Rules)),
+	}
+
+	for _, rule := range policy.Rules {
+		docRule := api.AccountDocumentPolicyRule{
+			Name:          rule.Name,
+			Description:   rule.Description,
+			Enabled:       rule.Enabled,
+			Action:        api.AccountDocumentPolicyRuleAction(rule.Action),
+			Bidirectional: rule.Bidirectional,
+			Protocol:      api.AccountDocumentPolicyRuleProtocol(rule.Protocol),
+			Schedule:      rule.Schedule.ToAPIResponse(),
+		}
+
+		if rule.SourceResource.ID != "" {
+			docRule.SourceResource = s.resourceIndex.namePtr(rule.SourceResource.ID)
+		} else {
+			sources := s.groupIndex.names(rule.Sources)
+			docRule.Sources = &sources
+		}
+
+		if rule.DestinationResource.ID != "" {
+			docRule.DestinationResource = s.resourceIndex.namePtr(rule.DestinationResource.ID)
+		} else {
+			destinations := s.groupIndex.names(rule.Destinations)
+			docRule.Destinations = &destinations
+		}
+
+		if len(rule.Ports) > 0 {
+			ports := slices.Clone(rule.Ports)
+			docRule.Ports = &ports
+		}
+
+		if len(rule.PortRanges) > 0 {
+			portRanges := make([]api.RulePortRange, 0, len(rule.PortRanges))
+			for _, portRange := range rule.PortRanges {
+				portRanges = append(portRanges, api.RulePortRange{Start: int(portRange.Start), End: int(portRange.End)})
+			}
+			docRule.PortRanges = &portRanges
+		}
+
+		doc.Rules = append(doc.Rules, docRule)
+	}
+
+	return doc
+}
+
+func (s *state) toDocumentRoute(r *route.Route) api.AccountDocumentRoute {
+	doc := api.AccountDocumentRoute{
+		NetworkId:     string(r.NetID),
+		Description:   r.Description,
+		Enabled:       r.Enabled,
+		Peer:          s.peerIndex.namePtr(r.Peer),
+		Metric:        r.Metric,
+		Masquerade:    r.Masquerade,
+		Groups:        s.groupIndex.names(r.Groups),
+		KeepRoute:     r.KeepRoute,
+		SkipAutoApply: r.SkipAutoApply,
+	}
+
+	if r.NetworkType == route.DomainNetwork {
+		domains := r.Domains.ToPunycodeList()
+		doc.Domains = &domains
+	} else {
+		network := r.Network.String()
+		doc.Network = &network
+	}
+
+	if len(r.PeerGroups) > 0 {
+		peerGroups := s.groupIndex.names(r.PeerGroups)
+		doc.PeerGroups = &peerGroups
+	}
+
+	if len(r.AccessControlGroups) > 0 {
+		accessControlGroups := s.groupIndex.names(r.AccessControlGroups)
+		doc.AccessControlGroups = &accessControlGroups
+	}
+
+	return doc
+}
+
+func (s *state) toDocumentNetwork(network *networkTypes.Network) api.AccountDocumentNetwork {
+	doc := api.AccountDocumentNetwork{
+		Name:        network.Name,
+		Description: network.Description,
+		Resources:   []api.AccountDocumentNetworkResource{},
+		Routers:     []api.AccountDocumentNetworkRouter{},
+	}
+
+	for _, resource := range s.resources {
+		if resource.NetworkID != network.ID {
+			continue
+		}
+		doc.Resources = append(doc.Resources, s.toDocumentNetworkResource(resource))
+	}
+	sortByName(doc.Resources, func(r api.AccountDocumentNetworkResource) string { return r.Name })
+
+	for _, router := range s.routers[network.ID] {
+		doc.Routers = append(doc.Routers, s.toDocumentNetworkRouter(router))
+	}
+	sortByName(doc.Routers, documentRouterKey)
+
+	return doc
+}
+
+func (s *state) toDocumentNetworkResource(resource *resourceTypes.NetworkResource) api.AccountDocumentNetworkResource {
+	address := resource.Prefix.String()
+	if resource.Type == resourceTypes.Domain {
+		address = resource.Domain
+	}
+
+	return api.AccountDocumentNetworkResource{
+		Name:        resource.Name,
+		Description: resource.Description,
+		Address:     address,
+		Enabled:     resource.Enabled,
+		Groups:      s.groupIndex.names(s.resourceGroups[resource.ID]),
+	}
+}
+
+func (s *state) toDocumentNetworkRouter(router *routerTypes.NetworkRouter) api.AccountDocumentNetworkRouter {
+	doc := api.AccountDocumentNetworkRouter{
+		Peer:       s.peerIndex.namePtr(router.Peer),
+		Metric:     router.Metric,
+		Masquerade: router.Masquerade,
+		Enabled:    router.Enabled,
+	}
+
+	if len(router.PeerGroups) > 0 {
+		peerGroups := s.groupIndex.names(router.PeerGroups)
+		doc.PeerGroups = &peerGroups
+	}
+
+	return doc
+}
+
+func (s *state) toDocumentNameserverGroup(nsGroup *nbdns.NameServerGroup) api.AccountDocumentNameserverGroup {
+	doc := api.AccountDocumentNameserverGroup{
+		Name:                 nsGroup.Name,
+		Description:          nsGroup.Description,
+		Nameservers:          make([]api.Nameserver, 0, len(nsGroup.NameServers)),
+		Enabled:              nsGroup.Enabled,
+		Groups:               s.groupIndex.names(nsGroup.Groups),
+		Primary:              nsGroup.Primary,
+		Domains:              sortedStrings(nsGroup.Domains),
+		SearchDomainsEnabled: nsGroup.SearchDomainsEnabled,
+	}
+
+	for _, ns := range nsGroup.NameServers {
+		doc.Nameservers = append(doc.Nameservers, toDocumentNameserver(ns))
+	}
+
+	return doc
+}
+
+func (s *state) routerKey(router *routerTypes.NetworkRouter) string {
+	return routerKey(s.peerIndex.name(router.Peer), s.groupIndex.names(router.PeerGroups))
+}
+
+// isManagedGroup returns true for groups that are created through the API and can be managed by documents
+func isManagedGroup(group *types.Group) bool {
+	return group.Issued == types.GroupIssuedAPI && !group.IsGroupAll()
+}
+
+// routeKey identifies a route by its network identifier and peer, HA routes share the network identifier
+func routeKey(netID, peer string) string {
+	if peer == "" {
+		return netID
+	}
+	return netID + "/" + peer
+}
+
+func documentRouteKey(r api.AccountDocumentRoute) string {
+	return routeKey(r.NetworkId, stringValue(r.Peer))
+}
+
+// routerKey identifies a router of a network by its peer or its peer groups
+func routerKey(peer string, peerGroups []string) string {
+	if peer != "" {
+		return peer
+	}
+	return strings.Join(sortedStrings(peerGroups), ",")
+}
+
+func documentRouterKey(r api.AccountDocumentNetworkRouter) string {
+	return routerKey(stringValue(r.Peer), sliceValue(r.PeerGroups))
+}
+
+// nameIndex maps the IDs of account objects to their names and back
+type nameIndex struct {
+	kind       string
+	byID       map[string]string
+	byName     map[string]string
+	duplicates map[string]struct{}
+}
+
+func newNameIndex(kind string) *nameIndex {
+	return &nameIndex{
+		kind:       kind,
+		byID:       make(map[string]string),
+		byName:     make(map[string]string),
+		duplicates: make(map[string]struct{}),
+	}
+}
+
+func (n *nameIndex) add(id, name string) {
+	if existing, ok := n.byName[name]; ok && existing != id {
+		n.duplicates[name] = struct{}{}
+	}
+	n.byID[id] = name
+	n.byName[name] = id
+}
+
+func (n *nameIndex) remove(id string) {
+	name, ok := n.byID[id]
+	if !ok {
+		return
+	}
+	delete(n.byID, id)
+	if n.byName[name] == id {
+		delete(n.byName, name)
+	}
+}
+
+func (n *nameIndex) validateUnique() error {
+	if len(n.duplicates) == 0 {
+		return nil
+	}
+	return status.Errorf(status.PreconditionFailed, "%s names aren't unique: %s", n.kind, strings.Join(sortedStrings(keys(n.duplicates)), ", "))
+}
+
+// name returns the name of the object or an empty string if it doesn't exist
+func (n *nameIndex) name(id string) string {
+	return n.byID[id]
+}
+
+func (n *nameIndex) namePtr(id string) *string {
+	name, ok := n.byID[id]
+	if !ok {
+		return nil
+	}
+	return &name
+}
+
+// names returns the sorted names of the objects, dangling references are skipped
+func (n *nameIndex) names(ids []string) []string {
+	names := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if name, ok := n.byID[id]; ok {
+			names = append(names, name)
+		}
+	}
+	return sortedStrings(names)
+}
+
+func (n *nameIndex) id(name string) (string, error) {
+	if _, ok := n.duplicates[name]; ok {
+		return "", status.Errorf(status.InvalidArgument, "%s name %s is ambiguous", n.kind, name)
+	}
+	id, ok := n.byName[name]
+	if !ok {
+		return "", status.Errorf(status.InvalidArgument, "%s %s not found", n.kind, name)
+	}
+	return id, nil
+}
+
+func (n *nameIndex) ids(names []string) ([]string, error) {
+	ids := make([]string, 0, len(names))
+	for _, name := range names {
+		id, err := n.id(name)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func sortByName[T any](entries []T, name func(T) string) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		return name(entries[i]) < name(entries[j])
+	})
+}
+
+func sortedStrings(values []string) []string {
+	sorted := make([]string, len(values))
+	copy(sorted, values)
+	sort.Strings(sorted)
+	return sorted
+}
+
+func keys[T any](m map[string]T) []string {
+	result := make([]string, 0, len(m))
+	for k := range m {
+		result = append(result, k)
+	}
+	return result
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func sliceValue(s *[]string) []string {
+	if s == nil {
+		return nil
+	}
+	return *s
+}
+
+func errDocument(format string, a ...any) error {
+	return status.Errorf(status.InvalidArgument, "invalid document: "+format, a...)
+}
@@ -0,0 +1,233 @@
+package gitops
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/management/server/account"
+	"github.com/netbirdio/netbird/management/server/networks"
+	"github.com/netbirdio/netbird/management/server/networks/resources"
+	"github.com/netbirdio/netbird/management/server/networks/routers"
+	"github.com/netbirdio/netbird/management/server/permissions"
+	"github.com/netbirdio/netbird/management/server/permissions/modules"
+	"github.com/netbirdio/netbird/management/server/permissions/operations"
+	"github.com/netbirdio/netbird/shared/management/http/api"
+	"github.com/netbirdio/netbird/shared/management/status"
+)
+
+// Manager exports the configuration of accounts to documents referencing objects by name and reconciles accounts with such documents
+type Manager interface {
+	// Export returns the groups, posture checks, policies, routes, networks, nameserver groups, DNS settings and setup keys of the account
+	Export(ctx context.Context, accountID, userID string) (*api.AccountDocument, error)
+	// Apply reconciles the account with the document and returns the changes in the order they are applied.
+	// Nothing is changed when dryRun is set, applying the same document again results in no changes.
+	// When planHash is set the changes are only applied if the account wasn't modified since the plan was made.
+	Apply(ctx context.Context, accountID, userID string, document *api.AccountDocument, dryRun bool, planHash string) (*api.AccountPlan, error)
+}
+
+type managerImpl struct {
+	accountManager     account.Manager
+	networksManager    networks.Manager
+	resourcesManager   resources.Manager
+	routersManager     routers.Manager
+	permissionsManager permissions.Manager
+}
+
+func NewManager(accountManager account.Manager, networksManager networks.Manager, resourcesManager resources.Manager, routersManager routers.Manager, permissionsManager permissions.Manager) Manager {
+	return &managerImpl{
+		accountManager:     accountManager,
+		networksManager:    networksManager,
+		resourcesManager:   resourcesManager,
+		routersManager:     routersManager,
+		permissionsManager: permissionsManager,
+	}
+}
+
+func (m *managerImpl) Export(ctx context.Context, accountID, userID string) (*api.AccountDocument, error) {
+	s, err := m.loadState(ctx, accountID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.validateUniqueNames(); err != nil {
+		return nil, err
+	}
+
+	return s.toDocument(), nil
+}
+
+func (m *managerImpl) Apply(ctx context.Context, accountID, userID string, document *api.AccountDocument, dryRun bool, planHash string) (*api.AccountPlan, error) {
+	s, err := m.loadState(ctx, accountID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := newReconciler(m, accountID, userID, s, document).plan()
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &api.AccountPlan{Changes: make([]api.AccountPlanChange, 0, len(changes))}
+	for _, c := range changes {
+		plan.Changes = append(plan.Changes, c.AccountPlanChange)
+	}
+
+	// the state is exported before applying, the changes update the indexes of the state
+	original := s.toDocument()
+	if plan.PlanHash, err = hashPlan(original, plan.Changes); err != nil {
+		return nil, fmt.Errorf("failed to hash the plan: %w", err)
+	}
+
+	if dryRun || len(changes) == 0 {
+		return plan, nil
+	}
+
+	if planHash != "" && planHash != plan.PlanHash {
+		return nil, status.Errorf(status.PreconditionFailed, "the account was modified since the plan was made, plan the changes again")
+	}
+
+	// validate all permissions upfront, so a missing permission doesn't leave the account partially reconciled
+	if err = m.validatePermissions(ctx, accountID, userID, changes); err != nil {
+		return nil, err
+	}
+
+	for i, c := range changes {
+		if err = c.apply(ctx); err != nil {
+			err = fmt.Errorf("failed to %s %s %s: %w", c.Action, c.Kind, c.Name, err)
+			if i > 0 {
+				if rollbackErr := m.rollback(ctx, accountID, userID, managedSections(document, original)); rollbackErr != nil {
+					log.WithContext(ctx).Errorf("failed to roll back the changes applied to account %s: %v", accountID, rollbackErr)
+					return nil, fmt.Errorf("%w, rolling back the applied changes failed: %v", err, rollbackErr)
+				}
+			}
+			return nil, err
+		}
+	}
+	plan.Applied = true
+
+	return plan, nil
+}
+
+// rollback reconciles the account with the document exported before the changes were applied.
+// Objects deleted by the changes are created again with new IDs, and setup keys with new secrets.
+func (m *managerImpl) rollback(ctx context.Context, accountID, userID string, original *api.AccountDocument) error {
+	s, err := m.loadState(ctx, accountID, userID)
+	if err != nil {
+		return err
+	}
+
+	changes, err := newReconciler(m, accountID, userID, s, original).plan()
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, c := range changes {
+		if err = c.apply(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("%s %s %s: %w", c.Action, c.Kind, c.Name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// managedSections returns the sections of the original document that are managed by the applied document
+func managedSections(document, original *api.AccountDocument) *api.AccountDocument {
+	sections := &api.AccountDocument{}
+	if document.Groups != nil {
+		sections.Groups = original.Groups
+	}
+	if document.PostureChecks != nil {
+		sections.PostureChecks = original.PostureChecks
+	}
+	if document.Policies != nil {
+		sections.Policies = original.Policies
+	}
+	if document.Routes != nil {
+		sections.Routes = original.Routes
+	}
+	if document.Networks != nil {
+		sections.Networks = original.Networks
+	}
+	if document.NameserverGroups != nil {
+		sections.NameserverGroups = original.NameserverGroups
+	}
+	if document.DnsSettings != nil {
+		sections.DnsSettings = original.DnsSettings
+	}
+	if document.SetupKeys != nil {
+		sections.SetupKeys = original.SetupKeys
+	}
+	return sections
+}
+
+// hashPlan returns a hash of the account state and the planned changes, it differs once the account is modified
+func hashPlan(original *api.AccountDocument, changes []api.AccountPlanChange) (string, error) {
+	data, err := json.Marshal(struct {
+		State   *api.AccountDocument    `json:"state"`
+		Changes []api.AccountPlanChange `json:"changes"`
+	}{original, changes})
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func (m *managerImpl) validatePermissions(ctx context.Context, accountID, userID string, changes []*change) error {
+	type permission struct {
+		module    modules.Module
+		operation operations.Operation
+	}
+
+	validated := make(map[permission]struct{})
+	for _, c := range changes {
+		// the inverse operation is required as well to roll the change back on failure
+		operation := toOperation(c.Action)
+		for _, p := range []permission{{c.module, operation}, {c.module, inverseOperation(operation)}} {
+			if _, ok := validated[p]; ok {
+				continue
+			}
+
+			allowed, err := m.permissionsManager.ValidateUserPermissions(ctx, accountID, userID, p.module, p.operation)
+			if err != nil {
+				return status.NewPermissionValidationError(err)
+			}
+			if !allowed {
+				return status.NewPermissionDeniedError()
+			}
+			validated[p] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+func toOperation(action api.AccountPlanChangeAction) operations.Operation {
+	switch action {
+	case api.AccountPlanChangeActionCreate:
+		return operations.Create
+	case api.AccountPlanChangeActionDelete:
+		return operations.Delete
+	default:
+		return operations.Update
+	}
+}
+
+func inverseOperation(operation operations.Operation) operations.Operation {
+	switch operation {
+	case operations.Create:
+		return operations.Delete
+	case operations.Delete:
+		return operations.Create
+	default:
+		return operation
+	}
+}
@@ -0,0 +1,383 @@
+package gitops
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	nbdns "github.com/netbirdio/netbird/dns"
+	"github.com/netbirdio/netbird/management/server/mock_server"
+	"github.com/netbirdio/netbird/management/server/networks"
+	"github.com/netbirdio/netbird/management/server/networks/resources"
+	"github.com/netbirdio/netbird/management/server/networks/routers"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/permissions"
+	"github.com/netbirdio/netbird/management/server/posture"
+	"github.com/netbirdio/netbird/management/server/types"
+	"github.com/netbirdio/netbird/route"
+	"github.com/netbirdio/netbird/shared/management/http/api"
+	"github.com/netbirdio/netbird/shared/management/status"
+)
+
+const (
+	testAccountID = "testAccountId"
+	testUserID    = "testUserId"
+)
+
+// testAccount keeps the objects of an account in memory and serves them through a mocked account manager
+type testAccount struct {
+	peers     []*nbpeer.Peer
+	groups    map[string]*types.Group
+	policies  map[string]*types.Policy
+	setupKeys map[string]*types.SetupKey
+	dns       types.DNSSettings
+	writes    int
+}
+
+func newTestAccount() *testAccount {
+	return &testAccount{
+		peers: []*nbpeer.Peer{
+			{ID: "peer1", DNSLabel: "web"},
+			{ID: "peer2", DNSLabel: "db"},
+		},
+		groups: map[string]*types.Group{
+			"all":   {ID: "all", Name: "All", Issued: types.GroupIssuedAPI, Peers: []string{"peer1", "peer2"}},
+			"jwt":   {ID: "jwt", Name: "idp", Issued: types.GroupIssuedJWT},
+			"web":   {ID: "web", Name: "web-servers", Issued: types.GroupIssuedAPI, Peers: []string{"peer1"}},
+			"db":    {ID: "db", Name: "databases", Issued: types.GroupIssuedAPI, Peers: []string{"peer2"}},
+			"stale": {ID: "stale", Name: "stale", Issued: types.GroupIssuedAPI},
+		},
+		policies: map[string]*types.Policy{
+			"policy1": {
+				ID:      "policy1",
+				Name:    "web to db",
+				Enabled: true,
+				Rules: []*types.PolicyRule{{
+					ID:           "rule1",
+					Name:         "postgres",
+					Enabled:      true,
+					Action:       types.PolicyTrafficActionAccept,
+					Protocol:     types.PolicyRuleProtocolTCP,
+					Ports:        []string{"5432"},
+					Sources:      []string{"web"},
+					Destinations: []string{"db"},
+				}},
+			},
+		},
+		setupKeys: map[string]*types.SetupKey{
+			"key1": {Id: "key1", Name: "servers", Type: types.SetupKeyReusable, AutoGroups: []string{"web"}},
+		},
+	}
+}
+
+func (a *testAccount) manager(t *testing.T) Manager {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	permissionsManager := permissions.NewMockManager(ctrl)
+	permissionsManager.EXPECT().ValidateUserPermissions(gomock.Any(), testAccountID, testUserID, gomock.Any(), gomock.Any()).Return(true, nil).AnyTimes()
+
+	am := &mock_server.MockAccountManager{
+		GetPeersFunc: func(_ context.Context, _, _, _, _ string) ([]*nbpeer.Peer, error) {
+			return a.peers, nil
+		},
+		GetAllGroupsFunc: func(_ context.Context, _, _ string) ([]*types.Group, error) {
+			return values(a.groups), nil
+		},
+		SaveGroupFunc: func(_ context.Context, _, _ string, group *types.Group, create bool) error {
+			if create {
+				group.ID = xid.New().String()
+			}
+			a.groups[group.ID] = group
+			a.writes++
+			return nil
+		},
+		DeleteGroupFunc: func(_ context.Context, _, _, groupID string) error {
+			delete(a.groups, groupID)
+			a.writes++
+			return nil
+		},
+		ListPoliciesFunc: func(_ context.Context, _, _ string) ([]*types.Policy, error) {
+			return values(a.policies), nil
+		},
+		SavePolicyFunc: func(_ context.Context, _, _ string, policy *types.Policy, create bool) (*types.Policy, error) {
+			if create {
+				policy.ID = xid.New().String()
+			}
+			a.policies[policy.ID] = policy
+			a.writes++
+			return policy, nil
+		},
+		DeletePolicyFunc: func(_ context.Context, _, policyID, _ string) error {
+			delete(a.policies, policyID)
+			a.writes++
+			return nil
+		},
+		ListSetupKeysFunc: func(_ context.Context, _, _ string) ([]*types.SetupKey, error) {
+			return values(a.setupKeys), nil
+		},
+		CreateSetupKeyFunc: func(_ context.Context, _ string, keyName string, keyType types.SetupKeyType, _ time.Duration, autoGroups []string, usageLimit int, _ string, ephemeral bool, allowExtraDNSLabels bool) (*types.SetupKey, error) {
+			key := &types.SetupKey{Id: xid.New().String(), Name: keyName, Type: keyType, AutoGroups: autoGroups, UsageLimit: usageLimit, Ephemeral: ephemeral, AllowExtraDNSLabels: allowExtraDNSLabels}
+			a.setupKeys[key.Id] = key
+			a.writes++
+			return key, nil
+		},
+		SaveSetupKeyFunc: func(_ context.Context, _ string, key *types.SetupKey, _ string) (*types.SetupKey, error) {
+			existing := a.setupKeys[key.Id]
+			existing.AutoGroups = key.AutoGroups
+			existing.Revoked = key.Revoked
+			a.writes++
+			return existing, nil
+		},
+		DeleteSetupKeyFunc: func(_ context.Context, _, _, keyID string) error {
+			delete(a.setupKeys, keyID)
+			a.writes++
+			return nil
+		},
+		ListPostureChecksFunc: func(_ context.Context, _, _ string) ([]*posture.Checks, error) {
+			return []*posture.Checks{}, nil
+		},
+		ListRoutesFunc: func(_ context.Context, _, _ string) ([]*route.Route, error) {
+			return []*route.Route{}, nil
+		},
+		ListNameServerGroupsFunc: func(_ context.Context, _, _ string) ([]*nbdns.NameServerGroup, error) {
+			return []*nbdns.NameServerGroup{}, nil
+		},
+		GetDNSSettingsFunc: func(_ context.Context, _, _ string) (*types.DNSSettings, error) {
+			return &a.dns, nil
+		},
+		SaveDNSSettingsFunc: func(_ context.Context, _, _ string, settings *types.DNSSettings) error {
+			a.dns = *settings
+			a.writes++
+			return nil
+		},
+	}
+
+	return NewManager(am, networks.NewManagerMock(), resources.NewManagerMock(), routers.NewManagerMock(), permissionsManager)
+}
+
+func values[T any](m map[string]T) []T {
+	result := make([]T, 0, len(m))
+	for _, v := range m {
+		result = append(result, v)
+	}
+	return result
+}
+
+func Test_ExportReferencesObjectsByName(t *testing.T) {
+	account := newTestAccount()
+
+	doc, err := account.manager(t).Export(context.Background(), testAccountID, testUserID)
+	require.NoError(t, err)
+
+	require.NotNil(t, doc.Groups)
+	assert.Equal(t, []api.AccountDocumentGroup{
+		{Name: "databases", Peers: &[]string{"db"}},
+		{Name: "stale", Peers: &[]string{}},
+		{Name: "web-servers", Peers: &[]string{"web"}},
+	}, *doc.Groups, "the All group and groups not issued by the API shouldn't be exported")
+
+	require.Len(t, *doc.Policies, 1)
+	rule := (*doc.Policies)[0].Rules[0]
+	assert.Equal(t, &[]string{"web-servers"}, rule.Sources)
+	assert.Equal(t, &[]string{"databases"}, rule.Destinations)
+
+	require.Len(t, *doc.SetupKeys, 1)
+	assert.Equal(t, []string{"web-servers"}, (*doc.SetupKeys)[0].AutoGroups)
+}
+
+func Test_ApplyExportedDocumentHasNoChanges(t *testing.T) {
+	account := newTestAccount()
+	manager := account.manager(t)
+
+	doc, err := manager.Export(context.Background(), testAccountID, testUserID)
+	require.NoError(t, err)
+
+	plan, err := manager.Apply(context.Background(), testAccountID, testUserID, doc, false, "")
+	require.NoError(t, err)
+	assert.Empty(t, plan.Changes)
+	assert.False(t, plan.Applied)
+	assert.Zero(t, account.writes)
+}
+
+func Test_ApplyReconcilesAccount(t *testing.T) {
+	account := newTestAccount()
+	manager := account.manager(t)
+	ctx := context.Background()
+
+	doc, err := manager.Export(ctx, testAccountID, testUserID)
+	require.NoError(t, err)
+
+	doc.Groups = &[]api.AccountDocumentGroup{
+		{Name: "databases", Peers: &[]string{"db", "web"}},
+		{Name: "monitoring"},
+		{Name: "web-servers"},
+	}
+	*doc.Policies = []api.AccountDocumentPolicy{}
+	doc.SetupKeys = &[]api.AccountDocumentSetupKey{
+		{Name: "servers", Type: "reusable", AutoGroups: []string{"web-servers", "monitoring"}},
+		{Name: "laptops", Type: "one-off", AutoGroups: []string{"monitoring"}, UsageLimit: 1},
+	}
+
+	plan, err := manager.Apply(ctx, testAccountID, testUserID, doc, true, "")
+	require.NoError(t, err)
+	expectedChanges := []api.AccountPlanChange{
+		{Action: api.AccountPlanChangeActionUpdate, Kind: api.AccountPlanChangeKindGroup, Name: "databases"},
+		{Action: api.AccountPlanChangeActionCreate, Kind: api.AccountPlanChangeKindGroup, Name: "monitoring"},
+		{Action: api.AccountPlanChangeActionUpdate, Kind: api.AccountPlanChangeKindSetupKey, Name: "servers"},
+		{Action: api.AccountPlanChangeActionCreate, Kind: api.AccountPlanChangeKindSetupKey, Name: "laptops"},
+		{Action: api.AccountPlanChangeActionDelete, Kind: api.AccountPlanChangeKindPolicy, Name: "web to db"},
+		{Action: api.AccountPlanChangeActionDelete, Kind: api.AccountPlanChangeKindGroup, Name: "stale"},
+	}
+	assert.ElementsMatch(t, expectedChanges, plan.Changes)
+	assert.False(t, plan.Applied)
+	assert.Zero(t, account.writes, "dry run shouldn't change the account")
+
+	plan, err = manager.Apply(ctx, testAccountID, testUserID, doc, false, plan.PlanHash)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, expectedChanges, plan.Changes)
+	assert.True(t, plan.Applied)
+
+	assert.ElementsMatch(t, []string{"peer1", "peer2"}, account.groups["db"].Peers)
+	assert.Equal(t, []string{"peer1"}, account.groups["web"].Peers, "peers shouldn't change when they are omitted")
+	assert.NotContains(t, account.groups, "stale")
+	assert.Empty(t, account.policies)
+	assert.Len(t, account.setupKeys, 2)
+
+	plan, err = manager.Apply(ctx, testAccountID, testUserID, doc, false, "")
+	require.NoError(t, err)
+	assert.Empty(t, plan.Changes, "applying the same document again shouldn't change anything")
+}
+
+func Test_ApplyOmittedSectionsAreUnmanaged(t *testing.T) {
+	account := newTestAccount()
+
+	plan, err := account.manager(t).Apply(context.Background(), testAccountID, testUserID, &api.AccountDocument{}, false, "")
+	require.NoError(t, err)
+	assert.Empty(t, plan.Changes)
+	assert.Len(t, account.groups, 5)
+	assert.Len(t, account.policies, 1)
+	assert.Len(t, account.setupKeys, 1)
+}
+
+func Test_ApplyRejectsInvalidDocuments(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  *api.AccountDocument
+	}{
+		{
+			name: "unknown peer",
+			doc:  &api.AccountDocument{Groups: &[]api.AccountDocumentGroup{{Name: "web-servers", Peers: &[]string{"unknown"}}}},
+		},
+		{
+			name: "unmanaged group",
+			doc:  &api.AccountDocument{Groups: &[]api.AccountDocumentGroup{{Name: "idp"}}},
+		},
+		{
+			name: "duplicated group",
+			doc:  &api.AccountDocument{Groups: &[]api.AccountDocumentGroup{{Name: "web-servers"}, {Name: "web-servers"}}},
+		},
+		{
+			name: "unknown group reference",
+			doc: &api.AccountDocument{SetupKeys: &[]api.AccountDocumentSetupKey{
+				{Name: "servers", Type: "reusable", AutoGroups: []string{"unknown"}},
+			}},
+		},
+		{
+			name: "changed immutable setup key field",
+			doc: &api.AccountDocument{SetupKeys: &[]api.AccountDocumentSetupKey{
+				{Name: "servers", Type: "one-off", AutoGroups: []string{"web-servers"}},
+			}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			account := newTestAccount()
+
+			_, err := account.manager(t).Apply(context.Background(), testAccountID, testUserID, tc.doc, false, "")
+			require.Error(t, err)
+			s, ok := status.FromError(err)
+			require.True(t, ok)
+			assert.Equal(t, status.InvalidArgument, s.Type())
+			assert.Zero(t, account.writes)
+		})
+	}
+}
+
+func Test_ApplyReturnsPermissionDenied(t *testing.T) {
+	account := newTestAccount()
+
+	ctrl := gomock.NewController(t)
+	permissionsManager := permissions.NewMockManager(ctrl)
+	permissionsManager.EXPECT().ValidateUserPermissions(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(false, nil).AnyTimes()
+
+	m := account.manager(t).(*managerImpl)
+	m.permissionsManager = permissionsManager
+
+	doc := &api.AccountDocument{Groups: &[]api.AccountDocumentGroup{{Name: "new"}}}
+	_, err := m.Apply(context.Background(), testAccountID, testUserID, doc, false, "")
+	require.Equal(t, status.NewPermissionDeniedError(), err)
+	assert.Zero(t, account.writes)
+}
+
+func Test_ApplyRejectsOutdatedPlan(t *testing.T) {
+	account := newTestAccount()
+	manager := account.manager(t)
+	ctx := context.Background()
+
+	doc := &api.AccountDocument{Groups: &[]api.AccountDocumentGroup{{Name: "web-servers"}, {Name: "databases"}, {Name: "monitoring"}}}
+	plan, err := manager.Apply(ctx, testAccountID, testUserID, doc, true, "")
+	require.NoError(t, err)
+	require.NotEmpty(t, plan.PlanHash)
+
+	replanned, err := manager.Apply(ctx, testAccountID, testUserID, doc, true, "")
+	require.NoError(t, err)
+	assert.Equal(t, plan.PlanHash, replanned.PlanHash, "the hash shouldn't change while the account isn't modified")
+
+	account.groups["new"] = &types.Group{ID: "new", Name: "new", Issued: types.GroupIssuedAPI}
+
+	_, err = manager.Apply(ctx, testAccountID, testUserID, doc, false, plan.PlanHash)
+	require.Error(t, err)
+	s, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, status.PreconditionFailed, s.Type())
+	assert.Contains(t, account.groups, "new")
+	assert.Contains(t, account.groups, "stale")
+}
+
+func Test_ApplyRollsBackOnFailure(t *testing.T) {
+	account := newTestAccount()
+	m := account.manager(t).(*managerImpl)
+	am := m.accountManager.(*mock_server.MockAccountManager)
+	am.DeleteSetupKeyFunc = func(_ context.Context, _, _, _ string) error {
+		return status.Errorf(status.Internal, "failed to delete setup key")
+	}
+
+	doc := &api.AccountDocument{
+		Groups: &[]api.AccountDocumentGroup{
+			{Name: "databases", Peers: &[]string{"db", "web"}},
+			{Name: "monitoring"},
+			{Name: "web-servers"},
+			{Name: "stale"},
+		},
+		SetupKeys: &[]api.AccountDocumentSetupKey{},
+	}
+
+	_, err := m.Apply(context.Background(), testAccountID, testUserID, doc, false, "")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to delete setup key")
+
+	names := make([]string, 0, len(account.groups))
+	for _, group := range account.groups {
+		names = append(names, group.Name)
+	}
+	assert.ElementsMatch(t, []string{"All", "idp", "web-servers", "databases", "stale"}, names, "the created group should be deleted")
+	assert.Equal(t, []string{"peer2"}, account.groups["db"].Peers, "the updated group should be restored")
+	assert.Contains(t, account.setupKeys, "key1")
+}
@@ -0,0 +1,1266 @@
+package gitops
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"slices"
+	"time"
+
+	nbdns "github.com/netbirdio/netbird/dns"
+	resourceTypes "github.com/netbirdio/netbird/management/server/networks/resources/types"
+	routerTypes "github.com/netbirdio/netbird/management/server/networks/routers/types"
+	networkTypes "github.com/netbirdio/netbird/management/server/networks/types"
+	"github.com/netbirdio/netbird/management/server/permissions/modules"
+	"github.com/netbirdio/netbird/management/server/posture"
+	"github.com/netbirdio/netbird/management/server/types"
+	"github.com/netbirdio/netbird/route"
+	"github.com/netbirdio/netbird/shared/management/domain"
+	"github.com/netbirdio/netbird/shared/management/http/api"
+)
+
+const dnsSettingsName = "dns"
+
+// change is a single create, update or delete of an account object
+type change struct {
+	api.AccountPlanChange
+	module modules.Module
+	apply  func(ctx context.Context) error
+}
+
+// planStep plans the changes of one kind of objects, the upserts are applied in the order of the steps
+// and the deletes in reverse order, so objects are created before and deleted after they are referenced
+type planStep func() (upserts []*change, deletes []*change, err error)
+
+// reconciler plans the changes required to reconcile the account with a document
+type reconciler struct {
+	m         *managerImpl
+	accountID string
+	userID    string
+	state     *state
+	doc       *api.AccountDocument
+
+	// known names that can be referenced by the document once it is applied
+	knownGroups        map[string]struct{}
+	knownPostureChecks map[string]struct{}
+	knownResources     map[string]struct{}
+	knownPeers         map[string]struct{}
+
+	// resources maps the network resource IDs to the resources, including the ones created while applying
+	resources map[string]*resourceTypes.NetworkResource
+}
+
+func newReconciler(m *managerImpl, accountID, userID string, s *state, doc *api.AccountDocument) *reconciler {
+	r := &reconciler{
+		m:         m,
+		accountID: accountID,
+		userID:    userID,
+		state:     s,
+		doc:       doc,
+		resources: make(map[string]*resourceTypes.NetworkResource),
+	}
+	for _, resource := range s.resources {
+		r.resources[resource.ID] = resource
+	}
+	return r
+}
+
+func (r *reconciler) plan() ([]*change, error) {
+	if err := r.state.validateUniqueNames(); err != nil {
+		return nil, err
+	}
+	r.collectKnownNames()
+
+	steps := []planStep{
+		r.planGroups,
+		r.planPostureChecks,
+		r.planNetworks,
+		r.planNetworkResources,
+		r.planNetworkRouters,
+		r.planRoutes,
+		r.planNameserverGroups,
+		r.planDNSSettings,
+		r.planSetupKeys,
+		r.planPolicies,
+	}
+
+	var changes []*change
+	deletes := make([][]*change, 0, len(steps))
+	for _, step := range steps {
+		upserts, stepDeletes, err := step()
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, upserts...)
+		deletes = append(deletes, stepDeletes)
+	}
+
+	for i := len(deletes) - 1; i >= 0; i-- {
+		changes = append(changes, deletes[i]...)
+	}
+
+	return changes, nil
+}
+
+// collectKnownNames collects the names of the objects that exist after the document is applied
+func (r *reconciler) collectKnownNames() {
+	r.knownPeers = make(map[string]struct{})
+	for _, peer := range r.state.peers {
+		r.knownPeers[peer.DNSLabel] = struct{}{}
+	}
+
+	r.knownGroups = make(map[string]struct{})
+	for _, group := range r.state.groups {
+		if r.doc.Groups == nil || !isManagedGroup(group) {
+			r.knownGroups[group.Name] = struct{}{}
+		}
+	}
+	if r.doc.Groups != nil {
+		for _, group := range *r.doc.Groups {
+			r.knownGroups[group.Name] = struct{}{}
+		}
+	}
+
+	r.knownPostureChecks = make(map[string]struct{})
+	if r.doc.PostureChecks != nil {
+		for _, checks := range *r.doc.PostureChecks {
+			r.knownPostureChecks[checks.Name] = struct{}{}
+		}
+	} else {
+		for _, checks := range r.state.postureChecks {
+			r.knownPostureChecks[checks.Name] = struct{}{}
+		}
+	}
+
+	r.knownResources = make(map[string]struct{})
+	if r.doc.Networks != nil {
+		for _, network := range *r.doc.Networks {
+			for _, resource := range network.Resources {
+				r.knownResources[resource.Name] = struct{}{}
+			}
+		}
+	} else {
+		for _, resource := range r.state.resources {
+			r.knownResources[resource.Name] = struct{}{}
+		}
+	}
+}
+
+func (r *reconciler) planGroups() ([]*change, []*change, error) {
+	if r.doc.Groups == nil {
+		return nil, nil, nil
+	}
+
+	current := make(map[string]*types.Group)
+	unmanaged := make(map[string]struct{})
+	for _, group := range r.state.groups {
+		if isManagedGroup(group) {
+			current[group.Name] = group
+		} else {
+			unmanaged[group.Name] = struct{}{}
+		}
+	}
+
+	var upserts []*change
+	seen := make(map[string]struct{})
+	for _, group := range *r.doc.Groups {
+		if err := checkName("group", group.Name, seen); err != nil {
+			return nil, nil, err
+		}
+		if _, ok := unmanaged[group.Name]; ok {
+			return nil, nil, errDocument("group %s isn't issued by the API and can't be managed by documents", group.Name)
+		}
+		if group.Peers != nil {
+			if err := checkRefs("group "+group.Name, "peer", r.knownPeers, *group.Peers); err != nil {
+				return nil, nil, err
+			}
+		}
+
+		existing, ok := current[group.Name]
+		switch {
+		case !ok:
+			upserts = append(upserts, r.newChange(api.AccountPlanChangeActionCreate, api.AccountPlanChangeKindGroup, group.Name, modules.Groups, func(ctx context.Context) error {
+				peerIDs, err := r.state.peerIndex.ids(sliceValue(group.Peers))
+				if err != nil {
+					return err
+				}
+				newGroup := &types.Group{Name: group.Name, Issued: types.GroupIssuedAPI, Peers: peerIDs}
+				if err = r.m.accountManager.CreateGroup(ctx, r.accountID, r.userID, newGroup); err != nil {
+					return err
+				}
+				r.state.groupIndex.add(newGroup.ID, newGroup.Name)
+				return nil
+			}))
+		case group.Peers != nil && !slices.Equal(sortedStrings(*group.Peers), r.state.peerIndex.names(existing.Peers)):
+			upserts = append(upserts, r.newChange(api.AccountPlanChangeActionUpdate, api.AccountPlanChangeKindGroup, group.Name, modules.Groups, func(ctx context.Context) error {
+				peerIDs, err := r.state.peerIndex.ids(*group.Peers)
+				if err != nil {
+					return err
+				}
+				updatedGroup := existing.Copy()
+				updatedGroup.Peers = peerIDs
+				return r.m.accountManager.UpdateGroup(ctx, r.accountID, r.userID, updatedGroup)
+			}))
+		}
+	}
+
+	var deletes []*change
+	for _, name := range sortedStrings(keys(current)) {
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		existing := current[name]
+		deletes = append(deletes, r.newChange(api.AccountPlanChangeActionDelete, api.AccountPlanChangeKindGroup, name, modules.Groups, func(ctx context.Context) error {
+			if err := r.m.accountManager.DeleteGroup(ctx, r.accountID, r.userID, existing.ID); err != nil {
+				return err
+			}
+			r.state.groupIndex.remove(existing.ID)
+			return nil
+		}))
+	}
+
+	return upserts, deletes, nil
+}
+
+func (r *reconciler) planPostureChecks() ([]*change, []*change, error) {
+	if r.doc.PostureChecks == nil {
+		return nil, nil, nil
+	}
+
+	current := make(map[string]*posture.Checks)
+	for _, checks := range r.state.postureChecks {
+		current[checks.Name] = checks
+	}
+
+	var upserts []*change
+	seen := make(map[string]struct{})
+	for _, docChecks := range *r.doc.PostureChecks {
+		if err := checkName("posture check", docChecks.Name, seen); err != nil {
+			return nil, nil, err
+		}
+
+		checks, err := posture.NewChecksFromAPIPostureCheckUpdate(api.PostureCheckUpdate{
+			Name:        docChecks.Name,
+			Description: docChecks.Description,
+			Checks:      &docChecks.Checks,
+		}, "")
+		if err != nil {
+			return nil, nil, errDocument("posture check %s: %v", docChecks.Name, err)
+		}
+		if err = checks.Validate(); err != nil {
+			return nil, nil, errDocument("posture check %s: %v", docChecks.Name, err)
+		}
+
+		existing, ok := current[docChecks.Name]
+		if !ok {
+			upserts = append(upserts, r.newChange(api.AccountPlanChangeActionCreate, api.AccountPlanChangeKindPostureCheck, checks.Name, modules.Policies, func(ctx context.Context) error {
+				saved, err := r.m.accountManager.SavePostureChecks(ctx, r.accountID, r.userID, checks, true)
+				if err != nil {
+					return err
+				}
+				r.state.postureCheckIndex.add(saved.ID, saved.Name)
+				return nil
+			}))
+			continue
+		}
+
+		if jsonEqual(toDocumentPostureCheck(checks), toDocumentPostureCheck(existing)) && !isTokenChanged(checks, existing) {
+			continue
+		}
+		checks.ID = existing.ID
+		upserts = append(upserts, r.newChange(api.AccountPlanChangeActionUpdate, api.AccountPlanChangeKindPostureCheck, checks.Name, modules.Policies, func(ctx context.Context) error {
+			_, err := r.m.accountManager.SavePostureChecks(ctx, r.accountID, r.userID, checks, false)
+			return err
+		}))
+	}
+
+	var deletes []*change
+	for _, name := range sortedStrings(keys(current)) {
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		existing := current[name]
+		deletes = append(deletes, r.newChange(api.AccountPlanChangeActionDelete, api.AccountPlanChangeKindPostureCheck, name, modules.Policies, func(ctx context.Context) error {
+			if err := r.m.accountManager.DeletePostureChecks(ctx, r.accountID, existing.ID, r.userID); err != nil {
+				return err
+			}
+			r.state.postureCheckIndex.remove(existing.ID)
+			return nil
+		}))
+	}
+
+	return upserts, deletes, nil
+}
+
+// isTokenChanged returns true if the document sets a new token for the external posture provider, the token isn't exported
+func isTokenChanged(checks, existing *posture.Checks) bool {
+	external := checks.Checks.ExternalCheck
+	if external == nil || external.Token == "" {
+		return false
+	}
+	return existing.Checks.ExternalCheck == nil || existing.Checks.ExternalCheck.Token != external.Token
+}
+
+func (r *reconciler) planNetworks() ([]*change, []*change, error) {
+	if r.doc.Networks == nil {
+		return nil, nil, nil
+	}
+
+	current := make(map[string]*networkTypes.Network)
+	for _, network := range r.state.networks {
+		current[network.Name] = network
+	}
+
+	var upserts []*change
+	seen := make(map[string]struct{})
+	for _, docNetwork := range *r.doc.Networks {
+		if err := checkName("network", docNetwork.Name, seen); err != nil {
+			return nil, nil, err
+		}
+
+		existing, ok := current[docNetwork.Name]
+		switch {
+		case !ok:
+			upserts = append(upserts, r.newChange(api.AccountPlanChangeActionCreate, api.AccountPlanChangeKindNetwork, docNetwork.Name, modules.Networks, func(ctx context.Context) error {
+				network, err := r.m.networksManager.CreateNetwork(ctx, r.userID, &networkTypes.Network{
+					AccountID:   r.accountID,
+					Name:        docNetwork.Name,
+					Description: docNetwork.Description,
+				})
+				if err != nil {
+					return err
+				}
+				r.state.networkIndex.add(network.ID, network.Name)
+				return nil
+			}))
+		case existing.Description != docNetwork.Description:
+			upserts = append(upserts, r.newChange(api.AccountPlanChangeActionUpdate, api.AccountPlanChangeKindNetwork, docNetwork.Name, modules.Networks, func(ctx context.Context) error {
+				network := existing.Copy()
+				network.Description = docNetwork.Description
+				_, err := r.m.networksManager.UpdateNetwork(ctx, r.userID, network)
+				return err
+			}))
+		}
+	}
+
+	var deletes []*change
+	for _, name := range sortedStrings(keys(current)) {
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		existing := current[name]
+		deletes = append(deletes, r.newChange(api.AccountPlanChangeActionDelete, api.AccountPlanChangeKindNetwork, name, modules.Networks, func(ctx context.Context) error {
+			if err := r.m.networksManager.DeleteNetwork(ctx, r.accountID, r.userID, existing.ID); err != nil {
+				return err
+			}
+			r.state.networkIndex.remove(existing.ID)
+			return nil
+		}))
+	}
+
+	return upserts, deletes, nil
+}
+
+// networkResourceEntry is a network resource together with the name of the network it belongs to
+type networkResourceEntry struct {
+	Network  string
+	Resource api.AccountDocumentNetworkResource
+}
+
+func (r *reconciler) planNetworkResources() ([]*change, []*change, error) {
+	if r.doc.Networks == nil {
+		return nil, nil, nil
+	}
+
+	current := make(map[string]*resourceTypes.NetworkResource)
+	for _, resource := range r.state.resources {
+		current[resource.Name] = resource
+	}
+
+	var upserts []*change
+	seen := make(map[string]struct{})
+	for _, docNetwork := range *r.doc.Networks {
+		for _, docResource := range docNetwork.Resources {
+			if err := checkName("network resource", docResource.Name, seen); err != nil {
+				return nil, nil, err
+			}
+			owner := "network resource " + docResource.Name
+			if err := checkRefs(owner, "group", r.knownGroups, docResource.Groups); err != nil {
+				return nil, nil, err
+			}
+			address, err := normalizeResourceAddress(docResource.Address)
+			if err != nil {
+				return nil, nil, errDocument("%s: %v", owner, err)
+			}
+			docResource.Address = address
+			docResource.Groups = sortedStrings(docResource.Groups)
+
+			desired := networkResourceEntry{Network: docNetwork.Name, Resource: docResource}
+			existing, ok := current[docResource.Name]
+			if !ok {
+				upserts = append(upserts, r.newChange(api.AccountPlanChangeActionCreate, api.AccountPlanChangeKindNetworkResource, docResource.Name, modules.Networks, func(ctx context.Context) error {
+					resource, err := r.toNetworkResource(desired, "")
+					if err != nil {
+						return err
+					}
+					created, err := r.m.resourcesManager.CreateResource(ctx, r.userID, resource)
+					if err != nil {
+						return err
+					}
+					r.state.resourceIndex.add(created.ID, created.Name)
+					r.resources[created.ID] = created
+					return nil
+				}))
+				continue
+			}
+
+			currentEntry := networkResourceEntry{
+				Network:  r.state.networkIndex.name(existing.NetworkID),
+				Resource: r.state.toDocumentNetworkResource(existing),
+			}
+			if jsonEqual(desired, currentEntry) {
+				continue
+			}
+			upserts = append(upserts, r.newChange(api.AccountPlanChangeActionUpdate, api.AccountPlanChangeKindNetworkResource, docResource.Name, modules.Networks, func(ctx context.Context) error {
+				resource, err := r.toNetworkResource(desired, existing.ID)
+				if err != nil {
+					return err
+				}
+				updated, err := r.m.resourcesManager.UpdateResource(ctx, r.userID, resource)
+				if err != nil {
+					return err
+				}
+				r.resources[updated.ID] = updated
+				return nil
+			}))
+		}
+	}
+
+	var deletes []*change
+	for _, name := range sortedStrings(keys(current)) {
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		existing := current[name]
+		deletes = append(deletes, r.newChange(api.AccountPlanChangeActionDelete, api.AccountPlanChangeKindNetworkResource, name, modules.Networks, func(ctx context.Context) error {
+			if err := r.m.resourcesManager.DeleteResource(ctx, r.accountID, r.userID, existing.NetworkID, existing.ID); err != nil {
+				return err
+			}
+			r.state.resourceIndex.remove(existing.ID)
+			delete(r.resources, existing.ID)
+			return nil
+		}))
+	}
+
+	return upserts, deletes, nil
+}
+
+func (r *reconciler) toNetworkResource(entry networkResourceEntry, id string) (*resourceTypes.NetworkResource, error) {
+	networkID, err := r.state.networkIndex.id(entry.Network)
+	if err != nil {
+		return nil, err
+	}
+	groupIDs, err := r.state.groupIndex.ids(entry.Resource.Groups)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resourceTypes.NetworkResource{
+		ID:          id,
+		AccountID:   r.accountID,
+		NetworkID:   networkID,
+		Name:        entry.Resource.Name,
+		Description: entry.Resource.Description,
+		Address:     entry.Resource.Address,
+		GroupIDs:    groupIDs,
+		Enabled:     entry.Resource.Enabled,
+	}, nil
+}
+
+// normalizeResourceAddress returns the address in the form it is exported in, e.g. 10.0.0.1 as 10.0.0.1/32
+func normalizeResourceAddress(address string) (string, error) {
+	resourceType, resourceDomain, prefix, err := resourceTypes.GetResourceType(address)
+	if err != nil {
+		return "", err
+	}
+	if resourceType == resourceTypes.Domain {
+		return resourceDomain, nil
+	}
+	return prefix.String(), nil
+}
+
+func (r *reconciler) planNetworkRouters() ([]*change, []*change, error) {
+	if r.doc.Networks == nil {
+		return nil, nil, nil
+	}
+
+	type currentRouter struct {
+		network string
+		router  *routerTypes.NetworkRouter
+	}
+	current := make(map[string]currentRouter)
+	for networkID, routers := range r.state.routers {
+		network := r.state.networkIndex.name(networkID)
+		for _, router := range routers {
+			current[network+"/"+r.state.routerKey(router)] = currentRouter{network: network, router: router}
+		}
+	}
+
+	var upserts []*change
+	seen := make(map[string]struct{})
+	for _, docNetwork := range *r.doc.Networks {
+		for _, docRouter := range docNetwork.Routers {
+			key := docNetwork.Name + "/" + documentRouterKey(docRouter)
+			if _, ok := seen[key]; ok {
+				return nil, nil, errDocument("network router %s is defined more than once", key)
+			}
+			seen[key] = struct{}{}
+
+			owner := "network router " + key
+			if (docRouter.Peer == nil) == (len(sliceValue(docRouter.PeerGroups)) == 0) {
+				return nil, nil, errDocument("%s: either peer or peer groups should be set", owner)
+			}
+			if docRouter.Peer != nil {
+				if err := checkRefs(owner, "peer", r.knownPeers, []string{*docRouter.Peer}); err != nil {
+					return nil, nil, err
+				}
+			}
+			if docRouter.PeerGroups != nil {
+				if err := checkRefs(owner, "group", r.knownGroups, *docRouter.PeerGroups); err != nil {
+					return nil, nil, err
+				}
+				peerGroups := sortedStrings(*docRouter.PeerGroups)
+				docRouter.PeerGroups = &peerGroups
+			}
+
+			network := docNetwork.Name
+			existing, ok := current[key]
+			if !ok {
+				upserts = append(upserts, r.newChange(api.AccountPlanChangeActionCreate, api.AccountPlanChangeKindNetworkRouter, key, modules.Networks, func(ctx context.Context) error {
+					router, err := r.toNetworkRouter(network, docRouter, "")
+					if err != nil {
+						return err
+					}
+					_, err = r.m.routersManager.CreateRouter(ctx, r.userID, router)
+					return err
+				}))
+				continue
+			}
+
+			if jsonEqual(docRouter, r.state.toDocumentNetworkRouter(existing.router)) {
+				continue
+			}
+			upserts = append(upserts, r.newChange(api.AccountPlanChangeActionUpdate, api.AccountPlanChangeKindNetworkRouter, key, modules.Networks, func(ctx context.Context) error {
+				router, err := r.toNetworkRouter(network, docRouter, existing.router.ID)
+				if err != nil {
+					return err
+				}
+				_, err = r.m.routersManager.UpdateRouter(ctx, r.userID, router)
+				return err
+			}))
+		}
+	}
+
+	var deletes []*change
+	for _, key := range sortedStrings(keys(current)) {
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		existing := current[key]
+		deletes = append(deletes, r.newChange(api.AccountPlanChangeActionDelete, api.AccountPlanChangeKindNetworkRouter, key, modules.Networks, func(ctx context.Context) error {
+			return r.m.routersManager.DeleteRouter(ctx, r.accountID, r.userID, existing.router.NetworkID, existing.router.ID)
+		}))
+	}
+
+	return upserts, deletes, nil
+}
+
+func (r *reconciler) toNetworkRouter(network string, docRouter api.AccountDocumentNetworkRouter, id string) (*routerTypes.NetworkRouter, error) {
+	networkID, err := r.state.networkIndex.id(network)
+	if err != nil {
+		return nil, err
+	}
+
+	router := &routerTypes.NetworkRouter{
+		ID:         id,
+		NetworkID:  networkID,
+		AccountID:  r.accountID,
+		Masquerade: docRouter.Masquerade,
+		Metric:     docRouter.Metric,
+		Enabled:    docRouter.Enabled,
+	}
+
+	if docRouter.Peer != nil {
+		if router.Peer, err = r.state.peerIndex.id(*docRouter.Peer); err != nil {
+			return nil, err
+		}
+	}
+	if docRouter.PeerGroups != nil {
+		if router.PeerGroups, err = r.state.groupIndex.ids(*docRouter.PeerGroups); err != nil {
+			return nil, err
+		}
+	}
+
+	return router, nil
+}
+
+func (r *reconciler) planRoutes() ([]*change, []*change, error) {
+	if r.doc.Routes == nil {
+		return nil, nil, nil
+	}
+
+	current := make(map[string]*route.Route)
+	for _, existing := range r.state.routes {
+		current[routeKey(string(existing.NetID), r.state.peerIndex.name(existing.Peer))] = existing
+	}
+
+	var upserts []*change
+	seen := make(map[string]struct{})
+	for _, docRoute := range *r.doc.Routes {
+		key := documentRouteKey(docRoute)
+		if err := checkName("route", key, seen); err != nil {
+			return nil, nil, err
+		}
+		if err := r.normalizeRoute(key, &docRoute); err != nil {
+			return nil, nil, err
+		}
+
+		existing, ok := current[key]
+		if !ok {
+			upserts = append(upserts, r.newChange(api.AccountPlanChangeActionCreate, api.AccountPlanChangeKindRoute, key, modules.Routes, func(ctx context.Context) error {
+				newRoute, err := r.toRoute(docRoute, &route.Route{})
+				if err != nil {
+					return err
+				}
+				_, err = r.m.accountManager.CreateRoute(ctx, r.accountID, newRoute.Network, newRoute.NetworkType, newRoute.Domains,
+					newRoute.Peer, newRoute.PeerGroups, newRoute.Description, newRoute.NetID, newRoute.Masquerade, newRoute.Metric,
+					newRoute.Groups, newRoute.AccessControlGroups, newRoute.Enabled, r.userID, newRoute.KeepRoute, newRoute.SkipAutoApply)
+				return err
+			}))
+			continue
+		}
+
+		if jsonEqual(docRoute, r.state.toDocumentRoute(existing)) {
+			continue
+		}
+		upserts = append(upserts, r.newChange(api.AccountPlanChangeActionUpdate, api.AccountPlanChangeKindRoute, key, modules.Routes, func(ctx context.Context) error {
+			updatedRoute, err := r.toRoute(docRoute, existing.Copy())
+			if err != nil {
+				return err
+			}
+			return r.m.accountManager.SaveRoute(ctx, r.accountID, r.userID, updatedRoute)
+		}))
+	}
+
+	var deletes []*change
+	for _, key := range sortedStrings(keys(current)) {
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		existing := current[key]
+		deletes = append(deletes, r.newChange(api.AccountPlanChangeActionDelete, api.AccountPlanChangeKindRoute, key, modules.Routes, func(ctx context.Context) error {
+			return r.m.accountManager.DeleteRoute(ctx, r.accountID, existing.ID, r.userID)
+		}))
+	}
+
+	return upserts, deletes, nil
+}
+
+// normalizeRoute validates the route and brings it into the form it is exported in
+func (r *reconciler) normalizeRoute(key string, docRoute *api.AccountDocumentRoute) error {
+	owner := "route " + key
+
+	if (docRoute.Network == nil) == (docRoute.Domains == nil) {
+		return errDocument("%s: either network or domains should be set", owner)
+	}
+	if docRoute.Network != nil {
+		_, prefix, err := route.ParseNetwork(*docRoute.Network)
+		if err != nil {
+			return errDocument("%s: %v", owner, err)
+		}
+		network := prefix.String()
+		docRoute.Network = &network
+	}
+	if docRoute.Domains != nil {
+		domains, err := domain.ValidateDomains(*docRoute.Domains)
+		if err != nil {
+			return errDocument("%s: invalid domains: %v", owner, err)
+		}
+		punycode := domains.ToPunycodeList()
+		docRoute.Domains = &punycode
+	}
+
+	if docRoute.Peer != nil {
+		if err := checkRefs(owner, "peer", r.knownPeers, []string{*docRoute.Peer}); err != nil {
+			return err
+		}
+	}
+	if err := checkRefs(owner, "group", r.knownGroups, docRoute.Groups); err != nil {
+		return err
+	}
+	docRoute.Groups = sortedStrings(docRoute.Groups)
+
+	for _, groups := range []**[]string{&docRoute.PeerGroups, &docRoute.AccessControlGroups} {
+		if len(sliceValue(*groups)) == 0 {
+			*groups = nil
+			continue
+		}
+		if err := checkRefs(owner, "group", r.knownGroups, **groups); err != nil {
+			return err
+		}
+		sorted := sortedStrings(**groups)
+		*groups = &sorted
+	}
+
+	return nil
+}
+
+func (r *reconciler) toRoute(docRoute api.AccountDocumentRoute, target *route.Route) (*route.Route, error) {
+	var err error
+
+	target.NetID = route.NetID(docRoute.NetworkId)
+	target.Description = docRoute.Description
+	target.Enabled = docRoute.Enabled
+	target.Metric = docRoute.Metric
+	target.Masquerade = docRoute.Masquerade
+	target.KeepRoute = docRoute.KeepRoute
+	target.SkipAutoApply = docRoute.SkipAutoApply
+
+	target.Network = netip.Prefix{}
+	target.Domains = nil
+	if docRoute.Network != nil {
+		if target.NetworkType, target.Network, err = route.ParseNetwork(*docRoute.Network); err != nil {
+			return nil, err
+		}
+	} else {
+		target.NetworkType = route.DomainNetwork
+		if target.Domains, err = domain.ValidateDomains(*docRoute.Domains); err != nil {
+			return nil, err
+		}
+	}
+
+	target.Peer = ""
+	if docRoute.Peer != nil {
+		if target.Peer, err = r.state.peerIndex.id(*docRoute.Peer); err != nil {
+			return nil, err
+		}
+	}
+	if target.PeerGroups, err = r.state.groupIndex.ids(sliceValue(docRoute.PeerGroups)); err != nil {
+		return nil, err
+	}
+	if target.Groups, err = r.state.groupIndex.ids(docRoute.Groups); err != nil {
+		return nil, err
+	}
+	if target.AccessControlGroups, err = r.state.groupIndex.ids(sliceValue(docRoute.AccessControlGroups)); err != nil {
+		return nil, err
+	}
+
+	return target, nil
+}
+
+func (r *reconciler) planNameserverGroups() ([]*change, []*change, error) {
+	if r.doc.NameserverGroups == nil {
+		return nil, nil, nil
+	}
+
+	current := make(map[string]*nbdns.NameServerGroup)
+	for _, nsGroup := range r.state.nsGroups {
+		current[nsGroup.Name] = nsGroup
+	}
+
+	var upserts []*change
+	seen := make(map[string]struct{})
+	for _, docGroup := range *r.doc.NameserverGroups {
+		if err := checkName("nameserver group", docGroup.Name, seen); err != nil {
+			return nil, nil, err
+		}
+		owner := "nameserver group " + docGroup.Name
+		if err := checkRefs(owner, "group", r.knownGroups, docGroup.Groups); err != nil {
+			return nil, nil, err
+		}
+
+		nameServers, err := toNameServers(docGroup.Nameservers)
+		if err != nil {
+			return nil, nil, errDocument("%s: %v", owner, err)
+		}
+		docGroup.Nameservers = toDocumentNameservers(nameServers)
+		docGroup.Groups = sortedStrings(docGroup.Groups)
+		docGroup.Domains = sortedStrings(docGroup.Domains)
+
+		existing, ok := current[docGroup.Name]
+		if !ok {
+			upserts = append(upserts, r.newChange(api.AccountPlanChangeActionCreate, api.AccountPlanChangeKindNameserverGroup, docGroup.Name, modules.Nameservers, func(ctx context.Context) error {
+				groupIDs, err := r.state.groupIndex.ids(docGroup.Groups)
+				if err != nil {
+					return err
+				}
+				_, err = r.m.accountManager.CreateNameServerGroup(ctx, r.accountID, docGroup.Name, docGroup.Description, nameServers,
+					groupIDs, docGroup.Primary, docGroup.Domains, docGroup.Enabled, r.userID, docGroup.SearchDomainsEnabled)
+				return err
+			}))
+			continue
+		}
+
+		if jsonEqual(docGroup, r.state.toDocumentNameserverGroup(existing)) {
+			continue
+		}
+		upserts = append(upserts, r.newChange(api.AccountPlanChangeActionUpdate, api.AccountPlanChangeKindNameserverGroup, docGroup.Name, modules.Nameservers, func(ctx context.Context) error {
+			groupIDs, err := r.state.groupIndex.ids(docGroup.Groups)
+			if err != nil {
+				return err
+			}
+			nsGroup := existing.Copy()
+			nsGroup.Description = docGroup.Description
+			nsGroup.NameServers = nameServers
+			nsGroup.Groups = groupIDs
+			nsGroup.Primary = docGroup.Primary
+			nsGroup.Domains = docGroup.Domains
+			nsGroup.Enabled = docGroup.Enabled
+			nsGroup.SearchDomainsEnabled = docGroup.SearchDomainsEnabled
+			return r.m.accountManager.SaveNameServerGroup(ctx, r.accountID, r.userID, nsGroup)
+		}))
+	}
+
+	var deletes []*change
+	for _, name := range sortedStrings(keys(current)) {
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		existing := current[name]
+		deletes = append(deletes, r.newChange(api.AccountPlanChangeActionDelete, api.AccountPlanChangeKindNameserverGroup, name, modules.Nameservers, func(ctx context.Context) error {
+			return r.m.accountManager.DeleteNameServerGroup(ctx, r.accountID, existing.ID, r.userID)
+		}))
+	}
+
+	return upserts, deletes, nil
+}
+
+func toNameServers(nameservers []api.Nameserver) ([]nbdns.NameServer, error) {
+	result := make([]nbdns.NameServer, 0, len(nameservers))
+	for _, ns := range nameservers {
+		parsed, err := nbdns.ParseNameServerURL(fmt.Sprintf("%s://%s:%d", ns.NsType, ns.Ip, ns.Port))
+		if err != nil {
+			return nil, err
+		}
+		if ns.ServerName != nil {
+			parsed.ServerName = *ns.ServerName
+		}
+		if ns.Path != nil {
+			parsed.Path = *ns.Path
+		}
+		if ns.Pin != nil {
+			parsed.Pin = *ns.Pin
+		}
+		if err = parsed.Validate(); err != nil {
+			return nil, err
+		}
+		result = append(result, parsed)
+	}
+	return result, nil
+}
+
+func toDocumentNameservers(nameServers []nbdns.NameServer) []api.Nameserver {
+	result := make([]api.Nameserver, 0, len(nameServers))
+	for _, ns := range nameServers {
+		result = append(result, toDocumentNameserver(ns))
+	}
+	return result
+}
+
+func toDocumentNameserver(ns nbdns.NameServer) api.Nameserver {
+	nameserver := api.Nameserver{
+		Ip:     ns.IP.String(),
+		NsType: api.NameserverNsType(ns.NSType.String()),
+		Port:   ns.Port,
+	}
+	if ns.ServerName != "" {
+		nameserver.ServerName = &ns.ServerName
+	}
+	if ns.Path != "" {
+		nameserver.Path = &ns.Path
+	}
+	if ns.Pin != "" {
+		nameserver.Pin = &ns.Pin
+	}
+	return nameserver
+}
+
+func (r *reconciler) planDNSSettings() ([]*change, []*change, error) {
+	if r.doc.DnsSettings == nil {
+		return nil, nil, nil
+	}
+
+	groups := sortedStrings(r.doc.DnsSettings.DisabledManagementGroups)
+	if err := checkRefs("dns settings", "group", r.knownGroups, groups); err != nil {
+		return nil, nil, err
+	}
+	if slices.Equal(groups, r.state.groupIndex.names(r.state.dnsSettings.DisabledManagementGroups)) {
+		return nil, nil, nil
+	}
+
+	return []*change{r.newChange(api.AccountPlanChangeActionUpdate, api.AccountPlanChangeKindDnsSettings, dnsSettingsName, modules.Dns, func(ctx context.Context) error {
+		groupIDs, err := r.state.groupIndex.ids(groups)
+		if err != nil {
+			return err
+		}
+		return r.m.accountManager.SaveDNSSettings(ctx, r.accountID, r.userID, &types.DNSSettings{DisabledManagementGroups: groupIDs})
+	})}, nil, nil
+}
+
+func (r *reconciler) planSetupKeys() ([]*change, []*change, error) {
+	if r.doc.SetupKeys == nil {
+		return nil, nil, nil
+	}
+
+	current := make(map[string]*types.SetupKey)
+	for _, key := range r.state.setupKeys {
+		current[key.Name] = key
+	}
+
+	var upserts []*change
+	seen := make(map[string]struct{})
+	for _, docKey := range *r.doc.SetupKeys {
+		if err := checkName("setup key", docKey.Name, seen); err != nil {
+			return nil, nil, err
+		}
+		owner := "setup key " + docKey.Name
+		if docKey.Type != string(types.SetupKeyReusable) && docKey.Type != string(types.SetupKeyOneOff) {
+			return nil, nil, errDocument("%s: invalid type %s", owner, docKey.Type)
+		}
+		if docKey.ExpiresIn != nil && *docKey.ExpiresIn < 0 {
+			return nil, nil, errDocument("%s: expires in can't be negative", owner)
+		}
+		if err := checkRefs(owner, "group", r.knownGroups, docKey.AutoGroups); err != nil {
+			return nil, nil, err
+		}
+		docKey.AutoGroups = sortedStrings(docKey.AutoGroups)
+
+		existing, ok := current[docKey.Name]
+		if !ok {
+			upserts = append(upserts, r.newChange(api.AccountPlanChangeActionCreate, api.AccountPlanChangeKindSetupKey, docKey.Name, modules.SetupKeys, func(ctx context.Context) error {
+				return r.createSetupKey(ctx, docKey)
+			}))
+			continue
+		}
+
+		currentKey := api.AccountDocumentSetupKey{
+			Name:                existing.Name,
+			Type:                string(existing.Type),
+			UsageLimit:          existing.UsageLimit,
+			Ephemeral:           existing.Ephemeral,
+			AllowExtraDnsLabels: existing.AllowExtraDNSLabels,
+		}
+		desiredKey := api.AccountDocumentSetupKey{
+			Name:                docKey.Name,
+			Type:                docKey.Type,
+			UsageLimit:          docKey.UsageLimit,
+			Ephemeral:           docKey.Ephemeral,
+			AllowExtraDnsLabels: docKey.AllowExtraDnsLabels,
+		}
+		if !jsonEqual(desiredKey, currentKey) {
+			return nil, nil, errDocument("%s: only auto groups and revoked can be changed, use a new name to replace the key", owner)
+		}
+		if existing.Revoked && !docKey.Revoked {
+			return nil, nil, errDocument("%s: a revoked setup key can't be un-revoked", owner)
+		}
+		if existing.Revoked == docKey.Revoked && slices.Equal(docKey.AutoGroups, r.state.groupIndex.names(existing.AutoGroups)) {
+			continue
+		}
+
+		upserts = append(upserts, r.newChange(api.AccountPlanChangeActionUpdate, api.AccountPlanChangeKindSetupKey, docKey.Name, modules.SetupKeys, func(ctx context.Context) error {
+			groupIDs, err := r.state.groupIndex.ids(docKey.AutoGroups)
+			if err != nil {
+				return err
+			}
+			_, err = r.m.accountManager.SaveSetupKey(ctx, r.accountID, &types.SetupKey{Id: existing.Id, AutoGroups: groupIDs, Revoked: docKey.Revoked}, r.userID)
+			return err
+		}))
+	}
+
+	var deletes []*change
+	for _, name := range sortedStrings(keys(current)) {
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		existing := current[name]
+		deletes = append(deletes, r.newChange(api.AccountPlanChangeActionDelete, api.AccountPlanChangeKindSetupKey, name, modules.SetupKeys, func(ctx context.Context) error {
+			return r.m.accountManager.DeleteSetupKey(ctx, r.accountID, r.userID, existing.Id)
+		}))
+	}
+
+	return upserts, deletes, nil
+}
+
+func (r *reconciler) createSetupKey(ctx context.Context, docKey api.AccountDocumentSetupKey) error {
+	groupIDs, err := r.state.groupIndex.ids(docKey.AutoGroups)
+	if err != nil {
+		return err
+	}
+
+	var expiresIn time.Duration
+	if docKey.ExpiresIn != nil {
+		expiresIn = time.Duration(*docKey.ExpiresIn) * time.Second
+	}
+
+	key, err := r.m.accountManager.CreateSetupKey(ctx, r.accountID, docKey.Name, types.SetupKeyType(docKey.Type), expiresIn,
+		groupIDs, docKey.UsageLimit, r.userID, docKey.Ephemeral, docKey.AllowExtraDnsLabels)
+	if err != nil {
+		return err
+	}
+
+	if !docKey.Revoked {
+		return nil
+	}
+	_, err = r.m.accountManager.SaveSetupKey(ctx, r.accountID, &types.SetupKey{Id: key.Id, AutoGroups: groupIDs, Revoked: true}, r.userID)
+	return err
+}
+
+func (r *reconciler) planPolicies() ([]*change, []*change, error) {
+	if r.doc.Policies == nil {
+		return nil, nil, nil
+	}
+
+	current := make(map[string]*types.Policy)
+	for _, policy := range r.state.policies {
+		current[policy.Name] = policy
+	}
+
+	var upserts []*change
+	seen := make(map[string]struct{})
+	for _, docPolicy := range *r.doc.Policies {
+		if err := checkName("policy", docPolicy.Name, seen); err != nil {
+			return nil, nil, err
+		}
+		if err := r.normalizePolicy(&docPolicy); err != nil {
+			return nil, nil, err
+		}
+
+		existing, ok := current[docPolicy.Name]
+		if !ok {
+			upserts = append(upserts, r.newChange(api.AccountPlanChangeActionCreate, api.AccountPlanChangeKindPolicy, docPolicy.Name, modules.Policies, func(ctx context.Context) error {
+				policy, err := r.toPolicy(docPolicy, nil)
+				if err != nil {
+					return err
+				}
+				_, err = r.m.accountManager.SavePolicy(ctx, r.accountID, r.userID, policy, true)
+				return err
+			}))
+			continue
+		}
+
+		if jsonEqual(docPolicy, r.state.toDocumentPolicy(existing)) {
+			continue
+		}
+		upserts = append(upserts, r.newChange(api.AccountPlanChangeActionUpdate, api.AccountPlanChangeKindPolicy, docPolicy.Name, modules.Policies, func(ctx context.Context) error {
+			policy, err := r.toPolicy(docPolicy, existing)
+			if err != nil {
+				return err
+			}
+			_, err = r.m.accountManager.SavePolicy(ctx, r.accountID, r.userID, policy, false)
+			return err
+		}))
+	}
+
+	var deletes []*change
+	for _, name := range sortedStrings(keys(current)) {
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		existing := current[name]
+		deletes = append(deletes, r.newChange(api.AccountPlanChangeActionDelete, api.AccountPlanChangeKindPolicy, name, modules.Policies, func(ctx context.Context) error {
+			return r.m.accountManager.DeletePolicy(ctx, r.accountID, existing.ID, r.userID)
+		}))
+	}
+
+	return upserts, deletes, nil
+}
+
+// normalizePolicy validates the policy and brings it into the form it is exported in
+func (r *reconciler) normalizePolicy(docPolicy *api.AccountDocumentPolicy) error {
+	owner := "policy " + docPolicy.Name
+
+	if len(docPolicy.Rules) == 0 {
+		return errDocument("%s: rules shouldn't be empty", owner)
+	}
+	if err := checkRefs(owner, "posture check", r.knownPostureChecks, docPolicy.SourcePostureChecks); err != nil {
+		return err
+	}
+	docPolicy.SourcePostureChecks = sortedStrings(docPolicy.SourcePostureChecks)
+
+	rules := make([]api.AccountDocumentPolicyRule, 0, len(docPolicy.Rules))
+	for _, rule := range docPolicy.Rules {
+		switch types.PolicyTrafficActionType(rule.Action) {
+		case types.PolicyTrafficActionAccept, types.PolicyTrafficActionDrop:
+		default:
+			return errDocument("%s: unknown action %s", owner, rule.Action)
+		}
+		switch types.PolicyRuleProtocolType(rule.Protocol) {
+		case types.PolicyRuleProtocolALL, types.PolicyRuleProtocolTCP, types.PolicyRuleProtocolUDP, types.PolicyRuleProtocolICMP:
+		default:
+			return errDocument("%s: unknown protocol %s", owner, rule.Protocol)
+		}
+
+		var err error
+		if rule.Sources, err = r.normalizeRulePeers(owner, rule.Sources, rule.SourceResource); err != nil {
+			return err
+		}
+		if rule.Destinations, err = r.normalizeRulePeers(owner, rule.Destinations, rule.DestinationResource); err != nil {
+			return err
+		}
+
+		if rule.Ports != nil && len(*rule.Ports) == 0 {
+			rule.Ports = nil
+		}
+		if rule.PortRanges != nil && len(*rule.PortRanges) == 0 {
+			rule.PortRanges = nil
+		}
+
+		if rule.Schedule != nil {
+			schedule := &types.PolicyRuleSchedule{}
+			schedule.FromAPIRequest(rule.Schedule)
+			if err = schedule.Validate(); err != nil {
+				return errDocument("%s: invalid schedule of rule %s: %v", owner, rule.Name, err)
+			}
+			rule.Schedule = schedule.ToAPIResponse()
+		}
+
+		rules = append(rules, rule)
+	}
+	docPolicy.Rules = rules
+
+	return nil
+}
+
+// normalizeRulePeers validates the groups or the network resource of a rule side, exactly one of them should be set
+func (r *reconciler) normalizeRulePeers(owner string, groups *[]string, resource *string) (*[]string, error) {
+	if resource != nil {
+		if groups != nil && len(*groups) > 0 {
+			return nil, errDocument("%s: specify either groups or a network resource, not both", owner)
+		}
+		if err := checkRefs(owner, "network resource", r.knownResources, []string{*resource}); err != nil {
+			return nil, err
+		}
+		return nil, nil
+	}
+
+	if groups == nil {
+		return nil, errDocument("%s: specify either groups or a network resource", owner)
+	}
+	if err := checkRefs(owner, "group", r.knownGroups, *groups); err != nil {
+		return nil, err
+	}
+	sorted := sortedStrings(*groups)
+	return &sorted, nil
+}
+
+func (r *reconciler) toPolicy(docPolicy api.AccountDocumentPolicy, existing *types.Policy) (*types.Policy, error) {
+	var err error
+
+	policy := &types.Policy{
+		AccountID:   r.accountID,
+		Name:        docPolicy.Name,
+		Description: docPolicy.Description,
+		Enabled:     docPolicy.Enabled,
+	}
+	if existing != nil {
+		policy.ID = existing.ID
+	}
+	if policy.SourcePostureChecks, err = r.state.postureCheckIndex.ids(docPolicy.SourcePostureChecks); err != nil {
+		return nil, err
+	}
+
+	for i, docRule := range docPolicy.Rules {
+		rule := &types.PolicyRule{
+			PolicyID:      policy.ID,
+			Name:          docRule.Name,
+			Description:   docRule.Description,
+			Enabled:       docRule.Enabled,
+			Action:        types.PolicyTrafficActionType(docRule.Action),
+			Bidirectional: docRule.Bidirectional,
+			Protocol:      types.PolicyRuleProtocolType(docRule.Protocol),
+			Ports:         slices.Clone(sliceValue(docRule.Ports)),
+		}
+		// rules are matched by their position, the policy keeps the IDs of the rules it is updated with
+		if existing != nil && i < len(existing.Rules) {
+			rule.ID = existing.Rules[i].ID
+		}
+
+		if docRule.PortRanges != nil {
+			for _, portRange := range *docRule.PortRanges {
+				rule.PortRanges = append(rule.PortRanges, types.RulePortRange{Start: uint16(portRange.Start), End: uint16(portRange.End)})
+			}
+		}
+
+		if docRule.Schedule != nil {
+			rule.Schedule = &types.PolicyRuleSchedule{}
+			rule.Schedule.FromAPIRequest(docRule.Schedule)
+		}
+
+		if rule.Sources, rule.SourceResource, err = r.toRuleSide(docRule.Sources, docRule.SourceResource); err != nil {
+			return nil, err
+		}
+		if rule.Destinations, rule.DestinationResource, err = r.toRuleSide(docRule.Destinations, docRule.DestinationResource); err != nil {
+			return nil, err
+		}
+
+		policy.Rules = append(policy.Rules, rule)
+	}
+
+	return policy, nil
+}
+
+func (r *reconciler) toRuleSide(groups *[]string, resource *string) ([]string, types.Resource, error) {
+	if resource != nil {
+		id, err := r.state.resourceIndex.id(*resource)
+		if err != nil {
+			return nil, types.Resource{}, err
+		}
+		networkResource, ok := r.resources[id]
+		if !ok {
+			return nil, types.Resource{}, errDocument("network resource %s not found", *resource)
+		}
+		return nil, types.Resource{ID: id, Type: networkResource.Type.String()}, nil
+	}
+
+	groupIDs, err := r.state.groupIndex.ids(sliceValue(groups))
+	return groupIDs, types.Resource{}, err
+}
+
+func (r *reconciler) newChange(action api.AccountPlanChangeAction, kind api.AccountPlanChangeKind, name string, module modules.Module, apply func(ctx context.Context) error) *change {
+	return &change{
+		AccountPlanChange: api.AccountPlanChange{Action: action, Kind: kind, Name: name},
+		module:            module,
+		apply:             apply,
+	}
+}
+
+// checkName makes sure the name is set and wasn't used by another entry of the same section
+func checkName(kind, name string, seen map[string]struct{}) error {
+	if name == "" {
+		return errDocument("%s name shouldn't be empty", kind)
+	}
+	if _, ok := seen[name]; ok {
+		return errDocument("%s %s is defined more than once", kind, name)
+	}
+	seen[name] = struct{}{}
+	return nil
+}
+
+// checkRefs makes sure the referenced objects exist once the document is applied
+func checkRefs(owner, kind string, known map[string]struct{}, names []string) error {
+	for _, name := range names {
+		if _, ok := known[name]; !ok {
+			return errDocument("%s references unknown %s %s", owner, kind, name)
+		}
+	}
+	return nil
+}
+
+// jsonEqual compares the entries in the form they are exported in
+func jsonEqual(a, b any) bool {
+	aJSON, errA := json.Marshal(a)
+	bJSON, errB := json.Marshal(b)
+	return errA == nil && errB == nil && bytes.Equal(aJSON, bJSON)
+}
@@ -11,12 +11,14 @@ import (
 
 	nbdns "github.com/netbirdio/netbird/dns"
 	"github.com/netbirdio/netbird/management/server/activity"
+	blocklistTypes "github.com/netbirdio/netbird/management/server/blocklists/types"
 	routerTypes "github.com/netbirdio/netbird/management/server/networks/routers/types"
 	"github.com/netbirdio/netbird/management/server/permissions/modules"
 	"github.com/netbirdio/netbird/management/server/permissions/operations"
 	"github.com/netbirdio/netbird/management/server/store"
 	"github.com/netbirdio/netbird/management/server/types"
 	"github.com/netbirdio/netbird/management/server/util"
+	zoneTypes "github.com/netbirdio/netbird/management/server/zones/types"
 	"github.com/netbirdio/netbird/route"
 	"github.com/netbirdio/netbird/shared/management/status"
 )
@@ -635,6 +637,14 @@ func validateDeleteGroup(ctx context.Context, transaction store.Store, group *ty
 		return &GroupLinkError{"name server groups", linkedDns.Name}
 	}
 
+	if isLinked, linkedZone := isGroupLinkedToDNSZone(ctx, transaction, group.AccountID, group.ID); isLinked {
+		return &GroupLinkError{"DNS zone", linkedZone.Name}
+	}
+
+	if isLinked, linkedBlocklist := isGroupLinkedToDNSBlocklist(ctx, transaction, group.AccountID, group.ID); isLinked {
+		return &GroupLinkError{"DNS blocklist", linkedBlocklist.Name}
+	}
+
 	if isLinked, linkedPolicy := isGroupLinkedToPolicy(ctx, transaction, group.AccountID, group.ID); isLinked {
 		return &GroupLinkError{"policy", linkedPolicy.Name}
 	}
@@ -734,6 +744,40 @@ func isGroupLinkedToDns(ctx context.Context, transaction store.Store, accountID
 	return false, nil
 }
 
+// isGroupLinkedToDNSZone checks if a group is linked to any DNS zone in the account.
+func isGroupLinkedToDNSZone(ctx context.Context, transaction store.Store, accountID string, groupID string) (bool, *zoneTypes.Zone) {
+	zones, err := transaction.GetAccountDNSZones(ctx, store.LockingStrengthNone, accountID)
+	if err != nil {
+		log.WithContext(ctx).Errorf("error retrieving DNS zones while checking group linkage: %v", err)
+		return false, nil
+	}
+
+	for _, zone := range zones {
+		if slices.Contains(zone.Groups, groupID) {
+			return true, zone
+		}
+	}
+
+	return false, nil
+}
+
+// isGroupLinkedToDNSBlocklist checks if a group is exempt from any DNS blocklist in the account.
+func isGroupLinkedToDNSBlocklist(ctx context.Context, transaction store.Store, accountID string, groupID string) (bool, *blocklistTypes.Blocklist) {
+	blocklists, err := transaction.GetAccountDNSBlocklists(ctx, store.LockingStrengthNone, accountID)
+	if err != nil {
+		log.WithContext(ctx).Errorf("error retrieving DNS blocklists while checking group linkage: %v", err)
+		return false, nil
+	}
+
+	for _, blocklist := range blocklists {
+		if slices.Contains(blocklist.ExemptGroups, groupID) {
+			return true, blocklist
+		}
+	}
+
+	return false, nil
+}
+
 // isGroupLinkedToSetupKey checks if a group is linked to any setup key in the account.
 func isGroupLinkedToSetupKey(ctx context.Context, transaction store.Store, accountID string, groupID string) (bool, *types.SetupKey) {
 	setupKeys, err := transaction.GetAccountSetupKeys(ctx, store.LockingStrengthNone, accountID)
@@ -800,6 +844,12 @@ func areGroupChangesAffectPeers(ctx context.Context, transaction store.Store, ac
 		if linked, _ := isGroupLinkedToDns(ctx, transaction, accountID, groupID); linked {
 			return true, nil
 		}
+		if linked, _ := isGroupLinkedToDNSZone(ctx, transaction, accountID, groupID); linked {
+			return true, nil
+		}
+		if linked, _ := isGroupLinkedToDNSBlocklist(ctx, transaction, accountID, groupID); linked {
+			return true, nil
+		}
 		if linked, _ := isGroupLinkedToPolicy(ctx, transaction, accountID, groupID); linked {
 			return true, nil
 		}
@@ -7,6 +7,7 @@ import (
 	"net"
 	"net/netip"
 	"os"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -19,6 +20,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	integrationsConfig "github.com/netbirdio/management-integrations/integrations/config"
 	nbconfig "github.com/netbirdio/netbird/management/internals/server/config"
@@ -43,6 +45,9 @@ import (
 const (
 	envLogBlockedPeers = "NB_LOG_BLOCKED_PEERS"
 	envBlockPeers      = "NB_BLOCK_SAME_PEERS"
+
+	// defaultFlowInterval is the interval between the event batches of the peers when none is configured
+	defaultFlowInterval = time.Minute
 )
 
 // GRPCServer an instance of a Management gRPC API server
@@ -58,6 +63,7 @@ type GRPCServer struct {
 	ephemeralManager   *EphemeralManager
 	peerLocks          sync.Map
 	authManager        auth.Manager
+	networkMaps        *networkMapTracker
 
 	logBlockedPeers          bool
 	blockPeersWithSameConfig bool
@@ -106,6 +112,7 @@ func NewServer(
 		authManager:              authManager,
 		appMetrics:               appMetrics,
 		ephemeralManager:         ephemeralManager,
+		networkMaps:              newNetworkMapTracker(),
 		logBlockedPeers:          logBlockedPeers,
 		blockPeersWithSameConfig: blockPeersWithSameConfig,
 		integratedPeerValidator:  integratedPeerValidator,
@@ -215,6 +222,9 @@ func (s *GRPCServer) Sync(req *proto.EncryptedMessage, srv proto.ManagementServi
 		return mapError(ctx, err)
 	}
 
+	networkMapState := s.networkMaps.onSync(peerKey.String(), syncReq.GetNetworkMapDeltaSupported(), syncReq.GetNetworkMapSerial())
+	defer s.networkMaps.release(peerKey.String(), networkMapState)
+
 	err = s.sendInitialSync(ctx, peerKey, peer, netMap, postureChecks, srv)
 	if err != nil {
 		log.WithContext(ctx).Debugf("error while sending initial sync for %s: %v", peerKey.String(), err)
@@ -225,7 +235,7 @@ func (s *GRPCServer) Sync(req *proto.EncryptedMessage, srv proto.ManagementServi
 
 	s.ephemeralManager.OnPeerConnected(ctx, peer)
 
-	s.secretsManager.SetupRefresh(ctx, accountID, peer.ID)
+	s.secretsManager.SetupRefresh(ctx, accountID, peer.ID, peer.Key)
 
 	if s.appMetrics != nil {
 		s.appMetrics.GRPCMetrics().CountSyncRequestDuration(time.Since(reqStart), accountID)
@@ -275,7 +285,7 @@ func (s *GRPCServer) handleUpdates(ctx context.Context, accountID string, peerKe
 // sendUpdate encrypts the update message using the peer key and the server's wireguard key,
 // then sends the encrypted message to the connected peer via the sync server.
 func (s *GRPCServer) sendUpdate(ctx context.Context, accountID string, peerKey wgtypes.Key, peer *nbpeer.Peer, update *UpdateMessage, srv proto.ManagementService_SyncServer) error {
-	encryptedResp, err := encryption.EncryptMessage(peerKey, s.wgKey, update.Update)
+	encryptedResp, err := encryption.EncryptMessage(peerKey, s.wgKey, s.networkMaps.prepareUpdate(peerKey.String(), update.Update))
 	if err != nil {
 		s.cancelPeerRoutines(ctx, accountID, peer)
 		return status.Errorf(codes.Internal, "failed processing update message")
@@ -415,6 +425,31 @@ func extractPeerMeta(ctx context.Context, meta *proto.PeerSystemMeta) nbpeer.Pee
 		})
 	}
 
+	var diskEncryption nbpeer.DiskEncryption
+	for _, volume := range meta.GetDiskEncryption().GetVolumes() {
+		diskEncryption.Volumes = append(diskEncryption.Volumes, nbpeer.EncryptedVolume{
+			Path:      volume.GetPath(),
+			Encrypted: volume.GetEncrypted(),
+		})
+	}
+
+	postureCheckResults := make([]nbpeer.PostureCheckResult, 0, len(meta.GetPostureCheckResults()))
+	for _, result := range meta.GetPostureCheckResults() {
+		postureCheckResults = append(postureCheckResults, nbpeer.PostureCheckResult{
+			ID:     result.GetId(),
+			Passed: result.GetPassed(),
+			Reason: result.GetReason(),
+		})
+	}
+
+	relayLatencies := make([]nbpeer.RelayLatency, 0, len(meta.GetRelayLatencies()))
+	for _, latency := range meta.GetRelayLatencies() {
+		relayLatencies = append(relayLatencies, nbpeer.RelayLatency{
+			URL:   latency.GetUrl(),
+			RTTMs: latency.GetRttMs(),
+		})
+	}
+
 	return nbpeer.PeerSystemMeta{
 		Hostname:           meta.GetHostname(),
 		GoOS:               meta.GetGoOS(),
@@ -445,7 +480,13 @@ func extractPeerMeta(ctx context.Context, meta *proto.PeerSystemMeta) nbpeer.Pee
 			BlockInbound:          meta.GetFlags().GetBlockInbound(),
 			LazyConnectionEnabled: meta.GetFlags().GetLazyConnectionEnabled(),
 		},
-		Files: files,
+		Files:          files,
+		DiskEncryption: diskEncryption,
+		HostFirewall: nbpeer.HostFirewall{
+			Active: meta.GetHostFirewall().GetActive(),
+		},
+		PostureCheckResults: postureCheckResults,
+		RelayLatencies:      relayLatencies,
 	}
 }
 
@@ -572,23 +613,31 @@ func (s *GRPCServer) prepareLoginResponse(ctx context.Context, peer *nbpeer.Peer
 	var relayToken *Token
 	var err error
 	if s.config.Relay != nil && len(s.config.Relay.Addresses) > 0 {
-		relayToken, err = s.secretsManager.GenerateRelayToken()
+		relayToken, err = s.secretsManager.GenerateRelayToken(peer.AccountID)
 		if err != nil {
 			log.Errorf("failed generating Relay token: %v", err)
 		}
 	}
 
+	flowToken := s.generateFlowToken(peer.AccountID, peer.Key)
+
 	settings, err := s.settingsManager.GetSettings(ctx, peer.AccountID, activity.SystemInitiator)
 	if err != nil {
 		log.WithContext(ctx).Warnf("failed getting settings for peer %s: %s", peer.Key, err)
 		return nil, status.Errorf(codes.Internal, "failed getting settings")
 	}
 
+	peerGroups, err := getPeerGroupIDs(ctx, s.accountManager.GetStore(), peer.AccountID, peer.ID)
+	if err != nil {
+		log.WithContext(ctx).Warnf("failed getting groups of peer %s: %s", peer.Key, err)
+		return nil, status.Errorf(codes.Internal, "failed getting peer groups")
+	}
+
 	// if peer has reached this point then it has logged in
 	loginResp := &proto.LoginResponse{
-		NetbirdConfig: toNetbirdConfig(s.config, nil, relayToken, nil),
+		NetbirdConfig: toNetbirdConfig(s.config, nil, relayToken, flowToken, nil, settings.RelayRegions(peerGroups)),
 		PeerConfig:    toPeerConfig(peer, netMap.Network, s.accountManager.GetDNSDomain(settings), settings),
-		Checks:        toProtocolChecks(ctx, postureChecks),
+		Checks:        toProtocolChecks(ctx, postureChecks, peer.Meta.GoOS),
 	}
 
 	return loginResp, nil
@@ -636,7 +685,7 @@ func ToResponseProto(configProto nbconfig.Protocol) proto.HostConfig_Protocol {
 	}
 }
 
-func toNetbirdConfig(config *nbconfig.Config, turnCredentials *Token, relayToken *Token, extraSettings *types.ExtraSettings) *proto.NetbirdConfig {
+func toNetbirdConfig(config *nbconfig.Config, turnCredentials *Token, relayToken *Token, flowToken *Token, extraSettings *types.ExtraSettings, relayRegions []string) *proto.NetbirdConfig {
 	if config == nil {
 		return nil
 	}
@@ -674,9 +723,7 @@ func toNetbirdConfig(config *nbconfig.Config, turnCredentials *Token, relayToken
 
 	var relayCfg *proto.RelayConfig
 	if config.Relay != nil && len(config.Relay.Addresses) > 0 {
-		relayCfg = &proto.RelayConfig{
-			Urls: config.Relay.Addresses,
-		}
+		relayCfg = toRelayConfig(config.Relay, relayRegions)
 
 		if relayToken != nil {
 			relayCfg.TokenPayload = relayToken.Payload
@@ -692,16 +739,69 @@ func toNetbirdConfig(config *nbconfig.Config, turnCredentials *Token, relayToken
 		}
 	}
 
+	var flowCfg *proto.FlowConfig
+	if config.Flow != nil && flowToken != nil {
+		flowCfg = toFlowConfig(config.Flow, flowToken)
+	}
+
 	nbConfig := &proto.NetbirdConfig{
 		Stuns:  stuns,
 		Turns:  turns,
 		Signal: signalCfg,
 		Relay:  relayCfg,
+		Flow:   flowCfg,
 	}
 
 	return nbConfig
 }
 
+// toRelayConfig returns the relay servers offered to a peer pinned to the regions. When none of the relay servers is in
+// the regions, the peer is offered all of them, so it is not left without a relay.
+func toRelayConfig(relay *nbconfig.Relay, regions []string) *proto.RelayConfig {
+	metadata := make(map[string]nbconfig.RelayServer, len(relay.Servers))
+	for _, server := range relay.Servers {
+		metadata[server.Address] = server
+	}
+
+	relayCfg := &proto.RelayConfig{}
+	for _, address := range relay.Addresses {
+		server := metadata[address]
+		if len(regions) > 0 && !slices.Contains(regions, server.Region) {
+			continue
+		}
+		relayCfg.Urls = append(relayCfg.Urls, address)
+		relayCfg.Servers = append(relayCfg.Servers, &proto.RelayServer{
+			Url:    address,
+			Region: server.Region,
+			Weight: server.Weight,
+		})
+	}
+
+	if len(relayCfg.Urls) == 0 && len(regions) > 0 {
+		return toRelayConfig(relay, nil)
+	}
+	return relayCfg
+}
+
+// toFlowConfig returns the flow config that enables the event collection of a peer with the built-in flow receiver
+func toFlowConfig(flow *nbconfig.Flow, flowToken *Token) *proto.FlowConfig {
+	interval := flow.Interval.Duration
+	if interval <= 0 {
+		interval = defaultFlowInterval
+	}
+
+	return &proto.FlowConfig{
+		Url:                flow.URL,
+		TokenPayload:       flowToken.Payload,
+		TokenSignature:     flowToken.Signature,
+		Interval:           durationpb.New(interval),
+		Enabled:            true,
+		Counters:           flow.Counters,
+		ExitNodeCollection: flow.ExitNodeCollection,
+		DnsCollection:      flow.DNSCollection,
+	}
+}
+
 func toPeerConfig(peer *nbpeer.Peer, network *types.Network, dnsName string, settings *types.Settings) *proto.PeerConfig {
 	netmask, _ := network.Net.Mask.Size()
 	fqdn := peer.FQDN(dnsName)
@@ -714,7 +814,7 @@ func toPeerConfig(peer *nbpeer.Peer, network *types.Network, dnsName string, set
 	}
 }
 
-func toSyncResponse(ctx context.Context, config *nbconfig.Config, peer *nbpeer.Peer, turnCredentials *Token, relayCredentials *Token, networkMap *types.NetworkMap, dnsName string, checks []*posture.Checks, dnsCache *DNSConfigCache, settings *types.Settings, extraSettings *types.ExtraSettings, peerGroups []string) *proto.SyncResponse {
+func toSyncResponse(ctx context.Context, config *nbconfig.Config, peer *nbpeer.Peer, turnCredentials *Token, relayCredentials *Token, flowCredentials *Token, networkMap *types.NetworkMap, dnsName string, checks []*posture.Checks, dnsCache *DNSConfigCache, settings *types.Settings, extraSettings *types.ExtraSettings, peerGroups []string) *proto.SyncResponse {
 	response := &proto.SyncResponse{
 		PeerConfig: toPeerConfig(peer, networkMap.Network, dnsName, settings),
 		NetworkMap: &proto.NetworkMap{
@@ -722,10 +822,10 @@ func toSyncResponse(ctx context.Context, config *nbconfig.Config, peer *nbpeer.P
 			Routes:    toProtocolRoutes(networkMap.Routes),
 			DNSConfig: toProtocolDNSConfig(networkMap.DNSConfig, dnsCache),
 		},
-		Checks: toProtocolChecks(ctx, checks),
+		Checks: toProtocolChecks(ctx, checks, peer.Meta.GoOS),
 	}
 
-	nbConfig := toNetbirdConfig(config, turnCredentials, relayCredentials, extraSettings)
+	nbConfig := toNetbirdConfig(config, turnCredentials, relayCredentials, flowCredentials, extraSettings, settings.RelayRegions(peerGroups))
 	extendedConfig := integrationsConfig.ExtendNetBirdConfig(peer.ID, peerGroups, nbConfig, extraSettings)
 	response.NetbirdConfig = extendedConfig
 
@@ -762,21 +862,51 @@ func toSyncResponse(ctx context.Context, config *nbconfig.Config, peer *nbpeer.P
 func appendRemotePeerConfig(dst []*proto.RemotePeerConfig, peers []*nbpeer.Peer, dnsName string) []*proto.RemotePeerConfig {
 	for _, rPeer := range peers {
 		dst = append(dst, &proto.RemotePeerConfig{
-			WgPubKey:     rPeer.Key,
-			AllowedIps:   []string{rPeer.IP.String() + "/32"},
-			SshConfig:    &proto.SSHConfig{SshPubKey: []byte(rPeer.SSHKey)},
-			Fqdn:         rPeer.FQDN(dnsName),
-			AgentVersion: rPeer.Meta.WtVersion,
+			WgPubKey:       rPeer.Key,
+			AllowedIps:     []string{rPeer.IP.String() + "/32"},
+			SshConfig:      &proto.SSHConfig{SshPubKey: []byte(rPeer.SSHKey)},
+			Fqdn:           rPeer.FQDN(dnsName),
+			AgentVersion:   rPeer.Meta.WtVersion,
+			RelayLatencies: toProtocolRelayLatencies(rPeer.Meta.RelayLatencies),
 		})
 	}
 	return dst
 }
 
+func toProtocolRelayLatencies(latencies []nbpeer.RelayLatency) []*proto.RelayLatency {
+	if len(latencies) == 0 {
+		return nil
+	}
+
+	protoLatencies := make([]*proto.RelayLatency, 0, len(latencies))
+	for _, latency := range latencies {
+		protoLatencies = append(protoLatencies, &proto.RelayLatency{
+			Url:   latency.URL,
+			RttMs: latency.RTTMs,
+		})
+	}
+	return protoLatencies
+}
+
 // IsHealthy indicates whether the service is healthy
 func (s *GRPCServer) IsHealthy(ctx context.Context, req *proto.Empty) (*proto.Empty, error) {
 	return &proto.Empty{}, nil
 }
 
+// generateFlowToken returns the flow receiver credentials of the account or nil when the flow receiver is not configured
+func (s *GRPCServer) generateFlowToken(accountID, peerKey string) *Token {
+	if s.config.Flow == nil {
+		return nil
+	}
+
+	flowToken, err := s.secretsManager.GenerateFlowToken(accountID, peerKey)
+	if err != nil {
+		log.Errorf("failed generating flow token: %v", err)
+		return nil
+	}
+	return flowToken
+}
+
 // sendInitialSync sends initial proto.SyncResponse to the peer requesting synchronization
 func (s *GRPCServer) sendInitialSync(ctx context.Context, peerKey wgtypes.Key, peer *nbpeer.Peer, networkMap *types.NetworkMap, postureChecks []*posture.Checks, srv proto.ManagementService_SyncServer) error {
 	var err error
@@ -791,12 +921,14 @@ func (s *GRPCServer) sendInitialSync(ctx context.Context, peerKey wgtypes.Key, p
 
 	var relayToken *Token
 	if s.config.Relay != nil && len(s.config.Relay.Addresses) > 0 {
-		relayToken, err = s.secretsManager.GenerateRelayToken()
+		relayToken, err = s.secretsManager.GenerateRelayToken(peer.AccountID)
 		if err != nil {
 			log.Errorf("failed generating Relay token: %v", err)
 		}
 	}
 
+	flowToken := s.generateFlowToken(peer.AccountID, peer.Key)
+
 	settings, err := s.settingsManager.GetSettings(ctx, peer.AccountID, activity.SystemInitiator)
 	if err != nil {
 		return status.Errorf(codes.Internal, "error handling request")
@@ -807,9 +939,9 @@ func (s *GRPCServer) sendInitialSync(ctx context.Context, peerKey wgtypes.Key, p
 		return status.Errorf(codes.Internal, "failed to get peer groups %s", err)
 	}
 
-	plainResp := toSyncResponse(ctx, s.config, peer, turnToken, relayToken, networkMap, s.accountManager.GetDNSDomain(settings), postureChecks, nil, settings, settings.Extra, peerGroups)
+	plainResp := toSyncResponse(ctx, s.config, peer, turnToken, relayToken, flowToken, networkMap, s.accountManager.GetDNSDomain(settings), postureChecks, nil, settings, settings.Extra, peerGroups)
 
-	encryptedResp, err := encryption.EncryptMessage(peerKey, s.wgKey, plainResp)
+	encryptedResp, err := encryption.EncryptMessage(peerKey, s.wgKey, s.networkMaps.prepareUpdate(peerKey.String(), plainResp))
 	if err != nil {
 		return status.Errorf(codes.Internal, "error handling request")
 	}
@@ -1000,24 +1132,44 @@ func (s *GRPCServer) Logout(ctx context.Context, req *proto.EncryptedMessage) (*
 		return nil, mapError(ctx, err)
 	}
 
+	s.networkMaps.remove(peerKey.String())
+
 	log.WithContext(ctx).Debugf("peer %s logged out successfully after %s", peerKey.String(), time.Since(start))
 
 	return &proto.Empty{}, nil
 }
 
-// toProtocolChecks converts posture checks to protocol checks.
-func toProtocolChecks(ctx context.Context, postureChecks []*posture.Checks) []*proto.Checks {
+// AckNetworkMap endpoint is used by peers to confirm that a network map received over the Sync stream was applied.
+// Only acknowledged network maps are used as a base for NetworkMapDelta updates.
+func (s *GRPCServer) AckNetworkMap(ctx context.Context, req *proto.EncryptedMessage) (*proto.Empty, error) {
+	ackReq := &proto.NetworkMapAck{}
+	peerKey, err := s.parseRequest(ctx, req, ackReq)
+	if err != nil {
+		return nil, err
+	}
+
+	log.WithContext(ctx).Tracef("peer %s acknowledged network map with serial %d", peerKey.String(), ackReq.GetSerial())
+
+	s.networkMaps.ack(peerKey.String(), ackReq.GetSerial())
+
+	return &proto.Empty{}, nil
+}
+
+// toProtocolChecks converts posture checks to protocol checks evaluated by a peer running the given operating system.
+func toProtocolChecks(ctx context.Context, postureChecks []*posture.Checks, goOS string) []*proto.Checks {
 	protoChecks := make([]*proto.Checks, 0, len(postureChecks))
 	for _, postureCheck := range postureChecks {
-		protoChecks = append(protoChecks, toProtocolCheck(postureCheck))
+		protoChecks = append(protoChecks, toProtocolCheck(postureCheck, goOS))
 	}
 
 	return protoChecks
 }
 
 // toProtocolCheck converts a posture.Checks to a proto.Checks.
-func toProtocolCheck(postureCheck *posture.Checks) *proto.Checks {
-	protoCheck := &proto.Checks{}
+func toProtocolCheck(postureCheck *posture.Checks, goOS string) *proto.Checks {
+	protoCheck := &proto.Checks{
+		ID: postureCheck.ID,
+	}
 
 	if check := postureCheck.Checks.ProcessCheck; check != nil {
 		for _, process := range check.Processes {
@@ -1030,8 +1182,19 @@ func toProtocolCheck(postureCheck *posture.Checks) *proto.Checks {
 			if process.WindowsPath != "" {
 				protoCheck.Files = append(protoCheck.Files, process.WindowsPath)
 			}
+			protoCheck.Processes = append(protoCheck.Processes, process.PathForOS(goOS))
 		}
 	}
 
+	if check := postureCheck.Checks.DiskEncryptionCheck; check != nil {
+		protoCheck.DiskEncryption = true
+		protoCheck.DiskEncryptionVolumes = check.Volumes
+	}
+
+	if check := postureCheck.Checks.HostFirewallCheck; check != nil {
+		protoCheck.HostFirewall = true
+		protoCheck.HostFirewalls = check.Firewalls
+	}
+
 	return protoCheck
 }
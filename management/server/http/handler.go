@@ -17,25 +17,41 @@ import (
 	"github.com/netbirdio/netbird/management/server/permissions"
 
 	"github.com/netbirdio/netbird/management/server/auth"
+	nbblocklists "github.com/netbirdio/netbird/management/server/blocklists"
+	nbeventsinks "github.com/netbirdio/netbird/management/server/eventsinks"
+	nbflows "github.com/netbirdio/netbird/management/server/flows"
 	"github.com/netbirdio/netbird/management/server/geolocation"
+	nbgitops "github.com/netbirdio/netbird/management/server/gitops"
 	nbgroups "github.com/netbirdio/netbird/management/server/groups"
 	"github.com/netbirdio/netbird/management/server/http/handlers/accounts"
+	"github.com/netbirdio/netbird/management/server/http/handlers/blocklists"
 	"github.com/netbirdio/netbird/management/server/http/handlers/dns"
 	"github.com/netbirdio/netbird/management/server/http/handlers/events"
+	"github.com/netbirdio/netbird/management/server/http/handlers/eventsinks"
+	"github.com/netbirdio/netbird/management/server/http/handlers/flows"
+	"github.com/netbirdio/netbird/management/server/http/handlers/gitops"
 	"github.com/netbirdio/netbird/management/server/http/handlers/groups"
+	"github.com/netbirdio/netbird/management/server/http/handlers/ingress"
 	"github.com/netbirdio/netbird/management/server/http/handlers/networks"
 	"github.com/netbirdio/netbird/management/server/http/handlers/peers"
 	"github.com/netbirdio/netbird/management/server/http/handlers/policies"
+	"github.com/netbirdio/netbird/management/server/http/handlers/roles"
 	"github.com/netbirdio/netbird/management/server/http/handlers/routes"
+	"github.com/netbirdio/netbird/management/server/http/handlers/scim"
 	"github.com/netbirdio/netbird/management/server/http/handlers/setup_keys"
 	"github.com/netbirdio/netbird/management/server/http/handlers/users"
+	"github.com/netbirdio/netbird/management/server/http/handlers/zones"
 	"github.com/netbirdio/netbird/management/server/http/middleware"
+	nbingress "github.com/netbirdio/netbird/management/server/ingress"
 	"github.com/netbirdio/netbird/management/server/integrations/integrated_validator"
 	nbnetworks "github.com/netbirdio/netbird/management/server/networks"
 	"github.com/netbirdio/netbird/management/server/networks/resources"
 	"github.com/netbirdio/netbird/management/server/networks/routers"
 	nbpeers "github.com/netbirdio/netbird/management/server/peers"
+	nbroles "github.com/netbirdio/netbird/management/server/roles"
+	nbscim "github.com/netbirdio/netbird/management/server/scim"
 	"github.com/netbirdio/netbird/management/server/telemetry"
+	nbzones "github.com/netbirdio/netbird/management/server/zones"
 )
 
 const apiPrefix = "/api"
@@ -56,6 +72,10 @@ func NewAPIHandler(
 	permissionsManager permissions.Manager,
 	peersManager nbpeers.Manager,
 	settingsManager settings.Manager,
+	rolesManager nbroles.Manager,
+	blocklistsManager nbblocklists.Manager,
+	ingressManager nbingress.Manager,
+	eventSinksManager nbeventsinks.Manager,
 ) (http.Handler, error) {
 
 	authMiddleware := middleware.NewAuthMiddleware(
@@ -89,8 +109,18 @@ func NewAPIHandler(
 	groups.AddEndpoints(accountManager, router)
 	routes.AddEndpoints(accountManager, router)
 	dns.AddEndpoints(accountManager, router)
+	zones.AddEndpoints(nbzones.NewManager(accountManager.GetStore(), accountManager, permissionsManager), router)
+	blocklists.AddEndpoints(blocklistsManager, router)
+	ingress.AddEndpoints(ingressManager, peersManager, router)
 	events.AddEndpoints(accountManager, router)
+	eventsinks.AddEndpoints(eventSinksManager, router)
+	flows.AddEndpoints(nbflows.NewManager(accountManager.GetStore(), permissionsManager), router)
 	networks.AddEndpoints(networksManager, resourceManager, routerManager, groupsManager, accountManager, router)
+	roles.AddEndpoints(rolesManager, router)
+	gitops.AddEndpoints(nbgitops.NewManager(accountManager, networksManager, resourceManager, routerManager, permissionsManager), router)
+	if err := scim.AddEndpoints(nbscim.NewManager(accountManager.GetStore(), accountManager, permissionsManager), prefix, router); err != nil {
+		return nil, fmt.Errorf("register SCIM endpoints: %w", err)
+	}
 
 	return rootRouter, nil
 }
@@ -226,6 +226,20 @@ func (h *handler) updateAccount(w http.ResponseWriter, r *http.Request) {
 	if req.Settings.LazyConnectionEnabled != nil {
 		settings.LazyConnectionEnabled = *req.Settings.LazyConnectionEnabled
 	}
+	if req.Settings.RelayRegionPins != nil {
+		for _, pin := range *req.Settings.RelayRegionPins {
+			settings.RelayRegionPins = append(settings.RelayRegionPins, types.RelayRegionPin{
+				Region: pin.Region,
+				Groups: pin.Groups,
+			})
+		}
+	}
+	if req.Settings.EventRetentionDays != nil {
+		settings.EventRetentionDays = *req.Settings.EventRetentionDays
+	}
+	if req.Settings.EventArchiveEnabled != nil {
+		settings.EventArchiveEnabled = *req.Settings.EventArchiveEnabled
+	}
 	if req.Settings.NetworkRange != nil && *req.Settings.NetworkRange != "" {
 		prefix, err := netip.ParsePrefix(*req.Settings.NetworkRange)
 		if err != nil {
@@ -313,6 +327,19 @@ func toAccountResponse(accountID string, settings *types.Settings, meta *types.A
 		RoutingPeerDnsResolutionEnabled: &settings.RoutingPeerDNSResolutionEnabled,
 		LazyConnectionEnabled:           &settings.LazyConnectionEnabled,
 		DnsDomain:                       &settings.DNSDomain,
+		EventRetentionDays:              &settings.EventRetentionDays,
+		EventArchiveEnabled:             &settings.EventArchiveEnabled,
+	}
+
+	if len(settings.RelayRegionPins) > 0 {
+		relayRegionPins := make([]api.RelayRegionPin, 0, len(settings.RelayRegionPins))
+		for _, pin := range settings.RelayRegionPins {
+			relayRegionPins = append(relayRegionPins, api.RelayRegionPin{
+				Region: pin.Region,
+				Groups: pin.Groups,
+			})
+		}
+		apiSettings.RelayRegionPins = &relayRegionPins
 	}
 
 	if settings.NetworkRange.IsValid() {
@@ -77,6 +77,7 @@ func TestAccounts_AccountsHandler(t *testing.T) {
 
 	sr := func(v string) *string { return &v }
 	br := func(v bool) *bool { return &v }
+	ir := func(v int) *int { return &v }
 
 	handler := initAccountsTestData(t, &types.Account{
 		Id:      accountID,
@@ -120,6 +121,8 @@ func TestAccounts_AccountsHandler(t *testing.T) {
 				RoutingPeerDnsResolutionEnabled: br(false),
 				LazyConnectionEnabled:           br(false),
 				DnsDomain:                       sr(""),
+				EventRetentionDays:              ir(0),
+				EventArchiveEnabled:             br(false),
 			},
 			expectedArray: true,
 			expectedID:    accountID,
@@ -142,6 +145,8 @@ func TestAccounts_AccountsHandler(t *testing.T) {
 				RoutingPeerDnsResolutionEnabled: br(false),
 				LazyConnectionEnabled:           br(false),
 				DnsDomain:                       sr(""),
+				EventRetentionDays:              ir(0),
+				EventArchiveEnabled:             br(false),
 			},
 			expectedArray: false,
 			expectedID:    accountID,
@@ -164,6 +169,8 @@ func TestAccounts_AccountsHandler(t *testing.T) {
 				RoutingPeerDnsResolutionEnabled: br(false),
 				LazyConnectionEnabled:           br(false),
 				DnsDomain:                       sr(""),
+				EventRetentionDays:              ir(0),
+				EventArchiveEnabled:             br(false),
 			},
 			expectedArray: false,
 			expectedID:    accountID,
@@ -186,6 +193,8 @@ func TestAccounts_AccountsHandler(t *testing.T) {
 				RoutingPeerDnsResolutionEnabled: br(false),
 				LazyConnectionEnabled:           br(false),
 				DnsDomain:                       sr(""),
+				EventRetentionDays:              ir(0),
+				EventArchiveEnabled:             br(false),
 			},
 			expectedArray: false,
 			expectedID:    accountID,
@@ -208,6 +217,32 @@ func TestAccounts_AccountsHandler(t *testing.T) {
 				RoutingPeerDnsResolutionEnabled: br(false),
 				LazyConnectionEnabled:           br(false),
 				DnsDomain:                       sr(""),
+				EventRetentionDays:              ir(0),
+				EventArchiveEnabled:             br(false),
+			},
+			expectedArray: false,
+			expectedID:    accountID,
+		},
+		{
+			name:           "PutAccount OK with event retention",
+			expectedBody:   true,
+			requestType:    http.MethodPut,
+			requestPath:    "/api/accounts/" + accountID,
+			requestBody:    bytes.NewBufferString("{\"settings\": {\"peer_login_expiration\": 15552000,\"peer_login_expiration_enabled\": false,\"regular_users_view_blocked\":true,\"event_retention_days\":400,\"event_archive_enabled\":true}}"),
+			expectedStatus: http.StatusOK,
+			expectedSettings: api.AccountSettings{
+				PeerLoginExpiration:             15552000,
+				PeerLoginExpirationEnabled:      false,
+				GroupsPropagationEnabled:        br(false),
+				JwtGroupsClaimName:              sr(""),
+				JwtGroupsEnabled:                br(false),
+				JwtAllowGroups:                  &[]string{},
+				RegularUsersViewBlocked:         true,
+				RoutingPeerDnsResolutionEnabled: br(false),
+				LazyConnectionEnabled:           br(false),
+				DnsDomain:                       sr(""),
+				EventRetentionDays:              ir(400),
+				EventArchiveEnabled:             br(true),
 			},
 			expectedArray: false,
 			expectedID:    accountID,
@@ -0,0 +1,167 @@
+package blocklists
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/netbirdio/netbird/management/server/blocklists"
+	"github.com/netbirdio/netbird/management/server/blocklists/types"
+	nbcontext "github.com/netbirdio/netbird/management/server/context"
+	"github.com/netbirdio/netbird/shared/management/http/api"
+	"github.com/netbirdio/netbird/shared/management/http/util"
+	"github.com/netbirdio/netbird/shared/management/status"
+)
+
+// handler is a handler that manages the DNS blocklists of the account
+type handler struct {
+	blocklistsManager blocklists.Manager
+}
+
+func AddEndpoints(blocklistsManager blocklists.Manager, router *mux.Router) {
+	blocklistsHandler := newHandler(blocklistsManager)
+	router.HandleFunc("/dns/blocklists", blocklistsHandler.getAllBlocklists).Methods("GET", "OPTIONS")
+	router.HandleFunc("/dns/blocklists", blocklistsHandler.createBlocklist).Methods("POST", "OPTIONS")
+	router.HandleFunc("/dns/blocklists/{blocklistId}", blocklistsHandler.getBlocklist).Methods("GET", "OPTIONS")
+	router.HandleFunc("/dns/blocklists/{blocklistId}", blocklistsHandler.updateBlocklist).Methods("PUT", "OPTIONS")
+	router.HandleFunc("/dns/blocklists/{blocklistId}", blocklistsHandler.deleteBlocklist).Methods("DELETE", "OPTIONS")
+}
+
+func newHandler(blocklistsManager blocklists.Manager) *handler {
+	return &handler{
+		blocklistsManager: blocklistsManager,
+	}
+}
+
+func (h *handler) getAllBlocklists(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accountID, userID := userAuth.AccountId, userAuth.UserId
+
+	blocklists, err := h.blocklistsManager.GetAllBlocklists(r.Context(), accountID, userID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	blocklistsResponse := make([]*api.DNSBlocklist, 0, len(blocklists))
+	for _, blocklist := range blocklists {
+		blocklistsResponse = append(blocklistsResponse, blocklist.ToAPIResponse())
+	}
+
+	util.WriteJSONObject(r.Context(), w, blocklistsResponse)
+}
+
+func (h *handler) createBlocklist(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accountID, userID := userAuth.AccountId, userAuth.UserId
+
+	var req api.PostApiDnsBlocklistsJSONRequestBody
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		util.WriteErrorResponse("couldn't parse JSON request", http.StatusBadRequest, w)
+		return
+	}
+
+	blocklist := &types.Blocklist{}
+	blocklist.FromAPIRequest(&req)
+	blocklist.AccountID = accountID
+
+	blocklist, err = h.blocklistsManager.CreateBlocklist(r.Context(), userID, blocklist)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, blocklist.ToAPIResponse())
+}
+
+func (h *handler) getBlocklist(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accountID, userID := userAuth.AccountId, userAuth.UserId
+	blocklistID := mux.Vars(r)["blocklistId"]
+	if len(blocklistID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid blocklist ID"), w)
+		return
+	}
+
+	blocklist, err := h.blocklistsManager.GetBlocklist(r.Context(), accountID, userID, blocklistID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, blocklist.ToAPIResponse())
+}
+
+func (h *handler) updateBlocklist(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accountID, userID := userAuth.AccountId, userAuth.UserId
+	blocklistID := mux.Vars(r)["blocklistId"]
+	if len(blocklistID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid blocklist ID"), w)
+		return
+	}
+
+	var req api.PutApiDnsBlocklistsBlocklistIdJSONRequestBody
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		util.WriteErrorResponse("couldn't parse JSON request", http.StatusBadRequest, w)
+		return
+	}
+
+	blocklist := &types.Blocklist{}
+	blocklist.FromAPIRequest(&req)
+	blocklist.ID = blocklistID
+	blocklist.AccountID = accountID
+
+	blocklist, err = h.blocklistsManager.UpdateBlocklist(r.Context(), userID, blocklist)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, blocklist.ToAPIResponse())
+}
+
+func (h *handler) deleteBlocklist(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accountID, userID := userAuth.AccountId, userAuth.UserId
+	blocklistID := mux.Vars(r)["blocklistId"]
+	if len(blocklistID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid blocklist ID"), w)
+		return
+	}
+
+	if err = h.blocklistsManager.DeleteBlocklist(r.Context(), accountID, userID, blocklistID); err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, util.EmptyObject{})
+}
@@ -79,7 +79,7 @@ func (h *nameserversHandler) createNameserverGroup(w http.ResponseWriter, r *htt
 
 	nsList, err := toServerNSList(req.Nameservers)
 	if err != nil {
-		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid NS servers format"), w)
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid NS servers format: %v", err), w)
 		return
 	}
 
@@ -119,7 +119,7 @@ func (h *nameserversHandler) updateNameserverGroup(w http.ResponseWriter, r *htt
 
 	nsList, err := toServerNSList(req.Nameservers)
 	if err != nil {
-		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid NS servers format"), w)
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid NS servers format: %v", err), w)
 		return
 	}
 
@@ -205,6 +205,14 @@ func toServerNSList(apiNSList []api.Nameserver) ([]nbdns.NameServer, error) {
 		if err != nil {
 			return nil, err
 		}
+		parsed.ServerName = stringValue(apiNS.ServerName)
+		if apiNS.Path != nil {
+			parsed.Path = *apiNS.Path
+		}
+		parsed.Pin = stringValue(apiNS.Pin)
+		if err = parsed.Validate(); err != nil {
+			return nil, err
+		}
 		nsList = append(nsList, parsed)
 	}
 
@@ -215,9 +223,12 @@ func toNameserverGroupResponse(serverNSGroup *nbdns.NameServerGroup) *api.Namese
 	var nsList []api.Nameserver
 	for _, ns := range serverNSGroup.NameServers {
 		apiNS := api.Nameserver{
-			Ip:     ns.IP.String(),
-			NsType: api.NameserverNsType(ns.NSType.String()),
-			Port:   ns.Port,
+			Ip:         ns.IP.String(),
+			NsType:     api.NameserverNsType(ns.NSType.String()),
+			Port:       ns.Port,
+			ServerName: stringPointer(ns.ServerName),
+			Path:       stringPointer(ns.Path),
+			Pin:        stringPointer(ns.Pin),
 		}
 		nsList = append(nsList, apiNS)
 	}
@@ -234,3 +245,18 @@ func toNameserverGroupResponse(serverNSGroup *nbdns.NameServerGroup) *api.Namese
 		SearchDomainsEnabled: serverNSGroup.SearchDomainsEnabled,
 	}
 }
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+// stringPointer returns nil for empty strings to omit them from responses
+func stringPointer(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
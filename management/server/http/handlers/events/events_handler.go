@@ -3,6 +3,10 @@ package events
 import (
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gorilla/mux"
 	log "github.com/sirupsen/logrus"
@@ -12,8 +16,12 @@ import (
 	nbcontext "github.com/netbirdio/netbird/management/server/context"
 	"github.com/netbirdio/netbird/shared/management/http/api"
 	"github.com/netbirdio/netbird/shared/management/http/util"
+	"github.com/netbirdio/netbird/shared/management/status"
 )
 
+// nextCursorHeader is the response header with the cursor of the next page of the audit events
+const nextCursorHeader = "X-Next-Cursor"
+
 // handler HTTP handler
 type handler struct {
 	accountManager account.Manager
@@ -22,7 +30,7 @@ type handler struct {
 func AddEndpoints(accountManager account.Manager, router *mux.Router) {
 	eventsHandler := newHandler(accountManager)
 	router.HandleFunc("/events", eventsHandler.getAllEvents).Methods("GET", "OPTIONS")
-	router.HandleFunc("/events/audit", eventsHandler.getAllEvents).Methods("GET", "OPTIONS")
+	router.HandleFunc("/events/audit", eventsHandler.getAuditEvents).Methods("GET", "OPTIONS")
 }
 
 // newHandler creates a new events handler
@@ -55,6 +63,97 @@ func (h *handler) getAllEvents(w http.ResponseWriter, r *http.Request) {
 	util.WriteJSONObject(r.Context(), w, events)
 }
 
+// getAuditEvents returns a page of the audit events of the given account that match the query filters, the cursor of
+// the next page is set in the nextCursorHeader
+func (h *handler) getAuditEvents(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accountID, userID := userAuth.AccountId, userAuth.UserId
+
+	filter, err := parseEventFilter(r.URL.Query())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accountEvents, next, err := h.accountManager.SearchEvents(r.Context(), accountID, userID, filter)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	events := make([]*api.Event, len(accountEvents))
+	for i, e := range accountEvents {
+		events[i] = toEventResponse(e)
+	}
+
+	if next != nil {
+		w.Header().Set(nextCursorHeader, next.String())
+	}
+
+	util.WriteJSONObject(r.Context(), w, events)
+}
+
+func parseEventFilter(query url.Values) (activity.EventFilter, error) {
+	filter := activity.EventFilter{
+		InitiatorID: query.Get("initiator_id"),
+		TargetID:    query.Get("target_id"),
+		Search:      query.Get("search"),
+		Descending:  true,
+	}
+
+	for _, codes := range query["activity_code"] {
+		for _, code := range strings.Split(codes, ",") {
+			if code == "" {
+				continue
+			}
+			a, ok := activity.ActivityFromStringCode(code)
+			if !ok {
+				return filter, status.Errorf(status.InvalidArgument, "unknown activity code %q", code)
+			}
+			filter.Activities = append(filter.Activities, a)
+		}
+	}
+
+	var err error
+	if filter.From, err = parseTime(query.Get("start_date")); err != nil {
+		return filter, status.Errorf(status.InvalidArgument, "invalid start_date: %v", err)
+	}
+	if filter.To, err = parseTime(query.Get("end_date")); err != nil {
+		return filter, status.Errorf(status.InvalidArgument, "invalid end_date: %v", err)
+	}
+	if !filter.From.IsZero() && !filter.To.IsZero() && filter.To.Before(filter.From) {
+		return filter, status.Errorf(status.InvalidArgument, "end_date should be after start_date")
+	}
+
+	if cursor := query.Get("cursor"); cursor != "" {
+		filter.Cursor, err = activity.ParseEventCursor(cursor)
+		if err != nil {
+			return filter, status.Errorf(status.InvalidArgument, "invalid cursor: %v", err)
+		}
+	}
+
+	if pageSize := query.Get("page_size"); pageSize != "" {
+		filter.Limit, err = strconv.Atoi(pageSize)
+		if err != nil || filter.Limit < 1 || filter.Limit > activity.MaxSearchLimit {
+			return filter, status.Errorf(status.InvalidArgument, "page_size should be between 1 and %d", activity.MaxSearchLimit)
+		}
+	}
+
+	return filter, nil
+}
+
+func parseTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
+
 func toEventResponse(event *activity.Event) *api.Event {
 	meta := make(map[string]string)
 	if event.Meta != nil {
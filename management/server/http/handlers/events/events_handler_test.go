@@ -240,3 +240,90 @@ func TestEvents_GetEvents(t *testing.T) {
 		})
 	}
 }
+
+func TestEvents_GetAuditEvents(t *testing.T) {
+	cursor := &activity.EventCursor{Timestamp: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), ID: 42}
+
+	tt := []struct {
+		name           string
+		requestPath    string
+		expectedStatus int
+		expectedFilter activity.EventFilter
+	}{
+		{
+			name:           "no filters",
+			requestPath:    "/api/events/audit",
+			expectedStatus: http.StatusOK,
+			expectedFilter: activity.EventFilter{Descending: true},
+		},
+		{
+			name:           "all filters",
+			requestPath:    "/api/events/audit?activity_code=peer.user.add,user.join&initiator_id=user&target_id=peer&search=laptop&start_date=2025-01-01T00:00:00Z&end_date=2025-01-31T00:00:00Z&page_size=50&cursor=" + cursor.String(),
+			expectedStatus: http.StatusOK,
+			expectedFilter: activity.EventFilter{
+				Activities:  []activity.Activity{activity.PeerAddedByUser, activity.UserJoined},
+				InitiatorID: "user",
+				TargetID:    "peer",
+				Search:      "laptop",
+				From:        time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+				To:          time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC),
+				Cursor:      cursor,
+				Limit:       50,
+				Descending:  true,
+			},
+		},
+		{
+			name:           "unknown activity code",
+			requestPath:    "/api/events/audit?activity_code=peer.unknown",
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "invalid date",
+			requestPath:    "/api/events/audit?start_date=yesterday",
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "invalid cursor",
+			requestPath:    "/api/events/audit?cursor=invalid",
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "page size too large",
+			requestPath:    "/api/events/audit?page_size=10001",
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			var filter activity.EventFilter
+			handler := &handler{
+				accountManager: &mock_server.MockAccountManager{
+					SearchEventsFunc: func(_ context.Context, _, _ string, f activity.EventFilter) ([]*activity.Event, *activity.EventCursor, error) {
+						filter = f
+						return []*activity.Event{}, cursor, nil
+					},
+				},
+			}
+
+			recorder := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, tc.requestPath, nil)
+			req = nbcontext.SetUserAuthInRequest(req, nbcontext.UserAuth{
+				UserId:    "test_user",
+				AccountId: "test_account",
+			})
+
+			router := mux.NewRouter()
+			router.HandleFunc("/api/events/audit", handler.getAuditEvents).Methods("GET")
+			router.ServeHTTP(recorder, req)
+
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+			if tc.expectedStatus != http.StatusOK {
+				return
+			}
+
+			assert.Equal(t, tc.expectedFilter, filter)
+			assert.Equal(t, cursor.String(), recorder.Header().Get(nextCursorHeader))
+		})
+	}
+}
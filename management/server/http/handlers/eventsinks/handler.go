@@ -0,0 +1,167 @@
+package eventsinks
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	nbcontext "github.com/netbirdio/netbird/management/server/context"
+	"github.com/netbirdio/netbird/management/server/eventsinks"
+	"github.com/netbirdio/netbird/management/server/eventsinks/types"
+	"github.com/netbirdio/netbird/shared/management/http/api"
+	"github.com/netbirdio/netbird/shared/management/http/util"
+	"github.com/netbirdio/netbird/shared/management/status"
+)
+
+// handler is a handler that manages the sinks the activity events of the account are streamed to
+type handler struct {
+	sinksManager eventsinks.Manager
+}
+
+func AddEndpoints(sinksManager eventsinks.Manager, router *mux.Router) {
+	sinksHandler := newHandler(sinksManager)
+	router.HandleFunc("/events/sinks", sinksHandler.getAllSinks).Methods("GET", "OPTIONS")
+	router.HandleFunc("/events/sinks", sinksHandler.createSink).Methods("POST", "OPTIONS")
+	router.HandleFunc("/events/sinks/{sinkId}", sinksHandler.getSink).Methods("GET", "OPTIONS")
+	router.HandleFunc("/events/sinks/{sinkId}", sinksHandler.updateSink).Methods("PUT", "OPTIONS")
+	router.HandleFunc("/events/sinks/{sinkId}", sinksHandler.deleteSink).Methods("DELETE", "OPTIONS")
+}
+
+func newHandler(sinksManager eventsinks.Manager) *handler {
+	return &handler{
+		sinksManager: sinksManager,
+	}
+}
+
+func (h *handler) getAllSinks(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accountID, userID := userAuth.AccountId, userAuth.UserId
+
+	sinks, err := h.sinksManager.GetAllSinks(r.Context(), accountID, userID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	sinksResponse := make([]*api.EventSink, 0, len(sinks))
+	for _, sink := range sinks {
+		sinksResponse = append(sinksResponse, sink.ToAPIResponse(h.sinksManager.GetDeliveryStatus(sink.ID)))
+	}
+
+	util.WriteJSONObject(r.Context(), w, sinksResponse)
+}
+
+func (h *handler) createSink(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accountID, userID := userAuth.AccountId, userAuth.UserId
+
+	var req api.PostApiEventsSinksJSONRequestBody
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		util.WriteErrorResponse("couldn't parse JSON request", http.StatusBadRequest, w)
+		return
+	}
+
+	sink := &types.Sink{}
+	sink.FromAPIRequest(&req)
+	sink.AccountID = accountID
+
+	sink, err = h.sinksManager.CreateSink(r.Context(), userID, sink)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, sink.ToAPIResponse(h.sinksManager.GetDeliveryStatus(sink.ID)))
+}
+
+func (h *handler) getSink(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accountID, userID := userAuth.AccountId, userAuth.UserId
+	sinkID := mux.Vars(r)["sinkId"]
+	if len(sinkID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid event sink ID"), w)
+		return
+	}
+
+	sink, err := h.sinksManager.GetSink(r.Context(), accountID, userID, sinkID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, sink.ToAPIResponse(h.sinksManager.GetDeliveryStatus(sink.ID)))
+}
+
+func (h *handler) updateSink(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accountID, userID := userAuth.AccountId, userAuth.UserId
+	sinkID := mux.Vars(r)["sinkId"]
+	if len(sinkID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid event sink ID"), w)
+		return
+	}
+
+	var req api.PutApiEventsSinksSinkIdJSONRequestBody
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		util.WriteErrorResponse("couldn't parse JSON request", http.StatusBadRequest, w)
+		return
+	}
+
+	sink := &types.Sink{}
+	sink.FromAPIRequest(&req)
+	sink.ID = sinkID
+	sink.AccountID = accountID
+
+	sink, err = h.sinksManager.UpdateSink(r.Context(), userID, sink)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, sink.ToAPIResponse(h.sinksManager.GetDeliveryStatus(sink.ID)))
+}
+
+func (h *handler) deleteSink(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accountID, userID := userAuth.AccountId, userAuth.UserId
+	sinkID := mux.Vars(r)["sinkId"]
+	if len(sinkID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid event sink ID"), w)
+		return
+	}
+
+	if err = h.sinksManager.DeleteSink(r.Context(), accountID, userID, sinkID); err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, util.EmptyObject{})
+}
@@ -0,0 +1,122 @@
+package flows
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	nbcontext "github.com/netbirdio/netbird/management/server/context"
+	"github.com/netbirdio/netbird/management/server/flows"
+	"github.com/netbirdio/netbird/management/server/flows/types"
+	"github.com/netbirdio/netbird/shared/management/http/api"
+	"github.com/netbirdio/netbird/shared/management/http/util"
+	"github.com/netbirdio/netbird/shared/management/status"
+)
+
+// nextCursorHeader is the response header with the cursor of the next page of the flows
+const nextCursorHeader = "X-Next-Cursor"
+
+// handler is a handler that returns the network traffic events reported by the peers of the account
+type handler struct {
+	flowsManager flows.Manager
+}
+
+func AddEndpoints(flowsManager flows.Manager, router *mux.Router) {
+	flowsHandler := newHandler(flowsManager)
+	router.HandleFunc("/events/flows", flowsHandler.getFlows).Methods("GET", "OPTIONS")
+}
+
+func newHandler(flowsManager flows.Manager) *handler {
+	return &handler{
+		flowsManager: flowsManager,
+	}
+}
+
+// getFlows returns a page of the flows of the account that match the query filters, the cursor of the next page is
+// set in the nextCursorHeader
+func (h *handler) getFlows(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accountID, userID := userAuth.AccountId, userAuth.UserId
+
+	filter, err := parseFilter(r.URL.Query())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accountFlows, next, err := h.flowsManager.GetFlows(r.Context(), accountID, userID, filter)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	flowsResponse := make([]*api.FlowEvent, 0, len(accountFlows))
+	for _, flow := range accountFlows {
+		flowsResponse = append(flowsResponse, flow.ToAPIResponse())
+	}
+
+	if next != nil {
+		w.Header().Set(nextCursorHeader, next.String())
+	}
+
+	util.WriteJSONObject(r.Context(), w, flowsResponse)
+}
+
+func parseFilter(query url.Values) (types.Filter, error) {
+	filter := types.Filter{
+		PeerID:     query.Get("peer_id"),
+		ResourceID: query.Get("resource_id"),
+		RuleID:     query.Get("rule_id"),
+	}
+
+	if protocol := query.Get("protocol"); protocol != "" {
+		value, err := strconv.ParseUint(protocol, 10, 8)
+		if err != nil {
+			return filter, status.Errorf(status.InvalidArgument, "protocol should be a number between 0 and 255")
+		}
+		p := uint32(value)
+		filter.Protocol = &p
+	}
+
+	var err error
+	if filter.From, err = parseTime(query.Get("start_date")); err != nil {
+		return filter, status.Errorf(status.InvalidArgument, "invalid start_date: %v", err)
+	}
+	if filter.To, err = parseTime(query.Get("end_date")); err != nil {
+		return filter, status.Errorf(status.InvalidArgument, "invalid end_date: %v", err)
+	}
+	if !filter.From.IsZero() && !filter.To.IsZero() && filter.To.Before(filter.From) {
+		return filter, status.Errorf(status.InvalidArgument, "end_date should be after start_date")
+	}
+
+	if cursor := query.Get("cursor"); cursor != "" {
+		filter.Cursor, err = types.ParseCursor(cursor)
+		if err != nil {
+			return filter, status.Errorf(status.InvalidArgument, "invalid cursor: %v", err)
+		}
+	}
+
+	if pageSize := query.Get("page_size"); pageSize != "" {
+		filter.Limit, err = strconv.Atoi(pageSize)
+		if err != nil || filter.Limit < 1 || filter.Limit > types.MaxSearchLimit {
+			return filter, status.Errorf(status.InvalidArgument, "page_size should be between 1 and %d", types.MaxSearchLimit)
+		}
+	}
+
+	return filter, nil
+}
+
+func parseTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
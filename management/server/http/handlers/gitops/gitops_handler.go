@@ -0,0 +1,100 @@
+package gitops
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	nbcontext "github.com/netbirdio/netbird/management/server/context"
+	"github.com/netbirdio/netbird/management/server/gitops"
+	"github.com/netbirdio/netbird/shared/management/http/api"
+	"github.com/netbirdio/netbird/shared/management/http/util"
+	"github.com/netbirdio/netbird/shared/management/status"
+)
+
+// handler is a handler that exports accounts to documents and applies documents to accounts
+type handler struct {
+	gitopsManager gitops.Manager
+}
+
+func AddEndpoints(gitopsManager gitops.Manager, router *mux.Router) {
+	gitopsHandler := newHandler(gitopsManager)
+	router.HandleFunc("/accounts/{accountId}/export", gitopsHandler.exportAccount).Methods("GET", "OPTIONS")
+	router.HandleFunc("/accounts/{accountId}/apply", gitopsHandler.applyAccount).Methods("POST", "OPTIONS")
+}
+
+func newHandler(gitopsManager gitops.Manager) *handler {
+	return &handler{
+		gitopsManager: gitopsManager,
+	}
+}
+
+// exportAccount is a HTTP GET handler that returns the account document
+func (h *handler) exportAccount(w http.ResponseWriter, r *http.Request) {
+	accountID, userID, err := getAccountAndUser(r)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	document, err := h.gitopsManager.Export(r.Context(), accountID, userID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, document)
+}
+
+// applyAccount is a HTTP POST handler that reconciles the account with a document and returns the planned or applied changes
+func (h *handler) applyAccount(w http.ResponseWriter, r *http.Request) {
+	accountID, userID, err := getAccountAndUser(r)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	dryRun := false
+	if value := r.URL.Query().Get("dry_run"); value != "" {
+		dryRun, err = strconv.ParseBool(value)
+		if err != nil {
+			util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid dry_run value %s", value), w)
+			return
+		}
+	}
+
+	var req api.PostApiAccountsAccountIdApplyJSONRequestBody
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteErrorResponse("couldn't parse JSON request", http.StatusBadRequest, w)
+		return
+	}
+
+	planHash := r.URL.Query().Get("plan_hash")
+	plan, err := h.gitopsManager.Apply(r.Context(), accountID, userID, &req, dryRun, planHash)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, plan)
+}
+
+// getAccountAndUser returns the account of the path, users can only export and apply documents of their own account
+func getAccountAndUser(r *http.Request) (string, string, error) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		return "", "", err
+	}
+
+	accountID := mux.Vars(r)["accountId"]
+	if len(accountID) == 0 {
+		return "", "", status.Errorf(status.InvalidArgument, "invalid account ID")
+	}
+	if accountID != userAuth.AccountId {
+		return "", "", status.NewPermissionDeniedError()
+	}
+
+	return accountID, userAuth.UserId, nil
+}
@@ -0,0 +1,425 @@
+package ingress
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	nbcontext "github.com/netbirdio/netbird/management/server/context"
+	"github.com/netbirdio/netbird/management/server/ingress"
+	"github.com/netbirdio/netbird/management/server/ingress/types"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/peers"
+	"github.com/netbirdio/netbird/shared/management/http/api"
+	"github.com/netbirdio/netbird/shared/management/http/util"
+	"github.com/netbirdio/netbird/shared/management/status"
+)
+
+// handler is a handler that manages the ingress peers and the ingress port allocations of the peers
+type handler struct {
+	ingressManager ingress.Manager
+	peersManager   peers.Manager
+}
+
+func AddEndpoints(ingressManager ingress.Manager, peersManager peers.Manager, router *mux.Router) {
+	ingressHandler := newHandler(ingressManager, peersManager)
+	router.HandleFunc("/ingress/peers", ingressHandler.getAllIngressPeers).Methods("GET", "OPTIONS")
+	router.HandleFunc("/ingress/peers", ingressHandler.createIngressPeer).Methods("POST", "OPTIONS")
+	router.HandleFunc("/ingress/peers/{ingressPeerId}", ingressHandler.getIngressPeer).Methods("GET", "OPTIONS")
+	router.HandleFunc("/ingress/peers/{ingressPeerId}", ingressHandler.updateIngressPeer).Methods("PUT", "OPTIONS")
+	router.HandleFunc("/ingress/peers/{ingressPeerId}", ingressHandler.deleteIngressPeer).Methods("DELETE", "OPTIONS")
+	router.HandleFunc("/peers/{peerId}/ingress/ports", ingressHandler.getAllPortAllocations).Methods("GET", "OPTIONS")
+	router.HandleFunc("/peers/{peerId}/ingress/ports", ingressHandler.createPortAllocation).Methods("POST", "OPTIONS")
+	router.HandleFunc("/peers/{peerId}/ingress/ports/{allocationId}", ingressHandler.getPortAllocation).Methods("GET", "OPTIONS")
+	router.HandleFunc("/peers/{peerId}/ingress/ports/{allocationId}", ingressHandler.updatePortAllocation).Methods("PUT", "OPTIONS")
+	router.HandleFunc("/peers/{peerId}/ingress/ports/{allocationId}", ingressHandler.deletePortAllocation).Methods("DELETE", "OPTIONS")
+}
+
+func newHandler(ingressManager ingress.Manager, peersManager peers.Manager) *handler {
+	return &handler{
+		ingressManager: ingressManager,
+		peersManager:   peersManager,
+	}
+}
+
+func (h *handler) getAllIngressPeers(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accountID, userID := userAuth.AccountId, userAuth.UserId
+
+	ingressPeers, err := h.ingressManager.GetAllIngressPeers(r.Context(), accountID, userID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accountPeers, err := h.getAccountPeers(r.Context(), accountID, userID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	availablePorts, err := h.ingressManager.GetAvailablePorts(r.Context(), accountID, userID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	ingressPeersResponse := make([]*api.IngressPeer, 0, len(ingressPeers))
+	for _, ingressPeer := range ingressPeers {
+		ingressPeersResponse = append(ingressPeersResponse, ingressPeer.ToAPIResponse(accountPeers[ingressPeer.PeerID], availablePorts[ingressPeer.ID]))
+	}
+
+	util.WriteJSONObject(r.Context(), w, ingressPeersResponse)
+}
+
+func (h *handler) createIngressPeer(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accountID, userID := userAuth.AccountId, userAuth.UserId
+
+	var req api.PostApiIngressPeersJSONRequestBody
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		util.WriteErrorResponse("couldn't parse JSON request", http.StatusBadRequest, w)
+		return
+	}
+
+	ingressPeer := &types.IngressPeer{
+		AccountID: accountID,
+		PeerID:    req.PeerId,
+		Enabled:   req.Enabled,
+		Fallback:  req.Fallback,
+	}
+
+	ingressPeer, err = h.ingressManager.CreateIngressPeer(r.Context(), userID, ingressPeer)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	h.writeIngressPeer(w, r, accountID, userID, ingressPeer)
+}
+
+func (h *handler) getIngressPeer(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accountID, userID := userAuth.AccountId, userAuth.UserId
+	ingressPeerID := mux.Vars(r)["ingressPeerId"]
+	if len(ingressPeerID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid ingress peer ID"), w)
+		return
+	}
+
+	ingressPeer, err := h.ingressManager.GetIngressPeer(r.Context(), accountID, userID, ingressPeerID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	h.writeIngressPeer(w, r, accountID, userID, ingressPeer)
+}
+
+func (h *handler) updateIngressPeer(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accountID, userID := userAuth.AccountId, userAuth.UserId
+	ingressPeerID := mux.Vars(r)["ingressPeerId"]
+	if len(ingressPeerID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid ingress peer ID"), w)
+		return
+	}
+
+	var req api.PutApiIngressPeersIngressPeerIdJSONRequestBody
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		util.WriteErrorResponse("couldn't parse JSON request", http.StatusBadRequest, w)
+		return
+	}
+
+	ingressPeer := &types.IngressPeer{
+		ID:        ingressPeerID,
+		AccountID: accountID,
+		Enabled:   req.Enabled,
+		Fallback:  req.Fallback,
+	}
+
+	ingressPeer, err = h.ingressManager.UpdateIngressPeer(r.Context(), userID, ingressPeer)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	h.writeIngressPeer(w, r, accountID, userID, ingressPeer)
+}
+
+func (h *handler) deleteIngressPeer(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accountID, userID := userAuth.AccountId, userAuth.UserId
+	ingressPeerID := mux.Vars(r)["ingressPeerId"]
+	if len(ingressPeerID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid ingress peer ID"), w)
+		return
+	}
+
+	if err = h.ingressManager.DeleteIngressPeer(r.Context(), accountID, userID, ingressPeerID); err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, util.EmptyObject{})
+}
+
+func (h *handler) getAllPortAllocations(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accountID, userID := userAuth.AccountId, userAuth.UserId
+	peerID := mux.Vars(r)["peerId"]
+	if len(peerID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid peer ID"), w)
+		return
+	}
+	nameFilter := r.URL.Query().Get("name")
+
+	allocations, err := h.ingressManager.GetAllPortAllocations(r.Context(), accountID, userID, peerID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	ingressPeers, err := h.getIngressPeerPeers(r.Context(), accountID, userID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	allocationsResponse := make([]*api.IngressPortAllocation, 0, len(allocations))
+	for _, allocation := range allocations {
+		if nameFilter != "" && !strings.EqualFold(allocation.Name, nameFilter) {
+			continue
+		}
+		allocationsResponse = append(allocationsResponse, allocation.ToAPIResponse(ingressPeers[allocation.IngressPeerID]))
+	}
+
+	util.WriteJSONObject(r.Context(), w, allocationsResponse)
+}
+
+func (h *handler) createPortAllocation(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accountID, userID := userAuth.AccountId, userAuth.UserId
+	peerID := mux.Vars(r)["peerId"]
+	if len(peerID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid peer ID"), w)
+		return
+	}
+
+	var req api.PostApiPeersPeerIdIngressPortsJSONRequestBody
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		util.WriteErrorResponse("couldn't parse JSON request", http.StatusBadRequest, w)
+		return
+	}
+
+	allocationReq, err := types.AllocationRequestFromAPI(&req)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	allocation, err := h.ingressManager.CreatePortAllocation(r.Context(), accountID, userID, peerID, allocationReq)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	h.writePortAllocation(w, r, accountID, userID, allocation)
+}
+
+func (h *handler) getPortAllocation(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accountID, userID := userAuth.AccountId, userAuth.UserId
+	peerID, allocationID, ok := allocationVars(w, r)
+	if !ok {
+		return
+	}
+
+	allocation, err := h.ingressManager.GetPortAllocation(r.Context(), accountID, userID, peerID, allocationID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	h.writePortAllocation(w, r, accountID, userID, allocation)
+}
+
+func (h *handler) updatePortAllocation(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accountID, userID := userAuth.AccountId, userAuth.UserId
+	peerID, allocationID, ok := allocationVars(w, r)
+	if !ok {
+		return
+	}
+
+	var req api.PutApiPeersPeerIdIngressPortsAllocationIdJSONRequestBody
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		util.WriteErrorResponse("couldn't parse JSON request", http.StatusBadRequest, w)
+		return
+	}
+
+	allocationReq, err := types.AllocationRequestFromAPI(&req)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	allocation, err := h.ingressManager.UpdatePortAllocation(r.Context(), accountID, userID, peerID, allocationID, allocationReq)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	h.writePortAllocation(w, r, accountID, userID, allocation)
+}
+
+func (h *handler) deletePortAllocation(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accountID, userID := userAuth.AccountId, userAuth.UserId
+	peerID, allocationID, ok := allocationVars(w, r)
+	if !ok {
+		return
+	}
+
+	if err = h.ingressManager.DeletePortAllocation(r.Context(), accountID, userID, peerID, allocationID); err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, util.EmptyObject{})
+}
+
+func (h *handler) writeIngressPeer(w http.ResponseWriter, r *http.Request, accountID, userID string, ingressPeer *types.IngressPeer) {
+	peer, err := h.peersManager.GetPeer(r.Context(), accountID, userID, ingressPeer.PeerID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	availablePorts, err := h.ingressManager.GetAvailablePorts(r.Context(), accountID, userID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, ingressPeer.ToAPIResponse(peer, availablePorts[ingressPeer.ID]))
+}
+
+func (h *handler) writePortAllocation(w http.ResponseWriter, r *http.Request, accountID, userID string, allocation *types.PortAllocation) {
+	ingressPeer, err := h.ingressManager.GetIngressPeer(r.Context(), accountID, userID, allocation.IngressPeerID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	peer, err := h.peersManager.GetPeer(r.Context(), accountID, userID, ingressPeer.PeerID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, allocation.ToAPIResponse(peer))
+}
+
+func (h *handler) getAccountPeers(ctx context.Context, accountID, userID string) (map[string]*nbpeer.Peer, error) {
+	accountPeers, err := h.peersManager.GetAllPeers(ctx, accountID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	peersByID := make(map[string]*nbpeer.Peer, len(accountPeers))
+	for _, peer := range accountPeers {
+		peersByID[peer.ID] = peer
+	}
+	return peersByID, nil
+}
+
+// getIngressPeerPeers returns the peers of the ingress peers by ingress peer ID
+func (h *handler) getIngressPeerPeers(ctx context.Context, accountID, userID string) (map[string]*nbpeer.Peer, error) {
+	ingressPeers, err := h.ingressManager.GetAllIngressPeers(ctx, accountID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	accountPeers, err := h.getAccountPeers(ctx, accountID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	peersByIngressPeerID := make(map[string]*nbpeer.Peer, len(ingressPeers))
+	for _, ingressPeer := range ingressPeers {
+		peersByIngressPeerID[ingressPeer.ID] = accountPeers[ingressPeer.PeerID]
+	}
+	return peersByIngressPeerID, nil
+}
+
+func allocationVars(w http.ResponseWriter, r *http.Request) (string, string, bool) {
+	vars := mux.Vars(r)
+	peerID, allocationID := vars["peerId"], vars["allocationId"]
+	if len(peerID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid peer ID"), w)
+		return "", "", false
+	}
+	if len(allocationID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid allocation ID"), w)
+		return "", "", false
+	}
+	return peerID, allocationID, true
+}
@@ -3,6 +3,7 @@ package policies
 import (
 	"encoding/json"
 	"net/http"
+	"net/netip"
 	"strconv"
 
 	"github.com/gorilla/mux"
@@ -25,6 +26,8 @@ func AddEndpoints(accountManager account.Manager, locationManager geolocation.Ge
 	policiesHandler := newHandler(accountManager)
 	router.HandleFunc("/policies", policiesHandler.getAllPolicies).Methods("GET", "OPTIONS")
 	router.HandleFunc("/policies", policiesHandler.createPolicy).Methods("POST", "OPTIONS")
+	router.HandleFunc("/policies/check", policiesHandler.checkAccess).Methods("POST", "OPTIONS")
+	router.HandleFunc("/policies/evaluate", policiesHandler.evaluatePolicies).Methods("POST", "OPTIONS")
 	router.HandleFunc("/policies/{policyId}", policiesHandler.updatePolicy).Methods("PUT", "OPTIONS")
 	router.HandleFunc("/policies/{policyId}", policiesHandler.getPolicy).Methods("GET", "OPTIONS")
 	router.HandleFunc("/policies/{policyId}", policiesHandler.deletePolicy).Methods("DELETE", "OPTIONS")
@@ -119,16 +122,43 @@ func (h *handler) savePolicy(w http.ResponseWriter, r *http.Request, accountID s
 		return
 	}
 
-	if req.Name == "" {
-		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "policy name shouldn't be empty"), w)
+	policy, err := toPolicy(accountID, policyID, api.PolicyUpdate(req))
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
 		return
 	}
 
-	if len(req.Rules) == 0 {
-		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "policy rules shouldn't be empty"), w)
+	policy, err = h.accountManager.SavePolicy(r.Context(), accountID, userID, policy, create)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
 		return
 	}
 
+	allGroups, err := h.accountManager.GetAllGroups(r.Context(), accountID, userID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	resp := toPolicyResponse(allGroups, policy)
+	if len(resp.Rules) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.Internal, "no rules in the policy"), w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, resp)
+}
+
+// toPolicy validates the policy request and converts it to a policy
+func toPolicy(accountID, policyID string, req api.PolicyUpdate) (*types.Policy, error) {
+	if req.Name == "" {
+		return nil, status.Errorf(status.InvalidArgument, "policy name shouldn't be empty")
+	}
+
+	if len(req.Rules) == 0 {
+		return nil, status.Errorf(status.InvalidArgument, "policy rules shouldn't be empty")
+	}
+
 	description := ""
 	if req.Description != nil {
 		description = *req.Description
@@ -154,18 +184,15 @@ func (h *handler) savePolicy(w http.ResponseWriter, r *http.Request, accountID s
 		hasDestinationResource := rule.DestinationResource != nil
 
 		if hasSources && hasSourceResource {
-			util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "specify either sources or  source resources, not both"), w)
-			return
+			return nil, status.Errorf(status.InvalidArgument, "specify either sources or  source resources, not both")
 		}
 
 		if hasDestinations && hasDestinationResource {
-			util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "specify either destinations or  destination resources, not both"), w)
-			return
+			return nil, status.Errorf(status.InvalidArgument, "specify either destinations or  destination resources, not both")
 		}
 
 		if !(hasSources || hasSourceResource) || !(hasDestinations || hasDestinationResource) {
-			util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "specify either sources or source resources and destinations or destination resources"), w)
-			return
+			return nil, status.Errorf(status.InvalidArgument, "specify either sources or source resources and destinations or destination resources")
 		}
 
 		pr := types.PolicyRule{
@@ -208,8 +235,7 @@ func (h *handler) savePolicy(w http.ResponseWriter, r *http.Request, accountID s
 		case api.PolicyRuleUpdateActionDrop:
 			pr.Action = types.PolicyTrafficActionDrop
 		default:
-			util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "unknown action type"), w)
-			return
+			return nil, status.Errorf(status.InvalidArgument, "unknown action type")
 		}
 
 		switch rule.Protocol {
@@ -222,20 +248,17 @@ func (h *handler) savePolicy(w http.ResponseWriter, r *http.Request, accountID s
 		case api.PolicyRuleUpdateProtocolIcmp:
 			pr.Protocol = types.PolicyRuleProtocolICMP
 		default:
-			util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "unknown protocol type: %v", rule.Protocol), w)
-			return
+			return nil, status.Errorf(status.InvalidArgument, "unknown protocol type: %v", rule.Protocol)
 		}
 
 		if (rule.Ports != nil && len(*rule.Ports) != 0) && (rule.PortRanges != nil && len(*rule.PortRanges) != 0) {
-			util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "specify either individual ports or port ranges, not both"), w)
-			return
+			return nil, status.Errorf(status.InvalidArgument, "specify either individual ports or port ranges, not both")
 		}
 
 		if rule.Ports != nil && len(*rule.Ports) != 0 {
 			for _, v := range *rule.Ports {
 				if port, err := strconv.Atoi(v); err != nil || port < 1 || port > 65535 {
-					util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "valid port value is in 1..65535 range"), w)
-					return
+					return nil, status.Errorf(status.InvalidArgument, "valid port value is in 1..65535 range")
 				}
 				pr.Ports = append(pr.Ports, v)
 			}
@@ -244,8 +267,7 @@ func (h *handler) savePolicy(w http.ResponseWriter, r *http.Request, accountID s
 		if rule.PortRanges != nil && len(*rule.PortRanges) != 0 {
 			for _, portRange := range *rule.PortRanges {
 				if portRange.Start < 1 || portRange.End > 65535 {
-					util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "valid port value is in 1..65535 range"), w)
-					return
+					return nil, status.Errorf(status.InvalidArgument, "valid port value is in 1..65535 range")
 				}
 				pr.PortRanges = append(pr.PortRanges, types.RulePortRange{
 					Start: uint16(portRange.Start),
@@ -254,11 +276,16 @@ func (h *handler) savePolicy(w http.ResponseWriter, r *http.Request, accountID s
 			}
 		}
 
+		if rule.Schedule != nil {
+			schedule := &types.PolicyRuleSchedule{}
+			schedule.FromAPIRequest(rule.Schedule)
+			pr.Schedule = schedule
+		}
+
 		// validate policy object
 		if pr.Protocol == types.PolicyRuleProtocolALL || pr.Protocol == types.PolicyRuleProtocolICMP {
 			if len(pr.Ports) != 0 || len(pr.PortRanges) != 0 {
-				util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "for ALL or ICMP protocol ports is not allowed"), w)
-				return
+				return nil, status.Errorf(status.InvalidArgument, "for ALL or ICMP protocol ports is not allowed")
 			}
 		}
 		policy.Rules = append(policy.Rules, &pr)
@@ -268,25 +295,130 @@ func (h *handler) savePolicy(w http.ResponseWriter, r *http.Request, accountID s
 		policy.SourcePostureChecks = *req.SourcePostureChecks
 	}
 
-	policy, err := h.accountManager.SavePolicy(r.Context(), accountID, userID, policy, create)
+	return policy, nil
+}
+
+// evaluatePolicies handles the evaluation of proposed policy changes without saving them
+func (h *handler) evaluatePolicies(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
 	if err != nil {
 		util.WriteError(r.Context(), err, w)
 		return
 	}
 
-	allGroups, err := h.accountManager.GetAllGroups(r.Context(), accountID, userID)
+	accountID, userID := userAuth.AccountId, userAuth.UserId
+
+	var req api.PostApiPoliciesEvaluateJSONRequestBody
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteErrorResponse("couldn't parse JSON request", http.StatusBadRequest, w)
+		return
+	}
+
+	changes := make([]*types.PolicyChange, 0, len(req.Changes))
+	for _, reqChange := range req.Changes {
+		change := &types.PolicyChange{}
+		if reqChange.PolicyId != nil {
+			change.PolicyID = *reqChange.PolicyId
+		}
+
+		if reqChange.Policy == nil {
+			if change.PolicyID == "" {
+				util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "specify either a policy, a policy ID or both"), w)
+				return
+			}
+			changes = append(changes, change)
+			continue
+		}
+
+		change.Policy, err = toPolicy(accountID, change.PolicyID, *reqChange.Policy)
+		if err != nil {
+			util.WriteError(r.Context(), err, w)
+			return
+		}
+		changes = append(changes, change)
+	}
+
+	evaluation, err := h.accountManager.EvaluatePolicyChanges(r.Context(), accountID, userID, changes)
 	if err != nil {
 		util.WriteError(r.Context(), err, w)
 		return
 	}
 
-	resp := toPolicyResponse(allGroups, policy)
-	if len(resp.Rules) == 0 {
-		util.WriteError(r.Context(), status.Errorf(status.Internal, "no rules in the policy"), w)
+	util.WriteJSONObject(r.Context(), w, toPolicyEvaluationResponse(evaluation))
+}
+
+// checkAccess handles a request to check whether the policies allow a connection
+func (h *handler) checkAccess(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
 		return
 	}
 
-	util.WriteJSONObject(r.Context(), w, resp)
+	accountID, userID := userAuth.AccountId, userAuth.UserId
+
+	var req api.PostApiPoliciesCheckJSONRequestBody
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteErrorResponse("couldn't parse JSON request", http.StatusBadRequest, w)
+		return
+	}
+
+	query, err := toAccessQuery(req)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	checks, err := h.accountManager.CheckAccess(r.Context(), accountID, userID, query)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, toAccessCheckResponse(checks))
+}
+
+func toAccessQuery(req api.AccessCheckRequest) (*types.AccessQuery, error) {
+	query := &types.AccessQuery{
+		Protocol: types.PolicyRuleProtocolType(req.Protocol),
+	}
+
+	if req.SourcePeerId != nil {
+		query.SourcePeerID = *req.SourcePeerId
+	}
+	if req.SourceUserId != nil {
+		query.SourceUserID = *req.SourceUserId
+	}
+	if req.SourceIp != nil {
+		ip, err := netip.ParseAddr(*req.SourceIp)
+		if err != nil {
+			return nil, status.Errorf(status.InvalidArgument, "invalid source IP %s", *req.SourceIp)
+		}
+		query.SourceIP = ip
+	}
+
+	if req.DestinationPeerId != nil {
+		query.DestinationPeerID = *req.DestinationPeerId
+	}
+	if req.DestinationResourceId != nil {
+		query.DestinationResourceID = *req.DestinationResourceId
+	}
+	if req.DestinationIp != nil {
+		ip, err := netip.ParseAddr(*req.DestinationIp)
+		if err != nil {
+			return nil, status.Errorf(status.InvalidArgument, "invalid destination IP %s", *req.DestinationIp)
+		}
+		query.DestinationIP = ip
+	}
+
+	if req.Port != nil {
+		if *req.Port < 1 || *req.Port > 65535 {
+			return nil, status.Errorf(status.InvalidArgument, "port should be between 1 and 65535")
+		}
+		query.Port = uint16(*req.Port)
+	}
+
+	return query, nil
 }
 
 // deletePolicy handles policy deletion request
@@ -378,6 +510,7 @@ func toPolicyResponse(groups []*types.Group, policy *types.Policy) *api.Policy {
 			Action:              api.PolicyRuleAction(r.Action),
 			SourceResource:      r.SourceResource.ToAPIResponse(),
 			DestinationResource: r.DestinationResource.ToAPIResponse(),
+			Schedule:            r.Schedule.ToAPIResponse(),
 		}
 
 		if len(r.Ports) != 0 {
@@ -439,3 +572,69 @@ func toPolicyResponse(groups []*types.Group, policy *types.Policy) *api.Policy {
 	}
 	return ap
 }
+
+func toPolicyEvaluationResponse(evaluation *types.PolicyEvaluation) *api.PolicyEvaluation {
+	return &api.PolicyEvaluation{
+		Added:   toPolicyAccessResponse(evaluation.Added),
+		Removed: toPolicyAccessResponse(evaluation.Removed),
+	}
+}
+
+func toPolicyAccessResponse(accesses []types.PolicyAccess) []api.PolicyAccess {
+	resp := make([]api.PolicyAccess, 0, len(accesses))
+	for _, access := range accesses {
+		item := api.PolicyAccess{
+			SourcePeerId:    access.SourcePeerID,
+			SourcePeerName:  access.SourcePeerName,
+			DestinationType: api.PolicyAccessDestinationType(access.DestinationType),
+			DestinationId:   access.DestinationID,
+			DestinationName: access.DestinationName,
+			Protocol:        api.PolicyAccessProtocol(access.Protocol),
+		}
+		if access.Port != 0 {
+			port := int(access.Port)
+			item.Port = &port
+		}
+		if access.PortRange.Start != 0 || access.PortRange.End != 0 {
+			item.PortRange = &api.RulePortRange{
+				Start: int(access.PortRange.Start),
+				End:   int(access.PortRange.End),
+			}
+		}
+		resp = append(resp, item)
+	}
+	return resp
+}
+
+func toAccessCheckResponse(checks []*types.AccessCheck) *api.AccessCheckResponse {
+	resp := &api.AccessCheckResponse{
+		Results: make([]api.AccessCheckResult, 0, len(checks)),
+	}
+	for _, check := range checks {
+		result := api.AccessCheckResult{
+			SourcePeerId:    check.SourcePeerID,
+			SourcePeerName:  check.SourcePeerName,
+			DestinationType: api.AccessCheckResultDestinationType(check.DestinationType),
+			DestinationId:   check.DestinationID,
+			DestinationName: check.DestinationName,
+			Allowed:         check.Allowed,
+			Rules:           make([]api.AccessCheckRule, 0, len(check.Rules)),
+		}
+		if check.Reason != "" {
+			reason := check.Reason
+			result.Reason = &reason
+		}
+		for _, rule := range check.Rules {
+			result.Rules = append(result.Rules, api.AccessCheckRule{
+				PolicyId:   rule.PolicyID,
+				PolicyName: rule.PolicyName,
+				RuleId:     rule.RuleID,
+				RuleName:   rule.RuleName,
+				Action:     api.AccessCheckRuleAction(rule.Action),
+			})
+		}
+		resp.Allowed = resp.Allowed || check.Allowed
+		resp.Results = append(resp.Results, result)
+	}
+	return resp
+}
@@ -7,6 +7,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/netip"
 	"strings"
 	"testing"
 
@@ -307,3 +308,204 @@ func TestPoliciesWritePolicy(t *testing.T) {
 		})
 	}
 }
+
+func TestPoliciesEvaluatePolicies(t *testing.T) {
+	tt := []struct {
+		name            string
+		expectedStatus  int
+		requestBody     string
+		expectedChanges []*types.PolicyChange
+	}{
+		{
+			name:           "evaluate create, update and delete",
+			expectedStatus: http.StatusOK,
+			requestBody: `{"changes": [
+				{"policy": {"name": "new", "enabled": true, "rules": [{"name": "ssh", "enabled": true, "action": "accept", "protocol": "tcp", "ports": ["22"], "sources": ["F"], "destinations": ["G"]}]}},
+				{"policy_id": "id-existed", "policy": {"name": "existing", "enabled": false, "rules": [{"name": "all", "enabled": true, "action": "accept", "protocol": "all", "bidirectional": true, "sources": ["F"], "destinations": ["F"]}]}},
+				{"policy_id": "id-removed"}
+			]}`,
+			expectedChanges: []*types.PolicyChange{
+				{Policy: &types.Policy{AccountID: "test_id", Name: "new", Enabled: true, Rules: []*types.PolicyRule{
+					{Name: "ssh", Enabled: true, Action: types.PolicyTrafficActionAccept, Protocol: types.PolicyRuleProtocolTCP, Ports: []string{"22"}, Sources: []string{"F"}, Destinations: []string{"G"}},
+				}}},
+				{PolicyID: "id-existed", Policy: &types.Policy{ID: "id-existed", AccountID: "test_id", Name: "existing", Rules: []*types.PolicyRule{
+					{PolicyID: "id-existed", Name: "all", Enabled: true, Bidirectional: true, Action: types.PolicyTrafficActionAccept, Protocol: types.PolicyRuleProtocolALL, Sources: []string{"F"}, Destinations: []string{"F"}},
+				}}},
+				{PolicyID: "id-removed"},
+			},
+		},
+		{
+			name:           "change without policy and ID",
+			expectedStatus: http.StatusUnprocessableEntity,
+			requestBody:    `{"changes": [{}]}`,
+		},
+		{
+			name:           "invalid policy",
+			expectedStatus: http.StatusUnprocessableEntity,
+			requestBody:    `{"changes": [{"policy": {"name": "", "enabled": true, "rules": []}}]}`,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			p := &handler{
+				accountManager: &mock_server.MockAccountManager{
+					EvaluatePolicyChangesFunc: func(_ context.Context, accountID, userID string, changes []*types.PolicyChange) (*types.PolicyEvaluation, error) {
+						assert.Equal(t, tc.expectedChanges, changes)
+						return &types.PolicyEvaluation{
+							Added: []types.PolicyAccess{{
+								SourcePeerID:    "peer1",
+								SourcePeerName:  "web",
+								DestinationType: types.PolicyAccessDestinationPeer,
+								DestinationID:   "peer2",
+								DestinationName: "db",
+								Protocol:        types.PolicyRuleProtocolTCP,
+								Port:            22,
+							}},
+							Removed: []types.PolicyAccess{},
+						}, nil
+					},
+				},
+			}
+
+			recorder := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/api/policies/evaluate", strings.NewReader(tc.requestBody))
+			req = nbcontext.SetUserAuthInRequest(req, nbcontext.UserAuth{
+				UserId:    "test_user",
+				Domain:    "hotmail.com",
+				AccountId: "test_id",
+			})
+
+			router := mux.NewRouter()
+			router.HandleFunc("/api/policies/evaluate", p.evaluatePolicies).Methods("POST")
+			router.ServeHTTP(recorder, req)
+
+			res := recorder.Result()
+			defer res.Body.Close()
+
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+			if tc.expectedStatus != http.StatusOK {
+				return
+			}
+
+			var evaluation api.PolicyEvaluation
+			err := json.NewDecoder(res.Body).Decode(&evaluation)
+			assert.NoError(t, err)
+
+			port := 22
+			assert.Equal(t, api.PolicyEvaluation{
+				Added: []api.PolicyAccess{{
+					SourcePeerId:    "peer1",
+					SourcePeerName:  "web",
+					DestinationType: api.PolicyAccessDestinationTypePeer,
+					DestinationId:   "peer2",
+					DestinationName: "db",
+					Protocol:        api.PolicyAccessProtocolTcp,
+					Port:            &port,
+				}},
+				Removed: []api.PolicyAccess{},
+			}, evaluation)
+		})
+	}
+}
+
+func TestPoliciesCheckAccess(t *testing.T) {
+	tt := []struct {
+		name           string
+		expectedStatus int
+		requestBody    string
+		expectedQuery  *types.AccessQuery
+	}{
+		{
+			name:           "check peer to IP",
+			expectedStatus: http.StatusOK,
+			requestBody:    `{"source_peer_id": "peer1", "destination_ip": "100.64.0.2", "protocol": "tcp", "port": 443}`,
+			expectedQuery: &types.AccessQuery{
+				SourcePeerID:  "peer1",
+				DestinationIP: netip.MustParseAddr("100.64.0.2"),
+				Protocol:      types.PolicyRuleProtocolTCP,
+				Port:          443,
+			},
+		},
+		{
+			name:           "invalid destination IP",
+			expectedStatus: http.StatusUnprocessableEntity,
+			requestBody:    `{"source_peer_id": "peer1", "destination_ip": "not-an-ip", "protocol": "tcp", "port": 443}`,
+		},
+		{
+			name:           "port out of range",
+			expectedStatus: http.StatusUnprocessableEntity,
+			requestBody:    `{"source_peer_id": "peer1", "destination_peer_id": "peer2", "protocol": "udp", "port": 70000}`,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			p := &handler{
+				accountManager: &mock_server.MockAccountManager{
+					CheckAccessFunc: func(_ context.Context, accountID, userID string, query *types.AccessQuery) ([]*types.AccessCheck, error) {
+						assert.Equal(t, tc.expectedQuery, query)
+						return []*types.AccessCheck{{
+							SourcePeerID:    "peer1",
+							SourcePeerName:  "web",
+							DestinationType: types.PolicyAccessDestinationPeer,
+							DestinationID:   "peer2",
+							DestinationName: "db",
+							Allowed:         true,
+							Rules: []types.AccessCheckRule{{
+								PolicyID:   "policy1",
+								PolicyName: "web to db",
+								RuleID:     "rule1",
+								RuleName:   "https",
+								Action:     types.PolicyTrafficActionAccept,
+							}},
+						}}, nil
+					},
+				},
+			}
+
+			recorder := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/api/policies/check", strings.NewReader(tc.requestBody))
+			req = nbcontext.SetUserAuthInRequest(req, nbcontext.UserAuth{
+				UserId:    "test_user",
+				Domain:    "hotmail.com",
+				AccountId: "test_id",
+			})
+
+			router := mux.NewRouter()
+			router.HandleFunc("/api/policies/check", p.checkAccess).Methods("POST")
+			router.ServeHTTP(recorder, req)
+
+			res := recorder.Result()
+			defer res.Body.Close()
+
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+			if tc.expectedStatus != http.StatusOK {
+				return
+			}
+
+			var check api.AccessCheckResponse
+			err := json.NewDecoder(res.Body).Decode(&check)
+			assert.NoError(t, err)
+
+			assert.Equal(t, api.AccessCheckResponse{
+				Allowed: true,
+				Results: []api.AccessCheckResult{{
+					SourcePeerId:    "peer1",
+					SourcePeerName:  "web",
+					DestinationType: api.AccessCheckResultDestinationTypePeer,
+					DestinationId:   "peer2",
+					DestinationName: "db",
+					Allowed:         true,
+					Rules: []api.AccessCheckRule{{
+						PolicyId:   "policy1",
+						PolicyName: "web to db",
+						RuleId:     "rule1",
+						RuleName:   "https",
+						Action:     api.AccessCheckRuleActionAccept,
+					}},
+				}},
+			}, check)
+		})
+	}
+}
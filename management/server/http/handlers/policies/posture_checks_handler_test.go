@@ -216,6 +216,8 @@ func TestGetPostureCheck(t *testing.T) {
 
 func TestPostureCheckUpdate(t *testing.T) {
 	str := func(s string) *string { return &s }
+	intPtr := func(i int) *int { return &i }
+	boolPtr := func(b bool) *bool { return &b }
 	tt := []struct {
 		name                 string
 		expectedStatus       int
@@ -463,6 +465,69 @@ func TestPostureCheckUpdate(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:        "Create Posture Checks Disk Encryption and Host Firewall",
+			requestType: http.MethodPost,
+			requestPath: "/api/posture-checks",
+			requestBody: bytes.NewBuffer(
+				[]byte(`{
+					"name": "default",
+					"description": "default",
+					"checks": {
+						"disk_encryption_check": {
+							"volumes": ["/"]
+						},
+						"host_firewall_check": {}
+					}
+				}`)),
+			expectedStatus: http.StatusOK,
+			expectedBody:   true,
+			expectedPostureCheck: &api.PostureCheck{
+				Id:          "postureCheck",
+				Name:        "default",
+				Description: str("default"),
+				Checks: api.Checks{
+					DiskEncryptionCheck: &api.DiskEncryptionCheck{
+						Volumes: &[]string{"/"},
+					},
+					HostFirewallCheck: &api.HostFirewallCheck{
+						Firewalls: &[]string{},
+					},
+				},
+			},
+		},
+		{
+			name:        "Create Posture Checks External Check",
+			requestType: http.MethodPost,
+			requestPath: "/api/posture-checks",
+			requestBody: bytes.NewBuffer(
+				[]byte(`{
+					"name": "default",
+					"description": "default",
+					"checks": {
+						"external_check": {
+							"url": "https://mdm.example.com/compliance",
+							"token": "secret",
+							"cache_ttl": 60,
+							"fail_open": true
+						}
+					}
+				}`)),
+			expectedStatus: http.StatusOK,
+			expectedBody:   true,
+			expectedPostureCheck: &api.PostureCheck{
+				Id:          "postureCheck",
+				Name:        "default",
+				Description: str("default"),
+				Checks: api.Checks{
+					ExternalCheck: &api.ExternalCheck{
+						Url:      "https://mdm.example.com/compliance",
+						CacheTtl: intPtr(60),
+						FailOpen: boolPtr(true),
+					},
+				},
+			},
+		},
 		{
 			name:        "Create Posture Checks Invalid Check",
 			requestType: http.MethodPost,
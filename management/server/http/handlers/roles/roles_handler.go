@@ -0,0 +1,155 @@
+package roles
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	nbcontext "github.com/netbirdio/netbird/management/server/context"
+	"github.com/netbirdio/netbird/management/server/permissions/roles"
+	nbroles "github.com/netbirdio/netbird/management/server/roles"
+	"github.com/netbirdio/netbird/shared/management/http/api"
+	"github.com/netbirdio/netbird/shared/management/http/util"
+)
+
+// handler is a handler that returns custom roles of the account
+type handler struct {
+	rolesManager nbroles.Manager
+}
+
+func AddEndpoints(rolesManager nbroles.Manager, router *mux.Router) {
+	rolesHandler := newHandler(rolesManager)
+	router.HandleFunc("/roles", rolesHandler.getAllRoles).Methods("GET", "OPTIONS")
+	router.HandleFunc("/roles", rolesHandler.createRole).Methods("POST", "OPTIONS")
+	router.HandleFunc("/roles/{roleId}", rolesHandler.getRole).Methods("GET", "OPTIONS")
+	router.HandleFunc("/roles/{roleId}", rolesHandler.updateRole).Methods("PUT", "OPTIONS")
+	router.HandleFunc("/roles/{roleId}", rolesHandler.deleteRole).Methods("DELETE", "OPTIONS")
+}
+
+func newHandler(rolesManager nbroles.Manager) *handler {
+	return &handler{
+		rolesManager: rolesManager,
+	}
+}
+
+func (h *handler) getAllRoles(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accountID, userID := userAuth.AccountId, userAuth.UserId
+
+	customRoles, err := h.rolesManager.GetAllRoles(r.Context(), accountID, userID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	rolesResponse := make([]*api.Role, 0, len(customRoles))
+	for _, role := range customRoles {
+		rolesResponse = append(rolesResponse, role.ToAPIResponse())
+	}
+
+	util.WriteJSONObject(r.Context(), w, rolesResponse)
+}
+
+func (h *handler) createRole(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accountID, userID := userAuth.AccountId, userAuth.UserId
+
+	var req api.RoleRequest
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		util.WriteErrorResponse("couldn't parse JSON request", http.StatusBadRequest, w)
+		return
+	}
+
+	role := &roles.CustomRole{}
+	role.FromAPIRequest(&req)
+
+	role.AccountID = accountID
+	role, err = h.rolesManager.CreateRole(r.Context(), userID, role)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, role.ToAPIResponse())
+}
+
+func (h *handler) getRole(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accountID, userID := userAuth.AccountId, userAuth.UserId
+
+	roleID := mux.Vars(r)["roleId"]
+	role, err := h.rolesManager.GetRole(r.Context(), accountID, userID, roleID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, role.ToAPIResponse())
+}
+
+func (h *handler) updateRole(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accountID, userID := userAuth.AccountId, userAuth.UserId
+
+	var req api.RoleRequest
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		util.WriteErrorResponse("couldn't parse JSON request", http.StatusBadRequest, w)
+		return
+	}
+
+	role := &roles.CustomRole{}
+	role.FromAPIRequest(&req)
+
+	role.ID = mux.Vars(r)["roleId"]
+	role.AccountID = accountID
+
+	role, err = h.rolesManager.UpdateRole(r.Context(), userID, role)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, role.ToAPIResponse())
+}
+
+func (h *handler) deleteRole(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accountID, userID := userAuth.AccountId, userAuth.UserId
+
+	roleID := mux.Vars(r)["roleId"]
+	err = h.rolesManager.DeleteRole(r.Context(), accountID, userID, roleID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, util.EmptyObject{})
+}
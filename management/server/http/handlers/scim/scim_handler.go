@@ -0,0 +1,314 @@
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/management/server/http/middleware/bypass"
+	nbscim "github.com/netbirdio/netbird/management/server/scim"
+	"github.com/netbirdio/netbird/management/server/types"
+	"github.com/netbirdio/netbird/shared/management/status"
+)
+
+const scimPath = "/scim/v2"
+
+// handler serves the SCIM 2.0 API identity providers push users and groups to.
+// It bypasses the auth middleware and authenticates requests with SCIM tokens.
+type handler struct {
+	scimManager nbscim.Manager
+}
+
+// AddEndpoints registers the SCIM 2.0 API and the SCIM token management endpoints
+func AddEndpoints(scimManager nbscim.Manager, prefix string, router *mux.Router) error {
+	for _, path := range []string{scimPath + "/*", scimPath + "/*/*"} {
+		if err := bypass.AddBypassPath(prefix + path); err != nil {
+			return fmt.Errorf("failed to add bypass path: %w", err)
+		}
+	}
+
+	h := &handler{
+		scimManager: scimManager,
+	}
+	router.HandleFunc(scimPath+"/ServiceProviderConfig", h.authenticated(h.getServiceProviderConfig)).Methods("GET")
+	router.HandleFunc(scimPath+"/Users", h.authenticated(h.listUsers)).Methods("GET")
+	router.HandleFunc(scimPath+"/Users", h.authenticated(h.createUser)).Methods("POST")
+	router.HandleFunc(scimPath+"/Users/{id}", h.authenticated(h.getUser)).Methods("GET")
+	router.HandleFunc(scimPath+"/Users/{id}", h.authenticated(h.replaceUser)).Methods("PUT")
+	router.HandleFunc(scimPath+"/Users/{id}", h.authenticated(h.patchUser)).Methods("PATCH")
+	router.HandleFunc(scimPath+"/Users/{id}", h.authenticated(h.deleteUser)).Methods("DELETE")
+	router.HandleFunc(scimPath+"/Groups", h.authenticated(h.listGroups)).Methods("GET")
+	router.HandleFunc(scimPath+"/Groups", h.authenticated(h.createGroup)).Methods("POST")
+	router.HandleFunc(scimPath+"/Groups/{id}", h.authenticated(h.getGroup)).Methods("GET")
+	router.HandleFunc(scimPath+"/Groups/{id}", h.authenticated(h.replaceGroup)).Methods("PUT")
+	router.HandleFunc(scimPath+"/Groups/{id}", h.authenticated(h.patchGroup)).Methods("PATCH")
+	router.HandleFunc(scimPath+"/Groups/{id}", h.authenticated(h.deleteGroup)).Methods("DELETE")
+
+	addTokensEndpoints(scimManager, router)
+
+	return nil
+}
+
+type scimHandlerFunc func(w http.ResponseWriter, r *http.Request, token *types.SCIMToken)
+
+// authenticated validates the bearer SCIM token of the request before calling next
+func (h *handler) authenticated(next scimHandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		authType, plainToken, _ := strings.Cut(r.Header.Get("Authorization"), " ")
+		if !strings.EqualFold(authType, "Bearer") || plainToken == "" {
+			writeError(w, r, status.Errorf(status.Unauthorized, "missing SCIM bearer token"))
+			return
+		}
+
+		token, err := h.scimManager.Authenticate(r.Context(), plainToken)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+
+		next(w, r, token)
+	}
+}
+
+func (h *handler) getServiceProviderConfig(w http.ResponseWriter, r *http.Request, _ *types.SCIMToken) {
+	writeResponse(w, r, http.StatusOK, nbscim.ServiceProviderConfig())
+}
+
+func (h *handler) listUsers(w http.ResponseWriter, r *http.Request, token *types.SCIMToken) {
+	query, err := parseListQuery(r)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	users, err := h.scimManager.ListUsers(r.Context(), token, query)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	writeResponse(w, r, http.StatusOK, users)
+}
+
+func (h *handler) getUser(w http.ResponseWriter, r *http.Request, token *types.SCIMToken) {
+	user, err := h.scimManager.GetUser(r.Context(), token, mux.Vars(r)["id"])
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	writeResponse(w, r, http.StatusOK, user)
+}
+
+func (h *handler) createUser(w http.ResponseWriter, r *http.Request, token *types.SCIMToken) {
+	var req nbscim.User
+	if !decodeRequest(w, r, &req) {
+		return
+	}
+
+	user, err := h.scimManager.CreateUser(r.Context(), token, &req)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	writeResponse(w, r, http.StatusCreated, user)
+}
+
+func (h *handler) replaceUser(w http.ResponseWriter, r *http.Request, token *types.SCIMToken) {
+	var req nbscim.User
+	if !decodeRequest(w, r, &req) {
+		return
+	}
+
+	user, err := h.scimManager.ReplaceUser(r.Context(), token, mux.Vars(r)["id"], &req)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	writeResponse(w, r, http.StatusOK, user)
+}
+
+func (h *handler) patchUser(w http.ResponseWriter, r *http.Request, token *types.SCIMToken) {
+	var req nbscim.PatchRequest
+	if !decodeRequest(w, r, &req) {
+		return
+	}
+
+	user, err := h.scimManager.PatchUser(r.Context(), token, mux.Vars(r)["id"], &req)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	writeResponse(w, r, http.StatusOK, user)
+}
+
+func (h *handler) deleteUser(w http.ResponseWriter, r *http.Request, token *types.SCIMToken) {
+	if err := h.scimManager.DeleteUser(r.Context(), token, mux.Vars(r)["id"]); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *handler) listGroups(w http.ResponseWriter, r *http.Request, token *types.SCIMToken) {
+	query, err := parseListQuery(r)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	groups, err := h.scimManager.ListGroups(r.Context(), token, query)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	writeResponse(w, r, http.StatusOK, groups)
+}
+
+func (h *handler) getGroup(w http.ResponseWriter, r *http.Request, token *types.SCIMToken) {
+	group, err := h.scimManager.GetGroup(r.Context(), token, mux.Vars(r)["id"])
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	writeResponse(w, r, http.StatusOK, group)
+}
+
+func (h *handler) createGroup(w http.ResponseWriter, r *http.Request, token *types.SCIMToken) {
+	var req nbscim.Group
+	if !decodeRequest(w, r, &req) {
+		return
+	}
+
+	group, err := h.scimManager.CreateGroup(r.Context(), token, &req)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	writeResponse(w, r, http.StatusCreated, group)
+}
+
+func (h *handler) replaceGroup(w http.ResponseWriter, r *http.Request, token *types.SCIMToken) {
+	var req nbscim.Group
+	if !decodeRequest(w, r, &req) {
+		return
+	}
+
+	group, err := h.scimManager.ReplaceGroup(r.Context(), token, mux.Vars(r)["id"], &req)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	writeResponse(w, r, http.StatusOK, group)
+}
+
+func (h *handler) patchGroup(w http.ResponseWriter, r *http.Request, token *types.SCIMToken) {
+	var req nbscim.PatchRequest
+	if !decodeRequest(w, r, &req) {
+		return
+	}
+
+	group, err := h.scimManager.PatchGroup(r.Context(), token, mux.Vars(r)["id"], &req)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	writeResponse(w, r, http.StatusOK, group)
+}
+
+func (h *handler) deleteGroup(w http.ResponseWriter, r *http.Request, token *types.SCIMToken) {
+	if err := h.scimManager.DeleteGroup(r.Context(), token, mux.Vars(r)["id"]); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func parseListQuery(r *http.Request) (nbscim.ListQuery, error) {
+	values := r.URL.Query()
+	query := nbscim.ListQuery{
+		Filter:     values.Get("filter"),
+		StartIndex: 1,
+		Count:      -1,
+	}
+
+	for name, target := range map[string]*int{"startIndex": &query.StartIndex, "count": &query.Count} {
+		value := values.Get(name)
+		if value == "" {
+			continue
+		}
+		parsed, err := strconv.Atoi(value)
+		if err != nil {
+			return query, status.Errorf(status.InvalidArgument, "invalid %s %q", name, value)
+		}
+		*target = parsed
+	}
+
+	return query, nil
+}
+
+func decodeRequest(w http.ResponseWriter, r *http.Request, req any) bool {
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		writeError(w, r, status.Errorf(status.BadRequest, "couldn't parse JSON request"))
+		return false
+	}
+	return true
+}
+
+func writeResponse(w http.ResponseWriter, r *http.Request, httpStatus int, body any) {
+	w.Header().Set("Content-Type", nbscim.ContentType)
+	w.WriteHeader(httpStatus)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		log.WithContext(r.Context()).Errorf("failed to encode SCIM response: %v", err)
+	}
+}
+
+// writeError writes the error as SCIM error response
+func writeError(w http.ResponseWriter, r *http.Request, err error) {
+	log.WithContext(r.Context()).Errorf("got a SCIM handler error: %s", err.Error())
+
+	httpStatus := http.StatusInternalServerError
+	detail := "internal server error"
+	scimType := ""
+	if errStatus, ok := status.FromError(err); ok {
+		switch errStatus.Type() {
+		case status.AlreadyExists, status.UserAlreadyExists:
+			httpStatus, scimType = http.StatusConflict, "uniqueness"
+		case status.InvalidArgument:
+			httpStatus, scimType = http.StatusBadRequest, "invalidValue"
+		case status.BadRequest:
+			httpStatus, scimType = http.StatusBadRequest, "invalidSyntax"
+		case status.NotFound:
+			httpStatus = http.StatusNotFound
+		case status.PermissionDenied:
+			httpStatus = http.StatusForbidden
+		case status.Unauthorized:
+			httpStatus = http.StatusUnauthorized
+		case status.PreconditionFailed:
+			httpStatus = http.StatusPreconditionFailed
+		}
+		detail = errStatus.Message
+	}
+
+	writeResponse(w, r, httpStatus, &nbscim.Error{
+		Schemas:  []string{nbscim.SchemaError},
+		Status:   strconv.Itoa(httpStatus),
+		ScimType: scimType,
+		Detail:   detail,
+	})
+}
@@ -0,0 +1,110 @@
+package scim
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	nbcontext "github.com/netbirdio/netbird/management/server/context"
+	nbscim "github.com/netbirdio/netbird/management/server/scim"
+	"github.com/netbirdio/netbird/management/server/types"
+	"github.com/netbirdio/netbird/shared/management/http/api"
+	"github.com/netbirdio/netbird/shared/management/http/util"
+	"github.com/netbirdio/netbird/shared/management/status"
+)
+
+// tokensHandler manages the SCIM tokens of the account
+type tokensHandler struct {
+	scimManager nbscim.Manager
+}
+
+func addTokensEndpoints(scimManager nbscim.Manager, router *mux.Router) {
+	tokensHandler := &tokensHandler{
+		scimManager: scimManager,
+	}
+	router.HandleFunc("/scim-tokens", tokensHandler.getAllTokens).Methods("GET", "OPTIONS")
+	router.HandleFunc("/scim-tokens", tokensHandler.createToken).Methods("POST", "OPTIONS")
+	router.HandleFunc("/scim-tokens/{tokenId}", tokensHandler.deleteToken).Methods("DELETE", "OPTIONS")
+}
+
+// getAllTokens is HTTP GET handler that returns the SCIM tokens of the account
+func (h *tokensHandler) getAllTokens(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	tokens, err := h.scimManager.GetAllTokens(r.Context(), userAuth.AccountId, userAuth.UserId)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	tokensResponse := make([]*api.SCIMToken, 0, len(tokens))
+	for _, token := range tokens {
+		tokensResponse = append(tokensResponse, toSCIMTokenResponse(token))
+	}
+
+	util.WriteJSONObject(r.Context(), w, tokensResponse)
+}
+
+// createToken is HTTP POST handler that creates a SCIM token and returns it in plain text once
+func (h *tokensHandler) createToken(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	var req api.PostApiScimTokensJSONRequestBody
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteErrorResponse("couldn't parse JSON request", http.StatusBadRequest, w)
+		return
+	}
+
+	token, err := h.scimManager.CreateToken(r.Context(), userAuth.AccountId, userAuth.UserId, req.Name)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, &api.SCIMTokenGenerated{
+		PlainToken: token.PlainToken,
+		ScimToken:  *toSCIMTokenResponse(&token.SCIMToken),
+	})
+}
+
+// deleteToken is HTTP DELETE handler that deletes a SCIM token and its service user
+func (h *tokensHandler) deleteToken(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	tokenID := mux.Vars(r)["tokenId"]
+	if len(tokenID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid token ID"), w)
+		return
+	}
+
+	if err = h.scimManager.DeleteToken(r.Context(), userAuth.AccountId, userAuth.UserId, tokenID); err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, util.EmptyObject{})
+}
+
+func toSCIMTokenResponse(token *types.SCIMToken) *api.SCIMToken {
+	return &api.SCIMToken{
+		Id:        token.ID,
+		Name:      token.Name,
+		UserId:    token.UserID,
+		CreatedBy: token.CreatedBy,
+		CreatedAt: token.CreatedAt,
+		LastUsed:  token.LastUsed,
+	}
+}
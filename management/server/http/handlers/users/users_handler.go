@@ -95,6 +95,8 @@ func (h *handler) updateUser(w http.ResponseWriter, r *http.Request) {
 		Blocked:              req.IsBlocked,
 		Issued:               existingUser.Issued,
 		IntegrationReference: existingUser.IntegrationReference,
+		Email:                existingUser.Email,
+		Name:                 existingUser.Name,
 	})
 
 	if err != nil {
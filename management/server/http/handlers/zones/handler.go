@@ -0,0 +1,167 @@
+package zones
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	nbcontext "github.com/netbirdio/netbird/management/server/context"
+	"github.com/netbirdio/netbird/management/server/zones"
+	"github.com/netbirdio/netbird/management/server/zones/types"
+	"github.com/netbirdio/netbird/shared/management/http/api"
+	"github.com/netbirdio/netbird/shared/management/http/util"
+	"github.com/netbirdio/netbird/shared/management/status"
+)
+
+// handler is a handler that manages the custom DNS zones of the account
+type handler struct {
+	zonesManager zones.Manager
+}
+
+func AddEndpoints(zonesManager zones.Manager, router *mux.Router) {
+	zonesHandler := newHandler(zonesManager)
+	router.HandleFunc("/dns/zones", zonesHandler.getAllZones).Methods("GET", "OPTIONS")
+	router.HandleFunc("/dns/zones", zonesHandler.createZone).Methods("POST", "OPTIONS")
+	router.HandleFunc("/dns/zones/{zoneId}", zonesHandler.getZone).Methods("GET", "OPTIONS")
+	router.HandleFunc("/dns/zones/{zoneId}", zonesHandler.updateZone).Methods("PUT", "OPTIONS")
+	router.HandleFunc("/dns/zones/{zoneId}", zonesHandler.deleteZone).Methods("DELETE", "OPTIONS")
+}
+
+func newHandler(zonesManager zones.Manager) *handler {
+	return &handler{
+		zonesManager: zonesManager,
+	}
+}
+
+func (h *handler) getAllZones(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accountID, userID := userAuth.AccountId, userAuth.UserId
+
+	zones, err := h.zonesManager.GetAllZones(r.Context(), accountID, userID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	zonesResponse := make([]*api.DNSZone, 0, len(zones))
+	for _, zone := range zones {
+		zonesResponse = append(zonesResponse, zone.ToAPIResponse())
+	}
+
+	util.WriteJSONObject(r.Context(), w, zonesResponse)
+}
+
+func (h *handler) createZone(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accountID, userID := userAuth.AccountId, userAuth.UserId
+
+	var req api.PostApiDnsZonesJSONRequestBody
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		util.WriteErrorResponse("couldn't parse JSON request", http.StatusBadRequest, w)
+		return
+	}
+
+	zone := &types.Zone{}
+	zone.FromAPIRequest(&req)
+	zone.AccountID = accountID
+
+	zone, err = h.zonesManager.CreateZone(r.Context(), userID, zone)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, zone.ToAPIResponse())
+}
+
+func (h *handler) getZone(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accountID, userID := userAuth.AccountId, userAuth.UserId
+	zoneID := mux.Vars(r)["zoneId"]
+	if len(zoneID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid zone ID"), w)
+		return
+	}
+
+	zone, err := h.zonesManager.GetZone(r.Context(), accountID, userID, zoneID)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, zone.ToAPIResponse())
+}
+
+func (h *handler) updateZone(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accountID, userID := userAuth.AccountId, userAuth.UserId
+	zoneID := mux.Vars(r)["zoneId"]
+	if len(zoneID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid zone ID"), w)
+		return
+	}
+
+	var req api.PutApiDnsZonesZoneIdJSONRequestBody
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		util.WriteErrorResponse("couldn't parse JSON request", http.StatusBadRequest, w)
+		return
+	}
+
+	zone := &types.Zone{}
+	zone.FromAPIRequest(&req)
+	zone.ID = zoneID
+	zone.AccountID = accountID
+
+	zone, err = h.zonesManager.UpdateZone(r.Context(), userID, zone)
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, zone.ToAPIResponse())
+}
+
+func (h *handler) deleteZone(w http.ResponseWriter, r *http.Request) {
+	userAuth, err := nbcontext.GetUserAuthFromContext(r.Context())
+	if err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	accountID, userID := userAuth.AccountId, userAuth.UserId
+	zoneID := mux.Vars(r)["zoneId"]
+	if len(zoneID) == 0 {
+		util.WriteError(r.Context(), status.Errorf(status.InvalidArgument, "invalid zone ID"), w)
+		return
+	}
+
+	if err = h.zonesManager.DeleteZone(r.Context(), accountID, userID, zoneID); err != nil {
+		util.WriteError(r.Context(), err, w)
+		return
+	}
+
+	util.WriteJSONObject(r.Context(), w, util.EmptyObject{})
+}
@@ -0,0 +1,176 @@
+//go:build integration
+// +build integration
+
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/management/server/http/testing/testing_tools"
+	"github.com/netbirdio/netbird/management/server/http/testing/testing_tools/channel"
+	"github.com/netbirdio/netbird/management/server/scim"
+	"github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/management/server/types"
+	"github.com/netbirdio/netbird/shared/management/http/api"
+)
+
+func Test_SCIM_Tokens(t *testing.T) {
+	users := []struct {
+		name           string
+		userId         string
+		expectedStatus int
+	}{
+		{
+			name:           "Regular user",
+			userId:         testing_tools.TestUserId,
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "Admin user",
+			userId:         testing_tools.TestAdminId,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Owner user",
+			userId:         testing_tools.TestOwnerId,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Regular service user",
+			userId:         testing_tools.TestServiceUserId,
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, user := range users {
+		t.Run(user.name, func(t *testing.T) {
+			apiHandler, am, _ := channel.BuildApiBlackBoxWithDBState(t, "../testdata/users.sql", nil, false)
+
+			body, err := json.Marshal(&api.PostApiScimTokensJSONRequestBody{Name: "okta"})
+			require.NoError(t, err)
+
+			recorder := httptest.NewRecorder()
+			apiHandler.ServeHTTP(recorder, testing_tools.BuildRequest(t, body, http.MethodPost, "/api/scim-tokens", user.userId))
+			content, expectResponse := testing_tools.ReadResponse(t, recorder, user.expectedStatus, true)
+			if !expectResponse {
+				return
+			}
+
+			got := &api.SCIMTokenGenerated{}
+			require.NoError(t, json.Unmarshal(content, got))
+			assert.Equal(t, "okta", got.ScimToken.Name)
+			assert.Equal(t, user.userId, got.ScimToken.CreatedBy)
+
+			serviceUser, err := am.GetStore().GetUserByUserID(context.Background(), store.LockingStrengthNone, got.ScimToken.UserId)
+			require.NoError(t, err)
+			assert.True(t, serviceUser.IsServiceUser)
+			assert.Equal(t, types.UserRoleAdmin, serviceUser.Role)
+
+			recorder = httptest.NewRecorder()
+			apiHandler.ServeHTTP(recorder, testing_tools.BuildRequest(t, nil, http.MethodGet, "/api/scim-tokens", user.userId))
+			content, _ = testing_tools.ReadResponse(t, recorder, http.StatusOK, true)
+			var tokens []api.SCIMToken
+			require.NoError(t, json.Unmarshal(content, &tokens))
+			require.Len(t, tokens, 1)
+			assert.Equal(t, got.ScimToken.Id, tokens[0].Id)
+
+			recorder = httptest.NewRecorder()
+			apiHandler.ServeHTTP(recorder, testing_tools.BuildRequest(t, nil, http.MethodDelete, "/api/scim-tokens/"+got.ScimToken.Id, user.userId))
+			testing_tools.ReadResponse(t, recorder, http.StatusOK, true)
+
+			_, err = am.GetStore().GetUserByUserID(context.Background(), store.LockingStrengthNone, got.ScimToken.UserId)
+			assert.Error(t, err, "service user of the token should be deleted")
+
+			recorder = httptest.NewRecorder()
+			apiHandler.ServeHTTP(recorder, testing_tools.BuildRequest(t, nil, http.MethodGet, "/api/scim/v2/Users", got.PlainToken))
+			assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+		})
+	}
+}
+
+func Test_SCIM_Provisioning(t *testing.T) {
+	apiHandler, am, _ := channel.BuildApiBlackBoxWithDBState(t, "../testdata/users.sql", nil, false)
+
+	body, err := json.Marshal(&api.PostApiScimTokensJSONRequestBody{Name: "okta"})
+	require.NoError(t, err)
+	recorder := httptest.NewRecorder()
+	apiHandler.ServeHTTP(recorder, testing_tools.BuildRequest(t, body, http.MethodPost, "/api/scim-tokens", testing_tools.TestAdminId))
+	content, _ := testing_tools.ReadResponse(t, recorder, http.StatusOK, true)
+	token := &api.SCIMTokenGenerated{}
+	require.NoError(t, json.Unmarshal(content, token))
+
+	do := func(method, path, body string, expectedStatus int, target any) {
+		t.Helper()
+		recorder := httptest.NewRecorder()
+		apiHandler.ServeHTTP(recorder, testing_tools.BuildRequest(t, []byte(body), method, path, token.PlainToken))
+		require.Equal(t, expectedStatus, recorder.Code, recorder.Body.String())
+		if target != nil {
+			assert.Equal(t, scim.ContentType, recorder.Header().Get("Content-Type"))
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), target))
+		}
+	}
+
+	recorder = httptest.NewRecorder()
+	apiHandler.ServeHTTP(recorder, testing_tools.BuildRequest(t, nil, http.MethodGet, "/api/scim/v2/Users", "nbs_invalid"))
+	assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+
+	user := &scim.User{}
+	do(http.MethodPost, "/api/scim/v2/Users",
+		`{"schemas":["urn:ietf:params:scim:schemas:core:2.0:User"],"externalId":"alice-sub","userName":"alice@example.com","name":{"givenName":"Alice","familyName":"Doe"},"active":true}`,
+		http.StatusCreated, user)
+	assert.Equal(t, "alice-sub", user.ID)
+	assert.Equal(t, "Alice Doe", user.DisplayName)
+
+	do(http.MethodPost, "/api/scim/v2/Users", `{"externalId":"alice-sub","userName":"alice@example.com"}`, http.StatusConflict, &scim.Error{})
+	do(http.MethodPost, "/api/scim/v2/Users", `{"externalId":"testServiceUserId","userName":"service"}`, http.StatusConflict, &scim.Error{})
+
+	// existing users are taken over
+	do(http.MethodPost, "/api/scim/v2/Users", `{"externalId":"testUserId","userName":"bob@example.com","active":"True"}`, http.StatusCreated, &scim.User{})
+
+	list := &scim.ListResponse{}
+	do(http.MethodGet, `/api/scim/v2/Users?filter=userName+eq+%22ALICE@example.com%22`, "", http.StatusOK, list)
+	assert.Equal(t, 1, list.TotalResults)
+
+	do(http.MethodGet, "/api/scim/v2/Users?count=1", "", http.StatusOK, list)
+	assert.Equal(t, 2, list.TotalResults)
+	assert.Equal(t, 1, list.ItemsPerPage)
+
+	group := &scim.Group{}
+	do(http.MethodPost, "/api/scim/v2/Groups", `{"displayName":"engineering","members":[{"value":"alice-sub"}]}`, http.StatusCreated, group)
+	require.Len(t, group.Members, 1)
+
+	do(http.MethodPatch, "/api/scim/v2/Groups/"+group.ID,
+		`{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":[{"op":"add","path":"members","value":[{"value":"testUserId"}]},{"op":"remove","path":"members[value eq \"alice-sub\"]"}]}`,
+		http.StatusOK, group)
+	require.Len(t, group.Members, 1)
+	assert.Equal(t, "testUserId", group.Members[0].Value)
+
+	dbUser, err := am.GetStore().GetUserByUserID(context.Background(), store.LockingStrengthNone, testing_tools.TestUserId)
+	require.NoError(t, err)
+	assert.Contains(t, dbUser.AutoGroups, group.ID)
+	assert.Equal(t, "bob@example.com", dbUser.Email)
+
+	do(http.MethodPatch, "/api/scim/v2/Users/alice-sub",
+		`{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":[{"op":"replace","value":{"active":false}}]}`,
+		http.StatusOK, user)
+	require.NotNil(t, user.Active)
+	assert.False(t, bool(*user.Active))
+
+	do(http.MethodDelete, "/api/scim/v2/Users/testUserId", "", http.StatusNoContent, nil)
+	dbUser, err = am.GetStore().GetUserByUserID(context.Background(), store.LockingStrengthNone, testing_tools.TestUserId)
+	require.NoError(t, err)
+	assert.True(t, dbUser.Blocked)
+	assert.NotContains(t, dbUser.AutoGroups, group.ID)
+	do(http.MethodGet, "/api/scim/v2/Users/testUserId", "", http.StatusNotFound, &scim.Error{})
+
+	do(http.MethodDelete, "/api/scim/v2/Groups/"+group.ID, "", http.StatusNoContent, nil)
+	do(http.MethodGet, "/api/scim/v2/Groups/"+group.ID, "", http.StatusNotFound, &scim.Error{})
+	do(http.MethodGet, "/api/scim/v2/Groups/testGroupId", "", http.StatusNotFound, &scim.Error{})
+}
@@ -0,0 +1,143 @@
+package ingress
+
+import (
+	"context"
+
+	"github.com/netbirdio/netbird/management/server/ingress/types"
+	"github.com/netbirdio/netbird/management/server/integrations/port_forwarding"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/store"
+	nbtypes "github.com/netbirdio/netbird/management/server/types"
+)
+
+// controller adds the forwarding rules of the port allocations to the network maps of the ingress peers and the
+// rules that accept the forwarded traffic to the network maps of the peers the ports are allocated for
+type controller struct {
+	store store.Store
+}
+
+func NewController(store store.Store) port_forwarding.Controller {
+	return &controller{
+		store: store,
+	}
+}
+
+// SendUpdate is a no-op, the ingress manager updates the account peers after a change
+func (c *controller) SendUpdate(ctx context.Context, accountID string, affectedProxyID string, affectedPeerIDs []string, accountPeers map[string]*nbpeer.Peer) {
+	// noop
+}
+
+func (c *controller) GetProxyNetworkMaps(ctx context.Context, accountID, peerID string, accountPeers map[string]*nbpeer.Peer) (map[string]*nbtypes.NetworkMap, error) {
+	networkMaps, err := c.GetProxyNetworkMapsAll(ctx, accountID, accountPeers)
+	if err != nil {
+		return nil, err
+	}
+
+	networkMap, ok := networkMaps[peerID]
+	if !ok {
+		return make(map[string]*nbtypes.NetworkMap), nil
+	}
+	return map[string]*nbtypes.NetworkMap{peerID: networkMap}, nil
+}
+
+func (c *controller) GetProxyNetworkMapsAll(ctx context.Context, accountID string, accountPeers map[string]*nbpeer.Peer) (map[string]*nbtypes.NetworkMap, error) {
+	ingressPeers, err := c.store.GetAccountIngressPeers(ctx, store.LockingStrengthNone, accountID)
+	if err != nil {
+		return nil, err
+	}
+	if len(ingressPeers) == 0 {
+		return make(map[string]*nbtypes.NetworkMap), nil
+	}
+
+	allocations, err := c.store.GetAccountIngressPortAllocations(ctx, store.LockingStrengthNone, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildNetworkMaps(ingressPeers, allocations, accountPeers), nil
+}
+
+func (c *controller) IsPeerInIngressPorts(ctx context.Context, accountID, peerID string) (bool, error) {
+	ingressPeers, err := c.store.GetAccountIngressPeers(ctx, store.LockingStrengthNone, accountID)
+	if err != nil {
+		return false, err
+	}
+	for _, ingressPeer := range ingressPeers {
+		if ingressPeer.PeerID == peerID {
+			return true, nil
+		}
+	}
+
+	allocations, err := c.store.GetAccountIngressPortAllocations(ctx, store.LockingStrengthNone, accountID)
+	if err != nil {
+		return false, err
+	}
+	for _, allocation := range allocations {
+		if allocation.PeerID == peerID {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// buildNetworkMaps returns the network maps of the enabled allocations by peer ID. The ingress peer receives the
+// peer and the forwarding rules, the peer receives the ingress peer and the rules that accept the forwarded traffic.
+func buildNetworkMaps(ingressPeers []*types.IngressPeer, allocations []*types.PortAllocation, accountPeers map[string]*nbpeer.Peer) map[string]*nbtypes.NetworkMap {
+	ingressPeersByID := make(map[string]*types.IngressPeer, len(ingressPeers))
+	for _, ingressPeer := range ingressPeers {
+		ingressPeersByID[ingressPeer.ID] = ingressPeer
+	}
+
+	networkMaps := make(map[string]*nbtypes.NetworkMap)
+	networkMapOf := func(peerID string) *nbtypes.NetworkMap {
+		networkMap, ok := networkMaps[peerID]
+		if !ok {
+			networkMap = &nbtypes.NetworkMap{}
+			networkMaps[peerID] = networkMap
+		}
+		return networkMap
+	}
+
+	for _, allocation := range allocations {
+		if !allocation.Enabled {
+			continue
+		}
+
+		ingressPeer, ok := ingressPeersByID[allocation.IngressPeerID]
+		if !ok || !ingressPeer.Enabled {
+			continue
+		}
+
+		ingress, target := accountPeers[ingressPeer.PeerID], accountPeers[allocation.PeerID]
+		if !isActive(ingress) || !isActive(target) {
+			continue
+		}
+
+		ingressMap := networkMapOf(ingress.ID)
+		ingressMap.Peers = appendPeer(ingressMap.Peers, target)
+
+		targetMap := networkMapOf(target.ID)
+		targetMap.Peers = appendPeer(targetMap.Peers, ingress)
+
+		for _, mapping := range allocation.PortMappings {
+			ingressMap.ForwardingRules = append(ingressMap.ForwardingRules, mapping.ForwardingRules(target.IP)...)
+			targetMap.FirewallRules = append(targetMap.FirewallRules, mapping.FirewallRules(allocation.ID, ingress.IP)...)
+		}
+	}
+
+	return networkMaps
+}
+
+func isActive(peer *nbpeer.Peer) bool {
+	return peer != nil && (peer.Status == nil || !peer.Status.LoginExpired)
+}
+
+func appendPeer(peers []*nbpeer.Peer, peer *nbpeer.Peer) []*nbpeer.Peer {
+	for _, p := range peers {
+		if p.ID == peer.ID {
+			return peers
+		}
+	}
+	return append(peers, peer)
+}
@@ -0,0 +1,49 @@
+package ingress
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/management/server/ingress/types"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+)
+
+func TestBuildNetworkMaps(t *testing.T) {
+	accountPeers := map[string]*nbpeer.Peer{
+		"ingress": {ID: "ingress", IP: net.ParseIP("100.64.0.1")},
+		"web":     {ID: "web", IP: net.ParseIP("100.64.0.2")},
+		"expired": {ID: "expired", IP: net.ParseIP("100.64.0.3"), Status: &nbpeer.PeerStatus{LoginExpired: true}},
+	}
+	ingressPeers := []*types.IngressPeer{
+		{ID: "ingress-peer", PeerID: "ingress", Enabled: true},
+		{ID: "disabled-peer", PeerID: "web", Enabled: false},
+	}
+	mapping := types.PortMapping{Protocol: types.ProtocolTCP, IngressStart: 443, IngressEnd: 443, TranslatedStart: 8080, TranslatedEnd: 8080}
+	allocations := []*types.PortAllocation{
+		{ID: "web-https", PeerID: "web", IngressPeerID: "ingress-peer", Enabled: true, PortMappings: []types.PortMapping{mapping}},
+		{ID: "web-disabled", PeerID: "web", IngressPeerID: "ingress-peer", Enabled: false, PortMappings: []types.PortMapping{mapping}},
+		{ID: "expired", PeerID: "expired", IngressPeerID: "ingress-peer", Enabled: true, PortMappings: []types.PortMapping{mapping}},
+		{ID: "disabled-ingress", PeerID: "ingress", IngressPeerID: "disabled-peer", Enabled: true, PortMappings: []types.PortMapping{mapping}},
+	}
+
+	networkMaps := buildNetworkMaps(ingressPeers, allocations, accountPeers)
+	require.Len(t, networkMaps, 2)
+
+	ingressMap := networkMaps["ingress"]
+	require.Len(t, ingressMap.Peers, 1)
+	assert.Equal(t, "web", ingressMap.Peers[0].ID)
+	require.Len(t, ingressMap.ForwardingRules, 1)
+	assert.True(t, accountPeers["web"].IP.Equal(ingressMap.ForwardingRules[0].TranslatedAddress))
+	assert.Empty(t, ingressMap.FirewallRules)
+
+	webMap := networkMaps["web"]
+	require.Len(t, webMap.Peers, 1)
+	assert.Equal(t, "ingress", webMap.Peers[0].ID)
+	require.Len(t, webMap.FirewallRules, 1)
+	assert.Equal(t, "100.64.0.1", webMap.FirewallRules[0].PeerIP)
+	assert.Equal(t, "web-https", webMap.FirewallRules[0].PolicyID)
+	assert.Empty(t, webMap.ForwardingRules)
+}
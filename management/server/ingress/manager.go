@@ -0,0 +1,531 @@
+package ingress
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/netbirdio/netbird/management/server/account"
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/ingress/types"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/permissions"
+	"github.com/netbirdio/netbird/management/server/permissions/modules"
+	"github.com/netbirdio/netbird/management/server/permissions/operations"
+	"github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/shared/management/status"
+)
+
+type Manager interface {
+	GetAllIngressPeers(ctx context.Context, accountID, userID string) ([]*types.IngressPeer, error)
+	GetIngressPeer(ctx context.Context, accountID, userID, ingressPeerID string) (*types.IngressPeer, error)
+	CreateIngressPeer(ctx context.Context, userID string, ingressPeer *types.IngressPeer) (*types.IngressPeer, error)
+	UpdateIngressPeer(ctx context.Context, userID string, ingressPeer *types.IngressPeer) (*types.IngressPeer, error)
+	DeleteIngressPeer(ctx context.Context, accountID, userID, ingressPeerID string) error
+	// GetAvailablePorts returns the number of the ports that can still be allocated automatically by ingress peer ID
+	GetAvailablePorts(ctx context.Context, accountID, userID string) (map[string]types.AvailablePorts, error)
+
+	GetAllPortAllocations(ctx context.Context, accountID, userID, peerID string) ([]*types.PortAllocation, error)
+	GetPortAllocation(ctx context.Context, accountID, userID, peerID, allocationID string) (*types.PortAllocation, error)
+	CreatePortAllocation(ctx context.Context, accountID, userID, peerID string, req *types.AllocationRequest) (*types.PortAllocation, error)
+	UpdatePortAllocation(ctx context.Context, accountID, userID, peerID, allocationID string, req *types.AllocationRequest) (*types.PortAllocation, error)
+	DeletePortAllocation(ctx context.Context, accountID, userID, peerID, allocationID string) error
+}
+
+type managerImpl struct {
+	store              store.Store
+	accountManager     account.Manager
+	permissionsManager permissions.Manager
+}
+
+func NewManager(store store.Store, accountManager account.Manager, permissionsManager permissions.Manager) Manager {
+	return &managerImpl{
+		store:              store,
+		accountManager:     accountManager,
+		permissionsManager: permissionsManager,
+	}
+}
+
+func (m *managerImpl) GetAllIngressPeers(ctx context.Context, accountID, userID string) ([]*types.IngressPeer, error) {
+	if err := m.validatePermissions(ctx, accountID, userID, operations.Read); err != nil {
+		return nil, err
+	}
+
+	return m.store.GetAccountIngressPeers(ctx, store.LockingStrengthNone, accountID)
+}
+
+func (m *managerImpl) GetIngressPeer(ctx context.Context, accountID, userID, ingressPeerID string) (*types.IngressPeer, error) {
+	if err := m.validatePermissions(ctx, accountID, userID, operations.Read); err != nil {
+		return nil, err
+	}
+
+	return m.store.GetIngressPeerByID(ctx, store.LockingStrengthNone, accountID, ingressPeerID)
+}
+
+func (m *managerImpl) CreateIngressPeer(ctx context.Context, userID string, ingressPeer *types.IngressPeer) (*types.IngressPeer, error) {
+	if err := m.validatePermissions(ctx, ingressPeer.AccountID, userID, operations.Create); err != nil {
+		return nil, err
+	}
+
+	ingressPeer = types.NewIngressPeer(ingressPeer.AccountID, ingressPeer.PeerID, ingressPeer.Enabled, ingressPeer.Fallback)
+
+	var peer *nbpeer.Peer
+	err := m.store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		var err error
+		peer, err = transaction.GetPeerByID(ctx, store.LockingStrengthNone, ingressPeer.AccountID, ingressPeer.PeerID)
+		if err != nil {
+			return err
+		}
+
+		// the forwarding rules are only applied by the Linux firewall managers
+		if peer.Meta.GoOS != "linux" {
+			return status.Errorf(status.InvalidArgument, "only Linux peers can be ingress peers")
+		}
+
+		ingressPeers, err := transaction.GetAccountIngressPeers(ctx, store.LockingStrengthUpdate, ingressPeer.AccountID)
+		if err != nil {
+			return err
+		}
+		for _, existing := range ingressPeers {
+			if existing.PeerID == ingressPeer.PeerID {
+				return status.Errorf(status.AlreadyExists, "peer %s is already an ingress peer", ingressPeer.PeerID)
+			}
+		}
+
+		if err = transaction.SaveIngressPeer(ctx, ingressPeer); err != nil {
+			return fmt.Errorf("failed to save ingress peer: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	m.accountManager.StoreEvent(ctx, userID, ingressPeer.ID, ingressPeer.AccountID, activity.IngressPeerCreated, ingressPeer.EventMeta(peer))
+
+	return ingressPeer, nil
+}
+
+func (m *managerImpl) UpdateIngressPeer(ctx context.Context, userID string, ingressPeer *types.IngressPeer) (*types.IngressPeer, error) {
+	if err := m.validatePermissions(ctx, ingressPeer.AccountID, userID, operations.Update); err != nil {
+		return nil, err
+	}
+
+	var (
+		peer               *nbpeer.Peer
+		updateAccountPeers bool
+	)
+	err := m.store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		current, err := transaction.GetIngressPeerByID(ctx, store.LockingStrengthUpdate, ingressPeer.AccountID, ingressPeer.ID)
+		if err != nil {
+			return err
+		}
+
+		// the peer of an ingress peer can't be changed, the allocations would move to another address
+		ingressPeer.PeerID = current.PeerID
+
+		peer, err = transaction.GetPeerByID(ctx, store.LockingStrengthNone, ingressPeer.AccountID, ingressPeer.PeerID)
+		if err != nil {
+			return err
+		}
+
+		if current.Enabled != ingressPeer.Enabled {
+			updateAccountPeers, err = hasPortAllocations(ctx, transaction, ingressPeer.AccountID, ingressPeer.ID)
+			if err != nil {
+				return err
+			}
+		}
+
+		if err = transaction.SaveIngressPeer(ctx, ingressPeer); err != nil {
+			return fmt.Errorf("failed to save ingress peer: %w", err)
+		}
+
+		if !updateAccountPeers {
+			return nil
+		}
+		return transaction.IncrementNetworkSerial(ctx, ingressPeer.AccountID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	m.accountManager.StoreEvent(ctx, userID, ingressPeer.ID, ingressPeer.AccountID, activity.IngressPeerUpdated, ingressPeer.EventMeta(peer))
+
+	if updateAccountPeers {
+		go m.accountManager.UpdateAccountPeers(ctx, ingressPeer.AccountID)
+	}
+
+	return ingressPeer, nil
+}
+
+func (m *managerImpl) DeleteIngressPeer(ctx context.Context, accountID, userID, ingressPeerID string) error {
+	if err := m.validatePermissions(ctx, accountID, userID, operations.Delete); err != nil {
+		return err
+	}
+
+	var (
+		ingressPeer *types.IngressPeer
+		peer        *nbpeer.Peer
+	)
+	err := m.store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		var err error
+		ingressPeer, err = transaction.GetIngressPeerByID(ctx, store.LockingStrengthUpdate, accountID, ingressPeerID)
+		if err != nil {
+			return err
+		}
+
+		allocated, err := hasPortAllocations(ctx, transaction, accountID, ingressPeerID)
+		if err != nil {
+			return err
+		}
+		if allocated {
+			return status.Errorf(status.PreconditionFailed, "ingress peer has port allocations, delete them first")
+		}
+
+		peer, err = transaction.GetPeerByID(ctx, store.LockingStrengthNone, accountID, ingressPeer.PeerID)
+		if err != nil && !isNotFound(err) {
+			return err
+		}
+
+		return transaction.DeleteIngressPeer(ctx, accountID, ingressPeerID)
+	})
+	if err != nil {
+		return err
+	}
+
+	m.accountManager.StoreEvent(ctx, userID, ingressPeer.ID, accountID, activity.IngressPeerDeleted, ingressPeer.EventMeta(peer))
+
+	return nil
+}
+
+func (m *managerImpl) GetAvailablePorts(ctx context.Context, accountID, userID string) (map[string]types.AvailablePorts, error) {
+	if err := m.validatePermissions(ctx, accountID, userID, operations.Read); err != nil {
+		return nil, err
+	}
+
+	ingressPeers, err := m.store.GetAccountIngressPeers(ctx, store.LockingStrengthNone, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	allocations, err := m.store.GetAccountIngressPortAllocations(ctx, store.LockingStrengthNone, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	available := make(map[string]types.AvailablePorts, len(ingressPeers))
+	for _, ingressPeer := range ingressPeers {
+		available[ingressPeer.ID] = ingressPeerPorts(allocations, ingressPeer.ID, "").Available()
+	}
+	return available, nil
+}
+
+func (m *managerImpl) GetAllPortAllocations(ctx context.Context, accountID, userID, peerID string) ([]*types.PortAllocation, error) {
+	if err := m.validatePermissions(ctx, accountID, userID, operations.Read); err != nil {
+		return nil, err
+	}
+
+	allocations, err := m.store.GetAccountIngressPortAllocations(ctx, store.LockingStrengthNone, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	peerAllocations := make([]*types.PortAllocation, 0)
+	for _, allocation := range allocations {
+		if allocation.PeerID == peerID {
+			peerAllocations = append(peerAllocations, allocation)
+		}
+	}
+	return peerAllocations, nil
+}
+
+func (m *managerImpl) GetPortAllocation(ctx context.Context, accountID, userID, peerID, allocationID string) (*types.PortAllocation, error) {
+	if err := m.validatePermissions(ctx, accountID, userID, operations.Read); err != nil {
+		return nil, err
+	}
+
+	return getPeerPortAllocation(ctx, m.store, store.LockingStrengthNone, accountID, peerID, allocationID)
+}
+
+func (m *managerImpl) CreatePortAllocation(ctx context.Context, accountID, userID, peerID string, req *types.AllocationRequest) (*types.PortAllocation, error) {
+	if err := m.validatePermissions(ctx, accountID, userID, operations.Create); err != nil {
+		return nil, err
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	allocation := types.NewPortAllocation(accountID, peerID, req.Name, req.Enabled)
+
+	var peer *nbpeer.Peer
+	err := m.store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		var err error
+		peer, err = transaction.GetPeerByID(ctx, store.LockingStrengthNone, accountID, peerID)
+		if err != nil {
+			return err
+		}
+
+		if err = allocatePorts(ctx, transaction, peer, allocation, req); err != nil {
+			return err
+		}
+
+		if err = transaction.SaveIngressPortAllocation(ctx, allocation); err != nil {
+			return fmt.Errorf("failed to save ingress port allocation: %w", err)
+		}
+
+		return transaction.IncrementNetworkSerial(ctx, accountID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	m.accountManager.StoreEvent(ctx, userID, allocation.ID, accountID, activity.IngressPortAllocationCreated, allocation.EventMeta(peer))
+
+	if allocation.Enabled {
+		go m.accountManager.UpdateAccountPeers(ctx, accountID)
+	}
+
+	return allocation, nil
+}
+
+func (m *managerImpl) UpdatePortAllocation(ctx context.Context, accountID, userID, peerID, allocationID string, req *types.AllocationRequest) (*types.PortAllocation, error) {
+	if err := m.validatePermissions(ctx, accountID, userID, operations.Update); err != nil {
+		return nil, err
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	var (
+		allocation         *types.PortAllocation
+		peer               *nbpeer.Peer
+		updateAccountPeers bool
+	)
+	err := m.store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		current, err := getPeerPortAllocation(ctx, transaction, store.LockingStrengthUpdate, accountID, peerID, allocationID)
+		if err != nil {
+			return err
+		}
+
+		peer, err = transaction.GetPeerByID(ctx, store.LockingStrengthNone, accountID, peerID)
+		if err != nil {
+			return err
+		}
+
+		allocation = current.Copy()
+		allocation.Name = req.Name
+		allocation.Enabled = req.Enabled
+		if err = allocatePorts(ctx, transaction, peer, allocation, req); err != nil {
+			return err
+		}
+
+		updateAccountPeers = current.Enabled || allocation.Enabled
+
+		if err = transaction.SaveIngressPortAllocation(ctx, allocation); err != nil {
+			return fmt.Errorf("failed to save ingress port allocation: %w", err)
+		}
+
+		return transaction.IncrementNetworkSerial(ctx, accountID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	m.accountManager.StoreEvent(ctx, userID, allocation.ID, accountID, activity.IngressPortAllocationUpdated, allocation.EventMeta(peer))
+
+	if updateAccountPeers {
+		go m.accountManager.UpdateAccountPeers(ctx, accountID)
+	}
+
+	return allocation, nil
+}
+
+func (m *managerImpl) DeletePortAllocation(ctx context.Context, accountID, userID, peerID, allocationID string) error {
+	if err := m.validatePermissions(ctx, accountID, userID, operations.Delete); err != nil {
+		return err
+	}
+
+	var (
+		allocation *types.PortAllocation
+		peer       *nbpeer.Peer
+	)
+	err := m.store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		var err error
+		allocation, err = getPeerPortAllocation(ctx, transaction, store.LockingStrengthUpdate, accountID, peerID, allocationID)
+		if err != nil {
+			return err
+		}
+
+		peer, err = transaction.GetPeerByID(ctx, store.LockingStrengthNone, accountID, peerID)
+		if err != nil && !isNotFound(err) {
+			return err
+		}
+
+		if err = transaction.DeleteIngressPortAllocation(ctx, accountID, allocationID); err != nil {
+			return err
+		}
+
+		return transaction.IncrementNetworkSerial(ctx, accountID)
+	})
+	if err != nil {
+		return err
+	}
+
+	m.accountManager.StoreEvent(ctx, userID, allocation.ID, accountID, activity.IngressPortAllocationDeleted, allocation.EventMeta(peer))
+
+	if allocation.Enabled {
+		go m.accountManager.UpdateAccountPeers(ctx, accountID)
+	}
+
+	return nil
+}
+
+func (m *managerImpl) validatePermissions(ctx context.Context, accountID, userID string, operation operations.Operation) error {
+	allowed, err := m.permissionsManager.ValidateUserPermissions(ctx, accountID, userID, modules.Peers, operation)
+	if err != nil {
+		return status.NewPermissionValidationError(err)
+	}
+	if !allowed {
+		return status.NewPermissionDeniedError()
+	}
+	return nil
+}
+
+// allocatePorts chooses the ingress peer of the allocation and maps the requested ports to its free ingress ports.
+// The allocations of the account are locked, so concurrent requests can't allocate the same ports.
+func allocatePorts(ctx context.Context, transaction store.Store, peer *nbpeer.Peer, allocation *types.PortAllocation, req *types.AllocationRequest) error {
+	ingressPeers, err := transaction.GetAccountIngressPeers(ctx, store.LockingStrengthUpdate, allocation.AccountID)
+	if err != nil {
+		return err
+	}
+
+	allocations, err := transaction.GetAccountIngressPortAllocations(ctx, store.LockingStrengthUpdate, allocation.AccountID)
+	if err != nil {
+		return err
+	}
+
+	candidates, err := ingressPeerCandidates(ctx, transaction, peer, ingressPeers, req.IngressPeerID)
+	if err != nil {
+		return err
+	}
+
+	var allocateErr error
+	for _, ingressPeer := range candidates {
+		var current []types.PortMapping
+		if ingressPeer.ID == allocation.IngressPeerID {
+			current = allocation.PortMappings
+		}
+
+		mappings, err := req.Allocate(ingressPeerPorts(allocations, ingressPeer.ID, allocation.ID), current)
+		if err != nil {
+			allocateErr = err
+			continue
+		}
+
+		allocation.IngressPeerID = ingressPeer.ID
+		allocation.PortMappings = mappings
+		return nil
+	}
+
+	return allocateErr
+}
+
+// ingressPeerCandidates returns the requested ingress peer or the enabled ingress peers in the region of the peer
+// followed by the fallback ones
+func ingressPeerCandidates(ctx context.Context, transaction store.Store, peer *nbpeer.Peer, ingressPeers []*types.IngressPeer, requestedID string) ([]*types.IngressPeer, error) {
+	if requestedID != "" {
+		for _, ingressPeer := range ingressPeers {
+			if ingressPeer.ID != requestedID {
+				continue
+			}
+			if !ingressPeer.Enabled {
+				return nil, status.Errorf(status.PreconditionFailed, "ingress peer %s is disabled", requestedID)
+			}
+			if ingressPeer.PeerID == peer.ID {
+				return nil, status.Errorf(status.InvalidArgument, "peer can't forward ports to itself")
+			}
+			return []*types.IngressPeer{ingressPeer}, nil
+		}
+		return nil, status.NewIngressPeerNotFoundError(requestedID)
+	}
+
+	peerIDs := make([]string, 0, len(ingressPeers))
+	for _, ingressPeer := range ingressPeers {
+		peerIDs = append(peerIDs, ingressPeer.PeerID)
+	}
+	peers, err := transaction.GetPeersByIDs(ctx, store.LockingStrengthNone, peer.AccountID, peerIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// sorted by ID so that the choice is stable
+	slices.SortFunc(ingressPeers, func(a, b *types.IngressPeer) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	var regional, fallback []*types.IngressPeer
+	region := types.Region(peer)
+	for _, ingressPeer := range ingressPeers {
+		if !ingressPeer.Enabled || ingressPeer.PeerID == peer.ID {
+			continue
+		}
+		switch {
+		case region != "" && types.Region(peers[ingressPeer.PeerID]) == region:
+			regional = append(regional, ingressPeer)
+		case ingressPeer.Fallback:
+			fallback = append(fallback, ingressPeer)
+		}
+	}
+
+	candidates := append(regional, fallback...)
+	if len(candidates) == 0 {
+		return nil, status.Errorf(status.PreconditionFailed, "no enabled ingress peer found in the region of the peer and no fallback ingress peer")
+	}
+	return candidates, nil
+}
+
+// ingressPeerPorts returns the allocated ports of the ingress peer, except the ones of the excluded allocation
+func ingressPeerPorts(allocations []*types.PortAllocation, ingressPeerID, excludedID string) *types.PortSet {
+	ingressPeerAllocations := make([]*types.PortAllocation, 0, len(allocations))
+	for _, allocation := range allocations {
+		if allocation.IngressPeerID == ingressPeerID {
+			ingressPeerAllocations = append(ingressPeerAllocations, allocation)
+		}
+	}
+	return types.NewPortSet(ingressPeerAllocations, excludedID)
+}
+
+func hasPortAllocations(ctx context.Context, transaction store.Store, accountID, ingressPeerID string) (bool, error) {
+	allocations, err := transaction.GetAccountIngressPortAllocations(ctx, store.LockingStrengthNone, accountID)
+	if err != nil {
+		return false, err
+	}
+	for _, allocation := range allocations {
+		if allocation.IngressPeerID == ingressPeerID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// getPeerPortAllocation returns the allocation if it belongs to the peer
+func getPeerPortAllocation(ctx context.Context, s store.Store, lockStrength store.LockingStrength, accountID, peerID, allocationID string) (*types.PortAllocation, error) {
+	allocation, err := s.GetIngressPortAllocationByID(ctx, lockStrength, accountID, allocationID)
+	if err != nil {
+		return nil, err
+	}
+	if allocation.PeerID != peerID {
+		return nil, status.NewIngressPortAllocationNotFoundError(allocationID)
+	}
+	return allocation, nil
+}
+
+func isNotFound(err error) bool {
+	var sErr *status.Error
+	return errors.As(err, &sErr) && sErr.Type() == status.NotFound
+}
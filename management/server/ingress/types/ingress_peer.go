@@ -0,0 +1,93 @@
+package types
+
+import (
+	"github.com/rs/xid"
+
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/shared/management/http/api"
+)
+
+// IngressPeer is a peer with a public address that forwards the allocated ports to the peers of the account
+type IngressPeer struct {
+	ID        string `gorm:"primaryKey"`
+	AccountID string `gorm:"index"`
+	// PeerID is the peer that forwards the ports
+	PeerID  string `gorm:"index"`
+	Enabled bool
+	// Fallback allows allocating ports on the ingress peer for the peers of other regions
+	Fallback bool
+}
+
+func NewIngressPeer(accountID, peerID string, enabled, fallback bool) *IngressPeer {
+	return &IngressPeer{
+		ID:        xid.New().String(),
+		AccountID: accountID,
+		PeerID:    peerID,
+		Enabled:   enabled,
+		Fallback:  fallback,
+	}
+}
+
+// TableName returns the name of the table for the IngressPeer model in the database.
+func (*IngressPeer) TableName() string {
+	return "ingress_peers"
+}
+
+// ToAPIResponse converts the ingress peer to the API response, the peer provides the address and region
+func (p *IngressPeer) ToAPIResponse(peer *nbpeer.Peer, available AvailablePorts) *api.IngressPeer {
+	return &api.IngressPeer{
+		Id:        p.ID,
+		PeerId:    p.PeerID,
+		IngressIp: IngressIP(peer),
+		AvailablePorts: api.AvailablePorts{
+			Tcp: available.TCP,
+			Udp: available.UDP,
+		},
+		Enabled:   p.Enabled,
+		Connected: peer != nil && peer.Status != nil && peer.Status.Connected,
+		Fallback:  p.Fallback,
+		Region:    Region(peer),
+	}
+}
+
+// Copy returns a copy of the ingress peer.
+func (p *IngressPeer) Copy() *IngressPeer {
+	return &IngressPeer{
+		ID:        p.ID,
+		AccountID: p.AccountID,
+		PeerID:    p.PeerID,
+		Enabled:   p.Enabled,
+		Fallback:  p.Fallback,
+	}
+}
+
+func (p *IngressPeer) EventMeta(peer *nbpeer.Peer) map[string]any {
+	meta := map[string]any{"peer_id": p.PeerID, "enabled": p.Enabled, "fallback": p.Fallback}
+	if peer != nil {
+		meta["peer_name"] = peer.Name
+		meta["peer_ip"] = peer.IP.String()
+	}
+	return meta
+}
+
+// AvailablePorts is the number of ports per protocol that can still be allocated on an ingress peer
+type AvailablePorts struct {
+	TCP int
+	UDP int
+}
+
+// IngressIP returns the public address of the ingress peer as seen by management
+func IngressIP(peer *nbpeer.Peer) string {
+	if peer == nil || peer.Location.ConnectionIP == nil {
+		return ""
+	}
+	return peer.Location.ConnectionIP.String()
+}
+
+// Region returns the region of the peer, the country of its public address
+func Region(peer *nbpeer.Peer) string {
+	if peer == nil {
+		return ""
+	}
+	return peer.Location.CountryCode
+}
@@ -0,0 +1,369 @@
+package types
+
+import (
+	"net"
+	"slices"
+	"strconv"
+
+	"github.com/rs/xid"
+
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	nbtypes "github.com/netbirdio/netbird/management/server/types"
+	"github.com/netbirdio/netbird/shared/management/http/api"
+	"github.com/netbirdio/netbird/shared/management/status"
+)
+
+// Protocol is the protocol of the forwarded ports
+type Protocol string
+
+const (
+	ProtocolTCP    Protocol = "tcp"
+	ProtocolUDP    Protocol = "udp"
+	ProtocolTCPUDP Protocol = "tcp/udp"
+)
+
+const (
+	// MinAllocatedPort and MaxAllocatedPort bound the ingress ports that are allocated automatically, the lower ports
+	// are left to the services of the ingress peer and can only be allocated explicitly
+	MinAllocatedPort = 10000
+	MaxAllocatedPort = 65535
+
+	// WireGuardPort is the default WireGuard port of the ingress peer, forwarding it would cut the peer off the network
+	WireGuardPort = 51820
+
+	// MaxDirectPorts is the maximum number of ports of a direct port request
+	MaxDirectPorts = 100
+)
+
+// PortAllocation forwards ports of an ingress peer to a peer of the account
+type PortAllocation struct {
+	ID        string `gorm:"primaryKey"`
+	AccountID string `gorm:"index"`
+	Name      string
+	Enabled   bool
+	// PeerID is the peer that receives the forwarded traffic
+	PeerID string `gorm:"index"`
+	// IngressPeerID is the ID of the IngressPeer that forwards the ports
+	IngressPeerID string        `gorm:"index"`
+	PortMappings  []PortMapping `gorm:"serializer:json"`
+}
+
+// PortMapping forwards a range of ingress ports to a range of ports of the same size on the peer
+type PortMapping struct {
+	Protocol        Protocol
+	IngressStart    uint16
+	IngressEnd      uint16
+	TranslatedStart uint16
+	TranslatedEnd   uint16
+	// Direct marks the mapping of a direct port request, the ingress ports are forwarded to the same ports
+	Direct bool
+}
+
+func NewPortAllocation(accountID, peerID, name string, enabled bool) *PortAllocation {
+	return &PortAllocation{
+		ID:        xid.New().String(),
+		AccountID: accountID,
+		Name:      name,
+		Enabled:   enabled,
+		PeerID:    peerID,
+	}
+}
+
+// TableName returns the name of the table for the PortAllocation model in the database.
+func (*PortAllocation) TableName() string {
+	return "ingress_port_allocations"
+}
+
+// AllocationRequest is the requested state of a port allocation, the ingress ports are allocated from it
+type AllocationRequest struct {
+	Name    string
+	Enabled bool
+	// IngressPeerID is the requested ingress peer, an ingress peer in the region of the peer is chosen when empty
+	IngressPeerID string
+	PortRanges    []PortRangeRequest
+	DirectPort    *DirectPortRequest
+}
+
+// PortRangeRequest is a range of ports of the peer that is forwarded by the ingress peer
+type PortRangeRequest struct {
+	Protocol Protocol
+	Start    uint16
+	End      uint16
+	// IngressStart is the first ingress port of the range, free ports are allocated when it's zero
+	IngressStart uint16
+}
+
+// DirectPortRequest is a number of free ingress ports that are forwarded to the same ports of the peer
+type DirectPortRequest struct {
+	Protocol Protocol
+	Count    uint16
+}
+
+// AllocationRequestFromAPI converts and validates the API request
+func AllocationRequestFromAPI(req *api.IngressPortAllocationRequest) (*AllocationRequest, error) {
+	allocationReq := &AllocationRequest{
+		Name:    req.Name,
+		Enabled: req.Enabled,
+	}
+	if req.IngressPeerId != nil {
+		allocationReq.IngressPeerID = *req.IngressPeerId
+	}
+
+	if req.PortRanges != nil {
+		for _, r := range *req.PortRanges {
+			start, end, err := toPortRange(r.Start, r.End)
+			if err != nil {
+				return nil, err
+			}
+			portRange := PortRangeRequest{Protocol: Protocol(r.Protocol), Start: start, End: end}
+			if r.IngressStart != nil {
+				ingressStart, _, err := toPortRange(*r.IngressStart, *r.IngressStart+r.End-r.Start)
+				if err != nil {
+					return nil, status.Errorf(status.InvalidArgument, "ingress ports of the range %d-%d don't fit in the valid ports", r.Start, r.End)
+				}
+				portRange.IngressStart = ingressStart
+			}
+			allocationReq.PortRanges = append(allocationReq.PortRanges, portRange)
+		}
+	}
+
+	if req.DirectPort != nil {
+		if req.DirectPort.Count < 0 || req.DirectPort.Count > MaxDirectPorts {
+			return nil, status.Errorf(status.InvalidArgument, "direct port count should be between 1 and %d", MaxDirectPorts)
+		}
+		allocationReq.DirectPort = &DirectPortRequest{
+			Protocol: Protocol(req.DirectPort.Protocol),
+			Count:    uint16(req.DirectPort.Count),
+		}
+	}
+
+	return allocationReq, allocationReq.Validate()
+}
+
+// Validate checks the name, protocols and the ranges of the request
+func (r *AllocationRequest) Validate() error {
+	if r.Name == "" {
+		return status.Errorf(status.InvalidArgument, "port allocation name shouldn't be empty")
+	}
+
+	if len(r.PortRanges) == 0 && r.DirectPort == nil {
+		return status.Errorf(status.InvalidArgument, "port allocation should have port ranges or a direct port")
+	}
+
+	for _, portRange := range r.PortRanges {
+		if !portRange.Protocol.isValid() {
+			return status.Errorf(status.InvalidArgument, "invalid port range protocol %q", portRange.Protocol)
+		}
+		if portRange.Start == 0 || portRange.Start > portRange.End {
+			return status.Errorf(status.InvalidArgument, "invalid port range %d-%d", portRange.Start, portRange.End)
+		}
+	}
+
+	if r.DirectPort != nil {
+		if !r.DirectPort.Protocol.isValid() {
+			return status.Errorf(status.InvalidArgument, "invalid direct port protocol %q", r.DirectPort.Protocol)
+		}
+		if r.DirectPort.Count == 0 || r.DirectPort.Count > MaxDirectPorts {
+			return status.Errorf(status.InvalidArgument, "direct port count should be between 1 and %d", MaxDirectPorts)
+		}
+	}
+
+	return nil
+}
+
+// Allocate maps the requested ranges to free ingress ports of the port set and adds them to it. The ingress ports of
+// the current mappings are kept for the unchanged ranges, so updating an allocation doesn't move its ports.
+func (r *AllocationRequest) Allocate(ports *PortSet, current []PortMapping) ([]PortMapping, error) {
+	mappings := make([]PortMapping, 0, len(r.PortRanges)+1)
+
+	for _, portRange := range r.PortRanges {
+		size := portRange.End - portRange.Start
+		ingressStart, err := allocateRange(ports, portRange.Protocol, size, portRange.IngressStart, func(m PortMapping) bool {
+			return !m.Direct && m.Protocol == portRange.Protocol && m.TranslatedStart == portRange.Start && m.TranslatedEnd == portRange.End
+		}, current)
+		if err != nil {
+			return nil, err
+		}
+
+		mappings = append(mappings, PortMapping{
+			Protocol:        portRange.Protocol,
+			IngressStart:    ingressStart,
+			IngressEnd:      ingressStart + size,
+			TranslatedStart: portRange.Start,
+			TranslatedEnd:   portRange.End,
+		})
+	}
+
+	if r.DirectPort != nil {
+		size := r.DirectPort.Count - 1
+		ingressStart, err := allocateRange(ports, r.DirectPort.Protocol, size, 0, func(m PortMapping) bool {
+			return m.Direct && m.Protocol == r.DirectPort.Protocol && m.IngressEnd-m.IngressStart == size
+		}, current)
+		if err != nil {
+			return nil, err
+		}
+
+		mappings = append(mappings, PortMapping{
+			Protocol:        r.DirectPort.Protocol,
+			IngressStart:    ingressStart,
+			IngressEnd:      ingressStart + size,
+			TranslatedStart: ingressStart,
+			TranslatedEnd:   ingressStart + size,
+			Direct:          true,
+		})
+	}
+
+	return mappings, nil
+}
+
+// allocateRange returns the first port of a free ingress range of size+1 ports. The explicit start is used if it is
+// set, then the range of a matching current mapping and finally the first free range.
+func allocateRange(ports *PortSet, protocol Protocol, size, explicitStart uint16, matches func(PortMapping) bool, current []PortMapping) (uint16, error) {
+	if explicitStart != 0 {
+		if int(explicitStart)+int(size) > MaxAllocatedPort {
+			return 0, status.Errorf(status.InvalidArgument, "ingress ports %d-%d don't fit in the valid ports", explicitStart, int(explicitStart)+int(size))
+		}
+		end := explicitStart + size
+		if isWireGuardPort(protocol, explicitStart, end) {
+			return 0, status.Errorf(status.InvalidArgument, "ingress port %d/udp is reserved for WireGuard", WireGuardPort)
+		}
+		if !ports.IsFree(protocol, explicitStart, end) {
+			return 0, status.Errorf(status.AlreadyExists, "ingress ports %d-%d/%s are already allocated on the ingress peer", explicitStart, end, protocol)
+		}
+		ports.Add(protocol, explicitStart, end)
+		return explicitStart, nil
+	}
+
+	for _, m := range current {
+		if matches(m) && ports.IsFree(protocol, m.IngressStart, m.IngressEnd) {
+			ports.Add(protocol, m.IngressStart, m.IngressEnd)
+			return m.IngressStart, nil
+		}
+	}
+
+	start, ok := ports.FindFree(protocol, size)
+	if !ok {
+		return 0, status.Errorf(status.PreconditionFailed, "no %d free %s ports left on the ingress peer", int(size)+1, protocol)
+	}
+	ports.Add(protocol, start, start+size)
+	return start, nil
+}
+
+func toPortRange(start, end int) (uint16, uint16, error) {
+	if start < 1 || end > MaxAllocatedPort || start > end {
+		return 0, 0, status.Errorf(status.InvalidArgument, "invalid port range %d-%d", start, end)
+	}
+	return uint16(start), uint16(end), nil
+}
+
+// ToAPIResponse converts the allocation to the API response, the ingress peer provides the address and region
+func (a *PortAllocation) ToAPIResponse(ingressPeer *nbpeer.Peer) *api.IngressPortAllocation {
+	mappings := make([]api.IngressPortAllocationPortMapping, 0, len(a.PortMappings))
+	for _, m := range a.PortMappings {
+		mappings = append(mappings, api.IngressPortAllocationPortMapping{
+			Protocol:        api.IngressPortAllocationPortMappingProtocol(m.Protocol),
+			IngressStart:    int(m.IngressStart),
+			IngressEnd:      int(m.IngressEnd),
+			TranslatedStart: int(m.TranslatedStart),
+			TranslatedEnd:   int(m.TranslatedEnd),
+		})
+	}
+
+	return &api.IngressPortAllocation{
+		Id:                a.ID,
+		Name:              a.Name,
+		IngressPeerId:     a.IngressPeerID,
+		Region:            Region(ingressPeer),
+		Enabled:           a.Enabled,
+		IngressIp:         IngressIP(ingressPeer),
+		PortRangeMappings: mappings,
+	}
+}
+
+// Copy returns a copy of the port allocation.
+func (a *PortAllocation) Copy() *PortAllocation {
+	return &PortAllocation{
+		ID:            a.ID,
+		AccountID:     a.AccountID,
+		Name:          a.Name,
+		Enabled:       a.Enabled,
+		PeerID:        a.PeerID,
+		IngressPeerID: a.IngressPeerID,
+		PortMappings:  slices.Clone(a.PortMappings),
+	}
+}
+
+func (a *PortAllocation) EventMeta(peer *nbpeer.Peer) map[string]any {
+	meta := map[string]any{"name": a.Name, "ingress_peer_id": a.IngressPeerID, "ports": a.portsDescription()}
+	if peer != nil {
+		meta["peer_name"] = peer.Name
+		meta["peer_ip"] = peer.IP.String()
+	}
+	return meta
+}
+
+func (a *PortAllocation) portsDescription() []string {
+	ports := make([]string, 0, len(a.PortMappings))
+	for _, m := range a.PortMappings {
+		ports = append(ports, formatRange(m.IngressStart, m.IngressEnd)+"->"+formatRange(m.TranslatedStart, m.TranslatedEnd)+"/"+string(m.Protocol))
+	}
+	return ports
+}
+
+func formatRange(start, end uint16) string {
+	if start == end {
+		return strconv.Itoa(int(start))
+	}
+	return strconv.Itoa(int(start)) + "-" + strconv.Itoa(int(end))
+}
+
+// ForwardingRules returns the rules that forward the ingress ports of the mapping to the peer address
+func (m *PortMapping) ForwardingRules(peerIP net.IP) []*nbtypes.ForwardingRule {
+	rules := make([]*nbtypes.ForwardingRule, 0, 2)
+	for _, protocol := range m.Protocol.split() {
+		rules = append(rules, &nbtypes.ForwardingRule{
+			RuleProtocol:      string(protocol),
+			DestinationPorts:  nbtypes.RulePortRange{Start: m.IngressStart, End: m.IngressEnd},
+			TranslatedAddress: peerIP,
+			TranslatedPorts:   nbtypes.RulePortRange{Start: m.TranslatedStart, End: m.TranslatedEnd},
+		})
+	}
+	return rules
+}
+
+// FirewallRules returns the rules that accept the forwarded traffic from the ingress peer address on the peer
+func (m *PortMapping) FirewallRules(allocationID string, ingressIP net.IP) []*nbtypes.FirewallRule {
+	rules := make([]*nbtypes.FirewallRule, 0, 2)
+	for _, protocol := range m.Protocol.split() {
+		rule := &nbtypes.FirewallRule{
+			PolicyID:  allocationID,
+			PeerIP:    ingressIP.String(),
+			Direction: nbtypes.FirewallRuleDirectionIN,
+			Action:    string(nbtypes.PolicyTrafficActionAccept),
+			Protocol:  string(protocol),
+		}
+		if m.TranslatedStart == m.TranslatedEnd {
+			rule.Port = strconv.Itoa(int(m.TranslatedStart))
+		} else {
+			rule.PortRange = nbtypes.RulePortRange{Start: m.TranslatedStart, End: m.TranslatedEnd}
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+func (p Protocol) isValid() bool {
+	return p == ProtocolTCP || p == ProtocolUDP || p == ProtocolTCPUDP
+}
+
+// split returns the single protocols of the protocol
+func (p Protocol) split() []Protocol {
+	if p == ProtocolTCPUDP {
+		return []Protocol{ProtocolTCP, ProtocolUDP}
+	}
+	return []Protocol{p}
+}
+
+func isWireGuardPort(protocol Protocol, start, end uint16) bool {
+	return slices.Contains(protocol.split(), ProtocolUDP) && start <= WireGuardPort && WireGuardPort <= end
+}
@@ -0,0 +1,127 @@
+package types
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	nbtypes "github.com/netbirdio/netbird/management/server/types"
+	"github.com/netbirdio/netbird/shared/management/status"
+)
+
+func TestAllocationRequest_Validate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		req     AllocationRequest
+		wantErr bool
+	}{
+		{name: "port range", req: AllocationRequest{Name: "web", PortRanges: []PortRangeRequest{{Protocol: ProtocolTCP, Start: 8080, End: 8080}}}},
+		{name: "direct port", req: AllocationRequest{Name: "game", DirectPort: &DirectPortRequest{Protocol: ProtocolUDP, Count: 5}}},
+		{name: "no name", req: AllocationRequest{PortRanges: []PortRangeRequest{{Protocol: ProtocolTCP, Start: 80, End: 80}}}, wantErr: true},
+		{name: "no ports", req: AllocationRequest{Name: "web"}, wantErr: true},
+		{name: "invalid protocol", req: AllocationRequest{Name: "web", PortRanges: []PortRangeRequest{{Protocol: "icmp", Start: 80, End: 80}}}, wantErr: true},
+		{name: "reversed range", req: AllocationRequest{Name: "web", PortRanges: []PortRangeRequest{{Protocol: ProtocolTCP, Start: 81, End: 80}}}, wantErr: true},
+		{name: "too many direct ports", req: AllocationRequest{Name: "game", DirectPort: &DirectPortRequest{Protocol: ProtocolUDP, Count: MaxDirectPorts + 1}}, wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.wantErr {
+				assert.Error(t, tc.req.Validate())
+				return
+			}
+			assert.NoError(t, tc.req.Validate())
+		})
+	}
+}
+
+func TestAllocationRequest_Allocate(t *testing.T) {
+	existing := []*PortAllocation{
+		{ID: "web", PortMappings: []PortMapping{{Protocol: ProtocolTCP, IngressStart: 443, IngressEnd: 443, TranslatedStart: 8443, TranslatedEnd: 8443}}},
+		{ID: "first", PortMappings: []PortMapping{{Protocol: ProtocolTCPUDP, IngressStart: MinAllocatedPort, IngressEnd: MinAllocatedPort + 1, TranslatedStart: 80, TranslatedEnd: 81}}},
+	}
+
+	t.Run("explicit ingress port", func(t *testing.T) {
+		req := &AllocationRequest{PortRanges: []PortRangeRequest{{Protocol: ProtocolUDP, Start: 8080, End: 8080, IngressStart: 443}}}
+		mappings, err := req.Allocate(NewPortSet(existing, ""), nil)
+		require.NoError(t, err)
+		assert.Equal(t, []PortMapping{{Protocol: ProtocolUDP, IngressStart: 443, IngressEnd: 443, TranslatedStart: 8080, TranslatedEnd: 8080}}, mappings)
+	})
+
+	t.Run("explicit ingress port collision", func(t *testing.T) {
+		req := &AllocationRequest{PortRanges: []PortRangeRequest{{Protocol: ProtocolTCPUDP, Start: 8080, End: 8080, IngressStart: 443}}}
+		_, err := req.Allocate(NewPortSet(existing, ""), nil)
+		sErr, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, status.AlreadyExists, sErr.Type())
+	})
+
+	t.Run("explicit ingress port of the updated allocation", func(t *testing.T) {
+		req := &AllocationRequest{PortRanges: []PortRangeRequest{{Protocol: ProtocolTCP, Start: 8080, End: 8080, IngressStart: 443}}}
+		_, err := req.Allocate(NewPortSet(existing, "web"), nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("wireguard port", func(t *testing.T) {
+		req := &AllocationRequest{PortRanges: []PortRangeRequest{{Protocol: ProtocolUDP, Start: 51810, End: 51830, IngressStart: 51810}}}
+		_, err := req.Allocate(NewPortSet(nil, ""), nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("free ports", func(t *testing.T) {
+		req := &AllocationRequest{
+			PortRanges: []PortRangeRequest{{Protocol: ProtocolTCP, Start: 8080, End: 8082}},
+			DirectPort: &DirectPortRequest{Protocol: ProtocolTCP, Count: 2},
+		}
+		mappings, err := req.Allocate(NewPortSet(existing, ""), nil)
+		require.NoError(t, err)
+		assert.Equal(t, []PortMapping{
+			{Protocol: ProtocolTCP, IngressStart: MinAllocatedPort + 2, IngressEnd: MinAllocatedPort + 4, TranslatedStart: 8080, TranslatedEnd: 8082},
+			{Protocol: ProtocolTCP, IngressStart: MinAllocatedPort + 5, IngressEnd: MinAllocatedPort + 6, TranslatedStart: MinAllocatedPort + 5, TranslatedEnd: MinAllocatedPort + 6, Direct: true},
+		}, mappings)
+	})
+
+	t.Run("keeps the ports of unchanged ranges", func(t *testing.T) {
+		current := []PortMapping{{Protocol: ProtocolTCP, IngressStart: 20000, IngressEnd: 20000, TranslatedStart: 22, TranslatedEnd: 22}}
+		req := &AllocationRequest{PortRanges: []PortRangeRequest{
+			{Protocol: ProtocolTCP, Start: 22, End: 22},
+			{Protocol: ProtocolTCP, Start: 80, End: 80},
+		}}
+		mappings, err := req.Allocate(NewPortSet(existing, "ssh"), current)
+		require.NoError(t, err)
+		require.Len(t, mappings, 2)
+		assert.Equal(t, uint16(20000), mappings[0].IngressStart)
+		assert.Equal(t, uint16(MinAllocatedPort+2), mappings[1].IngressStart)
+	})
+
+	t.Run("no free ports", func(t *testing.T) {
+		ports := NewPortSet(nil, "")
+		ports.Add(ProtocolTCP, MinAllocatedPort, MaxAllocatedPort)
+		req := &AllocationRequest{PortRanges: []PortRangeRequest{{Protocol: ProtocolTCP, Start: 80, End: 80}}}
+		_, err := req.Allocate(ports, nil)
+		sErr, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, status.PreconditionFailed, sErr.Type())
+	})
+}
+
+func TestPortMapping_Rules(t *testing.T) {
+	mapping := PortMapping{Protocol: ProtocolTCPUDP, IngressStart: 443, IngressEnd: 443, TranslatedStart: 8443, TranslatedEnd: 8443}
+	peerIP, ingressIP := net.ParseIP("100.64.0.2"), net.ParseIP("100.64.0.1")
+
+	forwardingRules := mapping.ForwardingRules(peerIP)
+	require.Len(t, forwardingRules, 2)
+	assert.Equal(t, "tcp", forwardingRules[0].RuleProtocol)
+	assert.Equal(t, "udp", forwardingRules[1].RuleProtocol)
+	assert.Equal(t, nbtypes.RulePortRange{Start: 443, End: 443}, forwardingRules[0].DestinationPorts)
+	assert.Equal(t, nbtypes.RulePortRange{Start: 8443, End: 8443}, forwardingRules[0].TranslatedPorts)
+	assert.True(t, peerIP.Equal(forwardingRules[0].TranslatedAddress))
+
+	firewallRules := mapping.FirewallRules("allocation", ingressIP)
+	require.Len(t, firewallRules, 2)
+	assert.Equal(t, "100.64.0.1", firewallRules[0].PeerIP)
+	assert.Equal(t, nbtypes.FirewallRuleDirectionIN, firewallRules[0].Direction)
+	assert.Equal(t, "8443", firewallRules[0].Port)
+}
@@ -0,0 +1,109 @@
+package types
+
+// PortSet is the set of the allocated ingress ports of an ingress peer per protocol
+type PortSet struct {
+	tcp portBitmap
+	udp portBitmap
+}
+
+type portBitmap [(MaxAllocatedPort + 1) / 64]uint64
+
+// NewPortSet returns the ports of the allocations, the allocation with the excluded ID is skipped so it can be
+// allocated again
+func NewPortSet(allocations []*PortAllocation, excludedID string) *PortSet {
+	ports := &PortSet{}
+	for _, allocation := range allocations {
+		if allocation.ID == excludedID {
+			continue
+		}
+		for _, m := range allocation.PortMappings {
+			ports.Add(m.Protocol, m.IngressStart, m.IngressEnd)
+		}
+	}
+	return ports
+}
+
+// Add marks the ports as allocated
+func (s *PortSet) Add(protocol Protocol, start, end uint16) {
+	for _, bitmap := range s.bitmaps(protocol) {
+		for port := int(start); port <= int(end); port++ {
+			bitmap.set(port)
+		}
+	}
+}
+
+// IsFree checks that none of the ports is allocated
+func (s *PortSet) IsFree(protocol Protocol, start, end uint16) bool {
+	for _, bitmap := range s.bitmaps(protocol) {
+		for port := int(start); port <= int(end); port++ {
+			if bitmap.isSet(port) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// FindFree returns the first port of the lowest free range of size+1 ports between MinAllocatedPort and
+// MaxAllocatedPort. The WireGuard port is never allocated for UDP.
+func (s *PortSet) FindFree(protocol Protocol, size uint16) (uint16, bool) {
+	free := 0
+	for port := MinAllocatedPort; port <= MaxAllocatedPort; port++ {
+		if !s.isAllocatable(protocol, port) {
+			free = 0
+			continue
+		}
+		free++
+		if free == int(size)+1 {
+			return uint16(port - int(size)), true
+		}
+	}
+	return 0, false
+}
+
+// Available returns the number of the ports that can be allocated automatically
+func (s *PortSet) Available() AvailablePorts {
+	var available AvailablePorts
+	for port := MinAllocatedPort; port <= MaxAllocatedPort; port++ {
+		if s.isAllocatable(ProtocolTCP, port) {
+			available.TCP++
+		}
+		if s.isAllocatable(ProtocolUDP, port) {
+			available.UDP++
+		}
+	}
+	return available
+}
+
+func (s *PortSet) isAllocatable(protocol Protocol, port int) bool {
+	if isWireGuardPort(protocol, uint16(port), uint16(port)) {
+		return false
+	}
+	for _, bitmap := range s.bitmaps(protocol) {
+		if bitmap.isSet(port) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *PortSet) bitmaps(protocol Protocol) []*portBitmap {
+	switch protocol {
+	case ProtocolTCP:
+		return []*portBitmap{&s.tcp}
+	case ProtocolUDP:
+		return []*portBitmap{&s.udp}
+	case ProtocolTCPUDP:
+		return []*portBitmap{&s.tcp, &s.udp}
+	default:
+		return nil
+	}
+}
+
+func (b *portBitmap) set(port int) {
+	b[port/64] |= 1 << (port % 64)
+}
+
+func (b *portBitmap) isSet(port int) bool {
+	return b[port/64]&(1<<(port%64)) != 0
+}
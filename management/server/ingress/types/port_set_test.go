@@ -0,0 +1,40 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPortSet(t *testing.T) {
+	ports := NewPortSet(nil, "")
+	ports.Add(ProtocolTCP, MinAllocatedPort, MinAllocatedPort+9)
+
+	assert.False(t, ports.IsFree(ProtocolTCP, MinAllocatedPort+9, MinAllocatedPort+10))
+	assert.False(t, ports.IsFree(ProtocolTCPUDP, MinAllocatedPort, MinAllocatedPort))
+	assert.True(t, ports.IsFree(ProtocolUDP, MinAllocatedPort, MinAllocatedPort+9))
+
+	start, ok := ports.FindFree(ProtocolTCP, 0)
+	assert.True(t, ok)
+	assert.Equal(t, uint16(MinAllocatedPort+10), start)
+
+	start, ok = ports.FindFree(ProtocolUDP, 0)
+	assert.True(t, ok)
+	assert.Equal(t, uint16(MinAllocatedPort), start)
+
+	total := MaxAllocatedPort - MinAllocatedPort + 1
+	assert.Equal(t, AvailablePorts{TCP: total - 10, UDP: total - 1}, ports.Available())
+}
+
+func TestPortSet_FindFreeSkipsWireGuardPort(t *testing.T) {
+	ports := NewPortSet(nil, "")
+	ports.Add(ProtocolUDP, MinAllocatedPort, WireGuardPort-2)
+
+	start, ok := ports.FindFree(ProtocolUDP, 1)
+	assert.True(t, ok)
+	assert.Equal(t, uint16(WireGuardPort+1), start)
+
+	start, ok = ports.FindFree(ProtocolTCP, 1)
+	assert.True(t, ok)
+	assert.Equal(t, uint16(MinAllocatedPort), start)
+}
@@ -57,6 +57,8 @@ type MockAccountManager struct {
 	SavePolicyFunc                        func(ctx context.Context, accountID, userID string, policy *types.Policy, create bool) (*types.Policy, error)
 	DeletePolicyFunc                      func(ctx context.Context, accountID, policyID, userID string) error
 	ListPoliciesFunc                      func(ctx context.Context, accountID, userID string) ([]*types.Policy, error)
+	EvaluatePolicyChangesFunc             func(ctx context.Context, accountID, userID string, changes []*types.PolicyChange) (*types.PolicyEvaluation, error)
+	CheckAccessFunc                       func(ctx context.Context, accountID, userID string, query *types.AccessQuery) ([]*types.AccessCheck, error)
 	GetUsersFromAccountFunc               func(ctx context.Context, accountID, userID string) (map[string]*types.UserInfo, error)
 	UpdatePeerMetaFunc                    func(ctx context.Context, peerID string, meta nbpeer.PeerSystemMeta) error
 	UpdatePeerFunc                        func(ctx context.Context, accountID, userID string, peer *nbpeer.Peer) (*nbpeer.Peer, error)
@@ -88,6 +90,7 @@ type MockAccountManager struct {
 	GetDNSDomainFunc                      func(settings *types.Settings) string
 	StoreEventFunc                        func(ctx context.Context, initiatorID, targetID, accountID string, activityID activity.ActivityDescriber, meta map[string]any)
 	GetEventsFunc                         func(ctx context.Context, accountID, userID string) ([]*activity.Event, error)
+	SearchEventsFunc                      func(ctx context.Context, accountID, userID string, filter activity.EventFilter) ([]*activity.Event, *activity.EventCursor, error)
 	GetDNSSettingsFunc                    func(ctx context.Context, accountID, userID string) (*types.DNSSettings, error)
 	SaveDNSSettingsFunc                   func(ctx context.Context, accountID, userID string, dnsSettingsToSave *types.DNSSettings) error
 	GetPeerFunc                           func(ctx context.Context, accountID, peerID, userID string) (*nbpeer.Peer, error)
@@ -457,6 +460,22 @@ func (am *MockAccountManager) ListPolicies(ctx context.Context, accountID, userI
 	return nil, status.Errorf(codes.Unimplemented, "method ListPolicies is not implemented")
 }
 
+// EvaluatePolicyChanges mock implementation of EvaluatePolicyChanges from server.AccountManager interface
+func (am *MockAccountManager) EvaluatePolicyChanges(ctx context.Context, accountID, userID string, changes []*types.PolicyChange) (*types.PolicyEvaluation, error) {
+	if am.EvaluatePolicyChangesFunc != nil {
+		return am.EvaluatePolicyChangesFunc(ctx, accountID, userID, changes)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method EvaluatePolicyChanges is not implemented")
+}
+
+// CheckAccess mock implementation of CheckAccess from server.AccountManager interface
+func (am *MockAccountManager) CheckAccess(ctx context.Context, accountID, userID string, query *types.AccessQuery) ([]*types.AccessCheck, error) {
+	if am.CheckAccessFunc != nil {
+		return am.CheckAccessFunc(ctx, accountID, userID, query)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method CheckAccess is not implemented")
+}
+
 // UpdatePeerMeta mock implementation of UpdatePeerMeta from server.AccountManager interface
 func (am *MockAccountManager) UpdatePeerMeta(ctx context.Context, peerID string, meta nbpeer.PeerSystemMeta) error {
 	if am.UpdatePeerMetaFunc != nil {
@@ -702,6 +721,14 @@ func (am *MockAccountManager) GetEvents(ctx context.Context, accountID, userID s
 	return nil, status.Errorf(codes.Unimplemented, "method GetEvents is not implemented")
 }
 
+// SearchEvents mocks SearchEvents of the AccountManager interface
+func (am *MockAccountManager) SearchEvents(ctx context.Context, accountID, userID string, filter activity.EventFilter) ([]*activity.Event, *activity.EventCursor, error) {
+	if am.SearchEventsFunc != nil {
+		return am.SearchEventsFunc(ctx, accountID, userID, filter)
+	}
+	return nil, nil, status.Errorf(codes.Unimplemented, "method SearchEvents is not implemented")
+}
+
 // GetDNSSettings mocks GetDNSSettings of the AccountManager interface
 func (am *MockAccountManager) GetDNSSettings(ctx context.Context, accountID string, userID string) (*types.DNSSettings, error) {
 	if am.GetDNSSettingsFunc != nil {
@@ -0,0 +1,123 @@
+package server
+
+import (
+	"sync"
+
+	"github.com/netbirdio/netbird/shared/management/proto"
+)
+
+// peerNetworkMapState holds the network map delivery state of a single peer
+type peerNetworkMapState struct {
+	// deltaSupported indicates whether the peer announced support for NetworkMapDelta updates
+	deltaSupported bool
+	// sent is the last network map sent to the peer either as a full snapshot or as a delta
+	sent *proto.NetworkMap
+	// pending is the number of network maps sent to the peer since the last full snapshot that weren't acknowledged yet
+	pending int
+	// snapshotSerial is the serial of the last full snapshot, the acknowledgements of the older network maps are
+	// ignored because the snapshot replaced them
+	snapshotSerial uint64
+}
+
+// networkMapTracker keeps track of the network maps sent to the peers and acknowledged by them.
+// Updates are sent as NetworkMapDelta only when the peer acknowledged every network map it received,
+// otherwise a full snapshot is sent.
+type networkMapTracker struct {
+	mu    sync.Mutex
+	peers map[string]*peerNetworkMapState
+}
+
+// newNetworkMapTracker returns a new instance of networkMapTracker
+func newNetworkMapTracker() *networkMapTracker {
+	return &networkMapTracker{
+		peers: make(map[string]*peerNetworkMapState),
+	}
+}
+
+// onSync registers a new Sync stream of the peer identified by peerKey and returns the state owned by the stream.
+// The network map of the previous stream is kept only if the peer reports it as the last applied one, which happens
+// when the peer reconnects before the previous stream was closed.
+func (t *networkMapTracker) onSync(peerKey string, deltaSupported bool, appliedSerial uint64) *peerNetworkMapState {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	state := &peerNetworkMapState{deltaSupported: deltaSupported}
+
+	previous, ok := t.peers[peerKey]
+	if ok && deltaSupported && previous.sent != nil && previous.pending == 0 && previous.sent.GetSerial() == appliedSerial && appliedSerial != 0 {
+		state.sent = previous.sent
+		state.snapshotSerial = previous.snapshotSerial
+	}
+	t.peers[peerKey] = state
+
+	return state
+}
+
+// release drops the state of the closed Sync stream unless a newer stream of the peer already replaced it
+func (t *networkMapTracker) release(peerKey string, state *peerNetworkMapState) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.peers[peerKey] == state {
+		delete(t.peers, peerKey)
+	}
+}
+
+// prepareUpdate returns the sync response to be sent to the peer, replacing the network map by a delta if possible,
+// and records the network map as sent.
+func (t *networkMapTracker) prepareUpdate(peerKey string, update *proto.SyncResponse) *proto.SyncResponse {
+	networkMap := update.GetNetworkMap()
+	if networkMap == nil {
+		return update
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	state, ok := t.peers[peerKey]
+	if !ok || !state.deltaSupported {
+		return update
+	}
+
+	response := update
+	if state.sent != nil && state.pending == 0 {
+		// deprecated peer fields are omitted because peers supporting deltas only read the network map
+		response = &proto.SyncResponse{
+			NetbirdConfig:   update.GetNetbirdConfig(),
+			PeerConfig:      update.GetPeerConfig(),
+			Checks:          update.GetChecks(),
+			NetworkMapDelta: proto.NewNetworkMapDelta(state.sent, networkMap),
+		}
+		state.pending++
+	} else {
+		// the snapshot replaces every network map the peer didn't acknowledge, so a lost acknowledgement doesn't
+		// disable the deltas for the rest of the stream
+		state.pending = 1
+		state.snapshotSerial = networkMap.GetSerial()
+	}
+
+	state.sent = networkMap
+
+	return response
+}
+
+// ack marks the network map with the given serial as applied by the peer
+func (t *networkMapTracker) ack(peerKey string, serial uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	state, ok := t.peers[peerKey]
+	if !ok || state.sent == nil || state.pending == 0 || serial > state.sent.GetSerial() || serial < state.snapshotSerial {
+		return
+	}
+
+	state.pending--
+}
+
+// remove drops the state of the peer identified by peerKey
+func (t *networkMapTracker) remove(peerKey string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	delete(t.peers, peerKey)
+}
@@ -0,0 +1,124 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/shared/management/proto"
+)
+
+func syncResponseWithSerial(serial uint64, peers ...string) *proto.SyncResponse {
+	networkMap := &proto.NetworkMap{Serial: serial}
+	for _, peer := range peers {
+		networkMap.RemotePeers = append(networkMap.RemotePeers, &proto.RemotePeerConfig{WgPubKey: peer})
+	}
+	return &proto.SyncResponse{NetworkMap: networkMap}
+}
+
+func TestNetworkMapTracker_DeltaAfterAck(t *testing.T) {
+	tracker := newNetworkMapTracker()
+	tracker.onSync("peer1", true, 0)
+
+	initial := tracker.prepareUpdate("peer1", syncResponseWithSerial(1, "peer2"))
+	require.NotNil(t, initial.GetNetworkMap(), "initial sync should contain a full network map")
+	assert.Nil(t, initial.GetNetworkMapDelta())
+
+	// not acknowledged yet, so the next update has to be a full snapshot
+	update := tracker.prepareUpdate("peer1", syncResponseWithSerial(2, "peer2", "peer3"))
+	require.NotNil(t, update.GetNetworkMap())
+
+	tracker.ack("peer1", 1)
+	update = tracker.prepareUpdate("peer1", syncResponseWithSerial(3, "peer3"))
+	require.NotNil(t, update.GetNetworkMap(), "only one of two network maps was acknowledged")
+
+	tracker.ack("peer1", 2)
+	tracker.ack("peer1", 3)
+	update = tracker.prepareUpdate("peer1", syncResponseWithSerial(4, "peer3", "peer4"))
+	assert.Nil(t, update.GetNetworkMap())
+	require.NotNil(t, update.GetNetworkMapDelta())
+	assert.Equal(t, uint64(3), update.GetNetworkMapDelta().GetBaseSerial())
+	assert.Equal(t, uint64(4), update.GetNetworkMapDelta().GetSerial())
+	assert.Len(t, update.GetNetworkMapDelta().GetUpsertedRemotePeers(), 1)
+}
+
+func TestNetworkMapTracker_DeltaNotSupported(t *testing.T) {
+	tracker := newNetworkMapTracker()
+	tracker.onSync("peer1", false, 0)
+
+	_ = tracker.prepareUpdate("peer1", syncResponseWithSerial(1, "peer2"))
+	tracker.ack("peer1", 1)
+
+	update := tracker.prepareUpdate("peer1", syncResponseWithSerial(2, "peer2", "peer3"))
+	assert.NotNil(t, update.GetNetworkMap())
+	assert.Nil(t, update.GetNetworkMapDelta())
+}
+
+func TestNetworkMapTracker_Reconnect(t *testing.T) {
+	tracker := newNetworkMapTracker()
+	tracker.onSync("peer1", true, 0)
+	_ = tracker.prepareUpdate("peer1", syncResponseWithSerial(5, "peer2"))
+	tracker.ack("peer1", 5)
+
+	// peer reconnects with the acknowledged serial, the initial sync can be a delta
+	tracker.onSync("peer1", true, 5)
+	update := tracker.prepareUpdate("peer1", syncResponseWithSerial(6, "peer2", "peer3"))
+	require.NotNil(t, update.GetNetworkMapDelta())
+	assert.Equal(t, uint64(5), update.GetNetworkMapDelta().GetBaseSerial())
+
+	// peer reconnects with an unknown serial, a full snapshot has to be sent
+	tracker.onSync("peer1", true, 3)
+	update = tracker.prepareUpdate("peer1", syncResponseWithSerial(7, "peer2"))
+	assert.NotNil(t, update.GetNetworkMap())
+	assert.Nil(t, update.GetNetworkMapDelta())
+}
+
+func TestNetworkMapTracker_UpdateWithoutNetworkMap(t *testing.T) {
+	tracker := newNetworkMapTracker()
+	tracker.onSync("peer1", true, 0)
+
+	update := &proto.SyncResponse{NetbirdConfig: &proto.NetbirdConfig{}}
+	assert.Same(t, update, tracker.prepareUpdate("peer1", update))
+}
+
+func TestNetworkMapTracker_SnapshotResetsPending(t *testing.T) {
+	tracker := newNetworkMapTracker()
+	tracker.onSync("peer1", true, 0)
+
+	_ = tracker.prepareUpdate("peer1", syncResponseWithSerial(1, "peer2"))
+	tracker.ack("peer1", 1)
+
+	// the peer fails to apply the delta and never acknowledges it
+	update := tracker.prepareUpdate("peer1", syncResponseWithSerial(2, "peer2", "peer3"))
+	require.NotNil(t, update.GetNetworkMapDelta())
+
+	update = tracker.prepareUpdate("peer1", syncResponseWithSerial(3, "peer3"))
+	require.NotNil(t, update.GetNetworkMap(), "the delta wasn't acknowledged")
+
+	// the late acknowledgement of the replaced network map is ignored
+	tracker.ack("peer1", 2)
+	update = tracker.prepareUpdate("peer1", syncResponseWithSerial(4, "peer3"))
+	require.NotNil(t, update.GetNetworkMap())
+
+	tracker.ack("peer1", 4)
+	update = tracker.prepareUpdate("peer1", syncResponseWithSerial(5, "peer3", "peer4"))
+	require.NotNil(t, update.GetNetworkMapDelta(), "the acknowledged snapshot enables the deltas again")
+	assert.Equal(t, uint64(4), update.GetNetworkMapDelta().GetBaseSerial())
+}
+
+func TestNetworkMapTracker_Release(t *testing.T) {
+	tracker := newNetworkMapTracker()
+	oldStream := tracker.onSync("peer1", true, 0)
+	_ = tracker.prepareUpdate("peer1", syncResponseWithSerial(1, "peer2"))
+	tracker.ack("peer1", 1)
+
+	// the peer reconnects before the previous stream is closed, closing it keeps the state of the new stream
+	newStream := tracker.onSync("peer1", true, 1)
+	tracker.release("peer1", oldStream)
+	update := tracker.prepareUpdate("peer1", syncResponseWithSerial(2, "peer2", "peer3"))
+	require.NotNil(t, update.GetNetworkMapDelta())
+
+	tracker.release("peer1", newStream)
+	assert.Empty(t, tracker.peers)
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/netbirdio/netbird/management/server/util"
+	"github.com/netbirdio/netbird/shared/management/posture"
 )
 
 // Peer represents a machine connected to the network.
@@ -94,6 +95,55 @@ type File struct {
 	ProcessIsRunning bool
 }
 
+// DiskEncryption is the encryption state of the system volumes
+type DiskEncryption struct {
+	Volumes []EncryptedVolume
+}
+
+// EncryptedVolume is a mounted volume and its encryption state
+type EncryptedVolume = posture.EncryptedVolume
+
+// HostFirewall is the state of the host firewalls
+type HostFirewall struct {
+	// Active is the list of host firewalls that are enabled and filter incoming traffic
+	Active []string
+}
+
+// PostureCheckResult is the result of the client-side evaluation of posture checks
+type PostureCheckResult struct {
+	// ID of the evaluated posture checks
+	ID     string
+	Passed bool
+	// Reason describes why the checks failed
+	Reason string
+}
+
+// RelayLatency is the round trip time the peer measured to a relay server
+type RelayLatency struct {
+	URL   string
+	RTTMs int64
+}
+
+func (d DiskEncryption) isEqual(other DiskEncryption) bool {
+	volumes := slices.Clone(d.Volumes)
+	otherVolumes := slices.Clone(other.Volumes)
+	sort.Slice(volumes, func(i, j int) bool {
+		return volumes[i].Path < volumes[j].Path
+	})
+	sort.Slice(otherVolumes, func(i, j int) bool {
+		return otherVolumes[i].Path < otherVolumes[j].Path
+	})
+	return slices.Equal(volumes, otherVolumes)
+}
+
+func (f HostFirewall) isEqual(other HostFirewall) bool {
+	active := slices.Clone(f.Active)
+	otherActive := slices.Clone(other.Active)
+	slices.Sort(active)
+	slices.Sort(otherActive)
+	return slices.Equal(active, otherActive)
+}
+
 // Flags defines a set of options to control feature behavior
 type Flags struct {
 	RosenpassEnabled    bool
@@ -126,9 +176,25 @@ type PeerSystemMeta struct { //nolint:revive
 	SystemSerialNumber string
 	SystemProductName  string
 	SystemManufacturer string
-	Environment        Environment `gorm:"serializer:json"`
-	Flags              Flags       `gorm:"serializer:json"`
-	Files              []File      `gorm:"serializer:json"`
+	Environment        Environment    `gorm:"serializer:json"`
+	Flags              Flags          `gorm:"serializer:json"`
+	Files              []File         `gorm:"serializer:json"`
+	DiskEncryption     DiskEncryption `gorm:"serializer:json"`
+	HostFirewall       HostFirewall   `gorm:"serializer:json"`
+	// PostureCheckResults are the results of the posture checks evaluated by the client
+	PostureCheckResults []PostureCheckResult `gorm:"serializer:json"`
+	// RelayLatencies are the latencies to the relay servers measured by the client
+	RelayLatencies []RelayLatency `gorm:"serializer:json"`
+}
+
+// GetPostureCheckResult returns the client-side evaluation result of the posture checks if reported
+func (p PeerSystemMeta) GetPostureCheckResult(postureChecksID string) (PostureCheckResult, bool) {
+	for _, result := range p.PostureCheckResults {
+		if result.ID == postureChecksID {
+			return result, true
+		}
+	}
+	return PostureCheckResult{}, false
 }
 
 func (p PeerSystemMeta) isEqual(other PeerSystemMeta) bool {
@@ -173,7 +239,35 @@ func (p PeerSystemMeta) isEqual(other PeerSystemMeta) bool {
 		p.SystemManufacturer == other.SystemManufacturer &&
 		p.Environment.Cloud == other.Environment.Cloud &&
 		p.Environment.Platform == other.Environment.Platform &&
-		p.Flags.isEqual(other.Flags)
+		p.Flags.isEqual(other.Flags) &&
+		p.DiskEncryption.isEqual(other.DiskEncryption) &&
+		p.HostFirewall.isEqual(other.HostFirewall) &&
+		isPostureCheckResultsEqual(p.PostureCheckResults, other.PostureCheckResults) &&
+		isRelayLatenciesEqual(p.RelayLatencies, other.RelayLatencies)
+}
+
+func isRelayLatenciesEqual(latencies, other []RelayLatency) bool {
+	latencies = slices.Clone(latencies)
+	other = slices.Clone(other)
+	sort.Slice(latencies, func(i, j int) bool {
+		return latencies[i].URL < latencies[j].URL
+	})
+	sort.Slice(other, func(i, j int) bool {
+		return other[i].URL < other[j].URL
+	})
+	return slices.Equal(latencies, other)
+}
+
+func isPostureCheckResultsEqual(results, other []PostureCheckResult) bool {
+	results = slices.Clone(results)
+	other = slices.Clone(other)
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].ID < results[j].ID
+	})
+	sort.Slice(other, func(i, j int) bool {
+		return other[i].ID < other[j].ID
+	})
+	return slices.Equal(results, other)
 }
 
 func (p PeerSystemMeta) isEmpty() bool {
@@ -193,7 +287,9 @@ func (p PeerSystemMeta) isEmpty() bool {
 		p.SystemManufacturer == "" &&
 		p.Environment.Cloud == "" &&
 		p.Environment.Platform == "" &&
-		len(p.Files) == 0
+		len(p.Files) == 0 &&
+		len(p.DiskEncryption.Volumes) == 0 &&
+		len(p.HostFirewall.Active) == 0
 }
 
 // AddedWithSSOLogin indicates whether this peer has been added with an SSO login by a user.
@@ -21,7 +21,7 @@ type Manager interface {
 	ValidateRoleModuleAccess(ctx context.Context, accountID string, role roles.RolePermissions, module modules.Module, operation operations.Operation) bool
 	ValidateAccountAccess(ctx context.Context, accountID string, user *types.User, allowOwnerAndAdmin bool) error
 
-	GetPermissionsByRole(ctx context.Context, role types.UserRole) (roles.Permissions, error)
+	GetPermissionsByRole(ctx context.Context, accountID string, role types.UserRole) (roles.Permissions, error)
 }
 
 type managerImpl struct {
@@ -70,14 +70,35 @@ func (m *managerImpl) ValidateUserPermissions(
 		return true, nil // this should be replaced by proper granular access role
 	}
 
-	role, ok := roles.RolesMap[user.Role]
-	if !ok {
-		return false, status.NewUserRoleNotFoundError(string(user.Role))
+	role, err := m.getRolePermissions(ctx, accountID, user.Role)
+	if err != nil {
+		return false, err
 	}
 
 	return m.ValidateRoleModuleAccess(ctx, accountID, role, module, operation), nil
 }
 
+// getRolePermissions returns the permissions of a built-in role or of an account defined role
+func (m *managerImpl) getRolePermissions(ctx context.Context, accountID string, role types.UserRole) (roles.RolePermissions, error) {
+	if roleID := role.CustomRoleID(); roleID != "" {
+		customRole, err := m.store.GetCustomRoleByID(ctx, store.LockingStrengthNone, accountID, roleID)
+		if err != nil {
+			if sErr, ok := status.FromError(err); ok && sErr.Type() == status.NotFound {
+				return roles.RolePermissions{}, status.NewUserRoleNotFoundError(string(role))
+			}
+			return roles.RolePermissions{}, err
+		}
+		return customRole.ToRolePermissions(), nil
+	}
+
+	rolePermissions, ok := roles.RolesMap[role]
+	if !ok {
+		return roles.RolePermissions{}, status.NewUserRoleNotFoundError(string(role))
+	}
+
+	return rolePermissions, nil
+}
+
 func (m *managerImpl) ValidateRoleModuleAccess(
 	ctx context.Context,
 	accountID string,
@@ -103,10 +124,10 @@ func (m *managerImpl) ValidateAccountAccess(ctx context.Context, accountID strin
 	return nil
 }
 
-func (m *managerImpl) GetPermissionsByRole(ctx context.Context, role types.UserRole) (roles.Permissions, error) {
-	roleMap, ok := roles.RolesMap[role]
-	if !ok {
-		return roles.Permissions{}, status.NewUserRoleNotFoundError(string(role))
+func (m *managerImpl) GetPermissionsByRole(ctx context.Context, accountID string, role types.UserRole) (roles.Permissions, error) {
+	roleMap, err := m.getRolePermissions(ctx, accountID, role)
+	if err != nil {
+		return roles.Permissions{}, err
 	}
 
 	permissions := roles.Permissions{}
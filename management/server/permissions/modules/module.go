@@ -16,6 +16,7 @@ const (
 	Users       Module = "users"
 	SetupKeys   Module = "setup_keys"
 	Pats        Module = "pats"
+	Roles       Module = "roles"
 )
 
 var All = map[Module]struct{}{
@@ -32,4 +33,5 @@ var All = map[Module]struct{}{
 	Users:       {},
 	SetupKeys:   {},
 	Pats:        {},
+	Roles:       {},
 }
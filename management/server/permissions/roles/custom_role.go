@@ -0,0 +1,136 @@
+package roles
+
+import (
+	"fmt"
+
+	"github.com/rs/xid"
+
+	"github.com/netbirdio/netbird/management/server/permissions/modules"
+	"github.com/netbirdio/netbird/management/server/permissions/operations"
+	"github.com/netbirdio/netbird/management/server/types"
+	"github.com/netbirdio/netbird/shared/management/http/api"
+)
+
+var allOperations = map[operations.Operation]struct{}{
+	operations.Create: {},
+	operations.Read:   {},
+	operations.Update: {},
+	operations.Delete: {},
+}
+
+// CustomRole is an account defined role granting the listed permissions only
+type CustomRole struct {
+	ID          string `gorm:"primaryKey"`
+	AccountID   string `gorm:"index"`
+	Name        string
+	Description string
+	Permissions Permissions `gorm:"serializer:json"`
+}
+
+// NewCustomRole creates a new CustomRole with a generated ID
+func NewCustomRole(accountID, name, description string, permissions Permissions) *CustomRole {
+	return &CustomRole{
+		ID:          xid.New().String(),
+		AccountID:   accountID,
+		Name:        name,
+		Description: description,
+		Permissions: permissions,
+	}
+}
+
+// UserRole returns the UserRole used to assign the role to users
+func (r *CustomRole) UserRole() types.UserRole {
+	return types.NewCustomUserRole(r.ID)
+}
+
+// ToRolePermissions returns the RolePermissions of the role. Modules and operations that aren't listed are denied.
+func (r *CustomRole) ToRolePermissions() RolePermissions {
+	return RolePermissions{
+		Role:        r.UserRole(),
+		Permissions: r.Permissions,
+		AutoAllowNew: map[operations.Operation]bool{
+			operations.Read:   false,
+			operations.Create: false,
+			operations.Update: false,
+			operations.Delete: false,
+		},
+	}
+}
+
+func (r *CustomRole) ToAPIResponse() *api.Role {
+	permissions := make(map[string]map[string]bool, len(r.Permissions))
+	for module, ops := range r.Permissions {
+		permissions[string(module)] = make(map[string]bool, len(ops))
+		for op, allowed := range ops {
+			permissions[string(module)][string(op)] = allowed
+		}
+	}
+
+	return &api.Role{
+		Id:          r.ID,
+		Name:        r.Name,
+		Description: r.Description,
+		Permissions: permissions,
+		Role:        string(r.UserRole()),
+	}
+}
+
+func (r *CustomRole) FromAPIRequest(req *api.RoleRequest) {
+	r.Name = req.Name
+	r.Description = req.Description
+	r.Permissions = make(Permissions, len(req.Permissions))
+	for module, ops := range req.Permissions {
+		r.Permissions[modules.Module(module)] = make(map[operations.Operation]bool, len(ops))
+		for op, allowed := range ops {
+			r.Permissions[modules.Module(module)][operations.Operation(op)] = allowed
+		}
+	}
+}
+
+// Validate checks that the role has a name and references only known modules and operations
+func (r *CustomRole) Validate() error {
+	if r.Name == "" {
+		return fmt.Errorf("role name can't be empty")
+	}
+
+	if _, ok := RolesMap[types.UserRole(r.Name)]; ok {
+		return fmt.Errorf("role name %s is reserved for a built-in role", r.Name)
+	}
+
+	for module, ops := range r.Permissions {
+		if _, ok := modules.All[module]; !ok {
+			return fmt.Errorf("unknown module %s", module)
+		}
+		for op := range ops {
+			if _, ok := allOperations[op]; !ok {
+				return fmt.Errorf("unknown operation %s on module %s", op, module)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Copy returns a copy of the role
+func (r *CustomRole) Copy() *CustomRole {
+	permissions := make(Permissions, len(r.Permissions))
+	for module, ops := range r.Permissions {
+		permissions[module] = make(map[operations.Operation]bool, len(ops))
+		for op, allowed := range ops {
+			permissions[module][op] = allowed
+		}
+	}
+
+	return &CustomRole{
+		ID:          r.ID,
+		AccountID:   r.AccountID,
+		Name:        r.Name,
+		Description: r.Description,
+		Permissions: permissions,
+	}
+}
+
+// EventMeta returns activity event meta related to the role
+func (r *CustomRole) EventMeta() map[string]any {
+	return map[string]any{"name": r.Name}
+}
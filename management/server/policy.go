@@ -3,8 +3,10 @@ package server
 import (
 	"context"
 	_ "embed"
+	"fmt"
 
 	"github.com/rs/xid"
+	"golang.org/x/exp/maps"
 
 	"github.com/netbirdio/netbird/management/server/permissions/modules"
 	"github.com/netbirdio/netbird/management/server/permissions/operations"
@@ -76,6 +78,8 @@ func (am *DefaultAccountManager) SavePolicy(ctx context.Context, accountID, user
 
 	am.StoreEvent(ctx, userID, policy.ID, accountID, action, policy.EventMeta())
 
+	am.reschedulePolicyRuleEvaluation(ctx, accountID)
+
 	if updateAccountPeers {
 		am.UpdateAccountPeers(ctx, accountID)
 	}
@@ -119,6 +123,8 @@ func (am *DefaultAccountManager) DeletePolicy(ctx context.Context, accountID, po
 
 	am.StoreEvent(ctx, userID, policyID, accountID, activity.PolicyRemoved, policy.EventMeta())
 
+	am.reschedulePolicyRuleEvaluation(ctx, accountID)
+
 	if updateAccountPeers {
 		am.UpdateAccountPeers(ctx, accountID)
 	}
@@ -139,6 +145,77 @@ func (am *DefaultAccountManager) ListPolicies(ctx context.Context, accountID, us
 	return am.Store.GetAccountPolicies(ctx, store.LockingStrengthNone, accountID)
 }
 
+// EvaluatePolicyChanges computes the connections gained and lost between peers, network resources and routes
+// if the policy changes were saved. The account isn't changed.
+func (am *DefaultAccountManager) EvaluatePolicyChanges(ctx context.Context, accountID, userID string, changes []*types.PolicyChange) (*types.PolicyEvaluation, error) {
+	if err := am.validatePolicyAnalysisPermissions(ctx, accountID, userID); err != nil {
+		return nil, err
+	}
+
+	var err error
+	for _, change := range changes {
+		if change.Policy == nil {
+			if _, err = am.Store.GetPolicyByID(ctx, store.LockingStrengthNone, accountID, change.PolicyID); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		change.Policy.ID = change.PolicyID
+		if err = validatePolicy(ctx, am.Store, accountID, change.Policy); err != nil {
+			return nil, err
+		}
+	}
+
+	account, err := am.Store.GetAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	validatedPeers, err := am.integratedPeerValidator.GetValidatedPeers(ctx, accountID, maps.Values(account.Groups), maps.Values(account.Peers), account.Settings.Extra)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get validated peers: %w", err)
+	}
+
+	proposed := account.Copy()
+	proposed.ApplyPolicyChanges(changes)
+
+	return types.EvaluatePolicyAccess(account.GetPolicyAccess(ctx, validatedPeers), proposed.GetPolicyAccess(ctx, validatedPeers)), nil
+}
+
+// CheckAccess evaluates whether the policies of the account allow the connection described by the query and which rules decide it
+func (am *DefaultAccountManager) CheckAccess(ctx context.Context, accountID, userID string, query *types.AccessQuery) ([]*types.AccessCheck, error) {
+	if err := am.validatePolicyAnalysisPermissions(ctx, accountID, userID); err != nil {
+		return nil, err
+	}
+
+	account, err := am.Store.GetAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	validatedPeers, err := am.integratedPeerValidator.GetValidatedPeers(ctx, accountID, maps.Values(account.Groups), maps.Values(account.Peers), account.Settings.Extra)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get validated peers: %w", err)
+	}
+
+	return account.CheckAccess(ctx, query, validatedPeers)
+}
+
+// validatePolicyAnalysisPermissions validates the user can read policies and peers, as the analysis of policies reveals peers
+func (am *DefaultAccountManager) validatePolicyAnalysisPermissions(ctx context.Context, accountID, userID string) error {
+	for _, module := range []modules.Module{modules.Policies, modules.Peers} {
+		allowed, err := am.permissionsManager.ValidateUserPermissions(ctx, accountID, userID, module, operations.Read)
+		if err != nil {
+			return status.NewPermissionValidationError(err)
+		}
+		if !allowed {
+			return status.NewPermissionDeniedError()
+		}
+	}
+	return nil
+}
+
 // arePolicyChangesAffectPeers checks if changes to a policy will affect any associated peers.
 func arePolicyChangesAffectPeers(ctx context.Context, transaction store.Store, accountID string, policy *types.Policy, isUpdate bool) (bool, error) {
 	if isUpdate {
@@ -188,6 +265,15 @@ func validatePolicy(ctx context.Context, transaction store.Store, accountID stri
 		policy.AccountID = accountID
 	}
 
+	for _, rule := range policy.Rules {
+		if rule.Schedule == nil {
+			continue
+		}
+		if err := rule.Schedule.Validate(); err != nil {
+			return status.Errorf(status.InvalidArgument, "invalid schedule of rule %s: %s", rule.Name, err)
+		}
+	}
+
 	groups, err := transaction.GetGroupsByIDs(ctx, store.LockingStrengthNone, accountID, policy.RuleGroups())
 	if err != nil {
 		return err
@@ -0,0 +1,168 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/formatter/hook"
+	"github.com/netbirdio/netbird/management/server/activity"
+	nbcontext "github.com/netbirdio/netbird/management/server/context"
+	"github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/management/server/types"
+)
+
+// expiredPolicyRule is a policy rule disabled because its schedule expired
+type expiredPolicyRule struct {
+	policy *types.Policy
+	rule   *types.PolicyRule
+}
+
+// policyRuleScheduleJob disables expired policy rules, updates the account peers when a rule was applied or stopped
+// being applied since the previous run and returns the duration until the next boundary if found
+func (am *DefaultAccountManager) policyRuleScheduleJob(ctx context.Context, accountID string) func() (time.Duration, bool) {
+	lastRun := time.Now()
+	return func() (time.Duration, bool) {
+		//nolint
+		ctx := context.WithValue(ctx, nbcontext.AccountIDKey, accountID)
+		//nolint
+		ctx = context.WithValue(ctx, hook.ExecutionContextKey, fmt.Sprintf("%s-POLICY-SCHEDULE", hook.SystemSource))
+
+		now := time.Now()
+		var expiredRules []expiredPolicyRule
+		var stateChanged bool
+		err := am.Store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+			policies, err := transaction.GetAccountPolicies(ctx, store.LockingStrengthUpdate, accountID)
+			if err != nil {
+				return err
+			}
+
+			for _, policy := range policies {
+				var policyChanged bool
+				for _, rule := range policy.Rules {
+					if policy.Enabled && rule.Enabled && rule.Schedule.IsActive(lastRun) != rule.Schedule.IsActive(now) {
+						stateChanged = true
+					}
+					if rule.Enabled && rule.Schedule.IsExpired(now) {
+						rule.Enabled = false
+						policyChanged = true
+						expiredRules = append(expiredRules, expiredPolicyRule{policy: policy, rule: rule})
+					}
+				}
+
+				if !policyChanged {
+					continue
+				}
+
+				if err = transaction.SavePolicy(ctx, policy); err != nil {
+					return err
+				}
+			}
+
+			if !stateChanged && len(expiredRules) == 0 {
+				return nil
+			}
+			return transaction.IncrementNetworkSerial(ctx, accountID)
+		})
+		if err != nil {
+			log.WithContext(ctx).Errorf("failed to evaluate policy rule schedules of account %s: %v", accountID, err)
+			return peerSchedulerRetryInterval, true
+		}
+		lastRun = now
+
+		for _, expired := range expiredRules {
+			meta := expired.policy.EventMeta()
+			meta["rule"] = expired.rule.Name
+			meta["expired_at"] = expired.rule.Schedule.ExpiresAt.UTC().Format(time.RFC3339)
+			am.StoreEvent(ctx, activity.SystemInitiator, expired.policy.ID, accountID, activity.PolicyRuleExpired, meta)
+		}
+
+		if stateChanged || len(expiredRules) > 0 {
+			log.WithContext(ctx).Debugf("policy rule schedule boundary reached for account %s, %d rules expired", accountID, len(expiredRules))
+			am.UpdateAccountPeers(ctx, accountID)
+		}
+
+		return am.getNextPolicyRuleTransition(ctx, accountID)
+	}
+}
+
+// schedulePolicyRuleEvaluations schedules the evaluation of the policy rule schedules of every account with scheduled
+// policy rules, the later policy changes reschedule the evaluation of their account
+func (am *DefaultAccountManager) schedulePolicyRuleEvaluations(ctx context.Context) {
+	accountIDs, err := am.Store.GetAccountIDsWithPolicyRuleSchedules(ctx, store.LockingStrengthNone)
+	if err != nil {
+		log.WithContext(ctx).Errorf("failed to get accounts with policy rule schedules: %v", err)
+		return
+	}
+
+	for _, accountID := range accountIDs {
+		am.schedulePolicyRuleEvaluation(ctx, accountID)
+	}
+}
+
+// schedulePolicyRuleEvaluation schedules the evaluation of the account policy rule schedules if it isn't scheduled yet
+func (am *DefaultAccountManager) schedulePolicyRuleEvaluation(ctx context.Context, accountID string) {
+	if am.policyRuleScheduler.IsSchedulerRunning(accountID) {
+		log.WithContext(ctx).Tracef("policy rule schedule job for account %s is already scheduled", accountID)
+		return
+	}
+	if nextRun, ok := am.getNextPolicyRuleTransition(ctx, accountID); ok {
+		go am.policyRuleScheduler.Schedule(ctx, nextRun, accountID, am.policyRuleScheduleJob(ctx, accountID))
+	}
+}
+
+// reschedulePolicyRuleEvaluation replaces the scheduled evaluation of the account policy rule schedules after policy changes
+func (am *DefaultAccountManager) reschedulePolicyRuleEvaluation(ctx context.Context, accountID string) {
+	am.policyRuleScheduler.Cancel(ctx, []string{accountID})
+	if nextRun, ok := am.getNextPolicyRuleTransition(ctx, accountID); ok {
+		go am.policyRuleScheduler.Schedule(ctx, nextRun, accountID, am.policyRuleScheduleJob(ctx, accountID))
+	}
+}
+
+// getNextPolicyRuleTransition returns the duration until the next time any enabled policy rule of the account
+// gets applied or stops being applied because of its schedule
+func (am *DefaultAccountManager) getNextPolicyRuleTransition(ctx context.Context, accountID string) (time.Duration, bool) {
+	policies, err := am.Store.GetAccountPolicies(ctx, store.LockingStrengthNone, accountID)
+	if err != nil {
+		log.WithContext(ctx).Errorf("failed to get account policies: %v", err)
+		return peerSchedulerRetryInterval, true
+	}
+
+	now := time.Now()
+	var nextTransition time.Time
+	for _, policy := range policies {
+		if !policy.Enabled {
+			continue
+		}
+
+		for _, rule := range policy.Rules {
+			if !rule.Enabled || rule.Schedule == nil {
+				continue
+			}
+
+			// expired rules that are still enabled are disabled by the job right away
+			if rule.Schedule.IsExpired(now) {
+				return time.Second, true
+			}
+
+			transition, ok := rule.Schedule.NextTransition(now)
+			if ok && (nextTransition.IsZero() || transition.Before(nextTransition)) {
+				nextTransition = transition
+			}
+		}
+	}
+
+	if nextTransition.IsZero() {
+		return 0, false
+	}
+
+	// if the transition is below 1s return 1s duration
+	// this avoids issues with ticker that can't be set to < 0
+	if in := nextTransition.Sub(now); in > time.Second {
+		return in, true
+	}
+
+	return time.Second, true
+}
@@ -0,0 +1,138 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/management/server/types"
+)
+
+func TestDefaultAccountManager_PolicyRuleScheduleJob(t *testing.T) {
+	manager, err := createManager(t)
+	require.NoError(t, err)
+
+	account, err := createAccount(manager, "test_account", userID, "")
+	require.NoError(t, err)
+
+	expiredAt := time.Now().Add(-time.Minute)
+	expiresAt := time.Now().Add(time.Hour)
+
+	expired := &types.Policy{
+		Name:    "contractor",
+		Enabled: true,
+		Rules: []*types.PolicyRule{{
+			Name:          "contractor",
+			Enabled:       true,
+			Action:        types.PolicyTrafficActionAccept,
+			Bidirectional: true,
+			Protocol:      types.PolicyRuleProtocolALL,
+			Schedule:      &types.PolicyRuleSchedule{ExpiresAt: &expiredAt},
+		}},
+	}
+	active := &types.Policy{
+		Name:    "temporary",
+		Enabled: true,
+		Rules: []*types.PolicyRule{{
+			Name:          "temporary",
+			Enabled:       true,
+			Action:        types.PolicyTrafficActionAccept,
+			Bidirectional: true,
+			Protocol:      types.PolicyRuleProtocolALL,
+			Schedule:      &types.PolicyRuleSchedule{ExpiresAt: &expiresAt},
+		}},
+	}
+
+	expired, err = manager.SavePolicy(context.Background(), account.Id, userID, expired, true)
+	require.NoError(t, err)
+	active, err = manager.SavePolicy(context.Background(), account.Id, userID, active, true)
+	require.NoError(t, err)
+
+	nextRun, ok := manager.getNextPolicyRuleTransition(context.Background(), account.Id)
+	require.True(t, ok)
+	assert.Equal(t, time.Second, nextRun, "expired rules should be handled right away")
+
+	nextRun, ok = manager.policyRuleScheduleJob(context.Background(), account.Id)()
+	require.True(t, ok)
+	assert.InDelta(t, time.Hour.Seconds(), nextRun.Seconds(), time.Minute.Seconds())
+
+	stored, err := manager.Store.GetPolicyByID(context.Background(), store.LockingStrengthNone, account.Id, expired.ID)
+	require.NoError(t, err)
+	assert.False(t, stored.Rules[0].Enabled, "expired rule should be disabled")
+
+	stored, err = manager.Store.GetPolicyByID(context.Background(), store.LockingStrengthNone, account.Id, active.ID)
+	require.NoError(t, err)
+	assert.True(t, stored.Rules[0].Enabled)
+
+	ev := getEvent(t, account.Id, manager, activity.PolicyRuleExpired)
+	assert.Equal(t, activity.SystemInitiator, ev.InitiatorID)
+	assert.Equal(t, expired.ID, ev.TargetID)
+	assert.Equal(t, "contractor", ev.Meta["rule"])
+}
+
+func TestDefaultAccountManager_SavePolicyWithInvalidSchedule(t *testing.T) {
+	manager, err := createManager(t)
+	require.NoError(t, err)
+
+	account, err := createAccount(manager, "test_account", userID, "")
+	require.NoError(t, err)
+
+	policy := &types.Policy{
+		Name:    "invalid",
+		Enabled: true,
+		Rules: []*types.PolicyRule{{
+			Name:     "invalid",
+			Enabled:  true,
+			Action:   types.PolicyTrafficActionAccept,
+			Protocol: types.PolicyRuleProtocolALL,
+			Schedule: &types.PolicyRuleSchedule{Timezone: "Mars/Olympus"},
+		}},
+	}
+
+	_, err = manager.SavePolicy(context.Background(), account.Id, userID, policy, true)
+	require.Error(t, err)
+}
+
+func TestDefaultAccountManager_PolicyRuleScheduleJob_WithoutTransition(t *testing.T) {
+	manager, err := createManager(t)
+	require.NoError(t, err)
+
+	account, err := createAccount(manager, "test_account", userID, "")
+	require.NoError(t, err)
+
+	expiresAt := time.Now().Add(time.Hour)
+	policy := &types.Policy{
+		Name:    "temporary",
+		Enabled: true,
+		Rules: []*types.PolicyRule{{
+			Name:          "temporary",
+			Enabled:       true,
+			Action:        types.PolicyTrafficActionAccept,
+			Bidirectional: true,
+			Protocol:      types.PolicyRuleProtocolALL,
+			Schedule:      &types.PolicyRuleSchedule{ExpiresAt: &expiresAt},
+		}},
+	}
+	_, err = manager.SavePolicy(context.Background(), account.Id, userID, policy, true)
+	require.NoError(t, err)
+
+	accountIDs, err := manager.Store.GetAccountIDsWithPolicyRuleSchedules(context.Background(), store.LockingStrengthNone)
+	require.NoError(t, err)
+	assert.Equal(t, []string{account.Id}, accountIDs)
+
+	network, err := manager.Store.GetAccountNetwork(context.Background(), store.LockingStrengthNone, account.Id)
+	require.NoError(t, err)
+
+	nextRun, ok := manager.policyRuleScheduleJob(context.Background(), account.Id)()
+	require.True(t, ok)
+	assert.InDelta(t, time.Hour.Seconds(), nextRun.Seconds(), time.Minute.Seconds())
+
+	updated, err := manager.Store.GetAccountNetwork(context.Background(), store.LockingStrengthNone, account.Id)
+	require.NoError(t, err)
+	assert.Equal(t, network.CurrentSerial(), updated.CurrentSerial(), "the serial shouldn't change when no rule changed its state")
+}
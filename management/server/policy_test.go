@@ -8,11 +8,13 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"golang.org/x/exp/slices"
 
 	nbpeer "github.com/netbirdio/netbird/management/server/peer"
 	"github.com/netbirdio/netbird/management/server/posture"
 	"github.com/netbirdio/netbird/management/server/types"
+	"github.com/netbirdio/netbird/shared/management/status"
 )
 
 func TestAccount_getPeersByPolicy(t *testing.T) {
@@ -1273,3 +1275,57 @@ func TestPolicyAccountPeersUpdate(t *testing.T) {
 	})
 
 }
+
+func TestDefaultAccountManager_EvaluatePolicyChanges(t *testing.T) {
+	manager, account, peer1, peer2, _ := setupNetworkMapTest(t)
+
+	for _, group := range []*types.Group{
+		{ID: "groupA", Name: "GroupA", Peers: []string{peer1.ID}},
+		{ID: "groupB", Name: "GroupB", Peers: []string{peer2.ID}},
+	} {
+		err := manager.CreateGroup(context.Background(), account.Id, userID, group)
+		require.NoError(t, err)
+	}
+
+	policies, err := manager.ListPolicies(context.Background(), account.Id, userID)
+	require.NoError(t, err)
+	require.Len(t, policies, 1, "account should only have the default policy")
+
+	evaluation, err := manager.EvaluatePolicyChanges(context.Background(), account.Id, userID, []*types.PolicyChange{
+		{PolicyID: policies[0].ID},
+		{Policy: &types.Policy{
+			Name:    "ssh",
+			Enabled: true,
+			Rules: []*types.PolicyRule{{
+				Enabled:      true,
+				Action:       types.PolicyTrafficActionAccept,
+				Protocol:     types.PolicyRuleProtocolTCP,
+				Ports:        []string{"22"},
+				Sources:      []string{"groupA"},
+				Destinations: []string{"groupB"},
+			}},
+		}},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []types.PolicyAccess{{
+		SourcePeerID:    peer1.ID,
+		SourcePeerName:  peer1.Name,
+		DestinationType: types.PolicyAccessDestinationPeer,
+		DestinationID:   peer2.ID,
+		DestinationName: peer2.Name,
+		Protocol:        types.PolicyRuleProtocolTCP,
+		Port:            22,
+	}}, evaluation.Added)
+	assert.Len(t, evaluation.Removed, 6, "the default policy connects all of the 3 peers in both directions")
+
+	policies, err = manager.ListPolicies(context.Background(), account.Id, userID)
+	require.NoError(t, err)
+	assert.Len(t, policies, 1, "evaluation shouldn't change the policies")
+
+	_, err = manager.EvaluatePolicyChanges(context.Background(), account.Id, userID, []*types.PolicyChange{{PolicyID: "unknown"}})
+	require.Error(t, err)
+	s, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, status.NotFound, s.Type())
+}
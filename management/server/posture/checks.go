@@ -5,6 +5,8 @@ import (
 	"errors"
 	"net/netip"
 	"regexp"
+	"slices"
+	"time"
 
 	"github.com/hashicorp/go-version"
 	"github.com/netbirdio/netbird/shared/management/http/api"
@@ -18,6 +20,9 @@ const (
 	GeoLocationCheckName      = "GeoLocationCheck"
 	PeerNetworkRangeCheckName = "PeerNetworkRangeCheck"
 	ProcessCheckName          = "ProcessCheck"
+	DiskEncryptionCheckName   = "DiskEncryptionCheck"
+	HostFirewallCheckName     = "HostFirewallCheck"
+	ExternalCheckName         = "ExternalCheck"
 
 	CheckActionAllow string = "allow"
 	CheckActionDeny  string = "deny"
@@ -48,7 +53,7 @@ type Checks struct {
 	AccountID string `json:"-" gorm:"index"`
 
 	// Checks is a set of objects that perform the actual checks
-	Checks ChecksDefinition `gorm:"serializer:json"`
+	Checks ChecksDefinition `gorm:"serializer:posture_checks"`
 }
 
 // ChecksDefinition contains definition of actual check
@@ -58,6 +63,9 @@ type ChecksDefinition struct {
 	GeoLocationCheck      *GeoLocationCheck      `json:",omitempty"`
 	PeerNetworkRangeCheck *PeerNetworkRangeCheck `json:",omitempty"`
 	ProcessCheck          *ProcessCheck          `json:",omitempty"`
+	DiskEncryptionCheck   *DiskEncryptionCheck   `json:",omitempty"`
+	HostFirewallCheck     *HostFirewallCheck     `json:",omitempty"`
+	ExternalCheck         *ExternalCheck         `json:",omitempty"`
 }
 
 // Copy returns a copy of a checks definition.
@@ -110,6 +118,20 @@ func (cd ChecksDefinition) Copy() ChecksDefinition {
 		}
 		copy(cdCopy.ProcessCheck.Processes, processCheck.Processes)
 	}
+	if cd.DiskEncryptionCheck != nil {
+		cdCopy.DiskEncryptionCheck = &DiskEncryptionCheck{
+			Volumes: slices.Clone(cd.DiskEncryptionCheck.Volumes),
+		}
+	}
+	if cd.HostFirewallCheck != nil {
+		cdCopy.HostFirewallCheck = &HostFirewallCheck{
+			Firewalls: slices.Clone(cd.HostFirewallCheck.Firewalls),
+		}
+	}
+	if cd.ExternalCheck != nil {
+		externalCheck := *cd.ExternalCheck
+		cdCopy.ExternalCheck = &externalCheck
+	}
 	return cdCopy
 }
 
@@ -153,6 +175,15 @@ func (pc *Checks) GetChecks() []Check {
 	if pc.Checks.ProcessCheck != nil {
 		checks = append(checks, pc.Checks.ProcessCheck)
 	}
+	if pc.Checks.DiskEncryptionCheck != nil {
+		checks = append(checks, pc.Checks.DiskEncryptionCheck)
+	}
+	if pc.Checks.HostFirewallCheck != nil {
+		checks = append(checks, pc.Checks.HostFirewallCheck)
+	}
+	if pc.Checks.ExternalCheck != nil {
+		checks = append(checks, pc.Checks.ExternalCheck)
+	}
 	return checks
 }
 
@@ -208,6 +239,24 @@ func buildPostureCheck(postureChecksID string, name string, description string,
 		postureChecks.Checks.ProcessCheck = toProcessCheck(processCheck)
 	}
 
+	if diskEncryptionCheck := checks.DiskEncryptionCheck; diskEncryptionCheck != nil {
+		postureChecks.Checks.DiskEncryptionCheck = &DiskEncryptionCheck{}
+		if diskEncryptionCheck.Volumes != nil {
+			postureChecks.Checks.DiskEncryptionCheck.Volumes = *diskEncryptionCheck.Volumes
+		}
+	}
+
+	if hostFirewallCheck := checks.HostFirewallCheck; hostFirewallCheck != nil {
+		postureChecks.Checks.HostFirewallCheck = &HostFirewallCheck{}
+		if hostFirewallCheck.Firewalls != nil {
+			postureChecks.Checks.HostFirewallCheck.Firewalls = *hostFirewallCheck.Firewalls
+		}
+	}
+
+	if externalCheck := checks.ExternalCheck; externalCheck != nil {
+		postureChecks.Checks.ExternalCheck = toExternalCheck(externalCheck)
+	}
+
 	return &postureChecks, nil
 }
 
@@ -242,6 +291,22 @@ func (pc *Checks) ToAPIResponse() *api.PostureCheck {
 		checks.ProcessCheck = toProcessCheckResponse(pc.Checks.ProcessCheck)
 	}
 
+	if pc.Checks.DiskEncryptionCheck != nil {
+		volumes := make([]string, len(pc.Checks.DiskEncryptionCheck.Volumes))
+		copy(volumes, pc.Checks.DiskEncryptionCheck.Volumes)
+		checks.DiskEncryptionCheck = &api.DiskEncryptionCheck{Volumes: &volumes}
+	}
+
+	if pc.Checks.HostFirewallCheck != nil {
+		firewalls := make([]string, len(pc.Checks.HostFirewallCheck.Firewalls))
+		copy(firewalls, pc.Checks.HostFirewallCheck.Firewalls)
+		checks.HostFirewallCheck = &api.HostFirewallCheck{Firewalls: &firewalls}
+	}
+
+	if pc.Checks.ExternalCheck != nil {
+		checks.ExternalCheck = toExternalCheckResponse(pc.Checks.ExternalCheck)
+	}
+
 	return &api.PostureCheck{
 		Id:          pc.ID,
 		Name:        pc.Name,
@@ -386,3 +451,30 @@ func toProcessCheck(check *api.ProcessCheck) *ProcessCheck {
 		Processes: processes,
 	}
 }
+
+// toExternalCheckResponse converts the external check to its API representation, the token is never returned
+func toExternalCheckResponse(check *ExternalCheck) *api.ExternalCheck {
+	cacheTTL := int(check.CacheTTL.Seconds())
+	failOpen := check.FailOpen
+	return &api.ExternalCheck{
+		Url:      check.URL,
+		CacheTtl: &cacheTTL,
+		FailOpen: &failOpen,
+	}
+}
+
+func toExternalCheck(check *api.ExternalCheck) *ExternalCheck {
+	externalCheck := &ExternalCheck{
+		URL: check.Url,
+	}
+	if check.Token != nil {
+		externalCheck.Token = *check.Token
+	}
+	if check.CacheTtl != nil {
+		externalCheck.CacheTTL = time.Duration(*check.CacheTtl) * time.Second
+	}
+	if check.FailOpen != nil {
+		externalCheck.FailOpen = *check.FailOpen
+	}
+	return externalCheck
+}
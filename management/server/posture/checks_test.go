@@ -283,6 +283,12 @@ func TestChecks_Copy(t *testing.T) {
 					},
 				},
 			},
+			DiskEncryptionCheck: &DiskEncryptionCheck{
+				Volumes: []string{"/"},
+			},
+			HostFirewallCheck: &HostFirewallCheck{
+				Firewalls: []string{"ufw"},
+			},
 		},
 	}
 	checkCopy := check.Copy()
@@ -0,0 +1,37 @@
+package posture
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	sharedposture "github.com/netbirdio/netbird/shared/management/posture"
+)
+
+type DiskEncryptionCheck struct {
+	// Volumes mount points on unix systems or drive letters on windows that must be encrypted.
+	// All volumes reported by the peer must be encrypted when empty.
+	Volumes []string
+}
+
+var _ Check = (*DiskEncryptionCheck)(nil)
+
+// Check verifies that the peer reported its volumes and that the required ones are encrypted.
+// Peers that don't report the disk encryption state, e.g. clients that don't support it yet, fail the check.
+func (d *DiskEncryptionCheck) Check(_ context.Context, peer nbpeer.Peer) (bool, error) {
+	return sharedposture.CheckDiskEncryption(peer.Meta.GoOS, d.Volumes, peer.Meta.DiskEncryption.Volumes) == "", nil
+}
+
+func (d *DiskEncryptionCheck) Name() string {
+	return DiskEncryptionCheckName
+}
+
+func (d *DiskEncryptionCheck) Validate() error {
+	for _, volume := range d.Volumes {
+		if strings.TrimSpace(volume) == "" {
+			return fmt.Errorf("%s volume shouldn't be empty", d.Name())
+		}
+	}
+	return nil
+}
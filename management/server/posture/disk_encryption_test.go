@@ -0,0 +1,122 @@
+package posture
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/netbirdio/netbird/management/server/peer"
+)
+
+func TestDiskEncryptionCheck_Check(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   peer.Peer
+		check   DiskEncryptionCheck
+		isValid bool
+	}{
+		{
+			name: "all reported volumes encrypted",
+			input: peer.Peer{
+				Meta: peer.PeerSystemMeta{
+					GoOS: "linux",
+					DiskEncryption: peer.DiskEncryption{
+						Volumes: []peer.EncryptedVolume{
+							{Path: "/", Encrypted: true},
+							{Path: "/home", Encrypted: true},
+						},
+					},
+				},
+			},
+			check:   DiskEncryptionCheck{},
+			isValid: true,
+		},
+		{
+			name: "one of the reported volumes isn't encrypted",
+			input: peer.Peer{
+				Meta: peer.PeerSystemMeta{
+					GoOS: "linux",
+					DiskEncryption: peer.DiskEncryption{
+						Volumes: []peer.EncryptedVolume{
+							{Path: "/", Encrypted: true},
+							{Path: "/data", Encrypted: false},
+						},
+					},
+				},
+			},
+			check:   DiskEncryptionCheck{},
+			isValid: false,
+		},
+		{
+			name: "required volume encrypted",
+			input: peer.Peer{
+				Meta: peer.PeerSystemMeta{
+					GoOS: "linux",
+					DiskEncryption: peer.DiskEncryption{
+						Volumes: []peer.EncryptedVolume{
+							{Path: "/", Encrypted: true},
+							{Path: "/data", Encrypted: false},
+						},
+					},
+				},
+			},
+			check:   DiskEncryptionCheck{Volumes: []string{"/"}},
+			isValid: true,
+		},
+		{
+			name: "required volume not reported",
+			input: peer.Peer{
+				Meta: peer.PeerSystemMeta{
+					GoOS: "linux",
+					DiskEncryption: peer.DiskEncryption{
+						Volumes: []peer.EncryptedVolume{
+							{Path: "/", Encrypted: true},
+						},
+					},
+				},
+			},
+			check:   DiskEncryptionCheck{Volumes: []string{"/home"}},
+			isValid: false,
+		},
+		{
+			name: "windows drive letters are case-insensitive",
+			input: peer.Peer{
+				Meta: peer.PeerSystemMeta{
+					GoOS: "windows",
+					DiskEncryption: peer.DiskEncryption{
+						Volumes: []peer.EncryptedVolume{
+							{Path: "C:", Encrypted: true},
+						},
+					},
+				},
+			},
+			check:   DiskEncryptionCheck{Volumes: []string{"c:\\"}},
+			isValid: true,
+		},
+		{
+			name: "peer without disk encryption state",
+			input: peer.Peer{
+				Meta: peer.PeerSystemMeta{
+					GoOS: "linux",
+				},
+			},
+			check:   DiskEncryptionCheck{},
+			isValid: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			isValid, err := tt.check.Check(context.Background(), tt.input)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.isValid, isValid)
+		})
+	}
+}
+
+func TestDiskEncryptionCheck_Validate(t *testing.T) {
+	assert.NoError(t, (&DiskEncryptionCheck{}).Validate())
+	assert.NoError(t, (&DiskEncryptionCheck{Volumes: []string{"/", "C:"}}).Validate())
+	assert.Error(t, (&DiskEncryptionCheck{Volumes: []string{" "}}).Validate())
+}
@@ -0,0 +1,301 @@
+package posture
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	gocache "github.com/patrickmn/go-cache"
+	log "github.com/sirupsen/logrus"
+
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/util"
+)
+
+const (
+	// DefaultExternalCheckCacheTTL is the time the verdict of the external posture provider is cached for by default
+	DefaultExternalCheckCacheTTL = 5 * time.Minute
+
+	// externalCheckRetryInterval is the time a failed request is cached for before the provider is asked again
+	externalCheckRetryInterval = 30 * time.Second
+	// externalVerdictRetention is the time a verdict is kept after it should have been refreshed, so the verdicts
+	// of the deleted peers are dropped eventually
+	externalVerdictRetention = time.Hour
+	// externalCheckTimeout limits the time a request to the external posture provider takes
+	externalCheckTimeout = 5 * time.Second
+	// externalCheckMaxResponseSize limits the size of the external posture provider response
+	externalCheckMaxResponseSize = 64 * 1024
+	// externalCheckMaxConcurrentRequests limits the requests sent to the external posture providers at once
+	externalCheckMaxConcurrentRequests = 16
+
+	// allowPrivateExternalEndpointsEnv allows the external posture providers in private networks when set to true
+	allowPrivateExternalEndpointsEnv = "NB_POSTURE_EXTERNAL_ALLOW_PRIVATE_NETWORKS"
+)
+
+// errExternalVerdictPending is returned until the first verdict of the external posture provider is received
+var errExternalVerdictPending = errors.New("external posture verdict is pending")
+
+var (
+	// externalVerdicts caches the verdicts of the external posture providers by endpoint and peer
+	externalVerdicts = gocache.New(externalVerdictRetention, 10*time.Minute)
+	// externalRefreshes holds the endpoint and peer keys of the verdicts being refreshed
+	externalRefreshes sync.Map
+	// externalRequestSlots limits the concurrent requests to the external posture providers
+	externalRequestSlots = make(chan struct{}, externalCheckMaxConcurrentRequests)
+	externalClient       = newExternalClient()
+
+	externalVerdictListener       atomic.Value
+	allowPrivateExternalEndpoints atomic.Bool
+)
+
+func init() {
+	allow, _ := strconv.ParseBool(os.Getenv(allowPrivateExternalEndpointsEnv))
+	allowPrivateExternalEndpoints.Store(allow)
+}
+
+// SetExternalVerdictListener sets the function called with the account of the peer whose verdict changed after it
+// was refreshed in the background, so the network maps of the account can be updated
+func SetExternalVerdictListener(listener func(accountID string)) {
+	externalVerdictListener.Store(listener)
+}
+
+// AllowPrivateExternalEndpoints allows the external posture providers in loopback, private and link-local networks,
+// they are rejected by default so the management host and its network can't be probed with the check
+func AllowPrivateExternalEndpoints(allow bool) {
+	allowPrivateExternalEndpoints.Store(allow)
+}
+
+// externalVerdict is the cached answer of the external posture provider for a peer
+type externalVerdict struct {
+	compliant bool
+	err       error
+	refreshAt time.Time
+}
+
+// ExternalCheck asks an external posture provider, e.g. an MDM, whether the peer is compliant.
+type ExternalCheck struct {
+	// URL of the endpoint the peer identity is posted to
+	URL string
+
+	// Token is sent as bearer token to the endpoint when set
+	Token string `json:",omitempty"`
+
+	// CacheTTL is the time the verdict is cached for, DefaultExternalCheckCacheTTL when zero
+	CacheTTL time.Duration
+
+	// FailOpen makes the check pass when the endpoint can't be reached or returns an invalid response
+	FailOpen bool
+}
+
+// ExternalCheckRequest is the body posted to the external posture provider
+type ExternalCheckRequest struct {
+	PeerID         string `json:"peer_id"`
+	AccountID      string `json:"account_id"`
+	UserID         string `json:"user_id,omitempty"`
+	Hostname       string `json:"hostname"`
+	SerialNumber   string `json:"serial_number"`
+	OS             string `json:"os"`
+	OSVersion      string `json:"os_version"`
+	NetbirdVersion string `json:"netbird_version"`
+	WgPubKey       string `json:"wg_pub_key"`
+}
+
+// ExternalCheckResponse is the verdict expected from the external posture provider
+type ExternalCheckResponse struct {
+	Compliant bool `json:"compliant"`
+}
+
+var _ Check = (*ExternalCheck)(nil)
+
+// Check returns the cached verdict of the external posture provider for the peer. The verdict is requested in the
+// background when it is missing or has to be refreshed, so the network map calculation never waits for the provider.
+// Missing verdicts and errors make the check fail unless FailOpen is set, errors are cached for a short time.
+func (e *ExternalCheck) Check(ctx context.Context, peer nbpeer.Peer) (bool, error) {
+	key := e.URL + "|" + peer.ID
+
+	verdict := getExternalVerdict(key)
+	if verdict == nil || !time.Now().Before(verdict.refreshAt) {
+		e.scheduleRefresh(ctx, key, peer)
+	}
+
+	switch {
+	case verdict == nil:
+		return e.FailOpen, errExternalVerdictPending
+	case verdict.err != nil:
+		return e.FailOpen, verdict.err
+	default:
+		return verdict.compliant, nil
+	}
+}
+
+func (e *ExternalCheck) Name() string {
+	return ExternalCheckName
+}
+
+func (e *ExternalCheck) Validate() error {
+	if e.URL == "" {
+		return fmt.Errorf("%s url shouldn't be empty", e.Name())
+	}
+
+	u, err := url.Parse(e.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%s url should be an absolute http or https url", e.Name())
+	}
+
+	if !allowPrivateExternalEndpoints.Load() {
+		if util.IsPrivateHost(u.Hostname()) {
+			return fmt.Errorf("%s url shouldn't point to a private network", e.Name())
+		}
+	}
+
+	if e.CacheTTL < 0 {
+		return fmt.Errorf("%s cache ttl shouldn't be negative", e.Name())
+	}
+
+	return nil
+}
+
+// InheritToken keeps the token of the existing check when no new token is set for the same endpoint,
+// the token isn't returned by the API so updates don't include it.
+func (e *ExternalCheck) InheritToken(existing *ExternalCheck) {
+	if e == nil || existing == nil || e.Token != "" || e.URL != existing.URL {
+		return
+	}
+	e.Token = existing.Token
+}
+
+func (e *ExternalCheck) cacheTTL() time.Duration {
+	if e.CacheTTL == 0 {
+		return DefaultExternalCheckCacheTTL
+	}
+	return e.CacheTTL
+}
+
+func getExternalVerdict(key string) *externalVerdict {
+	cached, ok := externalVerdicts.Get(key)
+	if !ok {
+		return nil
+	}
+	return cached.(*externalVerdict)
+}
+
+// scheduleRefresh requests the verdict of the peer in the background unless it is already being requested
+func (e *ExternalCheck) scheduleRefresh(ctx context.Context, key string, peer nbpeer.Peer) {
+	if _, inFlight := externalRefreshes.LoadOrStore(key, struct{}{}); inFlight {
+		return
+	}
+
+	check := *e
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		defer externalRefreshes.Delete(key)
+		check.refresh(ctx, key, peer)
+	}()
+}
+
+// refresh requests the verdict of the peer and notifies the listener when the outcome of the check changed
+func (e *ExternalCheck) refresh(ctx context.Context, key string, peer nbpeer.Peer) {
+	externalRequestSlots <- struct{}{}
+	compliant, err := e.requestVerdict(ctx, peer)
+	<-externalRequestSlots
+
+	verdict := &externalVerdict{compliant: compliant, refreshAt: time.Now().Add(e.cacheTTL())}
+	if err != nil {
+		log.WithContext(ctx).Debugf("failed to request the external posture verdict of peer %s: %v", peer.ID, err)
+		verdict.err = fmt.Errorf("request external posture provider: %w", err)
+		verdict.refreshAt = time.Now().Add(externalCheckRetryInterval)
+	}
+
+	previous := getExternalVerdict(key)
+	externalVerdicts.Set(key, verdict, time.Until(verdict.refreshAt)+externalVerdictRetention)
+
+	if e.outcome(previous) == e.outcome(verdict) {
+		return
+	}
+	if listener, ok := externalVerdictListener.Load().(func(string)); ok && listener != nil {
+		listener(peer.AccountID)
+	}
+}
+
+// outcome returns the result of the check with the verdict
+func (e *ExternalCheck) outcome(verdict *externalVerdict) bool {
+	if verdict == nil || verdict.err != nil {
+		return e.FailOpen
+	}
+	return verdict.compliant
+}
+
+func (e *ExternalCheck) requestVerdict(ctx context.Context, peer nbpeer.Peer) (bool, error) {
+	body, err := json.Marshal(ExternalCheckRequest{
+		PeerID:         peer.ID,
+		AccountID:      peer.AccountID,
+		UserID:         peer.UserID,
+		Hostname:       peer.Meta.Hostname,
+		SerialNumber:   peer.Meta.SystemSerialNumber,
+		OS:             peer.Meta.GoOS,
+		OSVersion:      peer.Meta.OSVersion,
+		NetbirdVersion: peer.Meta.WtVersion,
+		WgPubKey:       peer.Key,
+	})
+	if err != nil {
+		return false, fmt.Errorf("marshal request: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, externalCheckTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.URL, bytes.NewReader(body))
+	if err != nil {
+		return false, fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if e.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+e.Token)
+	}
+
+	resp, err := externalClient.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	var verdict ExternalCheckResponse
+	if err := json.NewDecoder(io.LimitReader(resp.Body, externalCheckMaxResponseSize)).Decode(&verdict); err != nil {
+		return false, fmt.Errorf("decode response: %w", err)
+	}
+
+	return verdict.Compliant, nil
+}
+
+// newExternalClient returns the client used to request the external posture providers. The dialer rejects the private
+// destinations after the name resolution and for every redirect, so they can't be reached through a public name.
+func newExternalClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: externalCheckTimeout,
+		Control: util.PrivateAddrDialControl(allowPrivateExternalEndpoints.Load),
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// a proxy would hide the destination from the dialer
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{Timeout: externalCheckTimeout, Transport: transport}
+}
@@ -0,0 +1,163 @@
+package posture_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/posture"
+	"github.com/netbirdio/netbird/management/server/posture/posturetest"
+)
+
+func TestMain(m *testing.M) {
+	// the stubs listen on the loopback interface
+	posture.AllowPrivateExternalEndpoints(true)
+	os.Exit(m.Run())
+}
+
+// checkEventually runs the check until the background refresh delivered the expected outcome
+func checkEventually(t *testing.T, check *posture.ExternalCheck, peer nbpeer.Peer, expected bool) {
+	t.Helper()
+	require.Eventually(t, func() bool {
+		isValid, err := check.Check(context.Background(), peer)
+		return err == nil && isValid == expected
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestExternalCheck_Check(t *testing.T) {
+	stub := posturetest.NewExternalStub(false)
+	stub.SetToken("secret")
+	stub.SetVerdict("serial-1", true)
+	server := httptest.NewServer(stub)
+	t.Cleanup(server.Close)
+
+	check := &posture.ExternalCheck{URL: server.URL, Token: "secret", CacheTTL: time.Minute}
+	compliant := nbpeer.Peer{ID: "peer-1", AccountID: "account", UserID: "user", Meta: nbpeer.PeerSystemMeta{Hostname: "laptop", SystemSerialNumber: "serial-1"}}
+	unknown := nbpeer.Peer{ID: "peer-2", AccountID: "account", Meta: nbpeer.PeerSystemMeta{SystemSerialNumber: "serial-2"}}
+
+	// the check doesn't wait for the provider, the peer fails it until the verdict is received
+	isValid, err := check.Check(context.Background(), compliant)
+	assert.Error(t, err)
+	assert.False(t, isValid)
+
+	checkEventually(t, check, compliant, true)
+	checkEventually(t, check, unknown, false)
+
+	requests := stub.Requests()
+	require.Len(t, requests, 2)
+	assert.Equal(t, "peer-1", requests[0].PeerID)
+	assert.Equal(t, "user", requests[0].UserID)
+	assert.Equal(t, "laptop", requests[0].Hostname)
+
+	// the verdict is cached until the TTL expires
+	stub.SetVerdict("serial-1", false)
+	isValid, err = check.Check(context.Background(), compliant)
+	require.NoError(t, err)
+	assert.True(t, isValid)
+	assert.Len(t, stub.Requests(), 2)
+}
+
+func TestExternalCheck_FailureBehavior(t *testing.T) {
+	stub := posturetest.NewExternalStub(true)
+	stub.SetStatusCode(http.StatusServiceUnavailable)
+	server := httptest.NewServer(stub)
+	t.Cleanup(server.Close)
+
+	failClosed := &posture.ExternalCheck{URL: server.URL}
+	failOpen := &posture.ExternalCheck{URL: server.URL, FailOpen: true}
+
+	require.Eventually(t, func() bool {
+		_, err := failClosed.Check(context.Background(), nbpeer.Peer{ID: "peer-1"})
+		return err != nil && !strings.Contains(err.Error(), "pending")
+	}, 5*time.Second, 10*time.Millisecond)
+
+	isValid, err := failClosed.Check(context.Background(), nbpeer.Peer{ID: "peer-1"})
+	assert.Error(t, err)
+	assert.False(t, isValid, "fail-closed check should fail when the provider is unavailable")
+
+	isValid, err = failOpen.Check(context.Background(), nbpeer.Peer{ID: "peer-1"})
+	assert.Error(t, err)
+	assert.True(t, isValid, "fail-open check should pass when the provider is unavailable")
+
+	// failures are cached for a short time, so an unavailable provider isn't asked on every check
+	assert.Len(t, stub.Requests(), 1)
+}
+
+func TestExternalCheck_VerdictListener(t *testing.T) {
+	stub := posturetest.NewExternalStub(true)
+	server := httptest.NewServer(stub)
+	t.Cleanup(server.Close)
+
+	updated := make(chan string, 1)
+	posture.SetExternalVerdictListener(func(accountID string) {
+		updated <- accountID
+	})
+	t.Cleanup(func() { posture.SetExternalVerdictListener(nil) })
+
+	check := &posture.ExternalCheck{URL: server.URL}
+	_, _ = check.Check(context.Background(), nbpeer.Peer{ID: "peer-1", AccountID: "account"})
+
+	select {
+	case accountID := <-updated:
+		assert.Equal(t, "account", accountID)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the listener wasn't notified about the received verdict")
+	}
+}
+
+func TestExternalCheck_RejectsPrivateEndpoints(t *testing.T) {
+	posture.AllowPrivateExternalEndpoints(false)
+	t.Cleanup(func() { posture.AllowPrivateExternalEndpoints(true) })
+
+	for _, endpoint := range []string{
+		"http://127.0.0.1/compliance",
+		"http://localhost/compliance",
+		"http://10.0.0.1/compliance",
+		"http://169.254.169.254/latest/meta-data",
+		"http://[::1]/compliance",
+		"http://[fd00::1]/compliance",
+		"http://100.64.0.1/compliance",
+	} {
+		assert.Error(t, (&posture.ExternalCheck{URL: endpoint}).Validate(), endpoint)
+	}
+
+	// names resolving to private addresses are rejected when dialing
+	stub := posturetest.NewExternalStub(true)
+	server := httptest.NewServer(stub)
+	t.Cleanup(server.Close)
+
+	check := &posture.ExternalCheck{URL: strings.Replace(server.URL, "127.0.0.1", "localhost", 1), FailOpen: true}
+	require.Eventually(t, func() bool {
+		_, err := check.Check(context.Background(), nbpeer.Peer{ID: "peer-1"})
+		return err != nil && strings.Contains(err.Error(), "private network")
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Empty(t, stub.Requests())
+}
+
+func TestExternalCheck_Validate(t *testing.T) {
+	assert.NoError(t, (&posture.ExternalCheck{URL: "https://mdm.example.com/compliance"}).Validate())
+	assert.Error(t, (&posture.ExternalCheck{}).Validate())
+	assert.Error(t, (&posture.ExternalCheck{URL: "mdm.example.com"}).Validate())
+	assert.Error(t, (&posture.ExternalCheck{URL: "ftp://mdm.example.com"}).Validate())
+	assert.Error(t, (&posture.ExternalCheck{URL: "https://mdm.example.com", CacheTTL: -time.Second}).Validate())
+}
+
+func TestExternalCheck_InheritToken(t *testing.T) {
+	existing := &posture.ExternalCheck{URL: "https://mdm.example.com", Token: "secret"}
+
+	check := &posture.ExternalCheck{URL: "https://mdm.example.com"}
+	check.InheritToken(existing)
+	assert.Equal(t, "secret", check.Token)
+
+	check = &posture.ExternalCheck{URL: "https://other.example.com"}
+	check.InheritToken(existing)
+	assert.Empty(t, check.Token, "token shouldn't be sent to another endpoint")
+}
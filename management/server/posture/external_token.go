@@ -0,0 +1,116 @@
+package posture
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync/atomic"
+
+	"gorm.io/gorm/schema"
+)
+
+const (
+	// checksSerializerName is the name of the gorm serializer of the checks definitions
+	checksSerializerName = "posture_checks"
+	// encryptedTokenPrefix marks the encrypted tokens, the tokens stored before the encryption was added don't have it
+	encryptedTokenPrefix = "enc:"
+)
+
+// TokenCipher encrypts the tokens of the external posture providers stored with the checks
+type TokenCipher interface {
+	Encrypt(payload string) (string, error)
+	Decrypt(data string) (string, error)
+}
+
+var tokenCipher atomic.Value
+
+func init() {
+	schema.RegisterSerializer(checksSerializerName, checksSerializer{})
+}
+
+// SetTokenCipher sets the cipher the tokens of the external posture providers are encrypted with in the store
+func SetTokenCipher(cipher TokenCipher) {
+	tokenCipher.Store(&cipher)
+}
+
+func getTokenCipher() TokenCipher {
+	cipher, ok := tokenCipher.Load().(*TokenCipher)
+	if !ok {
+		return nil
+	}
+	return *cipher
+}
+
+// checksSerializer stores the checks definitions as JSON with the token of the external check encrypted
+type checksSerializer struct{}
+
+func (checksSerializer) Scan(ctx context.Context, field *schema.Field, dst reflect.Value, dbValue any) error {
+	var checks ChecksDefinition
+
+	var data []byte
+	switch v := dbValue.(type) {
+	case nil:
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("unsupported posture checks value type %T", dbValue)
+	}
+
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &checks); err != nil {
+			return err
+		}
+	}
+
+	if external := checks.ExternalCheck; external != nil && strings.HasPrefix(external.Token, encryptedTokenPrefix) {
+		cipher := getTokenCipher()
+		if cipher == nil {
+			return errors.New("external posture provider token is encrypted but no cipher is set")
+		}
+		token, err := cipher.Decrypt(strings.TrimPrefix(external.Token, encryptedTokenPrefix))
+		if err != nil {
+			return fmt.Errorf("decrypt external posture provider token: %w", err)
+		}
+		external.Token = token
+	}
+
+	field.ReflectValueOf(ctx, dst).Set(reflect.ValueOf(checks))
+	return nil
+}
+
+func (checksSerializer) Value(_ context.Context, _ *schema.Field, _ reflect.Value, fieldValue any) (any, error) {
+	var checks ChecksDefinition
+	switch v := fieldValue.(type) {
+	case ChecksDefinition:
+		checks = v
+	case *ChecksDefinition:
+		if v != nil {
+			checks = *v
+		}
+	default:
+		return nil, fmt.Errorf("unsupported posture checks type %T", fieldValue)
+	}
+
+	if external := checks.ExternalCheck; external != nil && external.Token != "" {
+		if cipher := getTokenCipher(); cipher != nil {
+			token, err := cipher.Encrypt(external.Token)
+			if err != nil {
+				return nil, fmt.Errorf("encrypt external posture provider token: %w", err)
+			}
+			encrypted := *external
+			encrypted.Token = encryptedTokenPrefix + token
+			checks.ExternalCheck = &encrypted
+		}
+	}
+
+	data, err := json.Marshal(checks)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
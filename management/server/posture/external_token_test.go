@@ -0,0 +1,54 @@
+package posture
+
+import (
+	"context"
+	"encoding/base64"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm/schema"
+)
+
+type base64Cipher struct{}
+
+func (base64Cipher) Encrypt(payload string) (string, error) {
+	return base64.StdEncoding.EncodeToString([]byte(payload)), nil
+}
+
+func (base64Cipher) Decrypt(data string) (string, error) {
+	payload, err := base64.StdEncoding.DecodeString(data)
+	return string(payload), err
+}
+
+func TestChecksSerializer(t *testing.T) {
+	SetTokenCipher(base64Cipher{})
+	t.Cleanup(func() { SetTokenCipher(nil) })
+
+	field := &schema.Field{
+		FieldType: reflect.TypeOf(ChecksDefinition{}),
+		ReflectValueOf: func(_ context.Context, value reflect.Value) reflect.Value {
+			return value.Elem().FieldByName("Checks")
+		},
+	}
+
+	checks := ChecksDefinition{ExternalCheck: &ExternalCheck{URL: "https://mdm.example.com", Token: "secret"}}
+	value, err := checksSerializer{}.Value(context.Background(), field, reflect.Value{}, checks)
+	require.NoError(t, err)
+
+	stored := value.(string)
+	assert.NotContains(t, stored, "secret", "the token should be encrypted")
+	assert.True(t, strings.Contains(stored, encryptedTokenPrefix))
+	assert.Equal(t, "secret", checks.ExternalCheck.Token, "the stored checks shouldn't be modified")
+
+	var loaded Checks
+	require.NoError(t, checksSerializer{}.Scan(context.Background(), field, reflect.ValueOf(&loaded), stored))
+	assert.Equal(t, "secret", loaded.Checks.ExternalCheck.Token)
+
+	// the tokens stored before the encryption are read as they are
+	var legacy Checks
+	require.NoError(t, checksSerializer{}.Scan(context.Background(), field, reflect.ValueOf(&legacy), `{"ExternalCheck":{"URL":"https://mdm.example.com","Token":"plain"}}`))
+	assert.Equal(t, "plain", legacy.Checks.ExternalCheck.Token)
+}
@@ -0,0 +1,37 @@
+package posture
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	sharedposture "github.com/netbirdio/netbird/shared/management/posture"
+)
+
+type HostFirewallCheck struct {
+	// Firewalls names of the host firewalls accepted by the check, e.g. ufw, firewalld, nftables,
+	// iptables, application firewall or windows firewall. Any active host firewall is accepted when empty.
+	Firewalls []string
+}
+
+var _ Check = (*HostFirewallCheck)(nil)
+
+// Check verifies that the peer reported at least one active host firewall accepted by the check.
+// Firewall rules managed by NetBird itself aren't reported by the peer and don't count as a host firewall.
+func (h *HostFirewallCheck) Check(_ context.Context, peer nbpeer.Peer) (bool, error) {
+	return sharedposture.CheckHostFirewall(h.Firewalls, peer.Meta.HostFirewall.Active) == "", nil
+}
+
+func (h *HostFirewallCheck) Name() string {
+	return HostFirewallCheckName
+}
+
+func (h *HostFirewallCheck) Validate() error {
+	for _, firewall := range h.Firewalls {
+		if strings.TrimSpace(firewall) == "" {
+			return fmt.Errorf("%s firewall name shouldn't be empty", h.Name())
+		}
+	}
+	return nil
+}
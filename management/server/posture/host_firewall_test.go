@@ -0,0 +1,70 @@
+package posture
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/netbirdio/netbird/management/server/peer"
+)
+
+func TestHostFirewallCheck_Check(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   peer.Peer
+		check   HostFirewallCheck
+		isValid bool
+	}{
+		{
+			name: "any active firewall",
+			input: peer.Peer{
+				Meta: peer.PeerSystemMeta{
+					HostFirewall: peer.HostFirewall{Active: []string{"firewalld"}},
+				},
+			},
+			check:   HostFirewallCheck{},
+			isValid: true,
+		},
+		{
+			name:    "no active firewall",
+			input:   peer.Peer{},
+			check:   HostFirewallCheck{},
+			isValid: false,
+		},
+		{
+			name: "accepted firewall active",
+			input: peer.Peer{
+				Meta: peer.PeerSystemMeta{
+					HostFirewall: peer.HostFirewall{Active: []string{"nftables", "ufw"}},
+				},
+			},
+			check:   HostFirewallCheck{Firewalls: []string{"UFW"}},
+			isValid: true,
+		},
+		{
+			name: "only other firewalls active",
+			input: peer.Peer{
+				Meta: peer.PeerSystemMeta{
+					HostFirewall: peer.HostFirewall{Active: []string{"nftables"}},
+				},
+			},
+			check:   HostFirewallCheck{Firewalls: []string{"ufw", "firewalld"}},
+			isValid: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			isValid, err := tt.check.Check(context.Background(), tt.input)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.isValid, isValid)
+		})
+	}
+}
+
+func TestHostFirewallCheck_Validate(t *testing.T) {
+	assert.NoError(t, (&HostFirewallCheck{}).Validate())
+	assert.NoError(t, (&HostFirewallCheck{Firewalls: []string{"ufw"}}).Validate())
+	assert.Error(t, (&HostFirewallCheck{Firewalls: []string{""}}).Validate())
+}
@@ -0,0 +1,99 @@
+// Package posturetest provides a local external posture provider stub for tests and local setups.
+package posturetest
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	"github.com/netbirdio/netbird/management/server/posture"
+)
+
+// ExternalStub is an external posture provider answering with configured verdicts.
+// It can be served with httptest.NewServer or http.ListenAndServe.
+type ExternalStub struct {
+	mu sync.Mutex
+	// verdicts by peer ID or serial number
+	verdicts       map[string]bool
+	defaultVerdict bool
+	token          string
+	statusCode     int
+	requests       []posture.ExternalCheckRequest
+}
+
+// NewExternalStub returns a stub answering with the default verdict for peers without a configured one
+func NewExternalStub(defaultVerdict bool) *ExternalStub {
+	return &ExternalStub{
+		verdicts:       make(map[string]bool),
+		defaultVerdict: defaultVerdict,
+		statusCode:     http.StatusOK,
+	}
+}
+
+// SetVerdict sets the verdict for a peer ID or serial number
+func (s *ExternalStub) SetVerdict(peerIDOrSerial string, compliant bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.verdicts[peerIDOrSerial] = compliant
+}
+
+// SetToken makes the stub reject requests without the bearer token
+func (s *ExternalStub) SetToken(token string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.token = token
+}
+
+// SetStatusCode makes the stub answer with the status code instead of a verdict, e.g. to simulate outages
+func (s *ExternalStub) SetStatusCode(statusCode int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.statusCode = statusCode
+}
+
+// Requests returns the requests received by the stub
+func (s *ExternalStub) Requests() []posture.ExternalCheckRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	requests := make([]posture.ExternalCheckRequest, len(s.requests))
+	copy(requests, s.requests)
+	return requests
+}
+
+func (s *ExternalStub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	if s.token != "" && r.Header.Get("Authorization") != "Bearer "+s.token {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	var req posture.ExternalCheckRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	s.requests = append(s.requests, req)
+
+	if s.statusCode != http.StatusOK {
+		w.WriteHeader(s.statusCode)
+		return
+	}
+
+	compliant, ok := s.verdicts[req.PeerID]
+	if !ok {
+		compliant, ok = s.verdicts[req.SerialNumber]
+	}
+	if !ok {
+		compliant = s.defaultVerdict
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(posture.ExternalCheckResponse{Compliant: compliant})
+}
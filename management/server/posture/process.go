@@ -14,6 +14,20 @@ type Process struct {
 	WindowsPath string
 }
 
+// PathForOS returns the process path for the operating system, empty if the process isn't defined for it
+func (p Process) PathForOS(goOS string) string {
+	switch goOS {
+	case "linux":
+		return p.LinuxPath
+	case "darwin":
+		return p.MacPath
+	case "windows":
+		return p.WindowsPath
+	default:
+		return ""
+	}
+}
+
 type ProcessCheck struct {
 	Processes []Process
 }
@@ -23,19 +37,13 @@ var _ Check = (*ProcessCheck)(nil)
 func (p *ProcessCheck) Check(_ context.Context, peer nbpeer.Peer) (bool, error) {
 	peerActiveProcesses := extractPeerActiveProcesses(peer.Meta.Files)
 
-	var pathSelector func(Process) string
 	switch peer.Meta.GoOS {
-	case "linux":
-		pathSelector = func(process Process) string { return process.LinuxPath }
-	case "darwin":
-		pathSelector = func(process Process) string { return process.MacPath }
-	case "windows":
-		pathSelector = func(process Process) string { return process.WindowsPath }
+	case "linux", "darwin", "windows":
 	default:
 		return false, fmt.Errorf("unsupported peer's operating system: %s", peer.Meta.GoOS)
 	}
 
-	return p.areAllProcessesRunning(peerActiveProcesses, pathSelector), nil
+	return p.areAllProcessesRunning(peerActiveProcesses, peer.Meta.GoOS), nil
 }
 
 func (p *ProcessCheck) Name() string {
@@ -56,11 +64,11 @@ func (p *ProcessCheck) Validate() error {
 }
 
 // areAllProcessesRunning checks if all processes specified in ProcessCheck are running.
-// It uses the provided pathSelector to get the appropriate process path for the peer's OS.
+// It uses the process path for the peer's OS.
 // It returns true if all processes are running, otherwise false.
-func (p *ProcessCheck) areAllProcessesRunning(activeProcesses []string, pathSelector func(Process) string) bool {
+func (p *ProcessCheck) areAllProcessesRunning(activeProcesses []string, goOS string) bool {
 	for _, process := range p.Processes {
-		path := pathSelector(process)
+		path := process.PathForOS(goOS)
 		if path == "" || !slices.Contains(activeProcesses, path) {
 			return false
 		}
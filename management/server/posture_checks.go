@@ -7,9 +7,11 @@ import (
 	"slices"
 
 	"github.com/rs/xid"
+	log "github.com/sirupsen/logrus"
 	"golang.org/x/exp/maps"
 
 	"github.com/netbirdio/netbird/management/server/activity"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
 	"github.com/netbirdio/netbird/management/server/permissions/modules"
 	"github.com/netbirdio/netbird/management/server/permissions/operations"
 	"github.com/netbirdio/netbird/management/server/posture"
@@ -188,9 +190,11 @@ func validatePostureChecks(ctx context.Context, transaction store.Store, account
 
 	// If the posture check already has an ID, verify its existence in the store.
 	if postureChecks.ID != "" {
-		if _, err := transaction.GetPostureChecksByID(ctx, store.LockingStrengthNone, accountID, postureChecks.ID); err != nil {
+		existing, err := transaction.GetPostureChecksByID(ctx, store.LockingStrengthNone, accountID, postureChecks.ID)
+		if err != nil {
 			return err
 		}
+		postureChecks.Checks.ExternalCheck.InheritToken(existing.Checks.ExternalCheck)
 		return nil
 	}
 
@@ -270,3 +274,64 @@ func isPostureCheckLinkedToPolicy(ctx context.Context, transaction store.Store,
 
 	return nil
 }
+
+// postureChecksResult is the evaluation result of posture checks on a peer
+type postureChecksResult struct {
+	passed bool
+	reason string
+}
+
+// evaluatePeerPostureChecks evaluates the posture checks on the peer meta, including the results of the client-side evaluation
+func evaluatePeerPostureChecks(ctx context.Context, peer nbpeer.Peer, postureChecks []*posture.Checks) map[string]postureChecksResult {
+	results := make(map[string]postureChecksResult, len(postureChecks))
+	for _, postureCheck := range postureChecks {
+		result := postureChecksResult{passed: true}
+
+		if clientResult, ok := peer.Meta.GetPostureCheckResult(postureCheck.ID); ok && !clientResult.Passed {
+			result = postureChecksResult{reason: clientResult.Reason}
+		}
+
+		for _, check := range postureCheck.GetChecks() {
+			if !result.passed {
+				break
+			}
+			isValid, err := check.Check(ctx, peer)
+			if err != nil {
+				log.WithContext(ctx).Debugf("an error occurred check %s: on peer: %s :%s", check.Name(), peer.ID, err.Error())
+			}
+			if !isValid {
+				result = postureChecksResult{reason: fmt.Sprintf("%s failed", check.Name())}
+			}
+		}
+
+		results[postureCheck.ID] = result
+	}
+	return results
+}
+
+// storePostureChecksTransitionEvents stores an activity event for every posture checks that started failing or
+// passing on the peer after its meta changed
+func (am *DefaultAccountManager) storePostureChecksTransitionEvents(ctx context.Context, accountID string, peer *nbpeer.Peer, previousMeta nbpeer.PeerSystemMeta, postureChecks []*posture.Checks, dnsDomain string) {
+	previousPeer := *peer
+	previousPeer.Meta = previousMeta
+
+	previousResults := evaluatePeerPostureChecks(ctx, previousPeer, postureChecks)
+	results := evaluatePeerPostureChecks(ctx, *peer, postureChecks)
+
+	for _, postureCheck := range postureChecks {
+		result, previousResult := results[postureCheck.ID], previousResults[postureCheck.ID]
+		if result.passed == previousResult.passed {
+			continue
+		}
+
+		event := activity.PeerPostureCheckRecovered
+		meta := peer.EventMeta(dnsDomain)
+		meta["posture_check"] = postureCheck.Name
+		if !result.passed {
+			event = activity.PeerPostureCheckFailed
+			meta["reason"] = result.reason
+		}
+
+		am.StoreEvent(ctx, activity.SystemInitiator, peer.ID, accountID, event, meta)
+	}
+}
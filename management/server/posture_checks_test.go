@@ -8,7 +8,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/netbirdio/netbird/management/server/activity"
 	"github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/store"
 	"github.com/netbirdio/netbird/management/server/types"
 
 	"github.com/netbirdio/netbird/management/server/posture"
@@ -563,3 +565,110 @@ func TestArePostureCheckChangesAffectPeers(t *testing.T) {
 		assert.False(t, result)
 	})
 }
+
+func TestDefaultAccountManager_PostureCheckResults(t *testing.T) {
+	manager, account, peer1, peer2, _ := setupNetworkMapTest(t)
+
+	// remove the default policy allowing all peers to connect
+	for _, policy := range account.Policies {
+		require.NoError(t, manager.DeletePolicy(context.Background(), account.Id, policy.ID, userID))
+	}
+
+	err := manager.CreateGroup(context.Background(), account.Id, userID, &types.Group{
+		ID:    "groupA",
+		Name:  "GroupA",
+		Peers: []string{peer1.ID, peer2.ID},
+	})
+	require.NoError(t, err)
+
+	postureCheck, err := manager.SavePostureChecks(context.Background(), account.Id, userID, &posture.Checks{
+		Name:      "firewall",
+		AccountID: account.Id,
+		Checks: posture.ChecksDefinition{
+			HostFirewallCheck: &posture.HostFirewallCheck{},
+		},
+	}, true)
+	require.NoError(t, err)
+
+	_, err = manager.SavePolicy(context.Background(), account.Id, userID, &types.Policy{
+		Name:                "firewalled",
+		Enabled:             true,
+		SourcePostureChecks: []string{postureCheck.ID},
+		Rules: []*types.PolicyRule{{
+			Name:          "firewalled",
+			Enabled:       true,
+			Sources:       []string{"groupA"},
+			Destinations:  []string{"groupA"},
+			Bidirectional: true,
+			Action:        types.PolicyTrafficActionAccept,
+			Protocol:      types.PolicyRuleProtocolALL,
+		}},
+	}, true)
+	require.NoError(t, err)
+
+	syncMeta := func(passed bool) *types.NetworkMap {
+		t.Helper()
+		meta := peer.PeerSystemMeta{
+			Hostname:     peer1.Meta.Hostname,
+			GoOS:         "linux",
+			HostFirewall: peer.HostFirewall{Active: []string{"nftables"}},
+			PostureCheckResults: []peer.PostureCheckResult{
+				{ID: postureCheck.ID, Passed: passed},
+			},
+		}
+		if !passed {
+			meta.PostureCheckResults[0].Reason = "no host firewall is active"
+		}
+		_, networkMap, _, err := manager.SyncPeer(context.Background(), types.PeerSync{WireGuardPubKey: peer1.Key, Meta: meta}, account.Id)
+		require.NoError(t, err)
+		return networkMap
+	}
+
+	networkMap := syncMeta(true)
+	assert.Len(t, networkMap.Peers, 1, "peer passing the posture checks should have access")
+
+	networkMap = syncMeta(false)
+	assert.Empty(t, networkMap.Peers, "peer failing the client-side posture checks should lose access")
+
+	ev := getEvent(t, account.Id, manager, activity.PeerPostureCheckFailed)
+	assert.Equal(t, peer1.ID, ev.TargetID)
+	assert.Equal(t, "firewall", ev.Meta["posture_check"])
+	assert.Equal(t, "no host firewall is active", ev.Meta["reason"])
+
+	networkMap = syncMeta(true)
+	assert.Len(t, networkMap.Peers, 1)
+
+	ev = getEvent(t, account.Id, manager, activity.PeerPostureCheckRecovered)
+	assert.Equal(t, peer1.ID, ev.TargetID)
+}
+
+func TestDefaultAccountManager_SaveExternalPostureCheckKeepsToken(t *testing.T) {
+	manager, err := createManager(t)
+	require.NoError(t, err)
+
+	account, err := createAccount(manager, "test_account", userID, "")
+	require.NoError(t, err)
+
+	postureCheck, err := manager.SavePostureChecks(context.Background(), account.Id, userID, &posture.Checks{
+		Name: "mdm",
+		Checks: posture.ChecksDefinition{
+			ExternalCheck: &posture.ExternalCheck{URL: "https://mdm.example.com/compliance", Token: "secret"},
+		},
+	}, true)
+	require.NoError(t, err)
+
+	// updates from the API don't include the token
+	_, err = manager.SavePostureChecks(context.Background(), account.Id, userID, &posture.Checks{
+		ID:   postureCheck.ID,
+		Name: "mdm",
+		Checks: posture.ChecksDefinition{
+			ExternalCheck: &posture.ExternalCheck{URL: "https://mdm.example.com/compliance", FailOpen: true},
+		},
+	}, false)
+	require.NoError(t, err)
+
+	stored, err := manager.Store.GetPostureChecksByID(context.Background(), store.LockingStrengthNone, account.Id, postureCheck.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "secret", stored.Checks.ExternalCheck.Token)
+	assert.True(t, stored.Checks.ExternalCheck.FailOpen)
+}
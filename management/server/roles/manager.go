@@ -0,0 +1,246 @@
+package roles
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/netbirdio/netbird/management/server/account"
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/permissions"
+	"github.com/netbirdio/netbird/management/server/permissions/modules"
+	"github.com/netbirdio/netbird/management/server/permissions/operations"
+	"github.com/netbirdio/netbird/management/server/permissions/roles"
+	"github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/shared/management/status"
+)
+
+type Manager interface {
+	GetAllRoles(ctx context.Context, accountID, userID string) ([]*roles.CustomRole, error)
+	GetRole(ctx context.Context, accountID, userID, roleID string) (*roles.CustomRole, error)
+	CreateRole(ctx context.Context, userID string, role *roles.CustomRole) (*roles.CustomRole, error)
+	UpdateRole(ctx context.Context, userID string, role *roles.CustomRole) (*roles.CustomRole, error)
+	DeleteRole(ctx context.Context, accountID, userID, roleID string) error
+}
+
+type managerImpl struct {
+	store              store.Store
+	permissionsManager permissions.Manager
+	accountManager     account.Manager
+}
+
+type mockManager struct {
+}
+
+func NewManager(store store.Store, permissionsManager permissions.Manager, accountManager account.Manager) Manager {
+	return &managerImpl{
+		store:              store,
+		permissionsManager: permissionsManager,
+		accountManager:     accountManager,
+	}
+}
+
+func (m *managerImpl) GetAllRoles(ctx context.Context, accountID, userID string) ([]*roles.CustomRole, error) {
+	ok, err := m.permissionsManager.ValidateUserPermissions(ctx, accountID, userID, modules.Roles, operations.Read)
+	if err != nil {
+		return nil, status.NewPermissionValidationError(err)
+	}
+	if !ok {
+		return nil, status.NewPermissionDeniedError()
+	}
+
+	return m.store.GetAccountCustomRoles(ctx, store.LockingStrengthNone, accountID)
+}
+
+func (m *managerImpl) GetRole(ctx context.Context, accountID, userID, roleID string) (*roles.CustomRole, error) {
+	ok, err := m.permissionsManager.ValidateUserPermissions(ctx, accountID, userID, modules.Roles, operations.Read)
+	if err != nil {
+		return nil, status.NewPermissionValidationError(err)
+	}
+	if !ok {
+		return nil, status.NewPermissionDeniedError()
+	}
+
+	return m.store.GetCustomRoleByID(ctx, store.LockingStrengthNone, accountID, roleID)
+}
+
+func (m *managerImpl) CreateRole(ctx context.Context, userID string, role *roles.CustomRole) (*roles.CustomRole, error) {
+	ok, err := m.permissionsManager.ValidateUserPermissions(ctx, role.AccountID, userID, modules.Roles, operations.Create)
+	if err != nil {
+		return nil, status.NewPermissionValidationError(err)
+	}
+	if !ok {
+		return nil, status.NewPermissionDeniedError()
+	}
+
+	if err = role.Validate(); err != nil {
+		return nil, status.Errorf(status.InvalidArgument, "%s", err.Error())
+	}
+
+	if err = m.validateGrantedPermissions(ctx, role.AccountID, userID, role.Permissions); err != nil {
+		return nil, err
+	}
+
+	newRole := roles.NewCustomRole(role.AccountID, role.Name, role.Description, role.Permissions)
+
+	err = m.store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		if err = validateUniqueName(ctx, transaction, newRole); err != nil {
+			return err
+		}
+		return transaction.SaveCustomRole(ctx, newRole)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save role: %w", err)
+	}
+
+	m.accountManager.StoreEvent(ctx, userID, newRole.ID, newRole.AccountID, activity.CustomRoleCreated, newRole.EventMeta())
+
+	return newRole, nil
+}
+
+func (m *managerImpl) UpdateRole(ctx context.Context, userID string, role *roles.CustomRole) (*roles.CustomRole, error) {
+	ok, err := m.permissionsManager.ValidateUserPermissions(ctx, role.AccountID, userID, modules.Roles, operations.Update)
+	if err != nil {
+		return nil, status.NewPermissionValidationError(err)
+	}
+	if !ok {
+		return nil, status.NewPermissionDeniedError()
+	}
+
+	if err = role.Validate(); err != nil {
+		return nil, status.Errorf(status.InvalidArgument, "%s", err.Error())
+	}
+
+	if err = m.validateGrantedPermissions(ctx, role.AccountID, userID, role.Permissions); err != nil {
+		return nil, err
+	}
+
+	err = m.store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		existingRole, err := transaction.GetCustomRoleByID(ctx, store.LockingStrengthUpdate, role.AccountID, role.ID)
+		if err != nil {
+			return err
+		}
+		if err = m.validateGrantedPermissions(ctx, role.AccountID, userID, existingRole.Permissions); err != nil {
+			return err
+		}
+		if err = validateUniqueName(ctx, transaction, role); err != nil {
+			return err
+		}
+		return transaction.SaveCustomRole(ctx, role)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update role: %w", err)
+	}
+
+	m.accountManager.StoreEvent(ctx, userID, role.ID, role.AccountID, activity.CustomRoleUpdated, role.EventMeta())
+
+	return role, nil
+}
+
+func (m *managerImpl) DeleteRole(ctx context.Context, accountID, userID, roleID string) error {
+	ok, err := m.permissionsManager.ValidateUserPermissions(ctx, accountID, userID, modules.Roles, operations.Delete)
+	if err != nil {
+		return status.NewPermissionValidationError(err)
+	}
+	if !ok {
+		return status.NewPermissionDeniedError()
+	}
+
+	var role *roles.CustomRole
+	err = m.store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		role, err = transaction.GetCustomRoleByID(ctx, store.LockingStrengthUpdate, accountID, roleID)
+		if err != nil {
+			return err
+		}
+
+		users, err := transaction.GetAccountUsers(ctx, store.LockingStrengthNone, accountID)
+		if err != nil {
+			return fmt.Errorf("failed to get account users: %w", err)
+		}
+
+		for _, user := range users {
+			if user.Role == role.UserRole() {
+				return status.Errorf(status.PreconditionFailed, "role %s is assigned to user %s", role.Name, user.Id)
+			}
+		}
+
+		return transaction.DeleteCustomRole(ctx, accountID, roleID)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete role: %w", err)
+	}
+
+	m.accountManager.StoreEvent(ctx, userID, roleID, accountID, activity.CustomRoleDeleted, role.EventMeta())
+
+	return nil
+}
+
+// validateGrantedPermissions checks that the initiator holds every permission granted by a role they create or
+// update, so custom role users can't escalate their own privileges. Owners and admins can grant any permission.
+func (m *managerImpl) validateGrantedPermissions(ctx context.Context, accountID, userID string, granted roles.Permissions) error {
+	if userID == activity.SystemInitiator {
+		return nil
+	}
+
+	user, err := m.store.GetUserByUserID(ctx, store.LockingStrengthNone, userID)
+	if err != nil {
+		return err
+	}
+	if user.HasAdminPower() {
+		return nil
+	}
+
+	held, err := m.permissionsManager.GetPermissionsByRole(ctx, accountID, user.Role)
+	if err != nil {
+		return err
+	}
+
+	for module, ops := range granted {
+		for op, allowed := range ops {
+			if allowed && !held[module][op] {
+				return status.Errorf(status.PermissionDenied, "can't grant %s on %s without holding it", op, module)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateUniqueName checks that no other role of the account uses the name of the given role
+func validateUniqueName(ctx context.Context, transaction store.Store, role *roles.CustomRole) error {
+	existingRoles, err := transaction.GetAccountCustomRoles(ctx, store.LockingStrengthNone, role.AccountID)
+	if err != nil {
+		return fmt.Errorf("failed to get account roles: %w", err)
+	}
+
+	for _, existingRole := range existingRoles {
+		if existingRole.ID != role.ID && existingRole.Name == role.Name {
+			return status.Errorf(status.AlreadyExists, "role with name %s already exists", role.Name)
+		}
+	}
+
+	return nil
+}
+
+func NewManagerMock() Manager {
+	return &mockManager{}
+}
+
+func (m *mockManager) GetAllRoles(ctx context.Context, accountID, userID string) ([]*roles.CustomRole, error) {
+	return []*roles.CustomRole{}, nil
+}
+
+func (m *mockManager) GetRole(ctx context.Context, accountID, userID, roleID string) (*roles.CustomRole, error) {
+	return &roles.CustomRole{}, nil
+}
+
+func (m *mockManager) CreateRole(ctx context.Context, userID string, role *roles.CustomRole) (*roles.CustomRole, error) {
+	return role, nil
+}
+
+func (m *mockManager) UpdateRole(ctx context.Context, userID string, role *roles.CustomRole) (*roles.CustomRole, error) {
+	return role, nil
+}
+
+func (m *mockManager) DeleteRole(ctx context.Context, accountID, userID, roleID string) error {
+	return nil
+}
@@ -0,0 +1,172 @@
+package roles
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/management/server/mock_server"
+	"github.com/netbirdio/netbird/management/server/permissions"
+	"github.com/netbirdio/netbird/management/server/permissions/modules"
+	"github.com/netbirdio/netbird/management/server/permissions/operations"
+	"github.com/netbirdio/netbird/management/server/permissions/roles"
+	"github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/management/server/types"
+)
+
+func dnsOperatorRole(accountID string) *roles.CustomRole {
+	return &roles.CustomRole{
+		AccountID: accountID,
+		Name:      "DNS operator",
+		Permissions: roles.Permissions{
+			modules.Dns: {
+				operations.Read:   true,
+				operations.Create: true,
+				operations.Update: true,
+				operations.Delete: true,
+			},
+			modules.Peers: {
+				operations.Read: true,
+			},
+		},
+	}
+}
+
+func Test_CustomRoleLifecycle(t *testing.T) {
+	ctx := context.Background()
+	accountID := "testAccountId"
+	adminID := "testAdminId"
+	userID := "testUserId"
+
+	s, cleanUp, err := store.NewTestStoreFromSQL(context.Background(), "../testdata/networks.sql", t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(cleanUp)
+	am := mock_server.MockAccountManager{}
+	permissionsManager := permissions.NewManager(s)
+	manager := NewManager(s, permissionsManager, &am)
+
+	role, err := manager.CreateRole(ctx, adminID, dnsOperatorRole(accountID))
+	require.NoError(t, err)
+	require.NotEmpty(t, role.ID)
+
+	_, err = manager.CreateRole(ctx, adminID, dnsOperatorRole(accountID))
+	require.Error(t, err, "role names should be unique in the account")
+
+	allRoles, err := manager.GetAllRoles(ctx, accountID, adminID)
+	require.NoError(t, err)
+	require.Len(t, allRoles, 1)
+
+	user, err := s.GetUserByUserID(ctx, store.LockingStrengthNone, userID)
+	require.NoError(t, err)
+	user.Role = role.UserRole()
+	require.NoError(t, s.SaveUser(ctx, user))
+
+	allowed, err := permissionsManager.ValidateUserPermissions(ctx, accountID, userID, modules.Dns, operations.Update)
+	require.NoError(t, err)
+	require.True(t, allowed)
+
+	allowed, err = permissionsManager.ValidateUserPermissions(ctx, accountID, userID, modules.Peers, operations.Read)
+	require.NoError(t, err)
+	require.True(t, allowed)
+
+	allowed, err = permissionsManager.ValidateUserPermissions(ctx, accountID, userID, modules.Peers, operations.Delete)
+	require.NoError(t, err)
+	require.False(t, allowed)
+
+	allowed, err = permissionsManager.ValidateUserPermissions(ctx, accountID, userID, modules.Roles, operations.Read)
+	require.NoError(t, err)
+	require.False(t, allowed)
+
+	_, err = manager.GetAllRoles(ctx, accountID, userID)
+	require.Error(t, err)
+
+	err = manager.DeleteRole(ctx, accountID, adminID, role.ID)
+	require.Error(t, err, "roles assigned to users can't be deleted")
+
+	user.Role = types.UserRoleUser
+	require.NoError(t, s.SaveUser(ctx, user))
+
+	err = manager.DeleteRole(ctx, accountID, adminID, role.ID)
+	require.NoError(t, err)
+
+	_, err = manager.GetRole(ctx, accountID, adminID, role.ID)
+	require.Error(t, err)
+}
+
+func Test_CreateRoleValidatesPermissions(t *testing.T) {
+	ctx := context.Background()
+	accountID := "testAccountId"
+	adminID := "testAdminId"
+
+	s, cleanUp, err := store.NewTestStoreFromSQL(context.Background(), "../testdata/networks.sql", t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(cleanUp)
+	am := mock_server.MockAccountManager{}
+	manager := NewManager(s, permissions.NewManager(s), &am)
+
+	role := dnsOperatorRole(accountID)
+	role.Permissions["unknown"] = map[operations.Operation]bool{operations.Read: true}
+	_, err = manager.CreateRole(ctx, adminID, role)
+	require.Error(t, err)
+
+	role = dnsOperatorRole(accountID)
+	role.Name = string(types.UserRoleAdmin)
+	_, err = manager.CreateRole(ctx, adminID, role)
+	require.Error(t, err)
+
+	_, err = manager.CreateRole(ctx, "testUserId", dnsOperatorRole(accountID))
+	require.Error(t, err)
+}
+
+func Test_CustomRoleCantGrantPermissionsItLacks(t *testing.T) {
+	ctx := context.Background()
+	accountID := "testAccountId"
+	adminID := "testAdminId"
+	userID := "testUserId"
+
+	s, cleanUp, err := store.NewTestStoreFromSQL(context.Background(), "../testdata/networks.sql", t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(cleanUp)
+	am := mock_server.MockAccountManager{}
+	manager := NewManager(s, permissions.NewManager(s), &am)
+
+	roleManager := dnsOperatorRole(accountID)
+	roleManager.Name = "Role manager"
+	roleManager.Permissions[modules.Roles] = map[operations.Operation]bool{
+		operations.Read:   true,
+		operations.Create: true,
+		operations.Update: true,
+	}
+	roleManager, err = manager.CreateRole(ctx, adminID, roleManager)
+	require.NoError(t, err)
+
+	user, err := s.GetUserByUserID(ctx, store.LockingStrengthNone, userID)
+	require.NoError(t, err)
+	user.Role = roleManager.UserRole()
+	require.NoError(t, s.SaveUser(ctx, user))
+
+	escalated := roleManager.Copy()
+	escalated.Permissions[modules.Users] = map[operations.Operation]bool{operations.Update: true}
+	_, err = manager.UpdateRole(ctx, userID, escalated)
+	require.Error(t, err, "a role user can't grant its own role a permission it lacks")
+
+	escalated = dnsOperatorRole(accountID)
+	escalated.Name = "Settings"
+	escalated.Permissions[modules.Settings] = map[operations.Operation]bool{operations.Update: true}
+	_, err = manager.CreateRole(ctx, userID, escalated)
+	require.Error(t, err, "a role user can't create a role with a permission it lacks")
+
+	_, err = manager.CreateRole(ctx, userID, dnsOperatorRole(accountID))
+	require.NoError(t, err, "a role user can create a role within its own permissions")
+
+	updated, err := manager.GetRole(ctx, accountID, adminID, roleManager.ID)
+	require.NoError(t, err)
+	require.NotContains(t, updated.Permissions, modules.Users)
+}
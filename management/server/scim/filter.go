@@ -0,0 +1,153 @@
+package scim
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/netbirdio/netbird/shared/management/status"
+)
+
+// filterClause is an "attribute eq value" comparison of a filter
+type filterClause struct {
+	attribute string
+	value     string
+}
+
+// filter is a list of comparisons that all have to match
+type filter []filterClause
+
+// parseFilter parses SCIM filters made of "eq" comparisons joined by "and", e.g. userName eq "alice@example.com".
+// These are the filters identity providers use to look up resources before provisioning them.
+func parseFilter(expression string) (filter, error) {
+	var clauses filter
+
+	rest := strings.TrimSpace(expression)
+	for rest != "" {
+		var attribute, operator, value string
+		var err error
+
+		attribute, rest = nextWord(rest)
+		operator, rest = nextWord(rest)
+		if attribute == "" || !strings.EqualFold(operator, "eq") {
+			return nil, status.Errorf(status.InvalidArgument, "unsupported filter %q, only eq comparisons joined by and are supported", expression)
+		}
+
+		value, rest, err = nextValue(rest)
+		if err != nil {
+			return nil, status.Errorf(status.InvalidArgument, "invalid filter %q: %v", expression, err)
+		}
+		clauses = append(clauses, filterClause{attribute: attribute, value: value})
+
+		if rest == "" {
+			break
+		}
+		var join string
+		join, rest = nextWord(rest)
+		if !strings.EqualFold(join, "and") {
+			return nil, status.Errorf(status.InvalidArgument, "unsupported filter %q, only eq comparisons joined by and are supported", expression)
+		}
+	}
+
+	return clauses, nil
+}
+
+// matches checks the filter against the values of the attributes returned by values
+func (f filter) matches(values func(attribute string) []string) bool {
+	for _, clause := range f {
+		matched := false
+		for _, value := range values(strings.ToLower(clause.attribute)) {
+			if strings.EqualFold(value, clause.value) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+func nextWord(s string) (string, string) {
+	s = strings.TrimLeftFunc(s, unicode.IsSpace)
+	end := strings.IndexFunc(s, unicode.IsSpace)
+	if end < 0 {
+		return s, ""
+	}
+	return s[:end], strings.TrimLeftFunc(s[end:], unicode.IsSpace)
+}
+
+// nextValue reads a quoted string or a literal like true, false or a number
+func nextValue(s string) (string, string, error) {
+	s = strings.TrimLeftFunc(s, unicode.IsSpace)
+	if !strings.HasPrefix(s, `"`) {
+		value, rest := nextWord(s)
+		if value == "" {
+			return "", "", strconv.ErrSyntax
+		}
+		return value, rest, nil
+	}
+
+	escaped := false
+	for i := 1; i < len(s); i++ {
+		switch {
+		case escaped:
+			escaped = false
+		case s[i] == '\\':
+			escaped = true
+		case s[i] == '"':
+			value, err := strconv.Unquote(s[:i+1])
+			if err != nil {
+				return "", "", err
+			}
+			return value, strings.TrimLeftFunc(s[i+1:], unicode.IsSpace), nil
+		}
+	}
+
+	return "", "", strconv.ErrSyntax
+}
+
+// userAttributeValues returns the values of a lower case user attribute for filtering
+func userAttributeValues(user *User, attribute string) []string {
+	switch attribute {
+	case "id":
+		return []string{user.ID}
+	case "externalid":
+		return []string{user.ExternalID}
+	case "username":
+		return []string{user.UserName}
+	case "displayname":
+		return []string{user.DisplayName}
+	case "active":
+		return []string{strconv.FormatBool(user.active())}
+	case "emails", "emails.value":
+		values := make([]string, 0, len(user.Emails))
+		for _, email := range user.Emails {
+			values = append(values, email.Value)
+		}
+		return values
+	default:
+		return nil
+	}
+}
+
+// groupAttributeValues returns the values of a lower case group attribute for filtering
+func groupAttributeValues(group *Group, attribute string) []string {
+	switch attribute {
+	case "id":
+		return []string{group.ID}
+	case "externalid":
+		return []string{group.ExternalID}
+	case "displayname":
+		return []string{group.DisplayName}
+	case "members", "members.value":
+		values := make([]string, 0, len(group.Members))
+		for _, member := range group.Members {
+			values = append(values, member.Value)
+		}
+		return values
+	default:
+		return nil
+	}
+}
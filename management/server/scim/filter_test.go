@@ -0,0 +1,51 @@
+package scim
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFilter(t *testing.T) {
+	user := &User{
+		ID:       "alice-sub",
+		UserName: "alice@example.com",
+		Emails:   []Email{{Value: "alice@example.com"}},
+	}
+	values := func(attribute string) []string { return userAttributeValues(user, attribute) }
+
+	tests := []struct {
+		name       string
+		expression string
+		matches    bool
+		wantErr    bool
+	}{
+		{name: "empty filter", expression: "", matches: true},
+		{name: "quoted value", expression: `userName eq "alice@example.com"`, matches: true},
+		{name: "case insensitive", expression: `USERNAME EQ "ALICE@example.com"`, matches: true},
+		{name: "sub-attribute", expression: `emails.value eq "alice@example.com"`, matches: true},
+		{name: "literal value", expression: `active eq true`, matches: true},
+		{name: "and", expression: `userName eq "alice@example.com" and id eq "alice-sub"`, matches: true},
+		{name: "no match", expression: `userName eq "bob@example.com"`, matches: false},
+		{name: "and without match", expression: `userName eq "alice@example.com" and id eq "bob"`, matches: false},
+		{name: "unknown attribute", expression: `title eq "engineer"`, matches: false},
+		{name: "escaped quote", expression: `userName eq "a\"b"`, matches: false},
+		{name: "unsupported operator", expression: `userName sw "alice"`, wantErr: true},
+		{name: "or", expression: `id eq "a" or id eq "b"`, wantErr: true},
+		{name: "missing value", expression: `userName eq`, wantErr: true},
+		{name: "unterminated string", expression: `userName eq "alice`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := parseFilter(tt.expression)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.matches, f.matches(values))
+		})
+	}
+}
@@ -0,0 +1,622 @@
+package scim
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/rs/xid"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/management/server/account"
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/integration_reference"
+	"github.com/netbirdio/netbird/management/server/permissions"
+	"github.com/netbirdio/netbird/management/server/permissions/modules"
+	"github.com/netbirdio/netbird/management/server/permissions/operations"
+	"github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/management/server/types"
+	"github.com/netbirdio/netbird/shared/management/status"
+)
+
+// Manager manages SCIM tokens and provisions users and groups pushed by identity providers with SCIM 2.0.
+// Provisioning is done on behalf of the admin service user of the token, users are identified by their external ID
+// or user name, which has to match the subject of their JWTs, and group membership is kept in the user auto groups.
+type Manager interface {
+	GetAllTokens(ctx context.Context, accountID, userID string) ([]*types.SCIMToken, error)
+	// CreateToken creates a token and the admin service user the provisioning is done with
+	CreateToken(ctx context.Context, accountID, userID, name string) (*types.SCIMTokenGenerated, error)
+	// DeleteToken deletes a token and its service user, provisioned users and groups are kept
+	DeleteToken(ctx context.Context, accountID, userID, tokenID string) error
+
+	// Authenticate returns the token matching the plain text token and marks it as used
+	Authenticate(ctx context.Context, plainToken string) (*types.SCIMToken, error)
+
+	ListUsers(ctx context.Context, token *types.SCIMToken, query ListQuery) (*ListResponse, error)
+	GetUser(ctx context.Context, token *types.SCIMToken, userID string) (*User, error)
+	// CreateUser provisions a user, an existing user of the account that isn't provisioned yet is taken over
+	CreateUser(ctx context.Context, token *types.SCIMToken, user *User) (*User, error)
+	ReplaceUser(ctx context.Context, token *types.SCIMToken, userID string, user *User) (*User, error)
+	PatchUser(ctx context.Context, token *types.SCIMToken, userID string, patch *PatchRequest) (*User, error)
+	// DeleteUser deprovisions a user by blocking it and removing it from the provisioned groups
+	DeleteUser(ctx context.Context, token *types.SCIMToken, userID string) error
+
+	ListGroups(ctx context.Context, token *types.SCIMToken, query ListQuery) (*ListResponse, error)
+	GetGroup(ctx context.Context, token *types.SCIMToken, groupID string) (*Group, error)
+	CreateGroup(ctx context.Context, token *types.SCIMToken, group *Group) (*Group, error)
+	ReplaceGroup(ctx context.Context, token *types.SCIMToken, groupID string, group *Group) (*Group, error)
+	PatchGroup(ctx context.Context, token *types.SCIMToken, groupID string, patch *PatchRequest) (*Group, error)
+	DeleteGroup(ctx context.Context, token *types.SCIMToken, groupID string) error
+}
+
+type managerImpl struct {
+	store              store.Store
+	accountManager     account.Manager
+	permissionsManager permissions.Manager
+}
+
+func NewManager(store store.Store, accountManager account.Manager, permissionsManager permissions.Manager) Manager {
+	return &managerImpl{
+		store:              store,
+		accountManager:     accountManager,
+		permissionsManager: permissionsManager,
+	}
+}
+
+func (m *managerImpl) GetAllTokens(ctx context.Context, accountID, userID string) ([]*types.SCIMToken, error) {
+	if err := m.validatePermissions(ctx, accountID, userID, modules.Users, operations.Read); err != nil {
+		return nil, err
+	}
+
+	return m.store.GetAccountSCIMTokens(ctx, store.LockingStrengthNone, accountID)
+}
+
+func (m *managerImpl) CreateToken(ctx context.Context, accountID, userID, name string) (*types.SCIMTokenGenerated, error) {
+	if err := m.validatePermissions(ctx, accountID, userID, modules.Users, operations.Create); err != nil {
+		return nil, err
+	}
+
+	if name == "" {
+		return nil, status.Errorf(status.InvalidArgument, "token name shouldn't be empty")
+	}
+
+	serviceUser, err := m.accountManager.CreateUser(ctx, accountID, userID, &types.UserInfo{
+		Name:          "SCIM " + name,
+		Role:          string(types.UserRoleAdmin),
+		IsServiceUser: true,
+		AutoGroups:    []string{},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create SCIM service user: %w", err)
+	}
+
+	token, err := types.CreateNewSCIMToken(accountID, name, serviceUser.ID, userID)
+	if err == nil {
+		err = m.store.SaveSCIMToken(ctx, &token.SCIMToken)
+	}
+	if err != nil {
+		if deleteErr := m.accountManager.DeleteUser(ctx, accountID, userID, serviceUser.ID); deleteErr != nil {
+			log.WithContext(ctx).Errorf("failed to delete SCIM service user %s: %v", serviceUser.ID, deleteErr)
+		}
+		return nil, fmt.Errorf("failed to create SCIM token: %w", err)
+	}
+
+	m.accountManager.StoreEvent(ctx, userID, token.ID, accountID, activity.SCIMTokenCreated, token.EventMeta())
+
+	return token, nil
+}
+
+func (m *managerImpl) DeleteToken(ctx context.Context, accountID, userID, tokenID string) error {
+	if err := m.validatePermissions(ctx, accountID, userID, modules.Users, operations.Delete); err != nil {
+		return err
+	}
+
+	token, err := m.store.GetSCIMTokenByID(ctx, store.LockingStrengthNone, accountID, tokenID)
+	if err != nil {
+		return err
+	}
+
+	if err = m.store.DeleteSCIMToken(ctx, accountID, tokenID); err != nil {
+		return err
+	}
+
+	if err = m.accountManager.DeleteUser(ctx, accountID, userID, token.UserID); err != nil {
+		if sErr, ok := status.FromError(err); !ok || sErr.Type() != status.NotFound {
+			return fmt.Errorf("failed to delete SCIM service user: %w", err)
+		}
+	}
+
+	m.accountManager.StoreEvent(ctx, userID, token.ID, accountID, activity.SCIMTokenDeleted, token.EventMeta())
+
+	return nil
+}
+
+func (m *managerImpl) Authenticate(ctx context.Context, plainToken string) (*types.SCIMToken, error) {
+	hashedToken, err := types.HashSCIMToken(plainToken)
+	if err != nil {
+		return nil, status.Errorf(status.Unauthorized, "invalid SCIM token")
+	}
+
+	token, err := m.store.GetSCIMTokenByHashedToken(ctx, store.LockingStrengthNone, hashedToken)
+	if err != nil {
+		if sErr, ok := status.FromError(err); ok && sErr.Type() == status.NotFound {
+			return nil, status.Errorf(status.Unauthorized, "invalid SCIM token")
+		}
+		return nil, err
+	}
+
+	if err = m.store.MarkSCIMTokenUsed(ctx, token.ID); err != nil {
+		log.WithContext(ctx).Errorf("failed to mark SCIM token %s as used: %v", token.ID, err)
+	}
+
+	return token, nil
+}
+
+func (m *managerImpl) ListUsers(ctx context.Context, token *types.SCIMToken, query ListQuery) (*ListResponse, error) {
+	f, err := parseFilter(query.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	users, groups, err := m.loadUsersAndGroups(ctx, token, modules.Users)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]any, 0)
+	for _, user := range users {
+		resource := toUser(user, groups)
+		if f.matches(func(attribute string) []string { return userAttributeValues(resource, attribute) }) {
+			resources = append(resources, resource)
+		}
+	}
+
+	return toListResponse(resources, query), nil
+}
+
+func (m *managerImpl) GetUser(ctx context.Context, token *types.SCIMToken, userID string) (*User, error) {
+	users, groups, err := m.loadUsersAndGroups(ctx, token, modules.Users)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := findUser(users, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toUser(user, groups), nil
+}
+
+func (m *managerImpl) CreateUser(ctx context.Context, token *types.SCIMToken, user *User) (*User, error) {
+	if err := validateUser(user); err != nil {
+		return nil, err
+	}
+
+	users, _, err := m.loadUsersAndGroups(ctx, token, modules.Users)
+	if err != nil {
+		return nil, err
+	}
+
+	userID := user.ExternalID
+	if userID == "" {
+		userID = user.UserName
+	}
+
+	for _, existing := range users {
+		if existing.Id == userID || strings.EqualFold(existing.Email, user.UserName) {
+			return nil, status.Errorf(status.AlreadyExists, "user %s is already provisioned", user.UserName)
+		}
+	}
+
+	update := types.NewUser(userID, types.UserRoleUser, false, false, "", []string{}, types.UserIssuedIntegration)
+	existing, err := m.store.GetUserByUserID(ctx, store.LockingStrengthNone, userID)
+	switch {
+	case err == nil && (existing.AccountID != token.AccountID || existing.IsServiceUser):
+		return nil, status.Errorf(status.AlreadyExists, "user %s already exists", userID)
+	case err == nil:
+		update = existing.Copy()
+	case !isNotFound(err):
+		return nil, err
+	}
+
+	return m.saveUser(ctx, token, update, user)
+}
+
+func (m *managerImpl) ReplaceUser(ctx context.Context, token *types.SCIMToken, userID string, user *User) (*User, error) {
+	if err := validateUser(user); err != nil {
+		return nil, err
+	}
+
+	users, _, err := m.loadUsersAndGroups(ctx, token, modules.Users)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := findUser(users, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.saveUser(ctx, token, existing.Copy(), user)
+}
+
+func (m *managerImpl) PatchUser(ctx context.Context, token *types.SCIMToken, userID string, patch *PatchRequest) (*User, error) {
+	users, groups, err := m.loadUsersAndGroups(ctx, token, modules.Users)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := findUser(users, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	user := toUser(existing, groups)
+	if err = applyUserPatch(user, patch.Operations); err != nil {
+		return nil, err
+	}
+	if err = validateUser(user); err != nil {
+		return nil, err
+	}
+
+	return m.saveUser(ctx, token, existing.Copy(), user)
+}
+
+func (m *managerImpl) DeleteUser(ctx context.Context, token *types.SCIMToken, userID string) error {
+	users, groups, err := m.loadUsersAndGroups(ctx, token, modules.Users)
+	if err != nil {
+		return err
+	}
+
+	existing, err := findUser(users, userID)
+	if err != nil {
+		return err
+	}
+
+	update := existing.Copy()
+	update.Blocked = true
+	update.AutoGroups = slices.DeleteFunc(update.AutoGroups, func(groupID string) bool {
+		_, ok := groups[groupID]
+		return ok
+	})
+	update.Issued = types.UserIssuedAPI
+	update.IntegrationReference = integration_reference.IntegrationReference{}
+
+	_, err = m.accountManager.SaveUser(ctx, token.AccountID, token.UserID, update)
+	return err
+}
+
+func (m *managerImpl) ListGroups(ctx context.Context, token *types.SCIMToken, query ListQuery) (*ListResponse, error) {
+	f, err := parseFilter(query.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	users, groups, err := m.loadUsersAndGroups(ctx, token, modules.Groups)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]any, 0)
+	for _, group := range sortedGroups(groups) {
+		resource := toGroup(group, users)
+		if f.matches(func(attribute string) []string { return groupAttributeValues(resource, attribute) }) {
+			resources = append(resources, resource)
+		}
+	}
+
+	return toListResponse(resources, query), nil
+}
+
+func (m *managerImpl) GetGroup(ctx context.Context, token *types.SCIMToken, groupID string) (*Group, error) {
+	users, groups, err := m.loadUsersAndGroups(ctx, token, modules.Groups)
+	if err != nil {
+		return nil, err
+	}
+
+	group, ok := groups[groupID]
+	if !ok {
+		return nil, status.NewGroupNotFoundError(groupID)
+	}
+
+	return toGroup(group, users), nil
+}
+
+func (m *managerImpl) CreateGroup(ctx context.Context, token *types.SCIMToken, group *Group) (*Group, error) {
+	if group.DisplayName == "" {
+		return nil, status.Errorf(status.InvalidArgument, "displayName is required")
+	}
+
+	users, groups, err := m.loadUsersAndGroups(ctx, token, modules.Groups)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, existing := range groups {
+		if existing.Name == group.DisplayName {
+			return nil, status.Errorf(status.AlreadyExists, "group %s is already provisioned", group.DisplayName)
+		}
+	}
+
+	newGroup := &types.Group{
+		ID:                   xid.New().String(),
+		Name:                 group.DisplayName,
+		Issued:               types.GroupIssuedIntegration,
+		IntegrationReference: integration_reference.IntegrationReference{IntegrationType: IntegrationType},
+		Peers:                []string{},
+	}
+	if err = m.accountManager.CreateGroup(ctx, token.AccountID, token.UserID, newGroup); err != nil {
+		return nil, err
+	}
+
+	if err = m.setMembers(ctx, token, newGroup.ID, group.Members, users); err != nil {
+		return nil, err
+	}
+
+	return m.GetGroup(ctx, token, newGroup.ID)
+}
+
+func (m *managerImpl) ReplaceGroup(ctx context.Context, token *types.SCIMToken, groupID string, group *Group) (*Group, error) {
+	if group.DisplayName == "" {
+		return nil, status.Errorf(status.InvalidArgument, "displayName is required")
+	}
+
+	users, groups, err := m.loadUsersAndGroups(ctx, token, modules.Groups)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, ok := groups[groupID]
+	if !ok {
+		return nil, status.NewGroupNotFoundError(groupID)
+	}
+
+	return m.saveGroup(ctx, token, existing, group, users)
+}
+
+func (m *managerImpl) PatchGroup(ctx context.Context, token *types.SCIMToken, groupID string, patch *PatchRequest) (*Group, error) {
+	users, groups, err := m.loadUsersAndGroups(ctx, token, modules.Groups)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, ok := groups[groupID]
+	if !ok {
+		return nil, status.NewGroupNotFoundError(groupID)
+	}
+
+	group := toGroup(existing, users)
+	if err = applyGroupPatch(group, patch.Operations); err != nil {
+		return nil, err
+	}
+
+	return m.saveGroup(ctx, token, existing, group, users)
+}
+
+func (m *managerImpl) DeleteGroup(ctx context.Context, token *types.SCIMToken, groupID string) error {
+	users, groups, err := m.loadUsersAndGroups(ctx, token, modules.Groups)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := groups[groupID]; !ok {
+		return status.NewGroupNotFoundError(groupID)
+	}
+
+	// groups assigned to users can't be deleted
+	if err = m.setMembers(ctx, token, groupID, nil, users); err != nil {
+		return err
+	}
+
+	return m.accountManager.DeleteGroup(ctx, token.AccountID, token.UserID, groupID)
+}
+
+// saveUser applies the attributes of the SCIM user to the NetBird user and saves it as provisioned user
+func (m *managerImpl) saveUser(ctx context.Context, token *types.SCIMToken, update *types.User, user *User) (*User, error) {
+	update.Email = user.UserName
+	update.Name = user.displayName()
+	update.Blocked = !user.active()
+	update.Issued = types.UserIssuedIntegration
+	update.IntegrationReference = integration_reference.IntegrationReference{IntegrationType: IntegrationType}
+
+	if _, err := m.accountManager.SaveOrAddUser(ctx, token.AccountID, token.UserID, update, true); err != nil {
+		return nil, err
+	}
+
+	return m.GetUser(ctx, token, update.Id)
+}
+
+// saveGroup renames the group if needed and updates its members
+func (m *managerImpl) saveGroup(ctx context.Context, token *types.SCIMToken, existing *types.Group, group *Group, users []*types.User) (*Group, error) {
+	if existing.Name != group.DisplayName {
+		update := existing.Copy()
+		update.Name = group.DisplayName
+		if err := m.accountManager.UpdateGroup(ctx, token.AccountID, token.UserID, update); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := m.setMembers(ctx, token, existing.ID, group.Members, users); err != nil {
+		return nil, err
+	}
+
+	return m.GetGroup(ctx, token, existing.ID)
+}
+
+// setMembers assigns the group to the auto groups of the members and removes it from the other provisioned users
+func (m *managerImpl) setMembers(ctx context.Context, token *types.SCIMToken, groupID string, members []Reference, users []*types.User) error {
+	memberIDs := make(map[string]struct{}, len(members))
+	for _, member := range members {
+		if _, err := findUser(users, member.Value); err != nil {
+			return status.Errorf(status.InvalidArgument, "member %s isn't a provisioned user", member.Value)
+		}
+		memberIDs[member.Value] = struct{}{}
+	}
+
+	var updates []*types.User
+	for _, user := range users {
+		_, isMember := memberIDs[user.Id]
+		hasGroup := slices.Contains(user.AutoGroups, groupID)
+
+		switch {
+		case isMember && !hasGroup:
+			update := user.Copy()
+			update.AutoGroups = append(update.AutoGroups, groupID)
+			updates = append(updates, update)
+		case !isMember && hasGroup:
+			update := user.Copy()
+			update.AutoGroups = slices.DeleteFunc(update.AutoGroups, func(id string) bool { return id == groupID })
+			updates = append(updates, update)
+		}
+	}
+
+	if len(updates) == 0 {
+		return nil
+	}
+
+	_, err := m.accountManager.SaveOrAddUsers(ctx, token.AccountID, token.UserID, updates, false)
+	return err
+}
+
+// loadUsersAndGroups returns the provisioned users and groups of the account of the token
+func (m *managerImpl) loadUsersAndGroups(ctx context.Context, token *types.SCIMToken, module modules.Module) ([]*types.User, map[string]*types.Group, error) {
+	if err := m.validatePermissions(ctx, token.AccountID, token.UserID, module, operations.Read); err != nil {
+		return nil, nil, err
+	}
+
+	accountUsers, err := m.store.GetAccountUsers(ctx, store.LockingStrengthNone, token.AccountID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	users := make([]*types.User, 0, len(accountUsers))
+	for _, user := range accountUsers {
+		if user.Issued == types.UserIssuedIntegration && user.IntegrationReference.IntegrationType == IntegrationType {
+			users = append(users, user)
+		}
+	}
+	slices.SortFunc(users, func(a, b *types.User) int {
+		return strings.Compare(a.Id, b.Id)
+	})
+
+	accountGroups, err := m.store.GetAccountGroups(ctx, store.LockingStrengthNone, token.AccountID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	groups := make(map[string]*types.Group)
+	for _, group := range accountGroups {
+		if group.Issued == types.GroupIssuedIntegration && group.IntegrationReference.IntegrationType == IntegrationType {
+			groups[group.ID] = group
+		}
+	}
+
+	return users, groups, nil
+}
+
+func (m *managerImpl) validatePermissions(ctx context.Context, accountID, userID string, module modules.Module, operation operations.Operation) error {
+	allowed, err := m.permissionsManager.ValidateUserPermissions(ctx, accountID, userID, module, operation)
+	if err != nil {
+		return status.NewPermissionValidationError(err)
+	}
+	if !allowed {
+		return status.NewPermissionDeniedError()
+	}
+	return nil
+}
+
+func validateUser(user *User) error {
+	if user.UserName == "" {
+		return status.Errorf(status.InvalidArgument, "userName is required")
+	}
+	return nil
+}
+
+func findUser(users []*types.User, userID string) (*types.User, error) {
+	for _, user := range users {
+		if user.Id == userID {
+			return user, nil
+		}
+	}
+	return nil, status.NewUserNotFoundError(userID)
+}
+
+func isNotFound(err error) bool {
+	sErr, ok := status.FromError(err)
+	return ok && sErr.Type() == status.NotFound
+}
+
+func sortedGroups(groups map[string]*types.Group) []*types.Group {
+	sorted := make([]*types.Group, 0, len(groups))
+	for _, group := range groups {
+		sorted = append(sorted, group)
+	}
+	slices.SortFunc(sorted, func(a, b *types.Group) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+	return sorted
+}
+
+func toUser(user *types.User, groups map[string]*types.Group) *User {
+	active := Bool(!user.Blocked)
+	resource := &User{
+		Schemas:     []string{SchemaUser},
+		ID:          user.Id,
+		ExternalID:  user.Id,
+		UserName:    user.Email,
+		DisplayName: user.Name,
+		Active:      &active,
+		Meta:        &Meta{ResourceType: "User"},
+	}
+	if user.Name != "" {
+		resource.Name = &Name{Formatted: user.Name}
+	}
+	if strings.Contains(user.Email, "@") {
+		resource.Emails = []Email{{Value: user.Email, Type: "work", Primary: true}}
+	}
+	for _, groupID := range user.AutoGroups {
+		if group, ok := groups[groupID]; ok {
+			resource.Groups = append(resource.Groups, Reference{Value: group.ID, Display: group.Name})
+		}
+	}
+	return resource
+}
+
+func toGroup(group *types.Group, users []*types.User) *Group {
+	resource := &Group{
+		Schemas:     []string{SchemaGroup},
+		ID:          group.ID,
+		DisplayName: group.Name,
+		Meta:        &Meta{ResourceType: "Group"},
+	}
+	for _, user := range users {
+		if slices.Contains(user.AutoGroups, group.ID) {
+			resource.Members = append(resource.Members, Reference{Value: user.Id, Display: user.Email})
+		}
+	}
+	return resource
+}
+
+// toListResponse returns the page of the resources selected by the query
+func toListResponse(resources []any, query ListQuery) *ListResponse {
+	startIndex := max(query.StartIndex, 1)
+	count := query.Count
+	if count < 0 || count > maxResults {
+		count = maxResults
+	}
+
+	page := make([]any, 0)
+	if start := startIndex - 1; start < len(resources) {
+		page = resources[start:min(start+count, len(resources))]
+	}
+
+	return &ListResponse{
+		Schemas:      []string{SchemaListResponse},
+		TotalResults: len(resources),
+		StartIndex:   startIndex,
+		ItemsPerPage: len(page),
+		Resources:    page,
+	}
+}
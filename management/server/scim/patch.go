@@ -0,0 +1,290 @@
+package scim
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"github.com/netbirdio/netbird/shared/management/status"
+)
+
+const (
+	patchOpAdd     = "add"
+	patchOpReplace = "replace"
+	patchOpRemove  = "remove"
+)
+
+// applyUserPatch applies the operations of a patch request to the user.
+// Attributes NetBird doesn't store, like the enterprise extension, are ignored.
+func applyUserPatch(user *User, operations []PatchOperation) error {
+	for _, operation := range operations {
+		op, err := patchOp(operation)
+		if err != nil {
+			return err
+		}
+
+		if operation.Path == "" {
+			if err = applyPatchObject(op, operation.Value, func(op, path string, value json.RawMessage) error {
+				return applyUserPatchPath(user, op, path, value)
+			}); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err = applyUserPatchPath(user, op, operation.Path, operation.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func applyUserPatchPath(user *User, op, path string, value json.RawMessage) error {
+	attribute, valueFilter, subAttribute := splitPath(path)
+
+	switch attribute {
+	case "active":
+		if op == patchOpRemove {
+			user.Active = nil
+			return nil
+		}
+		return decodePatchValue(path, value, &user.Active)
+	case "username":
+		if op == patchOpRemove {
+			return status.Errorf(status.InvalidArgument, "userName is required")
+		}
+		return decodePatchValue(path, value, &user.UserName)
+	case "displayname":
+		if op == patchOpRemove {
+			user.DisplayName = ""
+			return nil
+		}
+		return decodePatchValue(path, value, &user.DisplayName)
+	case "externalid":
+		if op == patchOpRemove {
+			user.ExternalID = ""
+			return nil
+		}
+		return decodePatchValue(path, value, &user.ExternalID)
+	case "name":
+		return applyNamePatch(user, op, path, subAttribute, value)
+	case "emails":
+		return applyEmailsPatch(user, op, path, valueFilter, subAttribute, value)
+	default:
+		return nil
+	}
+}
+
+func applyNamePatch(user *User, op, path, subAttribute string, value json.RawMessage) error {
+	if subAttribute == "" {
+		if op == patchOpRemove {
+			user.Name = nil
+			return nil
+		}
+		return decodePatchValue(path, value, &user.Name)
+	}
+
+	if user.Name == nil {
+		user.Name = &Name{}
+	}
+
+	var field *string
+	switch subAttribute {
+	case "formatted":
+		field = &user.Name.Formatted
+	case "givenname":
+		field = &user.Name.GivenName
+	case "familyname":
+		field = &user.Name.FamilyName
+	default:
+		return nil
+	}
+
+	if op == patchOpRemove {
+		*field = ""
+		return nil
+	}
+	return decodePatchValue(path, value, field)
+}
+
+// applyEmailsPatch handles the emails, emails.value and emails[type eq "work"].value paths, NetBird keeps a single email
+func applyEmailsPatch(user *User, op, path, valueFilter, subAttribute string, value json.RawMessage) error {
+	if op == patchOpRemove {
+		user.Emails = nil
+		return nil
+	}
+
+	if valueFilter == "" && subAttribute == "" {
+		var emails []Email
+		if err := decodePatchValue(path, value, &emails); err != nil {
+			return err
+		}
+		if op == patchOpAdd {
+			emails = append(user.Emails, emails...)
+		}
+		user.Emails = emails
+		return nil
+	}
+
+	if subAttribute != "value" {
+		return nil
+	}
+
+	var email string
+	if err := decodePatchValue(path, value, &email); err != nil {
+		return err
+	}
+	user.Emails = []Email{{Value: email, Primary: true}}
+	return nil
+}
+
+// applyGroupPatch applies the operations of a patch request to the group
+func applyGroupPatch(group *Group, operations []PatchOperation) error {
+	for _, operation := range operations {
+		op, err := patchOp(operation)
+		if err != nil {
+			return err
+		}
+
+		if operation.Path == "" {
+			if err = applyPatchObject(op, operation.Value, func(op, path string, value json.RawMessage) error {
+				return applyGroupPatchPath(group, op, path, value)
+			}); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err = applyGroupPatchPath(group, op, operation.Path, operation.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func applyGroupPatchPath(group *Group, op, path string, value json.RawMessage) error {
+	attribute, valueFilter, _ := splitPath(path)
+
+	switch attribute {
+	case "displayname":
+		if op == patchOpRemove {
+			return status.Errorf(status.InvalidArgument, "displayName is required")
+		}
+		return decodePatchValue(path, value, &group.DisplayName)
+	case "externalid":
+		if op == patchOpRemove {
+			group.ExternalID = ""
+			return nil
+		}
+		return decodePatchValue(path, value, &group.ExternalID)
+	case "members":
+		return applyMembersPatch(group, op, path, valueFilter, value)
+	default:
+		return nil
+	}
+}
+
+// applyMembersPatch adds, replaces or removes members, members to remove are selected by value or by a filter like
+// members[value eq "id"]
+func applyMembersPatch(group *Group, op, path, valueFilter string, value json.RawMessage) error {
+	if op == patchOpRemove && valueFilter != "" {
+		f, err := parseFilter(valueFilter)
+		if err != nil {
+			return err
+		}
+		group.Members = slices.DeleteFunc(group.Members, func(member Reference) bool {
+			return f.matches(func(attribute string) []string {
+				if attribute == "value" {
+					return []string{member.Value}
+				}
+				return nil
+			})
+		})
+		return nil
+	}
+
+	var members []Reference
+	if len(value) > 0 {
+		if err := decodePatchValue(path, value, &members); err != nil {
+			return err
+		}
+	}
+
+	switch op {
+	case patchOpAdd:
+		for _, member := range members {
+			if !slices.ContainsFunc(group.Members, func(m Reference) bool { return m.Value == member.Value }) {
+				group.Members = append(group.Members, member)
+			}
+		}
+	case patchOpReplace:
+		group.Members = members
+	case patchOpRemove:
+		if len(value) == 0 {
+			group.Members = nil
+			return nil
+		}
+		group.Members = slices.DeleteFunc(group.Members, func(m Reference) bool {
+			return slices.ContainsFunc(members, func(member Reference) bool { return m.Value == member.Value })
+		})
+	}
+	return nil
+}
+
+func patchOp(operation PatchOperation) (string, error) {
+	op := strings.ToLower(operation.Op)
+	switch op {
+	case patchOpAdd, patchOpReplace:
+		if len(operation.Value) == 0 {
+			return "", status.Errorf(status.InvalidArgument, "%s operation requires a value", op)
+		}
+		return op, nil
+	case patchOpRemove:
+		if operation.Path == "" {
+			return "", status.Errorf(status.InvalidArgument, "remove operation requires a path")
+		}
+		return op, nil
+	default:
+		return "", status.Errorf(status.InvalidArgument, "unsupported patch operation %q", operation.Op)
+	}
+}
+
+// applyPatchObject applies an operation without path, its value is an object of attribute paths and values
+func applyPatchObject(op string, value json.RawMessage, apply func(op, path string, value json.RawMessage) error) error {
+	var attributes map[string]json.RawMessage
+	if err := json.Unmarshal(value, &attributes); err != nil {
+		return status.Errorf(status.InvalidArgument, "value of a patch operation without path should be an object")
+	}
+
+	for path, attributeValue := range attributes {
+		if err := apply(op, path, attributeValue); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// splitPath splits a path like emails[type eq "work"].value into lower case attribute, value filter and sub-attribute
+func splitPath(path string) (string, string, string) {
+	attribute, valueFilter := path, ""
+	if start := strings.Index(path, "["); start >= 0 {
+		if end := strings.LastIndex(path, "]"); end > start {
+			attribute, valueFilter = path[:start]+path[end+1:], path[start+1:end]
+		}
+	}
+
+	// strip the schema prefix of fully qualified paths
+	if index := strings.LastIndex(attribute, ":"); index >= 0 {
+		attribute = attribute[index+1:]
+	}
+
+	attribute, subAttribute, _ := strings.Cut(strings.ToLower(attribute), ".")
+	return attribute, valueFilter, subAttribute
+}
+
+func decodePatchValue(path string, value json.RawMessage, target any) error {
+	if err := json.Unmarshal(value, target); err != nil {
+		return status.Errorf(status.InvalidArgument, "invalid value for %s", path)
+	}
+	return nil
+}
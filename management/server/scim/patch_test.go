@@ -0,0 +1,73 @@
+package scim
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyUserPatch(t *testing.T) {
+	active := Bool(true)
+	user := &User{
+		UserName: "alice@example.com",
+		Name:     &Name{GivenName: "Alice", FamilyName: "Doe"},
+		Active:   &active,
+	}
+
+	err := applyUserPatch(user, []PatchOperation{
+		{Op: "Replace", Path: "active", Value: json.RawMessage(`"False"`)},
+		{Op: "replace", Path: "name.familyName", Value: json.RawMessage(`"Smith"`)},
+		{Op: "add", Path: `emails[type eq "work"].value`, Value: json.RawMessage(`"alice@corp.example.com"`)},
+		{Op: "replace", Value: json.RawMessage(`{"userName":"alice@corp.example.com","urn:ietf:params:scim:schemas:core:2.0:User:displayName":"Alice S."}`)},
+		{Op: "add", Path: "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:department", Value: json.RawMessage(`"R&D"`)},
+	})
+	require.NoError(t, err)
+
+	assert.False(t, user.active())
+	assert.Equal(t, "Smith", user.Name.FamilyName)
+	assert.Equal(t, []Email{{Value: "alice@corp.example.com", Primary: true}}, user.Emails)
+	assert.Equal(t, "alice@corp.example.com", user.UserName)
+	assert.Equal(t, "Alice S.", user.displayName())
+
+	err = applyUserPatch(user, []PatchOperation{{Op: "remove", Path: "userName"}})
+	assert.Error(t, err, "userName is required")
+
+	err = applyUserPatch(user, []PatchOperation{{Op: "move", Path: "userName", Value: json.RawMessage(`"x"`)}})
+	assert.Error(t, err, "unsupported operation")
+
+	err = applyUserPatch(user, []PatchOperation{{Op: "replace", Path: "active", Value: json.RawMessage(`"maybe"`)}})
+	assert.Error(t, err, "invalid value")
+}
+
+func TestApplyGroupPatch(t *testing.T) {
+	group := &Group{
+		DisplayName: "engineering",
+		Members:     []Reference{{Value: "alice"}, {Value: "bob"}},
+	}
+
+	err := applyGroupPatch(group, []PatchOperation{
+		{Op: "add", Path: "members", Value: json.RawMessage(`[{"value":"bob"},{"value":"carol"}]`)},
+		{Op: "remove", Path: `members[value eq "alice"]`},
+		{Op: "replace", Value: json.RawMessage(`{"displayName":"platform"}`)},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "platform", group.DisplayName)
+	assert.Equal(t, []Reference{{Value: "bob"}, {Value: "carol"}}, group.Members)
+
+	err = applyGroupPatch(group, []PatchOperation{{Op: "remove", Path: "members", Value: json.RawMessage(`[{"value":"bob"}]`)}})
+	require.NoError(t, err)
+	assert.Equal(t, []Reference{{Value: "carol"}}, group.Members)
+
+	err = applyGroupPatch(group, []PatchOperation{{Op: "replace", Path: "members", Value: json.RawMessage(`[{"value":"dave"}]`)}})
+	require.NoError(t, err)
+	assert.Equal(t, []Reference{{Value: "dave"}}, group.Members)
+
+	err = applyGroupPatch(group, []PatchOperation{{Op: "remove", Path: "members"}})
+	require.NoError(t, err)
+	assert.Empty(t, group.Members)
+
+	err = applyGroupPatch(group, []PatchOperation{{Op: "remove", Path: "displayName"}})
+	assert.Error(t, err)
+}
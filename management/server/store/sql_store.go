@@ -24,15 +24,21 @@ import (
 	"gorm.io/gorm/logger"
 
 	nbdns "github.com/netbirdio/netbird/dns"
+	blocklistTypes "github.com/netbirdio/netbird/management/server/blocklists/types"
 	nbcontext "github.com/netbirdio/netbird/management/server/context"
+	eventSinkTypes "github.com/netbirdio/netbird/management/server/eventsinks/types"
+	flowTypes "github.com/netbirdio/netbird/management/server/flows/types"
+	ingressTypes "github.com/netbirdio/netbird/management/server/ingress/types"
 	resourceTypes "github.com/netbirdio/netbird/management/server/networks/resources/types"
 	routerTypes "github.com/netbirdio/netbird/management/server/networks/routers/types"
 	networkTypes "github.com/netbirdio/netbird/management/server/networks/types"
 	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/permissions/roles"
 	"github.com/netbirdio/netbird/management/server/posture"
 	"github.com/netbirdio/netbird/management/server/telemetry"
 	"github.com/netbirdio/netbird/management/server/types"
 	"github.com/netbirdio/netbird/management/server/util"
+	zoneTypes "github.com/netbirdio/netbird/management/server/zones/types"
 	"github.com/netbirdio/netbird/route"
 	"github.com/netbirdio/netbird/shared/management/status"
 )
@@ -105,6 +111,9 @@ func NewSqlStore(ctx context.Context, db *gorm.DB, storeEngine types.Engine, met
 		&types.Account{}, &types.Policy{}, &types.PolicyRule{}, &route.Route{}, &nbdns.NameServerGroup{},
 		&installation{}, &types.ExtraSettings{}, &posture.Checks{}, &nbpeer.NetworkAddress{},
 		&networkTypes.Network{}, &routerTypes.NetworkRouter{}, &resourceTypes.NetworkResource{}, &types.AccountOnboarding{},
+		&roles.CustomRole{}, &types.SCIMToken{}, &zoneTypes.Zone{},
+		&blocklistTypes.Blocklist{}, &ingressTypes.IngressPeer{}, &ingressTypes.PortAllocation{}, &eventSinkTypes.Sink{},
+		&flowTypes.Flow{},
 	)
 	if err != nil {
 		return nil, fmt.Errorf("auto migratePreAuto: %w", err)
@@ -268,6 +277,46 @@ func (s *SqlStore) DeleteAccount(ctx context.Context, account *types.Account) er
 			return result.Error
 		}
 
+		result = tx.Delete(&roles.CustomRole{}, accountIDCondition, account.Id)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		result = tx.Delete(&types.SCIMToken{}, accountIDCondition, account.Id)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		result = tx.Delete(&zoneTypes.Zone{}, accountIDCondition, account.Id)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		result = tx.Delete(&blocklistTypes.Blocklist{}, accountIDCondition, account.Id)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		result = tx.Delete(&ingressTypes.PortAllocation{}, accountIDCondition, account.Id)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		result = tx.Delete(&ingressTypes.IngressPeer{}, accountIDCondition, account.Id)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		result = tx.Delete(&eventSinkTypes.Sink{}, accountIDCondition, account.Id)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		result = tx.Delete(&flowTypes.Flow{}, accountIDCondition, account.Id)
+		if result.Error != nil {
+			return result.Error
+		}
+
 		result = tx.Select(clause.Associations).Delete(account)
 		if result.Error != nil {
 			return result.Error
@@ -1106,6 +1155,44 @@ func (s *SqlStore) GetAccountSettings(ctx context.Context, lockStrength LockingS
 	return accountSettings.Settings, nil
 }
 
+// GetAccountIDsWithEventRetention returns the IDs of the accounts that limit the retention of their activity events
+func (s *SqlStore) GetAccountIDsWithEventRetention(ctx context.Context, lockStrength LockingStrength) ([]string, error) {
+	tx := s.db
+	if lockStrength != LockingStrengthNone {
+		tx = tx.Clauses(clause.Locking{Strength: string(lockStrength)})
+	}
+
+	var accountIDs []string
+	result := tx.Model(&types.Account{}).Where("settings_event_retention_days > 0").Pluck("id", &accountIDs)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to get accounts with event retention from the store: %s", result.Error)
+		return nil, status.Errorf(status.Internal, "failed to get accounts with event retention from store")
+	}
+
+	return accountIDs, nil
+}
+
+// GetAccountIDsWithPolicyRuleSchedules returns the IDs of the accounts that have policy rules with a schedule
+func (s *SqlStore) GetAccountIDsWithPolicyRuleSchedules(ctx context.Context, lockStrength LockingStrength) ([]string, error) {
+	tx := s.db
+	if lockStrength != LockingStrengthNone {
+		tx = tx.Clauses(clause.Locking{Strength: string(lockStrength)})
+	}
+
+	var accountIDs []string
+	result := tx.Model(&types.PolicyRule{}).
+		Joins("JOIN policies ON policies.id = policy_rules.policy_id").
+		Where("policy_rules.schedule IS NOT NULL").
+		Distinct().
+		Pluck("policies.account_id", &accountIDs)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to get accounts with policy rule schedules from the store: %s", result.Error)
+		return nil, status.Errorf(status.Internal, "failed to get accounts with policy rule schedules from store")
+	}
+
+	return accountIDs, nil
+}
+
 func (s *SqlStore) GetAccountCreatedBy(ctx context.Context, lockStrength LockingStrength, accountID string) (string, error) {
 	tx := s.db
 	if lockStrength != LockingStrengthNone {
@@ -2686,6 +2773,547 @@ func (s *SqlStore) DeletePAT(ctx context.Context, userID, patID string) error {
 	return nil
 }
 
+func (s *SqlStore) GetAccountCustomRoles(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*roles.CustomRole, error) {
+	tx := s.db
+	if lockStrength != LockingStrengthNone {
+		tx = tx.Clauses(clause.Locking{Strength: string(lockStrength)})
+	}
+
+	var customRoles []*roles.CustomRole
+	result := tx.Find(&customRoles, accountIDCondition, accountID)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to get custom roles from the store: %s", result.Error)
+		return nil, status.Errorf(status.Internal, "failed to get custom roles from store")
+	}
+
+	return customRoles, nil
+}
+
+func (s *SqlStore) GetCustomRoleByID(ctx context.Context, lockStrength LockingStrength, accountID, roleID string) (*roles.CustomRole, error) {
+	tx := s.db
+	if lockStrength != LockingStrengthNone {
+		tx = tx.Clauses(clause.Locking{Strength: string(lockStrength)})
+	}
+
+	var customRole *roles.CustomRole
+	result := tx.Take(&customRole, accountAndIDQueryCondition, accountID, roleID)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, status.NewCustomRoleNotFoundError(roleID)
+		}
+
+		log.WithContext(ctx).Errorf("failed to get custom role from store: %v", result.Error)
+		return nil, status.Errorf(status.Internal, "failed to get custom role from store")
+	}
+
+	return customRole, nil
+}
+
+func (s *SqlStore) SaveCustomRole(ctx context.Context, role *roles.CustomRole) error {
+	result := s.db.Save(role)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to save custom role to store: %v", result.Error)
+		return status.Errorf(status.Internal, "failed to save custom role to store")
+	}
+
+	return nil
+}
+
+func (s *SqlStore) DeleteCustomRole(ctx context.Context, accountID, roleID string) error {
+	result := s.db.Delete(&roles.CustomRole{}, accountAndIDQueryCondition, accountID, roleID)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to delete custom role from store: %v", result.Error)
+		return status.Errorf(status.Internal, "failed to delete custom role from store")
+	}
+
+	if result.RowsAffected == 0 {
+		return status.NewCustomRoleNotFoundError(roleID)
+	}
+
+	return nil
+}
+
+func (s *SqlStore) GetAccountSCIMTokens(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*types.SCIMToken, error) {
+	tx := s.db
+	if lockStrength != LockingStrengthNone {
+		tx = tx.Clauses(clause.Locking{Strength: string(lockStrength)})
+	}
+
+	var tokens []*types.SCIMToken
+	result := tx.Find(&tokens, accountIDCondition, accountID)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to get SCIM tokens from the store: %s", result.Error)
+		return nil, status.Errorf(status.Internal, "failed to get SCIM tokens from store")
+	}
+
+	return tokens, nil
+}
+
+func (s *SqlStore) GetSCIMTokenByID(ctx context.Context, lockStrength LockingStrength, accountID, tokenID string) (*types.SCIMToken, error) {
+	tx := s.db
+	if lockStrength != LockingStrengthNone {
+		tx = tx.Clauses(clause.Locking{Strength: string(lockStrength)})
+	}
+
+	var token *types.SCIMToken
+	result := tx.Take(&token, accountAndIDQueryCondition, accountID, tokenID)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, status.NewSCIMTokenNotFoundError(tokenID)
+		}
+
+		log.WithContext(ctx).Errorf("failed to get SCIM token from store: %v", result.Error)
+		return nil, status.Errorf(status.Internal, "failed to get SCIM token from store")
+	}
+
+	return token, nil
+}
+
+func (s *SqlStore) GetSCIMTokenByHashedToken(ctx context.Context, lockStrength LockingStrength, hashedToken string) (*types.SCIMToken, error) {
+	tx := s.db
+	if lockStrength != LockingStrengthNone {
+		tx = tx.Clauses(clause.Locking{Strength: string(lockStrength)})
+	}
+
+	var token *types.SCIMToken
+	result := tx.Take(&token, "hashed_token = ?", hashedToken)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, status.NewSCIMTokenNotFoundError(hashedToken)
+		}
+
+		log.WithContext(ctx).Errorf("failed to get SCIM token by hash from store: %v", result.Error)
+		return nil, status.Errorf(status.Internal, "failed to get SCIM token by hash from store")
+	}
+
+	return token, nil
+}
+
+func (s *SqlStore) MarkSCIMTokenUsed(ctx context.Context, tokenID string) error {
+	result := s.db.Model(&types.SCIMToken{}).
+		Where(idQueryCondition, tokenID).Update("last_used", time.Now().UTC())
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to mark SCIM token as used: %s", result.Error)
+		return status.Errorf(status.Internal, "failed to mark SCIM token as used")
+	}
+
+	if result.RowsAffected == 0 {
+		return status.NewSCIMTokenNotFoundError(tokenID)
+	}
+
+	return nil
+}
+
+func (s *SqlStore) SaveSCIMToken(ctx context.Context, token *types.SCIMToken) error {
+	result := s.db.Save(token)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to save SCIM token to store: %v", result.Error)
+		return status.Errorf(status.Internal, "failed to save SCIM token to store")
+	}
+
+	return nil
+}
+
+func (s *SqlStore) DeleteSCIMToken(ctx context.Context, accountID, tokenID string) error {
+	result := s.db.Delete(&types.SCIMToken{}, accountAndIDQueryCondition, accountID, tokenID)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to delete SCIM token from store: %v", result.Error)
+		return status.Errorf(status.Internal, "failed to delete SCIM token from store")
+	}
+
+	if result.RowsAffected == 0 {
+		return status.NewSCIMTokenNotFoundError(tokenID)
+	}
+
+	return nil
+}
+
+func (s *SqlStore) GetAccountDNSZones(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*zoneTypes.Zone, error) {
+	tx := s.db
+	if lockStrength != LockingStrengthNone {
+		tx = tx.Clauses(clause.Locking{Strength: string(lockStrength)})
+	}
+
+	var zones []*zoneTypes.Zone
+	result := tx.Find(&zones, accountIDCondition, accountID)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to get DNS zones from the store: %s", result.Error)
+		return nil, status.Errorf(status.Internal, "failed to get DNS zones from store")
+	}
+
+	return zones, nil
+}
+
+func (s *SqlStore) GetDNSZoneByID(ctx context.Context, lockStrength LockingStrength, accountID, zoneID string) (*zoneTypes.Zone, error) {
+	tx := s.db
+	if lockStrength != LockingStrengthNone {
+		tx = tx.Clauses(clause.Locking{Strength: string(lockStrength)})
+	}
+
+	var zone *zoneTypes.Zone
+	result := tx.Take(&zone, accountAndIDQueryCondition, accountID, zoneID)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, status.NewDNSZoneNotFoundError(zoneID)
+		}
+
+		log.WithContext(ctx).Errorf("failed to get DNS zone from store: %v", result.Error)
+		return nil, status.Errorf(status.Internal, "failed to get DNS zone from store")
+	}
+
+	return zone, nil
+}
+
+func (s *SqlStore) SaveDNSZone(ctx context.Context, zone *zoneTypes.Zone) error {
+	result := s.db.Save(zone)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to save DNS zone to store: %v", result.Error)
+		return status.Errorf(status.Internal, "failed to save DNS zone to store")
+	}
+
+	return nil
+}
+
+func (s *SqlStore) DeleteDNSZone(ctx context.Context, accountID, zoneID string) error {
+	result := s.db.Delete(&zoneTypes.Zone{}, accountAndIDQueryCondition, accountID, zoneID)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to delete DNS zone from store: %v", result.Error)
+		return status.Errorf(status.Internal, "failed to delete DNS zone from store")
+	}
+
+	if result.RowsAffected == 0 {
+		return status.NewDNSZoneNotFoundError(zoneID)
+	}
+
+	return nil
+}
+
+func (s *SqlStore) GetAccountDNSBlocklists(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*blocklistTypes.Blocklist, error) {
+	tx := s.db
+	if lockStrength != LockingStrengthNone {
+		tx = tx.Clauses(clause.Locking{Strength: string(lockStrength)})
+	}
+
+	var blocklists []*blocklistTypes.Blocklist
+	result := tx.Find(&blocklists, accountIDCondition, accountID)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to get DNS blocklists from the store: %s", result.Error)
+		return nil, status.Errorf(status.Internal, "failed to get DNS blocklists from store")
+	}
+
+	return blocklists, nil
+}
+
+func (s *SqlStore) GetDNSBlocklistByID(ctx context.Context, lockStrength LockingStrength, accountID, blocklistID string) (*blocklistTypes.Blocklist, error) {
+	tx := s.db
+	if lockStrength != LockingStrengthNone {
+		tx = tx.Clauses(clause.Locking{Strength: string(lockStrength)})
+	}
+
+	var blocklist *blocklistTypes.Blocklist
+	result := tx.Take(&blocklist, accountAndIDQueryCondition, accountID, blocklistID)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, status.NewDNSBlocklistNotFoundError(blocklistID)
+		}
+
+		log.WithContext(ctx).Errorf("failed to get DNS blocklist from store: %v", result.Error)
+		return nil, status.Errorf(status.Internal, "failed to get DNS blocklist from store")
+	}
+
+	return blocklist, nil
+}
+
+// GetDNSBlocklistsWithSource returns the blocklists of all accounts that mirror a source
+func (s *SqlStore) GetDNSBlocklistsWithSource(ctx context.Context, lockStrength LockingStrength) ([]*blocklistTypes.Blocklist, error) {
+	tx := s.db
+	if lockStrength != LockingStrengthNone {
+		tx = tx.Clauses(clause.Locking{Strength: string(lockStrength)})
+	}
+
+	var blocklists []*blocklistTypes.Blocklist
+	result := tx.Omit("source_domains").Find(&blocklists, "source_url <> ?", "")
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to get DNS blocklists with source from the store: %s", result.Error)
+		return nil, status.Errorf(status.Internal, "failed to get DNS blocklists from store")
+	}
+
+	return blocklists, nil
+}
+
+func (s *SqlStore) SaveDNSBlocklist(ctx context.Context, blocklist *blocklistTypes.Blocklist) error {
+	result := s.db.Save(blocklist)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to save DNS blocklist to store: %v", result.Error)
+		return status.Errorf(status.Internal, "failed to save DNS blocklist to store")
+	}
+
+	return nil
+}
+
+func (s *SqlStore) DeleteDNSBlocklist(ctx context.Context, accountID, blocklistID string) error {
+	result := s.db.Delete(&blocklistTypes.Blocklist{}, accountAndIDQueryCondition, accountID, blocklistID)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to delete DNS blocklist from store: %v", result.Error)
+		return status.Errorf(status.Internal, "failed to delete DNS blocklist from store")
+	}
+
+	if result.RowsAffected == 0 {
+		return status.NewDNSBlocklistNotFoundError(blocklistID)
+	}
+
+	return nil
+}
+
+func (s *SqlStore) GetAccountIngressPeers(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*ingressTypes.IngressPeer, error) {
+	tx := s.db
+	if lockStrength != LockingStrengthNone {
+		tx = tx.Clauses(clause.Locking{Strength: string(lockStrength)})
+	}
+
+	var ingressPeers []*ingressTypes.IngressPeer
+	result := tx.Find(&ingressPeers, accountIDCondition, accountID)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to get ingress peers from the store: %s", result.Error)
+		return nil, status.Errorf(status.Internal, "failed to get ingress peers from store")
+	}
+
+	return ingressPeers, nil
+}
+
+func (s *SqlStore) GetIngressPeerByID(ctx context.Context, lockStrength LockingStrength, accountID, ingressPeerID string) (*ingressTypes.IngressPeer, error) {
+	tx := s.db
+	if lockStrength != LockingStrengthNone {
+		tx = tx.Clauses(clause.Locking{Strength: string(lockStrength)})
+	}
+
+	var ingressPeer *ingressTypes.IngressPeer
+	result := tx.Take(&ingressPeer, accountAndIDQueryCondition, accountID, ingressPeerID)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, status.NewIngressPeerNotFoundError(ingressPeerID)
+		}
+
+		log.WithContext(ctx).Errorf("failed to get ingress peer from store: %v", result.Error)
+		return nil, status.Errorf(status.Internal, "failed to get ingress peer from store")
+	}
+
+	return ingressPeer, nil
+}
+
+func (s *SqlStore) SaveIngressPeer(ctx context.Context, ingressPeer *ingressTypes.IngressPeer) error {
+	result := s.db.Save(ingressPeer)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to save ingress peer to store: %v", result.Error)
+		return status.Errorf(status.Internal, "failed to save ingress peer to store")
+	}
+
+	return nil
+}
+
+func (s *SqlStore) DeleteIngressPeer(ctx context.Context, accountID, ingressPeerID string) error {
+	result := s.db.Delete(&ingressTypes.IngressPeer{}, accountAndIDQueryCondition, accountID, ingressPeerID)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to delete ingress peer from store: %v", result.Error)
+		return status.Errorf(status.Internal, "failed to delete ingress peer from store")
+	}
+
+	if result.RowsAffected == 0 {
+		return status.NewIngressPeerNotFoundError(ingressPeerID)
+	}
+
+	return nil
+}
+
+func (s *SqlStore) GetAccountIngressPortAllocations(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*ingressTypes.PortAllocation, error) {
+	tx := s.db
+	if lockStrength != LockingStrengthNone {
+		tx = tx.Clauses(clause.Locking{Strength: string(lockStrength)})
+	}
+
+	var allocations []*ingressTypes.PortAllocation
+	result := tx.Find(&allocations, accountIDCondition, accountID)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to get ingress port allocations from the store: %s", result.Error)
+		return nil, status.Errorf(status.Internal, "failed to get ingress port allocations from store")
+	}
+
+	return allocations, nil
+}
+
+func (s *SqlStore) GetIngressPortAllocationByID(ctx context.Context, lockStrength LockingStrength, accountID, allocationID string) (*ingressTypes.PortAllocation, error) {
+	tx := s.db
+	if lockStrength != LockingStrengthNone {
+		tx = tx.Clauses(clause.Locking{Strength: string(lockStrength)})
+	}
+
+	var allocation *ingressTypes.PortAllocation
+	result := tx.Take(&allocation, accountAndIDQueryCondition, accountID, allocationID)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, status.NewIngressPortAllocationNotFoundError(allocationID)
+		}
+
+		log.WithContext(ctx).Errorf("failed to get ingress port allocation from store: %v", result.Error)
+		return nil, status.Errorf(status.Internal, "failed to get ingress port allocation from store")
+	}
+
+	return allocation, nil
+}
+
+func (s *SqlStore) SaveIngressPortAllocation(ctx context.Context, allocation *ingressTypes.PortAllocation) error {
+	result := s.db.Save(allocation)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to save ingress port allocation to store: %v", result.Error)
+		return status.Errorf(status.Internal, "failed to save ingress port allocation to store")
+	}
+
+	return nil
+}
+
+func (s *SqlStore) DeleteIngressPortAllocation(ctx context.Context, accountID, allocationID string) error {
+	result := s.db.Delete(&ingressTypes.PortAllocation{}, accountAndIDQueryCondition, accountID, allocationID)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to delete ingress port allocation from store: %v", result.Error)
+		return status.Errorf(status.Internal, "failed to delete ingress port allocation from store")
+	}
+
+	if result.RowsAffected == 0 {
+		return status.NewIngressPortAllocationNotFoundError(allocationID)
+	}
+
+	return nil
+}
+
+func (s *SqlStore) GetAccountEventSinks(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*eventSinkTypes.Sink, error) {
+	tx := s.db
+	if lockStrength != LockingStrengthNone {
+		tx = tx.Clauses(clause.Locking{Strength: string(lockStrength)})
+	}
+
+	var sinks []*eventSinkTypes.Sink
+	result := tx.Find(&sinks, accountIDCondition, accountID)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to get event sinks from the store: %s", result.Error)
+		return nil, status.Errorf(status.Internal, "failed to get event sinks from store")
+	}
+
+	return sinks, nil
+}
+
+func (s *SqlStore) GetEventSinkByID(ctx context.Context, lockStrength LockingStrength, accountID, sinkID string) (*eventSinkTypes.Sink, error) {
+	tx := s.db
+	if lockStrength != LockingStrengthNone {
+		tx = tx.Clauses(clause.Locking{Strength: string(lockStrength)})
+	}
+
+	var sink *eventSinkTypes.Sink
+	result := tx.Take(&sink, accountAndIDQueryCondition, accountID, sinkID)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, status.NewEventSinkNotFoundError(sinkID)
+		}
+
+		log.WithContext(ctx).Errorf("failed to get event sink from store: %v", result.Error)
+		return nil, status.Errorf(status.Internal, "failed to get event sink from store")
+	}
+
+	return sink, nil
+}
+
+func (s *SqlStore) SaveEventSink(ctx context.Context, sink *eventSinkTypes.Sink) error {
+	result := s.db.Save(sink)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to save event sink to store: %v", result.Error)
+		return status.Errorf(status.Internal, "failed to save event sink to store")
+	}
+
+	return nil
+}
+
+func (s *SqlStore) DeleteEventSink(ctx context.Context, accountID, sinkID string) error {
+	result := s.db.Delete(&eventSinkTypes.Sink{}, accountAndIDQueryCondition, accountID, sinkID)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to delete event sink from store: %v", result.Error)
+		return status.Errorf(status.Internal, "failed to delete event sink from store")
+	}
+
+	if result.RowsAffected == 0 {
+		return status.NewEventSinkNotFoundError(sinkID)
+	}
+
+	return nil
+}
+
+func (s *SqlStore) SaveFlows(ctx context.Context, flows []*flowTypes.Flow) error {
+	if len(flows) == 0 {
+		return nil
+	}
+
+	result := s.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&flows)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to save flows to store: %v", result.Error)
+		return status.Errorf(status.Internal, "failed to save flows to store")
+	}
+
+	return nil
+}
+
+func (s *SqlStore) GetAccountFlows(ctx context.Context, lockStrength LockingStrength, accountID string, filter flowTypes.Filter) ([]*flowTypes.Flow, error) {
+	tx := s.db
+	if lockStrength != LockingStrengthNone {
+		tx = tx.Clauses(clause.Locking{Strength: string(lockStrength)})
+	}
+
+	query := tx.Where(accountIDCondition, accountID)
+	if filter.PeerID != "" {
+		query = query.Where("peer_id = ?", filter.PeerID)
+	}
+	if filter.ResourceID != "" {
+		query = query.Where("source_resource_id = ? OR dest_resource_id = ?", filter.ResourceID, filter.ResourceID)
+	}
+	if filter.RuleID != "" {
+		query = query.Where("rule_id = ?", filter.RuleID)
+	}
+	if filter.Protocol != nil {
+		query = query.Where("protocol = ?", *filter.Protocol)
+	}
+	if !filter.From.IsZero() {
+		query = query.Where("timestamp >= ?", filter.From.UTC())
+	}
+	if !filter.To.IsZero() {
+		query = query.Where("timestamp <= ?", filter.To.UTC())
+	}
+	if filter.Cursor != nil {
+		timestamp := filter.Cursor.Timestamp.UTC()
+		query = query.Where("timestamp < ? OR (timestamp = ? AND id < ?)", timestamp, timestamp, filter.Cursor.ID)
+	}
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = flowTypes.DefaultSearchLimit
+	}
+
+	var flows []*flowTypes.Flow
+	result := query.Order("timestamp DESC").Order("id DESC").Limit(limit).Find(&flows)
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to get flows from the store: %s", result.Error)
+		return nil, status.Errorf(status.Internal, "failed to get flows from store")
+	}
+
+	return flows, nil
+}
+
+func (s *SqlStore) DeleteFlowsReceivedBefore(ctx context.Context, before time.Time) (int64, error) {
+	result := s.db.Delete(&flowTypes.Flow{}, "received_at < ?", before.UTC())
+	if result.Error != nil {
+		log.WithContext(ctx).Errorf("failed to delete flows from store: %v", result.Error)
+		return 0, status.Errorf(status.Internal, "failed to delete flows from store")
+	}
+
+	return result.RowsAffected, nil
+}
+
 func (s *SqlStore) GetPeerByIP(ctx context.Context, lockStrength LockingStrength, accountID string, ip net.IP) (*nbpeer.Peer, error) {
 	tx := s.db
 	if lockStrength != LockingStrengthNone {
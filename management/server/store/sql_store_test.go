@@ -22,6 +22,9 @@ import (
 	"github.com/stretchr/testify/require"
 
 	nbdns "github.com/netbirdio/netbird/dns"
+	blocklistTypes "github.com/netbirdio/netbird/management/server/blocklists/types"
+	flowTypes "github.com/netbirdio/netbird/management/server/flows/types"
+	ingressTypes "github.com/netbirdio/netbird/management/server/ingress/types"
 	resourceTypes "github.com/netbirdio/netbird/management/server/networks/resources/types"
 	routerTypes "github.com/netbirdio/netbird/management/server/networks/routers/types"
 	networkTypes "github.com/netbirdio/netbird/management/server/networks/types"
@@ -29,6 +32,7 @@ import (
 	"github.com/netbirdio/netbird/management/server/posture"
 	"github.com/netbirdio/netbird/management/server/types"
 	"github.com/netbirdio/netbird/management/server/util"
+	zoneTypes "github.com/netbirdio/netbird/management/server/zones/types"
 	nbroute "github.com/netbirdio/netbird/route"
 	route2 "github.com/netbirdio/netbird/route"
 	"github.com/netbirdio/netbird/shared/management/status"
@@ -359,9 +363,22 @@ func TestSqlite_DeleteAccount(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, o.AccountID, account.Id)
 
+	zone := zoneTypes.NewZone(account.Id, "internal", "", "internal.example.com", true, []string{"group_id"}, []zoneTypes.Record{{Name: "api", Type: "A", TTL: 300, Value: "10.0.0.1"}})
+	require.NoError(t, store.SaveDNSZone(context.Background(), zone))
+	blocklist := blocklistTypes.NewBlocklist(account.Id, "ads", "", true, blocklistTypes.ActionNXDomain, []string{"ads.example.com"}, "", "", nil)
+	require.NoError(t, store.SaveDNSBlocklist(context.Background(), blocklist))
+
 	err = store.DeleteAccount(context.Background(), account)
 	require.NoError(t, err)
 
+	zones, err := store.GetAccountDNSZones(context.Background(), LockingStrengthNone, account.Id)
+	require.NoError(t, err)
+	require.Empty(t, zones, "expecting no DNS zones to be found after DeleteAccount")
+
+	blocklists, err := store.GetAccountDNSBlocklists(context.Background(), LockingStrengthNone, account.Id)
+	require.NoError(t, err)
+	require.Empty(t, blocklists, "expecting no DNS blocklists to be found after DeleteAccount")
+
 	_, err = store.GetAccountOnboarding(context.Background(), account.Id)
 	require.Error(t, err, "expecting error after removing DeleteAccount when getting onboarding")
 
@@ -411,6 +428,39 @@ func TestSqlite_DeleteAccount(t *testing.T) {
 	}
 }
 
+func TestSqlite_DeleteAccountWithIngressPeer(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("The SQLite store is not properly supported by Windows yet")
+	}
+
+	t.Setenv("NETBIRD_STORE_ENGINE", string(types.SqliteStoreEngine))
+	store, cleanUp, err := NewTestStoreFromSQL(context.Background(), "", t.TempDir())
+	t.Cleanup(cleanUp)
+	require.NoError(t, err)
+
+	account := newAccountWithId(context.Background(), "account_id", "testuser", "")
+	account.Peers["ingresspeer"] = &nbpeer.Peer{ID: "ingresspeer", Key: "ingresskey", DNSLabel: "ingress", IP: net.IP{100, 64, 0, 1}, Status: &nbpeer.PeerStatus{}}
+	account.Peers["testpeer"] = &nbpeer.Peer{ID: "testpeer", Key: "peerkey", DNSLabel: "testpeer", IP: net.IP{100, 64, 0, 2}, Status: &nbpeer.PeerStatus{}}
+	require.NoError(t, store.SaveAccount(context.Background(), account))
+
+	ingressPeer := ingressTypes.NewIngressPeer(account.Id, "ingresspeer", true, false)
+	require.NoError(t, store.SaveIngressPeer(context.Background(), ingressPeer))
+	allocation := ingressTypes.NewPortAllocation(account.Id, "testpeer", "web", true)
+	allocation.IngressPeerID = ingressPeer.ID
+	allocation.PortMappings = []ingressTypes.PortMapping{{Protocol: ingressTypes.ProtocolTCP, IngressStart: 10000, IngressEnd: 10000, TranslatedStart: 80, TranslatedEnd: 80}}
+	require.NoError(t, store.SaveIngressPortAllocation(context.Background(), allocation))
+
+	require.NoError(t, store.DeleteAccount(context.Background(), account))
+
+	ingressPeers, err := store.GetAccountIngressPeers(context.Background(), LockingStrengthNone, account.Id)
+	require.NoError(t, err)
+	require.Empty(t, ingressPeers, "expecting no ingress peers to be found after DeleteAccount")
+
+	allocations, err := store.GetAccountIngressPortAllocations(context.Background(), LockingStrengthNone, account.Id)
+	require.NoError(t, err)
+	require.Empty(t, allocations, "expecting no port allocations to be found after DeleteAccount")
+}
+
 func Test_GetAccount(t *testing.T) {
 	if runtime.GOOS == "windows" {
 		t.Skip("The SQLite store is not properly supported by Windows yet")
@@ -3717,3 +3767,67 @@ func TestSqlStore_GetPeersByGroupIDs(t *testing.T) {
 		})
 	}
 }
+
+func TestSqlStore_Flows(t *testing.T) {
+	store, cleanup, err := NewTestStoreFromSQL(context.Background(), "../testdata/store.sql", t.TempDir())
+	t.Cleanup(cleanup)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	accountID := "bf1c8084-ba50-4ce7-9439-34653001fc3b"
+	otherAccountID := "9439-34653001fc3b-bf1c8084-ba50-4ce7"
+	now := time.Now().UTC().Truncate(time.Millisecond)
+
+	flows := []*flowTypes.Flow{
+		{ID: "flow1", AccountID: accountID, PeerID: "peer1", Timestamp: now.Add(-3 * time.Hour), ReceivedAt: now.Add(-3 * time.Hour), Protocol: 6, RuleID: "rule1", SourceResourceID: "peer2"},
+		{ID: "flow2", AccountID: accountID, PeerID: "peer1", Timestamp: now.Add(-2 * time.Hour), ReceivedAt: now, Protocol: 17, RuleID: "rule2", DestResourceID: "resource1"},
+		{ID: "flow3", AccountID: accountID, PeerID: "peer2", Timestamp: now.Add(-time.Hour), ReceivedAt: now, Protocol: 6, RuleID: "rule1", DestResourceID: "peer1"},
+		{ID: "flow4", AccountID: accountID, PeerID: "peer2", Timestamp: now.Add(-time.Hour), ReceivedAt: now, Protocol: 1},
+		{ID: "flow1", AccountID: otherAccountID, PeerID: "peer3", Timestamp: now, ReceivedAt: now, Protocol: 6, DestResourceID: "peer1"},
+	}
+	require.NoError(t, store.SaveFlows(ctx, flows))
+
+	// the flows that are already stored are skipped
+	require.NoError(t, store.SaveFlows(ctx, []*flowTypes.Flow{{ID: "flow1", AccountID: accountID, PeerID: "changed"}}))
+
+	ids := func(flows []*flowTypes.Flow) []string {
+		var ids []string
+		for _, flow := range flows {
+			ids = append(ids, flow.ID)
+		}
+		return ids
+	}
+	tcp := uint32(6)
+
+	tests := []struct {
+		name   string
+		filter flowTypes.Filter
+		want   []string
+	}{
+		{name: "all", filter: flowTypes.Filter{}, want: []string{"flow4", "flow3", "flow2", "flow1"}},
+		{name: "peer", filter: flowTypes.Filter{PeerID: "peer1"}, want: []string{"flow2", "flow1"}},
+		{name: "resource", filter: flowTypes.Filter{ResourceID: "peer1"}, want: []string{"flow3"}},
+		{name: "resource and protocol", filter: flowTypes.Filter{ResourceID: "resource1", Protocol: &tcp}, want: nil},
+		{name: "other account", filter: flowTypes.Filter{PeerID: "peer3"}, want: nil},
+		{name: "rule", filter: flowTypes.Filter{RuleID: "rule1"}, want: []string{"flow3", "flow1"}},
+		{name: "protocol", filter: flowTypes.Filter{Protocol: &tcp}, want: []string{"flow3", "flow1"}},
+		{name: "time range", filter: flowTypes.Filter{From: now.Add(-2 * time.Hour), To: now.Add(-90 * time.Minute)}, want: []string{"flow2"}},
+		{name: "limit", filter: flowTypes.Filter{Limit: 2}, want: []string{"flow4", "flow3"}},
+		{name: "cursor", filter: flowTypes.Filter{Cursor: &flowTypes.Cursor{Timestamp: now.Add(-time.Hour), ID: "flow4"}}, want: []string{"flow3", "flow2", "flow1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flows, err := store.GetAccountFlows(ctx, LockingStrengthNone, accountID, tt.filter)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, ids(flows))
+		})
+	}
+
+	deleted, err := store.DeleteFlowsReceivedBefore(ctx, now.Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+
+	flows, err = store.GetAccountFlows(ctx, LockingStrengthNone, accountID, flowTypes.Filter{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"flow4", "flow3", "flow2"}, ids(flows))
+}
@@ -28,12 +28,18 @@ import (
 	"github.com/netbirdio/netbird/management/server/types"
 	"github.com/netbirdio/netbird/util"
 
+	blocklistTypes "github.com/netbirdio/netbird/management/server/blocklists/types"
+	eventSinkTypes "github.com/netbirdio/netbird/management/server/eventsinks/types"
+	flowTypes "github.com/netbirdio/netbird/management/server/flows/types"
+	ingressTypes "github.com/netbirdio/netbird/management/server/ingress/types"
 	"github.com/netbirdio/netbird/management/server/migration"
 	resourceTypes "github.com/netbirdio/netbird/management/server/networks/resources/types"
 	routerTypes "github.com/netbirdio/netbird/management/server/networks/routers/types"
 	networkTypes "github.com/netbirdio/netbird/management/server/networks/types"
 	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/management/server/permissions/roles"
 	"github.com/netbirdio/netbird/management/server/posture"
+	zoneTypes "github.com/netbirdio/netbird/management/server/zones/types"
 	"github.com/netbirdio/netbird/route"
 )
 
@@ -67,6 +73,8 @@ type Store interface {
 	GetAccountByPrivateDomain(ctx context.Context, domain string) (*types.Account, error)
 	GetAccountIDByPrivateDomain(ctx context.Context, lockStrength LockingStrength, domain string) (string, error)
 	GetAccountSettings(ctx context.Context, lockStrength LockingStrength, accountID string) (*types.Settings, error)
+	GetAccountIDsWithEventRetention(ctx context.Context, lockStrength LockingStrength) ([]string, error)
+	GetAccountIDsWithPolicyRuleSchedules(ctx context.Context, lockStrength LockingStrength) ([]string, error)
 	GetAccountDNSSettings(ctx context.Context, lockStrength LockingStrength, accountID string) (*types.DNSSettings, error)
 	GetAccountCreatedBy(ctx context.Context, lockStrength LockingStrength, accountID string) (string, error)
 	SaveAccount(ctx context.Context, account *types.Account) error
@@ -196,6 +204,50 @@ type Store interface {
 	GetNetworkResourceByName(ctx context.Context, lockStrength LockingStrength, accountID, resourceName string) (*resourceTypes.NetworkResource, error)
 	SaveNetworkResource(ctx context.Context, resource *resourceTypes.NetworkResource) error
 	DeleteNetworkResource(ctx context.Context, accountID, resourceID string) error
+
+	GetAccountCustomRoles(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*roles.CustomRole, error)
+	GetCustomRoleByID(ctx context.Context, lockStrength LockingStrength, accountID, roleID string) (*roles.CustomRole, error)
+	SaveCustomRole(ctx context.Context, role *roles.CustomRole) error
+	DeleteCustomRole(ctx context.Context, accountID, roleID string) error
+
+	GetAccountSCIMTokens(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*types.SCIMToken, error)
+	GetSCIMTokenByID(ctx context.Context, lockStrength LockingStrength, accountID, tokenID string) (*types.SCIMToken, error)
+	GetSCIMTokenByHashedToken(ctx context.Context, lockStrength LockingStrength, hashedToken string) (*types.SCIMToken, error)
+	MarkSCIMTokenUsed(ctx context.Context, tokenID string) error
+	SaveSCIMToken(ctx context.Context, token *types.SCIMToken) error
+	DeleteSCIMToken(ctx context.Context, accountID, tokenID string) error
+
+	GetAccountDNSZones(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*zoneTypes.Zone, error)
+	GetDNSZoneByID(ctx context.Context, lockStrength LockingStrength, accountID, zoneID string) (*zoneTypes.Zone, error)
+	SaveDNSZone(ctx context.Context, zone *zoneTypes.Zone) error
+	DeleteDNSZone(ctx context.Context, accountID, zoneID string) error
+
+	GetAccountDNSBlocklists(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*blocklistTypes.Blocklist, error)
+	GetDNSBlocklistByID(ctx context.Context, lockStrength LockingStrength, accountID, blocklistID string) (*blocklistTypes.Blocklist, error)
+	GetDNSBlocklistsWithSource(ctx context.Context, lockStrength LockingStrength) ([]*blocklistTypes.Blocklist, error)
+	SaveDNSBlocklist(ctx context.Context, blocklist *blocklistTypes.Blocklist) error
+	DeleteDNSBlocklist(ctx context.Context, accountID, blocklistID string) error
+
+	GetAccountIngressPeers(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*ingressTypes.IngressPeer, error)
+	GetIngressPeerByID(ctx context.Context, lockStrength LockingStrength, accountID, ingressPeerID string) (*ingressTypes.IngressPeer, error)
+	SaveIngressPeer(ctx context.Context, ingressPeer *ingressTypes.IngressPeer) error
+	DeleteIngressPeer(ctx context.Context, accountID, ingressPeerID string) error
+	GetAccountIngressPortAllocations(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*ingressTypes.PortAllocation, error)
+	GetIngressPortAllocationByID(ctx context.Context, lockStrength LockingStrength, accountID, allocationID string) (*ingressTypes.PortAllocation, error)
+	SaveIngressPortAllocation(ctx context.Context, allocation *ingressTypes.PortAllocation) error
+	DeleteIngressPortAllocation(ctx context.Context, accountID, allocationID string) error
+
+	GetAccountEventSinks(ctx context.Context, lockStrength LockingStrength, accountID string) ([]*eventSinkTypes.Sink, error)
+	GetEventSinkByID(ctx context.Context, lockStrength LockingStrength, accountID, sinkID string) (*eventSinkTypes.Sink, error)
+	SaveEventSink(ctx context.Context, sink *eventSinkTypes.Sink) error
+	DeleteEventSink(ctx context.Context, accountID, sinkID string) error
+
+	// SaveFlows stores the flows, the flows that are already stored are skipped
+	SaveFlows(ctx context.Context, flows []*flowTypes.Flow) error
+	GetAccountFlows(ctx context.Context, lockStrength LockingStrength, accountID string, filter flowTypes.Filter) ([]*flowTypes.Flow, error)
+	// DeleteFlowsReceivedBefore deletes the flows of all accounts received before the time and returns their count
+	DeleteFlowsReceivedBefore(ctx context.Context, before time.Time) (int64, error)
+
 	GetPeerByIP(ctx context.Context, lockStrength LockingStrength, accountID string, ip net.IP) (*nbpeer.Peer, error)
 	GetPeerIdByLabel(ctx context.Context, lockStrength LockingStrength, accountID string, hostname string) (string, error)
 	GetAccountGroupPeers(ctx context.Context, lockStrength LockingStrength, accountID string) (map[string]map[string]struct{}, error)
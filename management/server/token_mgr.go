@@ -13,6 +13,7 @@ import (
 
 	integrationsConfig "github.com/netbirdio/management-integrations/integrations/config"
 	nbconfig "github.com/netbirdio/netbird/management/internals/server/config"
+	"github.com/netbirdio/netbird/management/server/activity"
 	"github.com/netbirdio/netbird/management/server/groups"
 	"github.com/netbirdio/netbird/management/server/settings"
 	"github.com/netbirdio/netbird/shared/management/proto"
@@ -25,8 +26,9 @@ const defaultDuration = 12 * time.Hour
 // SecretsManager used to manage TURN and relay secrets
 type SecretsManager interface {
 	GenerateTurnToken() (*Token, error)
-	GenerateRelayToken() (*Token, error)
-	SetupRefresh(ctx context.Context, accountID, peerKey string)
+	GenerateRelayToken(accountID string) (*Token, error)
+	GenerateFlowToken(accountID, peerKey string) (*Token, error)
+	SetupRefresh(ctx context.Context, accountID, peerID, peerKey string)
 	CancelRefresh(peerKey string)
 }
 
@@ -35,24 +37,29 @@ type TimeBasedAuthSecretsManager struct {
 	mux             sync.Mutex
 	turnCfg         *nbconfig.TURNConfig
 	relayCfg        *nbconfig.Relay
+	flowCfg         *nbconfig.Flow
 	turnHmacToken   *auth.TimedHMAC
 	relayHmacToken  *authv2.Generator
+	flowHmacToken   *authv2.Generator
 	updateManager   *PeersUpdateManager
 	settingsManager settings.Manager
 	groupsManager   groups.Manager
 	turnCancelMap   map[string]chan struct{}
 	relayCancelMap  map[string]chan struct{}
+	flowCancelMap   map[string]chan struct{}
 }
 
 type Token auth.Token
 
-func NewTimeBasedAuthSecretsManager(updateManager *PeersUpdateManager, turnCfg *nbconfig.TURNConfig, relayCfg *nbconfig.Relay, settingsManager settings.Manager, groupsManager groups.Manager) *TimeBasedAuthSecretsManager {
+func NewTimeBasedAuthSecretsManager(updateManager *PeersUpdateManager, turnCfg *nbconfig.TURNConfig, relayCfg *nbconfig.Relay, flowCfg *nbconfig.Flow, settingsManager settings.Manager, groupsManager groups.Manager) *TimeBasedAuthSecretsManager {
 	mgr := &TimeBasedAuthSecretsManager{
 		updateManager:   updateManager,
 		turnCfg:         turnCfg,
 		relayCfg:        relayCfg,
+		flowCfg:         flowCfg,
 		turnCancelMap:   make(map[string]chan struct{}),
 		relayCancelMap:  make(map[string]chan struct{}),
+		flowCancelMap:   make(map[string]chan struct{}),
 		settingsManager: settingsManager,
 		groupsManager:   groupsManager,
 	}
@@ -80,6 +87,18 @@ func NewTimeBasedAuthSecretsManager(updateManager *PeersUpdateManager, turnCfg *
 		}
 	}
 
+	if flowCfg != nil {
+		if flowCfg.CredentialsTTL.Duration <= 0 {
+			log.Warnf("Flow credentials TTL is not set or invalid, using default value %s", defaultDuration)
+		}
+
+		hashedSecret := sha256.Sum256([]byte(flowCfg.Secret))
+		var err error
+		if mgr.flowHmacToken, err = authv2.NewGenerator(authv2.AuthAlgoHMACSHA256, hashedSecret[:], mgr.flowCredentialsTTL()); err != nil {
+			log.Errorf("failed to create flow token generator: %s", err)
+		}
+	}
+
 	return mgr
 }
 
@@ -95,12 +114,13 @@ func (m *TimeBasedAuthSecretsManager) GenerateTurnToken() (*Token, error) {
 	return (*Token)(turnToken), nil
 }
 
-// GenerateRelayToken generates new time-based secret credentials for relay
-func (m *TimeBasedAuthSecretsManager) GenerateRelayToken() (*Token, error) {
+// GenerateRelayToken generates new time-based secret credentials for relay. The account is added to the credentials
+// if the account scoped tokens are enabled.
+func (m *TimeBasedAuthSecretsManager) GenerateRelayToken(accountID string) (*Token, error) {
 	if m.relayHmacToken == nil {
 		return nil, fmt.Errorf("relay configuration is not set")
 	}
-	relayToken, err := m.relayHmacToken.GenerateToken()
+	relayToken, err := m.generateRelayToken(accountID)
 	if err != nil {
 		return nil, fmt.Errorf("generate relay token: %s", err)
 	}
@@ -111,6 +131,37 @@ func (m *TimeBasedAuthSecretsManager) GenerateRelayToken() (*Token, error) {
 	}, nil
 }
 
+func (m *TimeBasedAuthSecretsManager) generateRelayToken(accountID string) (*authv2.Token, error) {
+	if m.relayCfg.AccountScopedTokens {
+		return m.relayHmacToken.GenerateAccountToken(accountID)
+	}
+	return m.relayHmacToken.GenerateToken()
+}
+
+// GenerateFlowToken generates new time-based secret credentials for the flow receiver. The credentials carry the
+// account and the key of the peer, so the receiver only accepts the events the peer sends about itself.
+func (m *TimeBasedAuthSecretsManager) GenerateFlowToken(accountID, peerKey string) (*Token, error) {
+	if m.flowHmacToken == nil {
+		return nil, fmt.Errorf("flow configuration is not set")
+	}
+	flowToken, err := m.flowHmacToken.GeneratePeerToken(accountID, peerKey)
+	if err != nil {
+		return nil, fmt.Errorf("generate flow token: %s", err)
+	}
+
+	return &Token{
+		Payload:   string(flowToken.Payload),
+		Signature: base64.StdEncoding.EncodeToString(flowToken.Signature),
+	}, nil
+}
+
+func (m *TimeBasedAuthSecretsManager) flowCredentialsTTL() time.Duration {
+	if m.flowCfg.CredentialsTTL.Duration <= 0 {
+		return defaultDuration
+	}
+	return m.flowCfg.CredentialsTTL.Duration
+}
+
 func (m *TimeBasedAuthSecretsManager) cancelTURN(peerID string) {
 	if channel, ok := m.turnCancelMap[peerID]; ok {
 		close(channel)
@@ -125,21 +176,30 @@ func (m *TimeBasedAuthSecretsManager) cancelRelay(peerID string) {
 	}
 }
 
+func (m *TimeBasedAuthSecretsManager) cancelFlow(peerID string) {
+	if channel, ok := m.flowCancelMap[peerID]; ok {
+		close(channel)
+		delete(m.flowCancelMap, peerID)
+	}
+}
+
 // CancelRefresh cancels scheduled peer credentials refresh
 func (m *TimeBasedAuthSecretsManager) CancelRefresh(peerID string) {
 	m.mux.Lock()
 	defer m.mux.Unlock()
 	m.cancelTURN(peerID)
 	m.cancelRelay(peerID)
+	m.cancelFlow(peerID)
 }
 
 // SetupRefresh starts peer credentials refresh
-func (m *TimeBasedAuthSecretsManager) SetupRefresh(ctx context.Context, accountID, peerID string) {
+func (m *TimeBasedAuthSecretsManager) SetupRefresh(ctx context.Context, accountID, peerID, peerKey string) {
 	m.mux.Lock()
 	defer m.mux.Unlock()
 
 	m.cancelTURN(peerID)
 	m.cancelRelay(peerID)
+	m.cancelFlow(peerID)
 
 	if m.turnCfg != nil && m.turnCfg.TimeBasedCredentials {
 		turnCancel := make(chan struct{}, 1)
@@ -154,6 +214,13 @@ func (m *TimeBasedAuthSecretsManager) SetupRefresh(ctx context.Context, accountI
 		go m.refreshRelayTokens(ctx, accountID, peerID, relayCancel)
 		log.WithContext(ctx).Debugf("starting relay refresh for %s", peerID)
 	}
+
+	if m.flowHmacToken != nil {
+		flowCancel := make(chan struct{}, 1)
+		m.flowCancelMap[peerID] = flowCancel
+		go m.refreshFlowTokens(ctx, accountID, peerID, peerKey, flowCancel)
+		log.WithContext(ctx).Debugf("starting flow refresh for %s", peerID)
+	}
 }
 
 func (m *TimeBasedAuthSecretsManager) refreshTURNTokens(ctx context.Context, accountID, peerID string, cancel chan struct{}) {
@@ -186,6 +253,21 @@ func (m *TimeBasedAuthSecretsManager) refreshRelayTokens(ctx context.Context, ac
 	}
 }
 
+func (m *TimeBasedAuthSecretsManager) refreshFlowTokens(ctx context.Context, accountID, peerID, peerKey string, cancel chan struct{}) {
+	ticker := time.NewTicker(m.flowCredentialsTTL() / 4 * 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-cancel:
+			log.WithContext(ctx).Debugf("stopping flow refresh for %s", peerID)
+			return
+		case <-ticker.C:
+			m.pushNewFlowTokens(ctx, accountID, peerID, peerKey)
+		}
+	}
+}
+
 func (m *TimeBasedAuthSecretsManager) pushNewTURNAndRelayTokens(ctx context.Context, accountID, peerID string) {
 	turnToken, err := m.turnHmacToken.GenerateToken(sha1.New)
 	if err != nil {
@@ -214,13 +296,11 @@ func (m *TimeBasedAuthSecretsManager) pushNewTURNAndRelayTokens(ctx context.Cont
 
 	// workaround for the case when client is unable to handle turn and relay updates at different time
 	if m.relayCfg != nil {
-		token, err := m.GenerateRelayToken()
+		token, err := m.GenerateRelayToken(accountID)
 		if err == nil {
-			update.NetbirdConfig.Relay = &proto.RelayConfig{
-				Urls:           m.relayCfg.Addresses,
-				TokenPayload:   token.Payload,
-				TokenSignature: token.Signature,
-			}
+			update.NetbirdConfig.Relay = toRelayConfig(m.relayCfg, m.relayRegions(ctx, accountID, peerID))
+			update.NetbirdConfig.Relay.TokenPayload = token.Payload
+			update.NetbirdConfig.Relay.TokenSignature = token.Signature
 		}
 	}
 
@@ -231,19 +311,19 @@ func (m *TimeBasedAuthSecretsManager) pushNewTURNAndRelayTokens(ctx context.Cont
 }
 
 func (m *TimeBasedAuthSecretsManager) pushNewRelayTokens(ctx context.Context, accountID, peerID string) {
-	relayToken, err := m.relayHmacToken.GenerateToken()
+	relayToken, err := m.generateRelayToken(accountID)
 	if err != nil {
 		log.Errorf("failed to generate relay token for peer '%s': %s", peerID, err)
 		return
 	}
 
+	relayCfg := toRelayConfig(m.relayCfg, m.relayRegions(ctx, accountID, peerID))
+	relayCfg.TokenPayload = string(relayToken.Payload)
+	relayCfg.TokenSignature = base64.StdEncoding.EncodeToString(relayToken.Signature)
+
 	update := &proto.SyncResponse{
 		NetbirdConfig: &proto.NetbirdConfig{
-			Relay: &proto.RelayConfig{
-				Urls:           m.relayCfg.Addresses,
-				TokenPayload:   string(relayToken.Payload),
-				TokenSignature: base64.StdEncoding.EncodeToString(relayToken.Signature),
-			},
+			Relay: relayCfg,
 			// omit Turns to avoid updates there
 		},
 	}
@@ -254,6 +334,41 @@ func (m *TimeBasedAuthSecretsManager) pushNewRelayTokens(ctx context.Context, ac
 	m.updateManager.SendUpdate(ctx, peerID, &UpdateMessage{Update: update})
 }
 
+func (m *TimeBasedAuthSecretsManager) pushNewFlowTokens(ctx context.Context, accountID, peerID, peerKey string) {
+	flowToken, err := m.GenerateFlowToken(accountID, peerKey)
+	if err != nil {
+		log.WithContext(ctx).Errorf("failed to generate flow token for peer '%s': %s", peerID, err)
+		return
+	}
+
+	update := &proto.SyncResponse{
+		NetbirdConfig: &proto.NetbirdConfig{
+			Flow: toFlowConfig(m.flowCfg, flowToken),
+		},
+	}
+
+	m.extendNetbirdConfig(ctx, peerID, accountID, update)
+
+	log.WithContext(ctx).Debugf("sending new flow credentials to peer %s", peerID)
+	m.updateManager.SendUpdate(ctx, peerID, &UpdateMessage{Update: update})
+}
+
+// relayRegions returns the relay regions the peer is pinned to by the account settings
+func (m *TimeBasedAuthSecretsManager) relayRegions(ctx context.Context, accountID, peerID string) []string {
+	settings, err := m.settingsManager.GetSettings(ctx, accountID, activity.SystemInitiator)
+	if err != nil {
+		log.WithContext(ctx).Errorf("failed to get settings: %v", err)
+		return nil
+	}
+
+	peerGroups, err := m.groupsManager.GetPeerGroupIDs(ctx, accountID, peerID)
+	if err != nil {
+		log.WithContext(ctx).Errorf("failed to get peer groups: %v", err)
+		return nil
+	}
+	return settings.RelayRegions(peerGroups)
+}
+
 func (m *TimeBasedAuthSecretsManager) extendNetbirdConfig(ctx context.Context, peerID, accountID string, update *proto.SyncResponse) {
 	extraSettings, err := m.settingsManager.GetExtraSettings(ctx, accountID)
 	if err != nil {
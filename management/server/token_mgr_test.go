@@ -7,6 +7,7 @@ import (
 	"crypto/sha256"
 	"encoding/base64"
 	"hash"
+	"strings"
 	"testing"
 	"time"
 
@@ -49,7 +50,7 @@ func TestTimeBasedAuthSecretsManager_GenerateCredentials(t *testing.T) {
 		Secret:               secret,
 		Turns:                []*config.Host{TurnTestHost},
 		TimeBasedCredentials: true,
-	}, rc, settingsMockManager, groupsManager)
+	}, rc, nil, settingsMockManager, groupsManager)
 
 	turnCredentials, err := tested.GenerateTurnToken()
 	require.NoError(t, err)
@@ -63,7 +64,7 @@ func TestTimeBasedAuthSecretsManager_GenerateCredentials(t *testing.T) {
 
 	validateMAC(t, sha1.New, turnCredentials.Payload, turnCredentials.Signature, []byte(secret))
 
-	relayCredentials, err := tested.GenerateRelayToken()
+	relayCredentials, err := tested.GenerateRelayToken("")
 	require.NoError(t, err)
 
 	if relayCredentials.Payload == "" {
@@ -77,6 +78,58 @@ func TestTimeBasedAuthSecretsManager_GenerateCredentials(t *testing.T) {
 	validateMAC(t, sha256.New, relayCredentials.Payload, relayCredentials.Signature, hashedSecret[:])
 }
 
+func TestTimeBasedAuthSecretsManager_GenerateAccountScopedRelayToken(t *testing.T) {
+	secret := "some_secret"
+	rc := &config.Relay{
+		Addresses:           []string{"localhost:0"},
+		CredentialsTTL:      util.Duration{Duration: time.Hour},
+		Secret:              secret,
+		AccountScopedTokens: true,
+	}
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+	tested := NewTimeBasedAuthSecretsManager(NewPeersUpdateManager(nil), nil, rc, nil, settings.NewMockManager(ctrl), groups.NewManagerMock())
+
+	relayCredentials, err := tested.GenerateRelayToken("account1")
+	require.NoError(t, err)
+	require.True(t, strings.HasSuffix(relayCredentials.Payload, ":account1"), "payload %q doesn't carry the account", relayCredentials.Payload)
+
+	hashedSecret := sha256.Sum256([]byte(secret))
+	validateMAC(t, sha256.New, relayCredentials.Payload, relayCredentials.Signature, hashedSecret[:])
+}
+
+func TestTimeBasedAuthSecretsManager_GenerateFlowToken(t *testing.T) {
+	secret := "some_secret"
+	fc := &config.Flow{
+		URL:            "https://flow.netbird.io:443",
+		CredentialsTTL: util.Duration{Duration: time.Hour},
+		Secret:         secret,
+	}
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+	tested := NewTimeBasedAuthSecretsManager(NewPeersUpdateManager(nil), nil, nil, fc, settings.NewMockManager(ctrl), groups.NewManagerMock())
+
+	flowCredentials, err := tested.GenerateFlowToken("account1", "peerKey")
+	require.NoError(t, err)
+	require.True(t, strings.HasSuffix(flowCredentials.Payload, ":account1:peerKey"), "payload %q doesn't carry the account and peer", flowCredentials.Payload)
+
+	hashedSecret := sha256.Sum256([]byte(secret))
+	validateMAC(t, sha256.New, flowCredentials.Payload, flowCredentials.Signature, hashedSecret[:])
+
+	flowCfg := toFlowConfig(fc, flowCredentials)
+	require.True(t, flowCfg.Enabled)
+	require.Equal(t, fc.URL, flowCfg.Url)
+	require.Equal(t, flowCredentials.Payload, flowCfg.TokenPayload)
+	require.Equal(t, flowCredentials.Signature, flowCfg.TokenSignature)
+	require.Equal(t, defaultFlowInterval, flowCfg.Interval.AsDuration())
+
+	withoutFlow := NewTimeBasedAuthSecretsManager(NewPeersUpdateManager(nil), nil, nil, nil, settings.NewMockManager(ctrl), groups.NewManagerMock())
+	_, err = withoutFlow.GenerateFlowToken("account1", "peerKey")
+	require.Error(t, err)
+}
+
 func TestTimeBasedAuthSecretsManager_SetupRefresh(t *testing.T) {
 	ttl := util.Duration{Duration: 2 * time.Second}
 	secret := "some_secret"
@@ -94,6 +147,7 @@ func TestTimeBasedAuthSecretsManager_SetupRefresh(t *testing.T) {
 	t.Cleanup(ctrl.Finish)
 	settingsMockManager := settings.NewMockManager(ctrl)
 	settingsMockManager.EXPECT().GetExtraSettings(gomock.Any(), "someAccountID").Return(&types.ExtraSettings{}, nil).AnyTimes()
+	settingsMockManager.EXPECT().GetSettings(gomock.Any(), "someAccountID", gomock.Any()).Return(&types.Settings{}, nil).AnyTimes()
 	groupsManager := groups.NewManagerMock()
 
 	tested := NewTimeBasedAuthSecretsManager(peersManager, &config.TURNConfig{
@@ -101,12 +155,12 @@ func TestTimeBasedAuthSecretsManager_SetupRefresh(t *testing.T) {
 		Secret:               secret,
 		Turns:                []*config.Host{TurnTestHost},
 		TimeBasedCredentials: true,
-	}, rc, settingsMockManager, groupsManager)
+	}, rc, nil, settingsMockManager, groupsManager)
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	tested.SetupRefresh(ctx, "someAccountID", peer)
+	tested.SetupRefresh(ctx, "someAccountID", peer, "somePeerKey")
 
 	if _, ok := tested.turnCancelMap[peer]; !ok {
 		t.Errorf("expecting peer to be present in the turn cancel map, got not present")
@@ -204,9 +258,9 @@ func TestTimeBasedAuthSecretsManager_CancelRefresh(t *testing.T) {
 		Secret:               secret,
 		Turns:                []*config.Host{TurnTestHost},
 		TimeBasedCredentials: true,
-	}, rc, settingsMockManager, groupsManager)
+	}, rc, nil, settingsMockManager, groupsManager)
 
-	tested.SetupRefresh(context.Background(), "someAccountID", peer)
+	tested.SetupRefresh(context.Background(), "someAccountID", peer, "somePeerKey")
 	if _, ok := tested.turnCancelMap[peer]; !ok {
 		t.Errorf("expecting peer to be present in turn cancel map, got not present")
 	}
@@ -223,6 +277,31 @@ func TestTimeBasedAuthSecretsManager_CancelRefresh(t *testing.T) {
 	}
 }
 
+func TestToRelayConfig(t *testing.T) {
+	relay := &config.Relay{
+		Addresses: []string{"rels://fra.relay.io:443", "rels://sgp.relay.io:443", "rels://default.relay.io:443"},
+		Servers: []config.RelayServer{
+			{Address: "rels://fra.relay.io:443", Region: "eu", Weight: 200},
+			{Address: "rels://sgp.relay.io:443", Region: "ap"},
+		},
+	}
+
+	relayCfg := toRelayConfig(relay, nil)
+	require.Equal(t, relay.Addresses, relayCfg.Urls)
+	require.Len(t, relayCfg.Servers, 3)
+	require.Equal(t, "eu", relayCfg.Servers[0].Region)
+	require.Equal(t, uint32(200), relayCfg.Servers[0].Weight)
+	require.Equal(t, "", relayCfg.Servers[2].Region)
+
+	relayCfg = toRelayConfig(relay, []string{"ap"})
+	require.Equal(t, []string{"rels://sgp.relay.io:443"}, relayCfg.Urls)
+	require.Len(t, relayCfg.Servers, 1)
+
+	// a peer pinned to a region without relays is offered all of them
+	relayCfg = toRelayConfig(relay, []string{"us"})
+	require.Equal(t, relay.Addresses, relayCfg.Urls)
+}
+
 func validateMAC(t *testing.T, algo func() hash.Hash, username string, actualMAC string, key []byte) {
 	t.Helper()
 	mac := hmac.New(algo, key)
@@ -0,0 +1,482 @@
+package types
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	resourceTypes "github.com/netbirdio/netbird/management/server/networks/resources/types"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/route"
+	"github.com/netbirdio/netbird/shared/management/status"
+)
+
+// AccessQuery describes a connection to check against the policies of an account.
+// Exactly one of the source fields and one of the destination fields is expected to be set.
+type AccessQuery struct {
+	SourcePeerID string
+	// SourceUserID checks the connection for all peers of the user
+	SourceUserID string
+	// SourceIP is the overlay IP of the source peer
+	SourceIP netip.Addr
+
+	DestinationPeerID     string
+	DestinationResourceID string
+	// DestinationIP is resolved to a peer by its overlay IP, to a network resource or to a network route
+	DestinationIP netip.Addr
+
+	Protocol PolicyRuleProtocolType
+	// Port is the destination port, ignored for ICMP
+	Port uint16
+}
+
+// AccessCheckRule is a policy rule matching the checked connection
+type AccessCheckRule struct {
+	PolicyID   string
+	PolicyName string
+	RuleID     string
+	RuleName   string
+	Action     PolicyTrafficActionType
+}
+
+// AccessCheck is the verdict for a connection from a source peer to the destination of an AccessQuery
+type AccessCheck struct {
+	SourcePeerID   string
+	SourcePeerName string
+
+	DestinationType PolicyAccessDestinationType
+	DestinationID   string
+	DestinationName string
+
+	Allowed bool
+	// Reason explains the verdict when no rule decides it
+	Reason string
+	// Rules are the rules deciding the verdict, drop rules take precedence over accept rules
+	Rules []AccessCheckRule
+}
+
+// accessDestination is the resolved destination of an AccessQuery
+type accessDestination struct {
+	peer     *nbpeer.Peer
+	resource *resourceTypes.NetworkResource
+	route    *route.Route
+}
+
+// CheckAccess evaluates whether the connection described by the query is allowed by the policies of the account at the
+// current time. The verdict is derived from the network maps of the destination peers and routing peers, like GetPolicyAccess.
+func (a *Account) CheckAccess(ctx context.Context, query *AccessQuery, validatedPeersMap map[string]struct{}) ([]*AccessCheck, error) {
+	switch query.Protocol {
+	case PolicyRuleProtocolTCP, PolicyRuleProtocolUDP:
+		if query.Port == 0 {
+			return nil, status.Errorf(status.InvalidArgument, "destination port is required for protocol %s", query.Protocol)
+		}
+	case PolicyRuleProtocolICMP:
+		query.Port = 0
+	default:
+		return nil, status.Errorf(status.InvalidArgument, "protocol should be one of tcp, udp or icmp")
+	}
+
+	sources, err := a.accessSources(query)
+	if err != nil {
+		return nil, err
+	}
+
+	destination, err := a.accessDestination(query)
+	if err != nil {
+		return nil, err
+	}
+
+	evaluator := a.newAccessEvaluator(validatedPeersMap)
+
+	checks := make([]*AccessCheck, 0, len(sources))
+	for _, source := range sources {
+		check := &AccessCheck{SourcePeerID: source.ID, SourcePeerName: source.Name}
+
+		switch {
+		case destination.peer != nil:
+			check.DestinationType, check.DestinationID, check.DestinationName = PolicyAccessDestinationPeer, destination.peer.ID, destination.peer.Name
+			a.checkPeerAccess(ctx, evaluator, check, query, source, destination.peer)
+		case destination.resource != nil:
+			check.DestinationType, check.DestinationID, check.DestinationName = PolicyAccessDestinationResource, destination.resource.ID, destination.resource.Name
+			a.checkResourceAccess(ctx, evaluator, check, query, source, destination.resource)
+		default:
+			check.DestinationType, check.DestinationID, check.DestinationName = PolicyAccessDestinationRoute, string(destination.route.ID), string(destination.route.NetID)
+			a.checkRouteAccess(ctx, evaluator, check, query, source, destination.route)
+		}
+
+		checks = append(checks, check)
+	}
+
+	slices.SortFunc(checks, func(a, b *AccessCheck) int {
+		return strings.Compare(a.SourcePeerName+a.SourcePeerID, b.SourcePeerName+b.SourcePeerID)
+	})
+
+	return checks, nil
+}
+
+func (a *Account) accessSources(query *AccessQuery) ([]*nbpeer.Peer, error) {
+	switch {
+	case query.SourcePeerID != "" && query.SourceUserID == "" && !query.SourceIP.IsValid():
+		peer := a.GetPeer(query.SourcePeerID)
+		if peer == nil {
+			return nil, status.NewPeerNotFoundError(query.SourcePeerID)
+		}
+		return []*nbpeer.Peer{peer}, nil
+	case query.SourceUserID != "" && query.SourcePeerID == "" && !query.SourceIP.IsValid():
+		if _, ok := a.Users[query.SourceUserID]; !ok {
+			return nil, status.NewUserNotFoundError(query.SourceUserID)
+		}
+		var peers []*nbpeer.Peer
+		for _, peer := range a.Peers {
+			if peer.UserID == query.SourceUserID {
+				peers = append(peers, peer)
+			}
+		}
+		if len(peers) == 0 {
+			return nil, status.Errorf(status.NotFound, "user %s has no peers", query.SourceUserID)
+		}
+		return peers, nil
+	case query.SourceIP.IsValid() && query.SourcePeerID == "" && query.SourceUserID == "":
+		peer := a.peerByIP(query.SourceIP)
+		if peer == nil {
+			return nil, status.Errorf(status.NotFound, "no peer with IP %s found", query.SourceIP)
+		}
+		return []*nbpeer.Peer{peer}, nil
+	default:
+		return nil, status.Errorf(status.InvalidArgument, "specify exactly one of source peer, source user or source IP")
+	}
+}
+
+func (a *Account) accessDestination(query *AccessQuery) (*accessDestination, error) {
+	switch {
+	case query.DestinationPeerID != "" && query.DestinationResourceID == "" && !query.DestinationIP.IsValid():
+		peer := a.GetPeer(query.DestinationPeerID)
+		if peer == nil {
+			return nil, status.NewPeerNotFoundError(query.DestinationPeerID)
+		}
+		return &accessDestination{peer: peer}, nil
+	case query.DestinationResourceID != "" && query.DestinationPeerID == "" && !query.DestinationIP.IsValid():
+		for _, resource := range a.NetworkResources {
+			if resource.ID == query.DestinationResourceID {
+				return &accessDestination{resource: resource}, nil
+			}
+		}
+		return nil, status.NewNetworkResourceNotFoundError(query.DestinationResourceID)
+	case query.DestinationIP.IsValid() && query.DestinationPeerID == "" && query.DestinationResourceID == "":
+		return a.accessDestinationByIP(query.DestinationIP)
+	default:
+		return nil, status.Errorf(status.InvalidArgument, "specify exactly one of destination peer, destination resource or destination IP")
+	}
+}
+
+// accessDestinationByIP resolves the IP to a peer, the most specific enabled network resource or the most specific enabled route
+func (a *Account) accessDestinationByIP(ip netip.Addr) (*accessDestination, error) {
+	ip = ip.Unmap()
+	if peer := a.peerByIP(ip); peer != nil {
+		return &accessDestination{peer: peer}, nil
+	}
+
+	destination := &accessDestination{}
+	bits := -1
+	for _, resource := range a.NetworkResources {
+		if !resource.Enabled || resource.Type == resourceTypes.Domain || !resource.Prefix.Contains(ip) {
+			continue
+		}
+		if resource.Prefix.Bits() > bits {
+			destination.resource, bits = resource, resource.Prefix.Bits()
+		}
+	}
+	if destination.resource != nil {
+		return destination, nil
+	}
+
+	for _, r := range a.Routes {
+		if !r.Enabled || r.IsDynamic() || !r.Network.Contains(ip) {
+			continue
+		}
+		if r.Network.Bits() > bits {
+			destination.route, bits = r, r.Network.Bits()
+		}
+	}
+	if destination.route != nil {
+		return destination, nil
+	}
+
+	return nil, status.Errorf(status.NotFound, "no peer, network resource or network route matches IP %s", ip)
+}
+
+func (a *Account) peerByIP(ip netip.Addr) *nbpeer.Peer {
+	for _, peer := range a.Peers {
+		if peerIP, ok := netip.AddrFromSlice(peer.IP); ok && peerIP.Unmap() == ip.Unmap() {
+			return peer
+		}
+	}
+	return nil
+}
+
+func (a *Account) checkPeerAccess(ctx context.Context, evaluator *accessEvaluator, check *AccessCheck, query *AccessQuery, source, destination *nbpeer.Peer) {
+	if source.ID == destination.ID {
+		check.Allowed, check.Reason = true, "source and destination are the same peer"
+		return
+	}
+	if reason, ok := a.checkPeersUsable(evaluator.validatedPeersMap, source, destination); !ok {
+		check.Reason = reason
+		return
+	}
+
+	a.checkRules(check, query, source, evaluator.peerAccess(ctx, destination))
+	a.setDenyReason(ctx, check, query, source)
+}
+
+func (a *Account) checkResourceAccess(ctx context.Context, evaluator *accessEvaluator, check *AccessCheck, query *AccessQuery, source *nbpeer.Peer, resource *resourceTypes.NetworkResource) {
+	if !resource.Enabled {
+		check.Reason = fmt.Sprintf("network resource %s is disabled", resource.Name)
+		return
+	}
+	if reason, ok := a.checkPeersUsable(evaluator.validatedPeersMap, source); !ok {
+		check.Reason = reason
+		return
+	}
+
+	var rules []accessRule
+	for peerID := range evaluator.routers[resource.NetworkID] {
+		if _, ok := evaluator.validatedPeersMap[peerID]; !ok {
+			continue
+		}
+		if routingPeer := a.GetPeer(peerID); routingPeer != nil {
+			rules = append(rules, evaluator.peerAccess(ctx, routingPeer)...)
+		}
+	}
+	if rules == nil {
+		check.Reason = fmt.Sprintf("network of resource %s has no enabled and approved routing peer", resource.Name)
+		return
+	}
+
+	a.checkRules(check, query, source, rules)
+	a.setDenyReason(ctx, check, query, source)
+}
+
+func (a *Account) checkRouteAccess(ctx context.Context, evaluator *accessEvaluator, check *AccessCheck, query *AccessQuery, source *nbpeer.Peer, r *route.Route) {
+	if !r.Enabled {
+		check.Reason = fmt.Sprintf("network route %s is disabled", r.NetID)
+		return
+	}
+	if reason, ok := a.checkPeersUsable(evaluator.validatedPeersMap, source); !ok {
+		check.Reason = reason
+		return
+	}
+	if !a.peerInGroups(source.ID, r.Groups) {
+		check.Reason = fmt.Sprintf("peer %s isn't in the distribution groups of network route %s", source.Name, r.NetID)
+		return
+	}
+
+	var rules []accessRule
+	for _, peerID := range a.routingPeerIDs(r) {
+		if _, ok := evaluator.validatedPeersMap[peerID]; !ok {
+			continue
+		}
+		if routingPeer := a.GetPeer(peerID); routingPeer != nil {
+			rules = append(rules, evaluator.peerAccess(ctx, routingPeer)...)
+		}
+	}
+	if rules == nil {
+		check.Reason = fmt.Sprintf("network route %s has no approved routing peer", r.NetID)
+		return
+	}
+
+	a.checkRules(check, query, source, rules)
+	if check.Allowed && len(check.Rules) == 0 {
+		check.Reason = fmt.Sprintf("network route %s has no access control groups and permits all traffic", r.NetID)
+		return
+	}
+	a.setDenyReason(ctx, check, query, source)
+}
+
+// routingPeerIDs returns the IDs of the peers routing the network route
+func (a *Account) routingPeerIDs(r *route.Route) []string {
+	if r.Peer != "" {
+		return []string{r.Peer}
+	}
+
+	var peerIDs []string
+	for _, groupID := range r.PeerGroups {
+		if group := a.GetGroup(groupID); group != nil {
+			peerIDs = append(peerIDs, group.Peers...)
+		}
+	}
+	return peerIDs
+}
+
+// checkRules sets the verdict from the network map rules allowing or dropping the connection of the source to the
+// destination of the check. Drop rules take precedence over accept rules like in the peer firewalls.
+func (a *Account) checkRules(check *AccessCheck, query *AccessQuery, source *nbpeer.Peer, rules []accessRule) {
+	accepted := make(map[accessRuleRef]struct{})
+	dropped := make(map[accessRuleRef]struct{})
+	permitAll := false
+	for _, rule := range rules {
+		if rule.SourcePeerID != source.ID || rule.DestinationType != check.DestinationType || rule.DestinationID != check.DestinationID ||
+			!rule.allows(query.Protocol, query.Port) {
+			continue
+		}
+
+		ref := accessRuleRef{policyID: rule.policyID, ruleID: rule.ruleID}
+		switch {
+		case rule.action == PolicyTrafficActionDrop:
+			dropped[ref] = struct{}{}
+		case rule.permitAll:
+			permitAll = true
+		default:
+			accepted[ref] = struct{}{}
+		}
+	}
+
+	switch {
+	case len(dropped) > 0:
+		check.Rules = a.accessCheckRules(dropped, PolicyTrafficActionDrop, query)
+	case len(accepted) > 0:
+		check.Allowed, check.Rules = true, a.accessCheckRules(accepted, PolicyTrafficActionAccept, query)
+	case permitAll:
+		check.Allowed = true
+	}
+}
+
+// accessRuleRef references the policy rule or the policy a network map rule is derived from
+type accessRuleRef struct {
+	policyID string
+	ruleID   string
+}
+
+// accessCheckRules returns the rules referenced by the network map rules. Route firewall rules only reference their
+// policy, its active rules with the action matching the traffic of the query are returned for them.
+func (a *Account) accessCheckRules(refs map[accessRuleRef]struct{}, action PolicyTrafficActionType, query *AccessQuery) []AccessCheckRule {
+	now := time.Now()
+
+	var rules []AccessCheckRule
+	for _, policy := range a.Policies {
+		_, policyReferenced := refs[accessRuleRef{policyID: policy.ID}]
+		for _, rule := range policy.Rules {
+			_, ruleReferenced := refs[accessRuleRef{ruleID: rule.ID}]
+			if !ruleReferenced && !(policyReferenced && rule.Action == action && rule.IsActive(now) && ruleMatchesTraffic(rule, query.Protocol, query.Port)) {
+				continue
+			}
+			rules = append(rules, AccessCheckRule{
+				PolicyID:   policy.ID,
+				PolicyName: policy.Name,
+				RuleID:     rule.ID,
+				RuleName:   rule.Name,
+				Action:     rule.Action,
+			})
+		}
+	}
+	return rules
+}
+
+// setDenyReason explains why the connection isn't allowed when no rule decided it
+func (a *Account) setDenyReason(ctx context.Context, check *AccessCheck, query *AccessQuery, source *nbpeer.Peer) {
+	switch {
+	case check.Allowed:
+		return
+	case len(check.Rules) > 0:
+		check.Reason = "connection is dropped by a policy rule"
+	default:
+		if postureFailures := a.postureFailures(ctx, source); len(postureFailures) > 0 {
+			check.Reason = strings.Join(postureFailures, "; ")
+			return
+		}
+		traffic := string(query.Protocol)
+		if query.Port != 0 {
+			traffic += "/" + strconv.Itoa(int(query.Port))
+		}
+		check.Reason = fmt.Sprintf("no active policy rule allows %s from %s to %s", traffic, check.SourcePeerName, check.DestinationName)
+	}
+}
+
+// postureFailures lists the enabled policies with rules applying to the peer whose posture checks the peer fails,
+// they are a likely cause of a connection missing from the network maps
+func (a *Account) postureFailures(ctx context.Context, peer *nbpeer.Peer) []string {
+	var failures []string
+	for _, policy := range a.Policies {
+		if !policy.Enabled || len(policy.SourcePostureChecks) == 0 {
+			continue
+		}
+
+		applies := slices.ContainsFunc(policy.Rules, func(rule *PolicyRule) bool {
+			return rule.Enabled && (a.peerInGroups(peer.ID, rule.Sources) || rule.Bidirectional && a.peerInGroups(peer.ID, rule.Destinations))
+		})
+		if !applies {
+			continue
+		}
+
+		if failed := a.failedPostureChecks(ctx, policy.SourcePostureChecks, peer.ID); len(failed) > 0 {
+			failures = append(failures, fmt.Sprintf("peer %s fails posture checks %s of policy %s", peer.Name, strings.Join(failed, ", "), policy.Name))
+		}
+	}
+	return failures
+}
+
+// checkPeersUsable verifies that the peers are approved and their logins didn't expire
+func (a *Account) checkPeersUsable(validatedPeersMap map[string]struct{}, peers ...*nbpeer.Peer) (string, bool) {
+	for _, peer := range peers {
+		if _, ok := validatedPeersMap[peer.ID]; !ok {
+			return fmt.Sprintf("peer %s isn't approved", peer.Name), false
+		}
+		if a.Settings != nil && a.Settings.PeerLoginExpirationEnabled {
+			if expired, _ := peer.LoginExpired(a.Settings.PeerLoginExpiration); expired {
+				return fmt.Sprintf("login of peer %s expired", peer.Name), false
+			}
+		}
+	}
+	return "", true
+}
+
+func (a *Account) peerInGroups(peerID string, groupIDs []string) bool {
+	for _, groupID := range groupIDs {
+		if group := a.GetGroup(groupID); group != nil && slices.Contains(group.Peers, peerID) {
+			return true
+		}
+	}
+	return false
+}
+
+// failedPostureChecks returns the names of the posture checks the peer fails
+func (a *Account) failedPostureChecks(ctx context.Context, postureChecksIDs []string, peerID string) []string {
+	var failed []string
+	for _, postureChecksID := range postureChecksIDs {
+		if a.validatePostureChecksOnPeer(ctx, []string{postureChecksID}, peerID) {
+			continue
+		}
+		name := postureChecksID
+		if postureChecks := a.GetPostureChecks(postureChecksID); postureChecks != nil {
+			name = postureChecks.Name
+		}
+		failed = append(failed, name)
+	}
+	return failed
+}
+
+// ruleMatchesTraffic checks the protocol and destination port of a rule, a port of 0 matches ICMP traffic
+func ruleMatchesTraffic(rule *PolicyRule, protocol PolicyRuleProtocolType, port uint16) bool {
+	if rule.Protocol != PolicyRuleProtocolALL && rule.Protocol != protocol {
+		return false
+	}
+
+	if port == 0 || (len(rule.Ports) == 0 && len(rule.PortRanges) == 0) {
+		return true
+	}
+
+	for _, rulePort := range rule.Ports {
+		if p, err := strconv.ParseUint(rulePort, 10, 16); err == nil && uint16(p) == port {
+			return true
+		}
+	}
+	for _, portRange := range rule.PortRanges {
+		if port >= portRange.Start && port <= portRange.End {
+			return true
+		}
+	}
+	return false
+}
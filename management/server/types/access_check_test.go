@@ -0,0 +1,305 @@
+package types
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/management/server/posture"
+	"github.com/netbirdio/netbird/route"
+	"github.com/netbirdio/netbird/shared/management/status"
+)
+
+func TestAccount_CheckAccess_Peer(t *testing.T) {
+	account := setupPolicyEvaluationAccount()
+	validated := validatedPeers(account)
+
+	checks, err := account.CheckAccess(context.Background(), &AccessQuery{
+		SourcePeerID:  "peer1",
+		DestinationIP: netip.MustParseAddr("100.64.0.2"),
+		Protocol:      PolicyRuleProtocolTCP,
+		Port:          5432,
+	}, validated)
+	require.NoError(t, err)
+	assert.Equal(t, []*AccessCheck{{
+		SourcePeerID:    "peer1",
+		SourcePeerName:  "web",
+		DestinationType: PolicyAccessDestinationPeer,
+		DestinationID:   "peer2",
+		DestinationName: "db",
+		Allowed:         true,
+		Rules:           []AccessCheckRule{{PolicyID: "policy1", RuleID: "policy1", Action: PolicyTrafficActionAccept}},
+	}}, checks)
+
+	checks, err = account.CheckAccess(context.Background(), &AccessQuery{
+		SourcePeerID:      "peer2",
+		DestinationPeerID: "peer1",
+		Protocol:          PolicyRuleProtocolTCP,
+		Port:              5432,
+	}, validated)
+	require.NoError(t, err)
+	require.Len(t, checks, 1)
+	assert.False(t, checks[0].Allowed, "rule isn't bidirectional")
+	assert.Equal(t, "no active policy rule allows tcp/5432 from db to web", checks[0].Reason)
+
+	account.Policies[0].Rules[0].Bidirectional = true
+	checks, err = account.CheckAccess(context.Background(), &AccessQuery{
+		SourcePeerID:      "peer2",
+		DestinationPeerID: "peer1",
+		Protocol:          PolicyRuleProtocolTCP,
+		Port:              5432,
+	}, validated)
+	require.NoError(t, err)
+	require.Len(t, checks, 1)
+	assert.True(t, checks[0].Allowed)
+
+	delete(validated, "peer2")
+	checks, err = account.CheckAccess(context.Background(), &AccessQuery{
+		SourcePeerID:      "peer1",
+		DestinationPeerID: "peer2",
+		Protocol:          PolicyRuleProtocolTCP,
+		Port:              5432,
+	}, validated)
+	require.NoError(t, err)
+	require.Len(t, checks, 1)
+	assert.False(t, checks[0].Allowed)
+	assert.Equal(t, "peer db isn't approved", checks[0].Reason)
+}
+
+func TestAccount_CheckAccess_DropTakesPrecedence(t *testing.T) {
+	account := setupPolicyEvaluationAccount()
+	account.Policies = append(account.Policies, &Policy{
+		ID:      "policy2",
+		Name:    "deny db",
+		Enabled: true,
+		Rules: []*PolicyRule{{
+			ID:           "rule2",
+			PolicyID:     "policy2",
+			Enabled:      true,
+			Action:       PolicyTrafficActionDrop,
+			Protocol:     PolicyRuleProtocolALL,
+			Sources:      []string{"all"},
+			Destinations: []string{"db"},
+		}},
+	})
+
+	checks, err := account.CheckAccess(context.Background(), &AccessQuery{
+		SourcePeerID:      "peer1",
+		DestinationPeerID: "peer2",
+		Protocol:          PolicyRuleProtocolTCP,
+		Port:              5432,
+	}, validatedPeers(account))
+	require.NoError(t, err)
+	require.Len(t, checks, 1)
+	assert.False(t, checks[0].Allowed)
+	assert.Equal(t, []AccessCheckRule{{PolicyID: "policy2", PolicyName: "deny db", RuleID: "rule2", Action: PolicyTrafficActionDrop}}, checks[0].Rules)
+	assert.Equal(t, "connection is dropped by a policy rule", checks[0].Reason)
+}
+
+func TestAccount_CheckAccess_PostureChecks(t *testing.T) {
+	account := setupPolicyEvaluationAccount()
+	account.Peers["peer1"].Meta.WtVersion = "0.20.0"
+	account.PostureChecks = []*posture.Checks{{
+		ID:   "posture1",
+		Name: "recent client",
+		Checks: posture.ChecksDefinition{
+			NBVersionCheck: &posture.NBVersionCheck{MinVersion: "0.30.0"},
+		},
+	}}
+	account.Policies[0].Name = "web to db"
+	account.Policies[0].SourcePostureChecks = []string{"posture1"}
+
+	checks, err := account.CheckAccess(context.Background(), &AccessQuery{
+		SourcePeerID:      "peer1",
+		DestinationPeerID: "peer2",
+		Protocol:          PolicyRuleProtocolTCP,
+		Port:              5432,
+	}, validatedPeers(account))
+	require.NoError(t, err)
+	require.Len(t, checks, 1)
+	assert.False(t, checks[0].Allowed)
+	assert.Equal(t, "peer web fails posture checks recent client of policy web to db", checks[0].Reason)
+}
+
+func TestAccount_CheckAccess_Resource(t *testing.T) {
+	account := setupPolicyEvaluationAccount()
+	account.Policies = append(account.Policies, &Policy{
+		ID:      "policy2",
+		Enabled: true,
+		Rules: []*PolicyRule{{
+			ID:           "rule2",
+			PolicyID:     "policy2",
+			Enabled:      true,
+			Action:       PolicyTrafficActionAccept,
+			Protocol:     PolicyRuleProtocolTCP,
+			PortRanges:   []RulePortRange{{Start: 400, End: 500}},
+			Sources:      []string{"web"},
+			Destinations: []string{"office"},
+		}},
+	})
+	validated := validatedPeers(account)
+
+	checks, err := account.CheckAccess(context.Background(), &AccessQuery{
+		SourcePeerID:  "peer1",
+		DestinationIP: netip.MustParseAddr("10.0.0.10"),
+		Protocol:      PolicyRuleProtocolTCP,
+		Port:          443,
+	}, validated)
+	require.NoError(t, err)
+	require.Len(t, checks, 1)
+	assert.Equal(t, PolicyAccessDestinationResource, checks[0].DestinationType)
+	assert.Equal(t, "resource1", checks[0].DestinationID)
+	assert.True(t, checks[0].Allowed)
+
+	delete(validated, "peer3")
+	checks, err = account.CheckAccess(context.Background(), &AccessQuery{
+		SourcePeerID:          "peer1",
+		DestinationResourceID: "resource1",
+		Protocol:              PolicyRuleProtocolTCP,
+		Port:                  443,
+	}, validated)
+	require.NoError(t, err)
+	require.Len(t, checks, 1)
+	assert.False(t, checks[0].Allowed)
+	assert.Equal(t, "network of resource office has no enabled and approved routing peer", checks[0].Reason)
+}
+
+func TestAccount_CheckAccess_Route(t *testing.T) {
+	account := setupPolicyEvaluationAccount()
+	account.Routes = map[route.ID]*route.Route{
+		"route1": {
+			ID:          "route1",
+			NetID:       "lab",
+			Network:     netip.MustParsePrefix("192.168.0.0/16"),
+			Peer:        "peer3",
+			Enabled:     true,
+			Groups:      []string{"all"},
+			NetworkType: route.IPv4Network,
+		},
+	}
+
+	checks, err := account.CheckAccess(context.Background(), &AccessQuery{
+		SourcePeerID:  "peer2",
+		DestinationIP: netip.MustParseAddr("192.168.1.1"),
+		Protocol:      PolicyRuleProtocolICMP,
+	}, validatedPeers(account))
+	require.NoError(t, err)
+	require.Len(t, checks, 1)
+	assert.Equal(t, PolicyAccessDestinationRoute, checks[0].DestinationType)
+	assert.True(t, checks[0].Allowed)
+	assert.Equal(t, "network route lab has no access control groups and permits all traffic", checks[0].Reason)
+
+	account.Routes["route1"].AccessControlGroups = []string{"db"}
+	checks, err = account.CheckAccess(context.Background(), &AccessQuery{
+		SourcePeerID:  "peer2",
+		DestinationIP: netip.MustParseAddr("192.168.1.1"),
+		Protocol:      PolicyRuleProtocolICMP,
+	}, validatedPeers(account))
+	require.NoError(t, err)
+	require.Len(t, checks, 1)
+	assert.False(t, checks[0].Allowed)
+	assert.Equal(t, "no active policy rule allows icmp from db to lab", checks[0].Reason)
+}
+
+func TestAccount_CheckAccess_PeerGroupRoute(t *testing.T) {
+	account := setupPolicyEvaluationAccount()
+	account.Groups["routers"] = &Group{ID: "routers", Name: "routers", Peers: []string{"peer3"}}
+	account.Routes = map[route.ID]*route.Route{
+		"route1": {
+			ID:                  "route1",
+			NetID:               "lab",
+			Network:             netip.MustParsePrefix("192.168.0.0/16"),
+			PeerGroups:          []string{"routers"},
+			Enabled:             true,
+			Groups:              []string{"all"},
+			AccessControlGroups: []string{"lab"},
+			NetworkType:         route.IPv4Network,
+		},
+	}
+	account.Groups["lab"] = &Group{ID: "lab", Name: "lab"}
+	account.Policies = append(account.Policies, &Policy{
+		ID:      "policy2",
+		Name:    "lab access",
+		Enabled: true,
+		Rules: []*PolicyRule{{
+			ID:           "rule2",
+			PolicyID:     "policy2",
+			Enabled:      true,
+			Action:       PolicyTrafficActionAccept,
+			Protocol:     PolicyRuleProtocolUDP,
+			Ports:        []string{"53"},
+			Sources:      []string{"web"},
+			Destinations: []string{"lab"},
+		}},
+	})
+
+	checks, err := account.CheckAccess(context.Background(), &AccessQuery{
+		SourceIP:      netip.MustParseAddr("100.64.0.1"),
+		DestinationIP: netip.MustParseAddr("192.168.1.1"),
+		Protocol:      PolicyRuleProtocolUDP,
+		Port:          53,
+	}, validatedPeers(account))
+	require.NoError(t, err)
+	require.Len(t, checks, 1)
+	assert.Equal(t, "route1", checks[0].DestinationID)
+	assert.True(t, checks[0].Allowed)
+	assert.Equal(t, []AccessCheckRule{{PolicyID: "policy2", PolicyName: "lab access", RuleID: "rule2", Action: PolicyTrafficActionAccept}}, checks[0].Rules)
+
+	checks, err = account.CheckAccess(context.Background(), &AccessQuery{
+		SourcePeerID:  "peer2",
+		DestinationIP: netip.MustParseAddr("192.168.1.1"),
+		Protocol:      PolicyRuleProtocolUDP,
+		Port:          53,
+	}, validatedPeers(account))
+	require.NoError(t, err)
+	require.Len(t, checks, 1)
+	assert.False(t, checks[0].Allowed)
+}
+
+func TestAccount_CheckAccess_InvalidQuery(t *testing.T) {
+	account := setupPolicyEvaluationAccount()
+
+	tt := []struct {
+		name  string
+		query *AccessQuery
+		code  status.Type
+	}{
+		{
+			name:  "missing port",
+			query: &AccessQuery{SourcePeerID: "peer1", DestinationPeerID: "peer2", Protocol: PolicyRuleProtocolTCP},
+			code:  status.InvalidArgument,
+		},
+		{
+			name:  "unsupported protocol",
+			query: &AccessQuery{SourcePeerID: "peer1", DestinationPeerID: "peer2", Protocol: PolicyRuleProtocolALL},
+			code:  status.InvalidArgument,
+		},
+		{
+			name:  "multiple sources",
+			query: &AccessQuery{SourcePeerID: "peer1", SourceIP: netip.MustParseAddr("100.64.0.1"), DestinationPeerID: "peer2", Protocol: PolicyRuleProtocolICMP},
+			code:  status.InvalidArgument,
+		},
+		{
+			name:  "missing destination",
+			query: &AccessQuery{SourcePeerID: "peer1", Protocol: PolicyRuleProtocolICMP},
+			code:  status.InvalidArgument,
+		},
+		{
+			name:  "unknown destination IP",
+			query: &AccessQuery{SourcePeerID: "peer1", DestinationIP: netip.MustParseAddr("172.16.0.1"), Protocol: PolicyRuleProtocolICMP},
+			code:  status.NotFound,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := account.CheckAccess(context.Background(), tc.query, validatedPeers(account))
+			sErr, ok := status.FromError(err)
+			require.True(t, ok, "expected status error, got %v", err)
+			assert.Equal(t, tc.code, sErr.Type())
+		})
+	}
+}
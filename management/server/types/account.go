@@ -16,6 +16,7 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	nbdns "github.com/netbirdio/netbird/dns"
+	blocklistTypes "github.com/netbirdio/netbird/management/server/blocklists/types"
 	resourceTypes "github.com/netbirdio/netbird/management/server/networks/resources/types"
 	routerTypes "github.com/netbirdio/netbird/management/server/networks/routers/types"
 	networkTypes "github.com/netbirdio/netbird/management/server/networks/types"
@@ -23,6 +24,7 @@ import (
 	"github.com/netbirdio/netbird/management/server/posture"
 	"github.com/netbirdio/netbird/management/server/telemetry"
 	"github.com/netbirdio/netbird/management/server/util"
+	zoneTypes "github.com/netbirdio/netbird/management/server/zones/types"
 	"github.com/netbirdio/netbird/route"
 	"github.com/netbirdio/netbird/shared/management/domain"
 	"github.com/netbirdio/netbird/shared/management/status"
@@ -87,6 +89,8 @@ type Account struct {
 	NetworkRouters   []*routerTypes.NetworkRouter     `gorm:"foreignKey:AccountID;references:id"`
 	NetworkResources []*resourceTypes.NetworkResource `gorm:"foreignKey:AccountID;references:id"`
 	Onboarding       AccountOnboarding                `gorm:"foreignKey:AccountID;references:id;constraint:OnDelete:CASCADE"`
+	DNSZones         []*zoneTypes.Zone                `gorm:"foreignKey:AccountID;references:id"`
+	DNSBlocklists    []*blocklistTypes.Blocklist      `gorm:"foreignKey:AccountID;references:id"`
 }
 
 // this class is used by gorm only
@@ -307,8 +311,10 @@ func (a *Account) GetPeerNetworkMap(
 				Records: records,
 			})
 		}
+		zones = append(zones, getPeerDNSZones(a, peerID)...)
 		dnsUpdate.CustomZones = zones
 		dnsUpdate.NameServerGroups = getPeerNSGroups(a, peerID)
+		dnsUpdate.Blocklists = getPeerDNSBlocklists(a, peerID)
 	}
 
 	nm := &NetworkMap{
@@ -404,6 +410,45 @@ func getPeerNSGroups(account *Account, peerID string) []*nbdns.NameServerGroup {
 	return peerNSGroups
 }
 
+// getPeerDNSZones returns the enabled account DNS zones distributed to the peer
+func getPeerDNSZones(account *Account, peerID string) []nbdns.CustomZone {
+	groupList := account.GetPeerGroups(peerID)
+
+	var zones []nbdns.CustomZone
+	for _, zone := range account.DNSZones {
+		if !zone.Enabled {
+			continue
+		}
+		for _, gID := range zone.Groups {
+			if _, found := groupList[gID]; found {
+				zones = append(zones, zone.ToCustomZone())
+				break
+			}
+		}
+	}
+
+	return zones
+}
+
+// getPeerDNSBlocklists returns the enabled account DNS blocklists that the peer isn't exempt from
+func getPeerDNSBlocklists(account *Account, peerID string) []nbdns.Blocklist {
+	if len(account.DNSBlocklists) == 0 {
+		return nil
+	}
+
+	groupList := account.GetPeerGroups(peerID)
+
+	var blocklists []nbdns.Blocklist
+	for _, blocklist := range account.DNSBlocklists {
+		if !blocklist.Enabled || blocklist.IsExempt(groupList) {
+			continue
+		}
+		blocklists = append(blocklists, blocklist.ToDNSBlocklist())
+	}
+
+	return blocklists
+}
+
 // peerIsNameserver returns true if the peer is a nameserver for a nsGroup
 func peerIsNameserver(peer *nbpeer.Peer, nsGroup *nbdns.NameServerGroup) bool {
 	for _, ns := range nsGroup.NameServers {
@@ -868,6 +913,16 @@ func (a *Account) Copy() *Account {
 		networkResources = append(networkResources, resource.Copy())
 	}
 
+	dnsZones := []*zoneTypes.Zone{}
+	for _, zone := range a.DNSZones {
+		dnsZones = append(dnsZones, zone.Copy())
+	}
+
+	dnsBlocklists := []*blocklistTypes.Blocklist{}
+	for _, blocklist := range a.DNSBlocklists {
+		dnsBlocklists = append(dnsBlocklists, blocklist.Copy())
+	}
+
 	return &Account{
 		Id:                     a.Id,
 		CreatedBy:              a.CreatedBy,
@@ -890,6 +945,8 @@ func (a *Account) Copy() *Account {
 		NetworkRouters:         networkRouters,
 		NetworkResources:       networkResources,
 		Onboarding:             a.Onboarding,
+		DNSZones:               dnsZones,
+		DNSBlocklists:          dnsBlocklists,
 	}
 }
 
@@ -997,7 +1054,7 @@ func (a *Account) GetPeerConnectionResources(ctx context.Context, peer *nbpeer.P
 		}
 
 		for _, rule := range policy.Rules {
-			if !rule.Enabled {
+			if !rule.IsActive(time.Now()) {
 				continue
 			}
 
@@ -1137,6 +1194,12 @@ func (a *Account) validatePostureChecksOnPeer(ctx context.Context, sourcePosture
 			continue
 		}
 
+		// the client periodically re-evaluates the checks it collects the data for and reports the results
+		// with its meta, a reported failure fails the posture checks
+		if result, ok := peer.Meta.GetPostureCheckResult(postureChecksID); ok && !result.Passed {
+			return false
+		}
+
 		for _, check := range postureChecks.GetChecks() {
 			isValid, err := check.Check(ctx, *peer)
 			if err != nil {
@@ -1192,7 +1255,7 @@ func (a *Account) getRouteFirewallRules(ctx context.Context, peerID string, poli
 		}
 
 		for _, rule := range policy.Rules {
-			if !rule.Enabled {
+			if !rule.IsActive(time.Now()) {
 				continue
 			}
 
@@ -1461,7 +1524,7 @@ func (a *Account) GetPoliciesForNetworkResource(resourceId string) []*Policy {
 		}
 
 		for _, rule := range policy.Rules {
-			if !rule.Enabled {
+			if !rule.IsActive(time.Now()) {
 				continue
 			}
 
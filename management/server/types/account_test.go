@@ -13,11 +13,13 @@ import (
 	"github.com/stretchr/testify/require"
 
 	nbdns "github.com/netbirdio/netbird/dns"
+	blocklistTypes "github.com/netbirdio/netbird/management/server/blocklists/types"
 	resourceTypes "github.com/netbirdio/netbird/management/server/networks/resources/types"
 	routerTypes "github.com/netbirdio/netbird/management/server/networks/routers/types"
 	networkTypes "github.com/netbirdio/netbird/management/server/networks/types"
 	nbpeer "github.com/netbirdio/netbird/management/server/peer"
 	"github.com/netbirdio/netbird/management/server/posture"
+	zoneTypes "github.com/netbirdio/netbird/management/server/zones/types"
 	"github.com/netbirdio/netbird/route"
 )
 
@@ -944,3 +946,73 @@ func Test_FilterZoneRecordsForPeers(t *testing.T) {
 		})
 	}
 }
+
+func Test_GetPeerDNSZones(t *testing.T) {
+	account := &Account{
+		Groups: map[string]*Group{
+			"group1": {ID: "group1", Peers: []string{"peer1"}},
+			"group2": {ID: "group2", Peers: []string{"peer2"}},
+		},
+		DNSZones: []*zoneTypes.Zone{
+			{
+				ID: "zone1", Domain: "corp.internal", Enabled: true, Groups: []string{"group2", "group1"},
+				Records: []zoneTypes.Record{{Name: "www", Type: "A", TTL: 300, Value: "100.64.0.10"}},
+			},
+			{
+				ID: "zone2", Domain: "lab.internal", Enabled: true, Groups: []string{"group2"},
+				Records: []zoneTypes.Record{{Name: "www", Type: "A", TTL: 300, Value: "100.64.0.11"}},
+			},
+			{
+				ID: "zone3", Domain: "disabled.internal", Enabled: false, Groups: []string{"group1"},
+				Records: []zoneTypes.Record{{Name: "www", Type: "A", TTL: 300, Value: "100.64.0.12"}},
+			},
+		},
+	}
+
+	zones := getPeerDNSZones(account, "peer1")
+	require.Len(t, zones, 1)
+	assert.Equal(t, "corp.internal.", zones[0].Domain)
+	require.Len(t, zones[0].Records, 1)
+	assert.Equal(t, "www.corp.internal.", zones[0].Records[0].Name)
+
+	assert.Len(t, getPeerDNSZones(account, "peer2"), 2)
+	assert.Empty(t, getPeerDNSZones(account, "peer3"))
+}
+
+func Test_GetPeerDNSBlocklists(t *testing.T) {
+	account := &Account{
+		Groups: map[string]*Group{
+			"group1": {ID: "group1", Peers: []string{"peer1"}},
+			"group2": {ID: "group2", Peers: []string{"peer2"}},
+		},
+		DNSBlocklists: []*blocklistTypes.Blocklist{
+			{
+				ID: "blocklist1", Name: "ads", Enabled: true, Action: blocklistTypes.ActionSinkhole,
+				Domains: []string{"ads.example.com"}, SourceDomains: []string{"tracker.example.com", "ads.example.com"},
+				ExemptGroups: []string{"group2"},
+			},
+			{
+				ID: "blocklist2", Name: "malware", Enabled: true, Action: blocklistTypes.ActionNXDomain,
+				Domains: []string{"malware.example.com"},
+			},
+			{
+				ID: "blocklist3", Name: "disabled", Enabled: false, Action: blocklistTypes.ActionNXDomain,
+				Domains: []string{"disabled.example.com"},
+			},
+		},
+	}
+
+	blocklists := getPeerDNSBlocklists(account, "peer1")
+	require.Len(t, blocklists, 2)
+	assert.Equal(t, nbdns.Blocklist{
+		ID:       "blocklist1",
+		Name:     "ads",
+		Sinkhole: true,
+		Domains:  []string{"ads.example.com", "tracker.example.com"},
+	}, blocklists[0])
+	assert.Equal(t, "blocklist2", blocklists[1].ID)
+
+	blocklists = getPeerDNSBlocklists(account, "peer2")
+	require.Len(t, blocklists, 1)
+	assert.Equal(t, "blocklist2", blocklists[0].ID)
+}
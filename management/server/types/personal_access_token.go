@@ -76,7 +76,7 @@ type PersonalAccessTokenGenerated struct {
 // CreateNewPAT will generate a new PersonalAccessToken that can be assigned to a User.
 // Additionally, it will return the token in plain text once, to give to the user and only save a hashed version
 func CreateNewPAT(name string, expirationInDays int, targetID, createdBy string) (*PersonalAccessTokenGenerated, error) {
-	hashedToken, plainToken, err := generateNewToken()
+	hashedToken, plainToken, err := generateNewToken(PATPrefix)
 	if err != nil {
 		return nil, err
 	}
@@ -96,7 +96,7 @@ func CreateNewPAT(name string, expirationInDays int, targetID, createdBy string)
 
 }
 
-func generateNewToken() (string, string, error) {
+func generateNewToken(prefix string) (string, string, error) {
 	secret, err := b.Random(PATSecretLength)
 	if err != nil {
 		return "", "", err
@@ -105,7 +105,7 @@ func generateNewToken() (string, string, error) {
 	checksum := crc32.ChecksumIEEE([]byte(secret))
 	encodedChecksum := base62.Encode(checksum)
 	paddedChecksum := fmt.Sprintf("%06s", encodedChecksum)
-	plainToken := PATPrefix + secret + paddedChecksum
+	plainToken := prefix + secret + paddedChecksum
 	hashedToken := sha256.Sum256([]byte(plainToken))
 	encodedHashedToken := b64.StdEncoding.EncodeToString(hashedToken[:])
 	return encodedHashedToken, plainToken, nil
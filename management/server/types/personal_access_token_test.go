@@ -14,20 +14,20 @@ import (
 )
 
 func TestPAT_GenerateToken_Hashing(t *testing.T) {
-	hashedToken, plainToken, _ := generateNewToken()
+	hashedToken, plainToken, _ := generateNewToken(PATPrefix)
 	expectedToken := sha256.Sum256([]byte(plainToken))
 	encodedExpectedToken := b64.StdEncoding.EncodeToString(expectedToken[:])
 	assert.Equal(t, hashedToken, encodedExpectedToken)
 }
 
 func TestPAT_GenerateToken_Prefix(t *testing.T) {
-	_, plainToken, _ := generateNewToken()
+	_, plainToken, _ := generateNewToken(PATPrefix)
 	fourCharPrefix := plainToken[:4]
 	assert.Equal(t, PATPrefix, fourCharPrefix)
 }
 
 func TestPAT_GenerateToken_Checksum(t *testing.T) {
-	_, plainToken, _ := generateNewToken()
+	_, plainToken, _ := generateNewToken(PATPrefix)
 	tokenWithoutPrefix := strings.Split(plainToken, "_")[1]
 	if len(tokenWithoutPrefix) != 36 {
 		t.Fatal("Token has wrong length")
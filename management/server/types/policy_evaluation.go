@@ -0,0 +1,310 @@
+package types
+
+import (
+	"cmp"
+	"context"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+
+	nbdns "github.com/netbirdio/netbird/dns"
+	routerTypes "github.com/netbirdio/netbird/management/server/networks/routers/types"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+	"github.com/netbirdio/netbird/route"
+)
+
+const (
+	// PolicyAccessDestinationPeer indicates that the destination of the access is a peer
+	PolicyAccessDestinationPeer = PolicyAccessDestinationType("peer")
+	// PolicyAccessDestinationResource indicates that the destination of the access is a network resource
+	PolicyAccessDestinationResource = PolicyAccessDestinationType("resource")
+	// PolicyAccessDestinationRoute indicates that the destination of the access is a network route
+	PolicyAccessDestinationRoute = PolicyAccessDestinationType("route")
+)
+
+// PolicyAccessDestinationType type of the destination a peer has access to
+type PolicyAccessDestinationType string
+
+// PolicyAccess is a connection a source peer is allowed to open by the policies of the account
+type PolicyAccess struct {
+	SourcePeerID   string
+	SourcePeerName string
+
+	DestinationType PolicyAccessDestinationType
+	DestinationID   string
+	DestinationName string
+
+	Protocol PolicyRuleProtocolType
+
+	// Port is set when the access is limited to a single port
+	Port uint16
+
+	// PortRange is set when the access is limited to a range of ports
+	PortRange RulePortRange
+}
+
+// PolicyChange is a proposed change of the policies of an account.
+// The policy with PolicyID is deleted when Policy is nil, a Policy without ID is created.
+type PolicyChange struct {
+	PolicyID string
+	Policy   *Policy
+}
+
+// PolicyEvaluation is the difference of the allowed connections before and after policy changes
+type PolicyEvaluation struct {
+	Added   []PolicyAccess
+	Removed []PolicyAccess
+}
+
+// ApplyPolicyChanges replaces, adds and removes the policies of the account.
+// It is meant to be used on a copy of the account.
+func (a *Account) ApplyPolicyChanges(changes []*PolicyChange) {
+	for _, change := range changes {
+		index := slices.IndexFunc(a.Policies, func(policy *Policy) bool {
+			return change.PolicyID != "" && policy.ID == change.PolicyID
+		})
+
+		switch {
+		case change.Policy == nil && index >= 0:
+			a.Policies = slices.Delete(a.Policies, index, index+1)
+		case change.Policy != nil && index >= 0:
+			a.Policies[index] = change.Policy
+		case change.Policy != nil:
+			a.Policies = append(a.Policies, change.Policy)
+		}
+	}
+}
+
+// GetPolicyAccess returns the connections allowed by the policies of the account at the current time.
+// The connections are derived from the network maps of the validated peers, so they match what is
+// distributed to the peers. Connections explicitly dropped by a rule are excluded.
+func (a *Account) GetPolicyAccess(ctx context.Context, validatedPeersMap map[string]struct{}) map[PolicyAccess]struct{} {
+	evaluator := a.newAccessEvaluator(validatedPeersMap)
+
+	accepted := make(map[PolicyAccess]struct{})
+	dropped := make(map[PolicyAccess]struct{})
+	for _, peer := range a.Peers {
+		if _, ok := validatedPeersMap[peer.ID]; !ok {
+			continue
+		}
+
+		for _, rule := range evaluator.peerAccess(ctx, peer) {
+			// rules without policy permit all traffic of routes without access control groups and don't depend on policies
+			if rule.permitAll {
+				continue
+			}
+			if rule.action == PolicyTrafficActionDrop {
+				dropped[rule.PolicyAccess] = struct{}{}
+				continue
+			}
+			accepted[rule.PolicyAccess] = struct{}{}
+		}
+	}
+
+	for access := range dropped {
+		delete(accepted, access)
+	}
+
+	return accepted
+}
+
+// accessRule is a connection allowed or dropped by a firewall rule of a network map
+type accessRule struct {
+	PolicyAccess
+	action PolicyTrafficActionType
+	// peer firewall rules reference the policy rule and route firewall rules the policy they are derived from
+	ruleID   string
+	policyID string
+	// permitAll is set for the rules permitting all traffic of routes without access control groups
+	permitAll bool
+}
+
+// accessEvaluator derives the connections of the peers from their network maps
+type accessEvaluator struct {
+	account           *Account
+	validatedPeersMap map[string]struct{}
+	peersByIP         map[netip.Addr]*nbpeer.Peer
+	resourcePolicies  map[string][]*Policy
+	routers           map[string]map[string]*routerTypes.NetworkRouter
+
+	// rules caches the connections by the ID of the destination peer
+	rules map[string][]accessRule
+}
+
+func (a *Account) newAccessEvaluator(validatedPeersMap map[string]struct{}) *accessEvaluator {
+	peersByIP := make(map[netip.Addr]*nbpeer.Peer, len(a.Peers))
+	for _, peer := range a.Peers {
+		if _, ok := validatedPeersMap[peer.ID]; !ok {
+			continue
+		}
+		if ip, ok := netip.AddrFromSlice(peer.IP); ok {
+			peersByIP[ip.Unmap()] = peer
+		}
+	}
+
+	return &accessEvaluator{
+		account:           a,
+		validatedPeersMap: validatedPeersMap,
+		peersByIP:         peersByIP,
+		resourcePolicies:  a.GetResourcePoliciesMap(),
+		routers:           a.GetResourceRoutersMap(),
+		rules:             make(map[string][]accessRule),
+	}
+}
+
+// peerAccess returns the connections to the peer and the routes it routes that are allowed or dropped by its network map
+func (e *accessEvaluator) peerAccess(ctx context.Context, peer *nbpeer.Peer) []accessRule {
+	if rules, ok := e.rules[peer.ID]; ok {
+		return rules
+	}
+
+	a := e.account
+	networkMap := a.GetPeerNetworkMap(ctx, peer.ID, nbdns.CustomZone{}, e.validatedPeersMap, e.resourcePolicies, e.routers, nil)
+
+	var rules []accessRule
+
+	// inbound rules of the destination peer describe each connection once, no matter the rule direction
+	for _, rule := range networkMap.FirewallRules {
+		if rule.Direction != FirewallRuleDirectionIN {
+			continue
+		}
+		access := accessRule{
+			PolicyAccess: PolicyAccess{
+				DestinationType: PolicyAccessDestinationPeer,
+				DestinationID:   peer.ID,
+				DestinationName: peer.Name,
+				Protocol:        PolicyRuleProtocolType(rule.Protocol),
+				PortRange:       rule.PortRange,
+			},
+			action: PolicyTrafficActionType(rule.Action),
+			ruleID: rule.PolicyID,
+		}
+		if port, err := strconv.ParseUint(rule.Port, 10, 16); err == nil {
+			access.Port = uint16(port)
+		}
+		for _, source := range sourcePeers(rule.PeerIP, peer.ID, e.peersByIP) {
+			access.SourcePeerID, access.SourcePeerName = source.ID, source.Name
+			rules = append(rules, access)
+		}
+	}
+
+	for _, rule := range networkMap.RoutesFirewallRules {
+		access := accessRule{
+			PolicyAccess: PolicyAccess{
+				Protocol:  PolicyRuleProtocolType(rule.Protocol),
+				Port:      rule.Port,
+				PortRange: rule.PortRange,
+			},
+			action:    PolicyTrafficActionType(rule.Action),
+			policyID:  rule.PolicyID,
+			permitAll: rule.PolicyID == "",
+		}
+		access.DestinationType, access.DestinationID, access.DestinationName = a.routeDestination(rule.RouteID)
+		for _, sourceRange := range rule.SourceRanges {
+			prefix, err := netip.ParsePrefix(sourceRange)
+			if err != nil {
+				continue
+			}
+			for _, source := range sourcePeers(prefix.Addr().String(), peer.ID, e.peersByIP) {
+				access.SourcePeerID, access.SourcePeerName = source.ID, source.Name
+				rules = append(rules, access)
+			}
+		}
+	}
+
+	e.rules[peer.ID] = rules
+	return rules
+}
+
+// allows checks whether the connection covers the protocol and destination port, a port of 0 matches ICMP traffic
+func (p PolicyAccess) allows(protocol PolicyRuleProtocolType, port uint16) bool {
+	if p.Protocol != PolicyRuleProtocolALL && p.Protocol != protocol {
+		return false
+	}
+	if port == 0 || (p.Port == 0 && p.PortRange.Start == 0 && p.PortRange.End == 0) {
+		return true
+	}
+	return p.Port == port || (port >= p.PortRange.Start && port <= p.PortRange.End)
+}
+
+// routeDestination returns the network resource a route was generated for or the route itself
+func (a *Account) routeDestination(routeID route.ID) (PolicyAccessDestinationType, string, string) {
+	if r, ok := a.Routes[routeID]; ok {
+		return PolicyAccessDestinationRoute, string(r.ID), string(r.NetID)
+	}
+
+	// routes of network resources and routes of peer groups are identified by their ID and the routing peer ID
+	id, _, _ := strings.Cut(string(routeID), ":")
+	if r, ok := a.Routes[route.ID(id)]; ok {
+		return PolicyAccessDestinationRoute, string(r.ID), string(r.NetID)
+	}
+	for _, resource := range a.NetworkResources {
+		if resource.ID == id {
+			return PolicyAccessDestinationResource, resource.ID, resource.Name
+		}
+	}
+
+	return PolicyAccessDestinationRoute, string(routeID), ""
+}
+
+// sourcePeers resolves the source IP of a firewall rule to peers, an unspecified IP stands for all peers but the target
+func sourcePeers(ip string, targetPeerID string, peersByIP map[netip.Addr]*nbpeer.Peer) []*nbpeer.Peer {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return nil
+	}
+
+	if !addr.IsUnspecified() {
+		if peer, ok := peersByIP[addr.Unmap()]; ok {
+			return []*nbpeer.Peer{peer}
+		}
+		return nil
+	}
+
+	peers := make([]*nbpeer.Peer, 0, len(peersByIP))
+	for _, peer := range peersByIP {
+		if peer.ID != targetPeerID {
+			peers = append(peers, peer)
+		}
+	}
+	return peers
+}
+
+// EvaluatePolicyAccess returns the connections that are only in after as added and the ones only in before as removed
+func EvaluatePolicyAccess(before, after map[PolicyAccess]struct{}) *PolicyEvaluation {
+	evaluation := &PolicyEvaluation{
+		Added:   make([]PolicyAccess, 0),
+		Removed: make([]PolicyAccess, 0),
+	}
+
+	for access := range after {
+		if _, ok := before[access]; !ok {
+			evaluation.Added = append(evaluation.Added, access)
+		}
+	}
+	for access := range before {
+		if _, ok := after[access]; !ok {
+			evaluation.Removed = append(evaluation.Removed, access)
+		}
+	}
+
+	slices.SortFunc(evaluation.Added, comparePolicyAccess)
+	slices.SortFunc(evaluation.Removed, comparePolicyAccess)
+
+	return evaluation
+}
+
+func comparePolicyAccess(a, b PolicyAccess) int {
+	return cmp.Or(
+		cmp.Compare(a.SourcePeerName, b.SourcePeerName),
+		cmp.Compare(a.SourcePeerID, b.SourcePeerID),
+		cmp.Compare(a.DestinationType, b.DestinationType),
+		cmp.Compare(a.DestinationName, b.DestinationName),
+		cmp.Compare(a.DestinationID, b.DestinationID),
+		cmp.Compare(a.Protocol, b.Protocol),
+		cmp.Compare(a.Port, b.Port),
+		cmp.Compare(a.PortRange.Start, b.PortRange.Start),
+		cmp.Compare(a.PortRange.End, b.PortRange.End),
+	)
+}
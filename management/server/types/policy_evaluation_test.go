@@ -0,0 +1,152 @@
+package types
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	resourceTypes "github.com/netbirdio/netbird/management/server/networks/resources/types"
+	routerTypes "github.com/netbirdio/netbird/management/server/networks/routers/types"
+	nbpeer "github.com/netbirdio/netbird/management/server/peer"
+)
+
+func setupPolicyEvaluationAccount() *Account {
+	peer := func(id, name, ip string) *nbpeer.Peer {
+		return &nbpeer.Peer{ID: id, Name: name, Key: id + "Key", IP: net.ParseIP(ip), Meta: nbpeer.PeerSystemMeta{WtVersion: "dev"}}
+	}
+
+	return &Account{
+		Id:       "accountID",
+		Network:  &Network{},
+		Settings: &Settings{},
+		Peers: map[string]*nbpeer.Peer{
+			"peer1": peer("peer1", "web", "100.64.0.1"),
+			"peer2": peer("peer2", "db", "100.64.0.2"),
+			"peer3": peer("peer3", "router", "100.64.0.3"),
+		},
+		Groups: map[string]*Group{
+			"all":    {ID: "all", Name: "All", Peers: []string{"peer1", "peer2", "peer3"}},
+			"web":    {ID: "web", Name: "web", Peers: []string{"peer1"}},
+			"db":     {ID: "db", Name: "db", Peers: []string{"peer2"}},
+			"office": {ID: "office", Name: "office", Resources: []Resource{{ID: "resource1", Type: "subnet"}}},
+		},
+		Policies: []*Policy{
+			{
+				ID:      "policy1",
+				Enabled: true,
+				Rules: []*PolicyRule{{
+					ID:           "policy1",
+					PolicyID:     "policy1",
+					Enabled:      true,
+					Action:       PolicyTrafficActionAccept,
+					Protocol:     PolicyRuleProtocolTCP,
+					Ports:        []string{"5432"},
+					Sources:      []string{"web"},
+					Destinations: []string{"db"},
+				}},
+			},
+		},
+		NetworkResources: []*resourceTypes.NetworkResource{
+			{ID: "resource1", NetworkID: "network1", Name: "office", Type: resourceTypes.Subnet, Prefix: netip.MustParsePrefix("10.0.0.0/24"), Enabled: true},
+		},
+		NetworkRouters: []*routerTypes.NetworkRouter{
+			{ID: "router1", NetworkID: "network1", Peer: "peer3", Enabled: true},
+		},
+	}
+}
+
+func validatedPeers(account *Account) map[string]struct{} {
+	peers := make(map[string]struct{}, len(account.Peers))
+	for id := range account.Peers {
+		peers[id] = struct{}{}
+	}
+	return peers
+}
+
+func TestAccount_GetPolicyAccess(t *testing.T) {
+	account := setupPolicyEvaluationAccount()
+
+	access := account.GetPolicyAccess(context.Background(), validatedPeers(account))
+
+	assert.Equal(t, map[PolicyAccess]struct{}{
+		{
+			SourcePeerID:    "peer1",
+			SourcePeerName:  "web",
+			DestinationType: PolicyAccessDestinationPeer,
+			DestinationID:   "peer2",
+			DestinationName: "db",
+			Protocol:        PolicyRuleProtocolTCP,
+			Port:            5432,
+		}: {},
+	}, access)
+}
+
+func TestEvaluatePolicyAccess(t *testing.T) {
+	account := setupPolicyEvaluationAccount()
+	validated := validatedPeers(account)
+
+	proposed := account.Copy()
+	proposed.ApplyPolicyChanges([]*PolicyChange{
+		{PolicyID: "policy1"},
+		{Policy: &Policy{
+			ID:      "policy2",
+			Enabled: true,
+			Rules: []*PolicyRule{{
+				ID:           "policy2",
+				PolicyID:     "policy2",
+				Enabled:      true,
+				Action:       PolicyTrafficActionAccept,
+				Protocol:     PolicyRuleProtocolUDP,
+				PortRanges:   []RulePortRange{{Start: 1000, End: 2000}},
+				Sources:      []string{"db"},
+				Destinations: []string{"office"},
+			}},
+		}},
+	})
+	require.Len(t, account.Policies, 1, "changes shouldn't be applied to the original account")
+
+	evaluation := EvaluatePolicyAccess(account.GetPolicyAccess(context.Background(), validated), proposed.GetPolicyAccess(context.Background(), validated))
+
+	assert.Equal(t, []PolicyAccess{{
+		SourcePeerID:    "peer2",
+		SourcePeerName:  "db",
+		DestinationType: PolicyAccessDestinationResource,
+		DestinationID:   "resource1",
+		DestinationName: "office",
+		Protocol:        PolicyRuleProtocolUDP,
+		PortRange:       RulePortRange{Start: 1000, End: 2000},
+	}}, evaluation.Added)
+	assert.Equal(t, []PolicyAccess{{
+		SourcePeerID:    "peer1",
+		SourcePeerName:  "web",
+		DestinationType: PolicyAccessDestinationPeer,
+		DestinationID:   "peer2",
+		DestinationName: "db",
+		Protocol:        PolicyRuleProtocolTCP,
+		Port:            5432,
+	}}, evaluation.Removed)
+}
+
+func TestGetPolicyAccess_DropRulesExcludeConnections(t *testing.T) {
+	account := setupPolicyEvaluationAccount()
+	account.Policies = append(account.Policies, &Policy{
+		ID:      "policy2",
+		Enabled: true,
+		Rules: []*PolicyRule{{
+			ID:           "policy2",
+			PolicyID:     "policy2",
+			Enabled:      true,
+			Action:       PolicyTrafficActionDrop,
+			Protocol:     PolicyRuleProtocolTCP,
+			Ports:        []string{"5432"},
+			Sources:      []string{"web"},
+			Destinations: []string{"db"},
+		}},
+	})
+
+	assert.Empty(t, account.GetPolicyAccess(context.Background(), validatedPeers(account)))
+}
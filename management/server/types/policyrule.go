@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	"github.com/netbirdio/netbird/shared/management/proto"
 )
 
@@ -80,6 +82,9 @@ type PolicyRule struct {
 
 	// PortRanges a list of port ranges.
 	PortRanges []RulePortRange `gorm:"serializer:json"`
+
+	// Schedule optionally restricts the time in which the enabled rule is applied
+	Schedule *PolicyRuleSchedule `gorm:"serializer:json"`
 }
 
 // Copy returns a copy of a policy rule
@@ -99,6 +104,7 @@ func (pm *PolicyRule) Copy() *PolicyRule {
 		Protocol:            pm.Protocol,
 		Ports:               make([]string, len(pm.Ports)),
 		PortRanges:          make([]RulePortRange, len(pm.PortRanges)),
+		Schedule:            pm.Schedule.Copy(),
 	}
 	copy(rule.Destinations, pm.Destinations)
 	copy(rule.Sources, pm.Sources)
@@ -106,3 +112,8 @@ func (pm *PolicyRule) Copy() *PolicyRule {
 	copy(rule.PortRanges, pm.PortRanges)
 	return rule
 }
+
+// IsActive returns true if the rule is enabled and its schedule, if any, applies it at the given time
+func (pm *PolicyRule) IsActive(now time.Time) bool {
+	return pm.Enabled && pm.Schedule.IsActive(now)
+}
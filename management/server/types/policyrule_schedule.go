@@ -0,0 +1,293 @@
+package types
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/netbirdio/netbird/shared/management/http/api"
+)
+
+// scheduleWeekdays maps the day names accepted in schedule windows to time.Weekday
+var scheduleWeekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// PolicyRuleSchedule restricts the time in which an enabled policy rule is applied
+type PolicyRuleSchedule struct {
+	// Timezone IANA time zone name the windows are evaluated in, UTC when empty
+	Timezone string
+
+	// Windows recurring weekly windows in which the rule is applied, the rule is applied at any time when empty
+	Windows []ScheduleWindow
+
+	// StartsAt the rule isn't applied before this time when set
+	StartsAt *time.Time
+
+	// ExpiresAt the rule isn't applied from this time on when set
+	ExpiresAt *time.Time
+}
+
+// ScheduleWindow is a recurring daily time window
+type ScheduleWindow struct {
+	// Days week days (mon, tue, wed, thu, fri, sat, sun) the window starts on, every day when empty
+	Days []string
+
+	// Start time of the day the window opens at in HH:MM format
+	Start string
+
+	// End time of the day the window closes at in HH:MM format. Windows ending before they start close on the next day
+	End string
+}
+
+// Copy returns a copy of the schedule
+func (s *PolicyRuleSchedule) Copy() *PolicyRuleSchedule {
+	if s == nil {
+		return nil
+	}
+
+	schedule := &PolicyRuleSchedule{
+		Timezone: s.Timezone,
+		Windows:  make([]ScheduleWindow, len(s.Windows)),
+	}
+	for i, window := range s.Windows {
+		schedule.Windows[i] = ScheduleWindow{
+			Days:  slices.Clone(window.Days),
+			Start: window.Start,
+			End:   window.End,
+		}
+	}
+	if s.StartsAt != nil {
+		startsAt := *s.StartsAt
+		schedule.StartsAt = &startsAt
+	}
+	if s.ExpiresAt != nil {
+		expiresAt := *s.ExpiresAt
+		schedule.ExpiresAt = &expiresAt
+	}
+
+	return schedule
+}
+
+// ToAPIResponse converts the schedule to its API representation
+func (s *PolicyRuleSchedule) ToAPIResponse() *api.PolicyRuleSchedule {
+	if s == nil {
+		return nil
+	}
+
+	schedule := &api.PolicyRuleSchedule{
+		StartsAt:  s.StartsAt,
+		ExpiresAt: s.ExpiresAt,
+	}
+	if s.Timezone != "" {
+		timezone := s.Timezone
+		schedule.Timezone = &timezone
+	}
+	if len(s.Windows) != 0 {
+		windows := make([]api.ScheduleWindow, 0, len(s.Windows))
+		for _, window := range s.Windows {
+			apiWindow := api.ScheduleWindow{
+				Start: window.Start,
+				End:   window.End,
+			}
+			if len(window.Days) != 0 {
+				days := make([]api.ScheduleWindowDays, 0, len(window.Days))
+				for _, day := range window.Days {
+					days = append(days, api.ScheduleWindowDays(day))
+				}
+				apiWindow.Days = &days
+			}
+			windows = append(windows, apiWindow)
+		}
+		schedule.Windows = &windows
+	}
+
+	return schedule
+}
+
+// FromAPIRequest fills the schedule from its API representation
+func (s *PolicyRuleSchedule) FromAPIRequest(req *api.PolicyRuleSchedule) {
+	s.StartsAt = req.StartsAt
+	s.ExpiresAt = req.ExpiresAt
+	if req.Timezone != nil {
+		s.Timezone = *req.Timezone
+	}
+	if req.Windows != nil {
+		for _, window := range *req.Windows {
+			scheduleWindow := ScheduleWindow{
+				Start: window.Start,
+				End:   window.End,
+			}
+			if window.Days != nil {
+				for _, day := range *window.Days {
+					scheduleWindow.Days = append(scheduleWindow.Days, string(day))
+				}
+			}
+			s.Windows = append(s.Windows, scheduleWindow)
+		}
+	}
+}
+
+// Validate checks that the time zone, the windows and the absolute time range of the schedule are valid
+func (s *PolicyRuleSchedule) Validate() error {
+	if _, err := time.LoadLocation(s.Timezone); err != nil {
+		return fmt.Errorf("invalid time zone %s", s.Timezone)
+	}
+
+	if s.StartsAt != nil && s.ExpiresAt != nil && !s.ExpiresAt.After(*s.StartsAt) {
+		return fmt.Errorf("schedule expiration must be after its start")
+	}
+
+	for _, window := range s.Windows {
+		for _, day := range window.Days {
+			if _, ok := scheduleWeekdays[strings.ToLower(day)]; !ok {
+				return fmt.Errorf("invalid schedule window day %s", day)
+			}
+		}
+
+		start, err := parseTimeOfDay(window.Start)
+		if err != nil {
+			return err
+		}
+		end, err := parseTimeOfDay(window.End)
+		if err != nil {
+			return err
+		}
+		if start == end {
+			return fmt.Errorf("schedule window start and end can't be equal")
+		}
+	}
+
+	return nil
+}
+
+// IsExpired returns true if the schedule has an expiration time that has passed
+func (s *PolicyRuleSchedule) IsExpired(now time.Time) bool {
+	return s != nil && s.ExpiresAt != nil && !now.Before(*s.ExpiresAt)
+}
+
+// IsActive returns true if the rule should be applied at the given time
+func (s *PolicyRuleSchedule) IsActive(now time.Time) bool {
+	if s == nil {
+		return true
+	}
+
+	if s.StartsAt != nil && now.Before(*s.StartsAt) {
+		return false
+	}
+
+	if s.IsExpired(now) {
+		return false
+	}
+
+	if len(s.Windows) == 0 {
+		return true
+	}
+
+	loc := s.location()
+	for _, window := range s.Windows {
+		for _, openPeriod := range window.periods(now.In(loc), loc) {
+			if !now.Before(openPeriod[0]) && now.Before(openPeriod[1]) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// NextTransition returns the first time after now at which the schedule may switch between active and inactive
+func (s *PolicyRuleSchedule) NextTransition(now time.Time) (time.Time, bool) {
+	if s == nil || s.IsExpired(now) {
+		return time.Time{}, false
+	}
+
+	var next time.Time
+	consider := func(t time.Time) {
+		if t.After(now) && (next.IsZero() || t.Before(next)) {
+			next = t
+		}
+	}
+
+	if s.StartsAt != nil {
+		consider(*s.StartsAt)
+	}
+	if s.ExpiresAt != nil {
+		consider(*s.ExpiresAt)
+	}
+
+	loc := s.location()
+	for _, window := range s.Windows {
+		for _, openPeriod := range window.periods(now.In(loc), loc) {
+			consider(openPeriod[0])
+			consider(openPeriod[1])
+		}
+	}
+
+	return next, !next.IsZero()
+}
+
+func (s *PolicyRuleSchedule) location() *time.Location {
+	loc, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// periods returns the open periods of the window starting from the day before until a week after the given time
+func (w ScheduleWindow) periods(now time.Time, loc *time.Location) [][2]time.Time {
+	start, err := parseTimeOfDay(w.Start)
+	if err != nil {
+		return nil
+	}
+	end, err := parseTimeOfDay(w.End)
+	if err != nil {
+		return nil
+	}
+
+	var periods [][2]time.Time
+	for offset := -1; offset <= 7; offset++ {
+		day := time.Date(now.Year(), now.Month(), now.Day()+offset, 0, 0, 0, 0, loc)
+		if !w.onDay(day.Weekday()) {
+			continue
+		}
+
+		opensAt := time.Date(day.Year(), day.Month(), day.Day(), 0, start, 0, 0, loc)
+		closesAt := time.Date(day.Year(), day.Month(), day.Day(), 0, end, 0, 0, loc)
+		if end < start {
+			closesAt = time.Date(day.Year(), day.Month(), day.Day()+1, 0, end, 0, 0, loc)
+		}
+		periods = append(periods, [2]time.Time{opensAt, closesAt})
+	}
+
+	return periods
+}
+
+func (w ScheduleWindow) onDay(weekday time.Weekday) bool {
+	if len(w.Days) == 0 {
+		return true
+	}
+	for _, day := range w.Days {
+		if d, ok := scheduleWeekdays[strings.ToLower(day)]; ok && d == weekday {
+			return true
+		}
+	}
+	return false
+}
+
+// parseTimeOfDay returns the number of minutes since midnight of a time in HH:MM format
+func parseTimeOfDay(value string) (int, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid schedule window time %s, expected HH:MM", value)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
@@ -0,0 +1,113 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicyRuleSchedule_IsActive(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	officeHours := &PolicyRuleSchedule{
+		Timezone: "Europe/Berlin",
+		Windows: []ScheduleWindow{
+			{Days: []string{"mon", "tue", "wed", "thu", "fri"}, Start: "08:00", End: "18:00"},
+		},
+	}
+	nightShift := &PolicyRuleSchedule{
+		Windows: []ScheduleWindow{
+			{Days: []string{"fri"}, Start: "22:00", End: "06:00"},
+		},
+	}
+	startsAt := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	expiresAt := time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)
+	oneOff := &PolicyRuleSchedule{
+		StartsAt:  &startsAt,
+		ExpiresAt: &expiresAt,
+	}
+
+	tests := []struct {
+		name     string
+		schedule *PolicyRuleSchedule
+		now      time.Time
+		expected bool
+	}{
+		{"no schedule", nil, time.Now(), true},
+		{"weekday inside the window", officeHours, time.Date(2025, 3, 5, 9, 0, 0, 0, berlin), true},
+		{"weekday before the window", officeHours, time.Date(2025, 3, 5, 7, 59, 0, 0, berlin), false},
+		{"window end is exclusive", officeHours, time.Date(2025, 3, 5, 18, 0, 0, 0, berlin), false},
+		{"weekend", officeHours, time.Date(2025, 3, 8, 9, 0, 0, 0, berlin), false},
+		{"window evaluated in its time zone", officeHours, time.Date(2025, 3, 5, 7, 30, 0, 0, time.UTC), true},
+		{"overnight window on its start day", nightShift, time.Date(2025, 3, 7, 23, 0, 0, 0, time.UTC), true},
+		{"overnight window on the next day", nightShift, time.Date(2025, 3, 8, 5, 0, 0, 0, time.UTC), true},
+		{"overnight window closed", nightShift, time.Date(2025, 3, 8, 7, 0, 0, 0, time.UTC), false},
+		{"before start", oneOff, startsAt.Add(-time.Minute), false},
+		{"between start and expiration", oneOff, startsAt.Add(time.Hour), true},
+		{"expired", oneOff, expiresAt, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.schedule.IsActive(tt.now))
+		})
+	}
+}
+
+func TestPolicyRuleSchedule_NextTransition(t *testing.T) {
+	schedule := &PolicyRuleSchedule{
+		Windows: []ScheduleWindow{
+			{Days: []string{"mon", "tue", "wed", "thu", "fri"}, Start: "08:00", End: "18:00"},
+		},
+	}
+
+	// Friday evening, the window opens next on Monday
+	next, ok := schedule.NextTransition(time.Date(2025, 3, 7, 18, 0, 0, 0, time.UTC))
+	require.True(t, ok)
+	assert.Equal(t, time.Date(2025, 3, 10, 8, 0, 0, 0, time.UTC), next)
+
+	next, ok = schedule.NextTransition(time.Date(2025, 3, 10, 8, 0, 0, 0, time.UTC))
+	require.True(t, ok)
+	assert.Equal(t, time.Date(2025, 3, 10, 18, 0, 0, 0, time.UTC), next)
+
+	expiresAt := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	schedule.ExpiresAt = &expiresAt
+	next, ok = schedule.NextTransition(time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC))
+	require.True(t, ok)
+	assert.Equal(t, expiresAt, next)
+
+	_, ok = schedule.NextTransition(expiresAt)
+	assert.False(t, ok, "expired schedules have no transitions")
+}
+
+func TestPolicyRuleSchedule_Validate(t *testing.T) {
+	now := time.Now()
+	earlier := now.Add(-time.Hour)
+
+	tests := []struct {
+		name     string
+		schedule *PolicyRuleSchedule
+		valid    bool
+	}{
+		{"valid", &PolicyRuleSchedule{Timezone: "Europe/Berlin", Windows: []ScheduleWindow{{Days: []string{"Mon"}, Start: "08:00", End: "18:00"}}}, true},
+		{"unknown time zone", &PolicyRuleSchedule{Timezone: "Mars/Olympus"}, false},
+		{"unknown day", &PolicyRuleSchedule{Windows: []ScheduleWindow{{Days: []string{"someday"}, Start: "08:00", End: "18:00"}}}, false},
+		{"invalid time", &PolicyRuleSchedule{Windows: []ScheduleWindow{{Start: "8am", End: "18:00"}}}, false},
+		{"empty window", &PolicyRuleSchedule{Windows: []ScheduleWindow{{Start: "08:00", End: "08:00"}}}, false},
+		{"expiration before start", &PolicyRuleSchedule{StartsAt: &now, ExpiresAt: &earlier}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.schedule.Validate()
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
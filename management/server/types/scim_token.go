@@ -0,0 +1,96 @@
+package types
+
+import (
+	"crypto/sha256"
+	b64 "encoding/base64"
+	"fmt"
+	"hash/crc32"
+	"time"
+
+	"github.com/rs/xid"
+
+	"github.com/netbirdio/netbird/base62"
+)
+
+// SCIMTokenPrefix is the 4 char prefix for SCIM provisioning tokens
+const SCIMTokenPrefix = "nbs_"
+
+// SCIMToken authenticates an identity provider pushing users and groups to an account with SCIM.
+// Changes are made on behalf of the admin service user the token belongs to.
+type SCIMToken struct {
+	ID        string `gorm:"primaryKey"`
+	AccountID string `gorm:"index"`
+	Name      string
+	// UserID is the admin service user provisioning is done with
+	UserID      string
+	HashedToken string `gorm:"index"`
+	CreatedBy   string
+	CreatedAt   time.Time
+	LastUsed    *time.Time
+}
+
+// GetLastUsed returns the last time the token was used.
+func (t *SCIMToken) GetLastUsed() time.Time {
+	if t.LastUsed != nil {
+		return *t.LastUsed
+	}
+	return time.Time{}
+}
+
+// EventMeta returns activity event meta related to the token
+func (t *SCIMToken) EventMeta() map[string]any {
+	return map[string]any{"name": t.Name}
+}
+
+// SCIMTokenGenerated holds the new SCIMToken and the plain text version of it
+type SCIMTokenGenerated struct {
+	PlainToken string
+	SCIMToken
+}
+
+// CreateNewSCIMToken generates a new SCIMToken for the account acting as the given service user.
+// The token is returned in plain text once, only a hashed version of it is saved.
+func CreateNewSCIMToken(accountID, name, userID, createdBy string) (*SCIMTokenGenerated, error) {
+	hashedToken, plainToken, err := generateNewToken(SCIMTokenPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SCIMTokenGenerated{
+		SCIMToken: SCIMToken{
+			ID:          xid.New().String(),
+			AccountID:   accountID,
+			Name:        name,
+			UserID:      userID,
+			HashedToken: hashedToken,
+			CreatedBy:   createdBy,
+			CreatedAt:   time.Now().UTC(),
+		},
+		PlainToken: plainToken,
+	}, nil
+}
+
+// HashSCIMToken validates the structure of a plain SCIM token and returns its hashed version
+func HashSCIMToken(token string) (string, error) {
+	if len(token) != PATLength {
+		return "", fmt.Errorf("SCIM token has incorrect length")
+	}
+
+	if token[:len(SCIMTokenPrefix)] != SCIMTokenPrefix {
+		return "", fmt.Errorf("SCIM token has wrong prefix")
+	}
+	secret := token[len(SCIMTokenPrefix) : len(SCIMTokenPrefix)+PATSecretLength]
+	encodedChecksum := token[len(SCIMTokenPrefix)+PATSecretLength:]
+
+	verificationChecksum, err := base62.Decode(encodedChecksum)
+	if err != nil {
+		return "", fmt.Errorf("SCIM token checksum decoding failed: %w", err)
+	}
+
+	if crc32.ChecksumIEEE([]byte(secret)) != verificationChecksum {
+		return "", fmt.Errorf("SCIM token checksum does not match")
+	}
+
+	hashedToken := sha256.Sum256([]byte(token))
+	return b64.StdEncoding.EncodeToString(hashedToken[:]), nil
+}
@@ -52,6 +52,38 @@ type Settings struct {
 
 	// LazyConnectionEnabled indicates if the experimental feature is enabled or disabled
 	LazyConnectionEnabled bool `gorm:"default:false"`
+
+	// RelayRegionPins pins the peers of groups to the relay servers of regions
+	RelayRegionPins []RelayRegionPin `gorm:"serializer:json"`
+
+	// EventRetentionDays is the number of days the activity events are kept, the events are kept forever when it is 0
+	EventRetentionDays int
+
+	// EventArchiveEnabled archives the activity events before the retention purges them
+	EventArchiveEnabled bool
+}
+
+// RelayRegionPin offers the peers of the groups only the relay servers of the region
+type RelayRegionPin struct {
+	Region string
+	Groups []string
+}
+
+// RelayRegions returns the relay regions the peer of the groups is pinned to. The peer is not pinned if it is empty.
+func (s *Settings) RelayRegions(peerGroups []string) []string {
+	var regions []string
+	for _, pin := range s.RelayRegionPins {
+		if slices.Contains(regions, pin.Region) {
+			continue
+		}
+		for _, group := range pin.Groups {
+			if slices.Contains(peerGroups, group) {
+				regions = append(regions, pin.Region)
+				break
+			}
+		}
+	}
+	return regions
 }
 
 // Copy copies the Settings struct
@@ -72,6 +104,9 @@ func (s *Settings) Copy() *Settings {
 		LazyConnectionEnabled:           s.LazyConnectionEnabled,
 		DNSDomain:                       s.DNSDomain,
 		NetworkRange:                    s.NetworkRange,
+		RelayRegionPins:                 copyRelayRegionPins(s.RelayRegionPins),
+		EventRetentionDays:              s.EventRetentionDays,
+		EventArchiveEnabled:             s.EventArchiveEnabled,
 	}
 	if s.Extra != nil {
 		settings.Extra = s.Extra.Copy()
@@ -79,6 +114,17 @@ func (s *Settings) Copy() *Settings {
 	return settings
 }
 
+func copyRelayRegionPins(pins []RelayRegionPin) []RelayRegionPin {
+	if pins == nil {
+		return nil
+	}
+	copied := make([]RelayRegionPin, 0, len(pins))
+	for _, pin := range pins {
+		copied = append(copied, RelayRegionPin{Region: pin.Region, Groups: slices.Clone(pin.Groups)})
+	}
+	return copied
+}
+
 type ExtraSettings struct {
 	// PeerApprovalEnabled enables or disables the need for peers bo be approved by an administrator
 	PeerApprovalEnabled bool
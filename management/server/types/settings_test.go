@@ -0,0 +1,25 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSettings_RelayRegions(t *testing.T) {
+	settings := &Settings{
+		RelayRegionPins: []RelayRegionPin{
+			{Region: "ap", Groups: []string{"singapore", "sydney"}},
+			{Region: "eu", Groups: []string{"frankfurt"}},
+			{Region: "ap", Groups: []string{"tokyo"}},
+		},
+	}
+
+	assert.Equal(t, []string{"ap"}, settings.RelayRegions([]string{"all", "singapore", "tokyo"}))
+	assert.Equal(t, []string{"ap", "eu"}, settings.RelayRegions([]string{"frankfurt", "sydney"}))
+	assert.Empty(t, settings.RelayRegions([]string{"all"}))
+
+	copied := settings.Copy()
+	copied.RelayRegionPins[0].Groups[0] = "changed"
+	assert.Equal(t, "singapore", settings.RelayRegionPins[0].Groups[0])
+}
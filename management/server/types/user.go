@@ -24,6 +24,9 @@ const (
 
 	UserIssuedAPI         = "api"
 	UserIssuedIntegration = "integration"
+
+	// CustomUserRolePrefix prefixes the ID of an account defined role when it is assigned to a user
+	CustomUserRolePrefix = "custom:"
 )
 
 // StrRoleToUserRole returns UserRole for a given strRole or UserRoleUnknown if the specified role is unknown
@@ -42,16 +45,37 @@ func StrRoleToUserRole(strRole string) UserRole {
 	case "network_admin":
 		return UserRoleNetworkAdmin
 	default:
+		if role := UserRole(strRole); role.IsCustom() {
+			return role
+		}
 		return UserRoleUnknown
 	}
 }
 
+// NewCustomUserRole returns the UserRole referencing the account defined role with the given ID
+func NewCustomUserRole(roleID string) UserRole {
+	return UserRole(CustomUserRolePrefix + roleID)
+}
+
 // UserStatus is the status of a User
 type UserStatus string
 
 // UserRole is the role of a User
 type UserRole string
 
+// IsCustom returns true if the role references an account defined role
+func (r UserRole) IsCustom() bool {
+	return strings.HasPrefix(string(r), CustomUserRolePrefix) && len(r) > len(CustomUserRolePrefix)
+}
+
+// CustomRoleID returns the ID of the referenced account defined role or an empty string for built-in roles
+func (r UserRole) CustomRoleID() string {
+	if !r.IsCustom() {
+		return ""
+	}
+	return strings.TrimPrefix(string(r), CustomUserRolePrefix)
+}
+
 type UserInfo struct {
 	ID                   string                                     `json:"id"`
 	Email                string                                     `json:"email"`
@@ -79,6 +103,9 @@ type User struct {
 	NonDeletable bool
 	// ServiceUserName is only set if IsServiceUser is true
 	ServiceUserName string
+	// Email and Name are only set for users provisioned with SCIM, other users get them from the IdP
+	Email string
+	Name  string
 	// AutoGroups is a list of Group IDs to auto-assign to peers registered by this user
 	AutoGroups []string                        `gorm:"serializer:json"`
 	PATs       map[string]*PersonalAccessToken `gorm:"-"`
@@ -115,7 +142,8 @@ func (u *User) GetLastLogin() time.Time {
 	return time.Time{}
 }
 
-// HasAdminPower returns true if the user has admin or owner roles, false otherwise
+// HasAdminPower returns true if the user has admin or owner roles, false otherwise. Custom roles never have admin
+// power, whatever permissions they grant.
 func (u *User) HasAdminPower() bool {
 	return u.Role == UserRoleAdmin || u.Role == UserRoleOwner
 }
@@ -125,14 +153,14 @@ func (u *User) IsAdminOrServiceUser() bool {
 	return u.HasAdminPower() || u.IsServiceUser
 }
 
-// IsRegularUser checks if the user is a regular user.
+// IsRegularUser checks if the user is a regular user. Users with a custom role are regular users.
 func (u *User) IsRegularUser() bool {
 	return !u.HasAdminPower() && !u.IsServiceUser
 }
 
-// IsRestrictable checks whether a user is in a restrictable role.
+// IsRestrictable checks whether a user is in a restrictable role. Users with a custom role are restrictable.
 func (u *User) IsRestrictable() bool {
-	return u.Role == UserRoleUser || u.Role == UserRoleBillingAdmin
+	return u.Role == UserRoleUser || u.Role == UserRoleBillingAdmin || u.Role.IsCustom()
 }
 
 // ToUserInfo converts a User object to a UserInfo object.
@@ -143,10 +171,14 @@ func (u *User) ToUserInfo(userData *idp.UserData) (*UserInfo, error) {
 	}
 
 	if userData == nil {
+		name := u.ServiceUserName
+		if !u.IsServiceUser {
+			name = u.Name
+		}
 		return &UserInfo{
 			ID:              u.Id,
-			Email:           "",
-			Name:            u.ServiceUserName,
+			Email:           u.Email,
+			Name:            name,
 			Role:            string(u.Role),
 			AutoGroups:      u.AutoGroups,
 			Status:          string(UserStatusActive),
@@ -197,6 +229,8 @@ func (u *User) Copy() *User {
 		IsServiceUser:        u.IsServiceUser,
 		NonDeletable:         u.NonDeletable,
 		ServiceUserName:      u.ServiceUserName,
+		Email:                u.Email,
+		Name:                 u.Name,
 		PATs:                 pats,
 		Blocked:              u.Blocked,
 		PendingApproval:      u.PendingApproval,
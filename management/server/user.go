@@ -17,6 +17,7 @@ import (
 	nbpeer "github.com/netbirdio/netbird/management/server/peer"
 	"github.com/netbirdio/netbird/management/server/permissions/modules"
 	"github.com/netbirdio/netbird/management/server/permissions/operations"
+	"github.com/netbirdio/netbird/management/server/permissions/roles"
 	"github.com/netbirdio/netbird/management/server/store"
 	"github.com/netbirdio/netbird/management/server/types"
 	"github.com/netbirdio/netbird/management/server/users"
@@ -38,6 +39,18 @@ func (am *DefaultAccountManager) createServiceUser(ctx context.Context, accountI
 		return nil, status.NewServiceUserRoleInvalidError()
 	}
 
+	var initiatorUser *types.User
+	if initiatorUserID != activity.SystemInitiator {
+		initiatorUser, err = am.Store.GetUserByUserID(ctx, store.LockingStrengthNone, initiatorUserID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err = am.validateRoleAssignment(ctx, am.Store, accountID, initiatorUser, role); err != nil {
+		return nil, err
+	}
+
 	newUserID := uuid.New().String()
 	newUser := types.NewUser(newUserID, role, true, nonDeletable, serviceUserName, autoGroups, types.UserIssuedAPI)
 	newUser.AccountID = accountID
@@ -94,6 +107,10 @@ func (am *DefaultAccountManager) inviteNewUser(ctx context.Context, accountID, u
 		return nil, err
 	}
 
+	if err = am.validateRoleAssignment(ctx, am.Store, accountID, initiatorUser, types.StrRoleToUserRole(invite.Role)); err != nil {
+		return nil, err
+	}
+
 	inviterID := userID
 	if initiatorUser.IsServiceUser {
 		createdBy, err := am.Store.GetAccountCreatedBy(ctx, store.LockingStrengthNone, accountID)
@@ -640,14 +657,23 @@ func (am *DefaultAccountManager) processUserUpdate(ctx context.Context, transact
 		return false, nil, nil, nil, err
 	}
 
+	// a user added by the update has the role of the update as its old role
+	if update.Role != oldUser.Role || oldUser == update {
+		if err := am.validateRoleAssignment(ctx, transaction, accountID, initiatorUser, update.Role); err != nil {
+			return false, nil, nil, nil, err
+		}
+	}
+
 	// only auto groups, revoked status, and integration reference can be updated for now
 	updatedUser := oldUser.Copy()
 	updatedUser.Role = update.Role
 	updatedUser.Blocked = update.Blocked
 	updatedUser.AutoGroups = update.AutoGroups
-	// these two fields can't be set via API, only via direct call to the method
+	// these fields can't be set via API, only via direct call to the method
 	updatedUser.Issued = update.Issued
 	updatedUser.IntegrationReference = update.IntegrationReference
+	updatedUser.Email = update.Email
+	updatedUser.Name = update.Name
 
 	var transferredOwnerRole bool
 	result, err := handleOwnerRoleTransfer(ctx, transaction, initiatorUser, update)
@@ -738,6 +764,76 @@ func (am *DefaultAccountManager) getUserInfo(ctx context.Context, user *types.Us
 	return user.ToUserInfo(nil)
 }
 
+// validateCustomUserRole checks that a custom role assigned to a user exists in the account.
+func validateCustomUserRole(ctx context.Context, transaction store.Store, accountID string, role types.UserRole) error {
+	if !role.IsCustom() {
+		return nil
+	}
+
+	_, err := transaction.GetCustomRoleByID(ctx, store.LockingStrengthNone, accountID, role.CustomRoleID())
+	if err != nil {
+		if sErr, ok := status.FromError(err); ok && sErr.Type() == status.NotFound {
+			return status.Errorf(status.InvalidArgument, "role %s doesn't exist", role)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// validateRoleAssignment checks that the role assigned by the initiator exists and doesn't grant more than the
+// initiator is allowed to do. Only owners and admins can assign the owner, admin and custom roles.
+func (am *DefaultAccountManager) validateRoleAssignment(ctx context.Context, transaction store.Store, accountID string, initiatorUser *types.User, role types.UserRole) error {
+	if err := validateCustomUserRole(ctx, transaction, accountID, role); err != nil {
+		return err
+	}
+
+	if initiatorUser == nil || initiatorUser.HasAdminPower() {
+		return nil
+	}
+
+	if role == types.UserRoleOwner || role == types.UserRoleAdmin || role.IsCustom() {
+		return status.Errorf(status.PermissionDenied, "only owners and admins can assign the %s role", role)
+	}
+
+	granted, err := getUserRolePermissions(ctx, transaction, accountID, role)
+	if err != nil {
+		return err
+	}
+	held, err := getUserRolePermissions(ctx, transaction, accountID, initiatorUser.Role)
+	if err != nil {
+		return err
+	}
+
+	for module := range modules.All {
+		for _, op := range []operations.Operation{operations.Create, operations.Read, operations.Update, operations.Delete} {
+			if am.permissionsManager.ValidateRoleModuleAccess(ctx, accountID, granted, module, op) &&
+				!am.permissionsManager.ValidateRoleModuleAccess(ctx, accountID, held, module, op) {
+				return status.Errorf(status.PermissionDenied, "can't assign the %s role granting %s on %s", role, op, module)
+			}
+		}
+	}
+
+	return nil
+}
+
+// getUserRolePermissions returns the permissions of a built-in role or of an account defined role
+func getUserRolePermissions(ctx context.Context, transaction store.Store, accountID string, role types.UserRole) (roles.RolePermissions, error) {
+	if roleID := role.CustomRoleID(); roleID != "" {
+		customRole, err := transaction.GetCustomRoleByID(ctx, store.LockingStrengthNone, accountID, roleID)
+		if err != nil {
+			return roles.RolePermissions{}, err
+		}
+		return customRole.ToRolePermissions(), nil
+	}
+
+	rolePermissions, ok := roles.RolesMap[role]
+	if !ok {
+		return roles.RolePermissions{}, status.NewUserRoleNotFoundError(string(role))
+	}
+	return rolePermissions, nil
+}
+
 // validateUserUpdate validates the update operation for a user.
 func validateUserUpdate(groupsMap map[string]*types.Group, initiatorUser, oldUser, update *types.User) error {
 	if initiatorUser == nil {
@@ -760,9 +856,15 @@ func validateUserUpdate(groupsMap map[string]*types.Group, initiatorUser, oldUse
 	if initiatorUser.Role == types.UserRoleAdmin && update.Role == types.UserRoleOwner && update.Role != oldUser.Role {
 		return status.Errorf(status.PermissionDenied, "only owners can add owner role to other users")
 	}
+	if !initiatorUser.HasAdminPower() && oldUser.HasAdminPower() && (update.Role != oldUser.Role || update.IsBlocked() != oldUser.IsBlocked()) {
+		return status.Errorf(status.PermissionDenied, "only owners and admins can change the role or block owners and admins")
+	}
 	if oldUser.IsServiceUser && update.Role == types.UserRoleOwner {
 		return status.Errorf(status.PermissionDenied, "can't update a service user with owner role")
 	}
+	if oldUser.Role == types.UserRoleOwner && update.Role.IsCustom() {
+		return status.Errorf(status.PermissionDenied, "can't assign a custom role to the owner, transfer the owner role first")
+	}
 
 	for _, newGroupID := range update.AutoGroups {
 		group, ok := groupsMap[newGroupID]
@@ -906,14 +1008,14 @@ func (am *DefaultAccountManager) BuildUserInfosForAccount(ctx context.Context, a
 				return nil, err
 			}
 		} else {
-			name := ""
+			name := localUser.Name
 			if localUser.IsServiceUser {
 				name = localUser.ServiceUserName
 			}
 
 			info = &types.UserInfo{
 				ID:            localUser.Id,
-				Email:         "",
+				Email:         localUser.Email,
 				Name:          name,
 				Role:          string(localUser.Role),
 				AutoGroups:    localUser.AutoGroups,
@@ -1206,7 +1308,7 @@ func (am *DefaultAccountManager) GetCurrentUserInfo(ctx context.Context, userAut
 		Restricted: !userAuth.IsChild && user.IsRestrictable() && settings.RegularUsersViewBlocked,
 	}
 
-	permissions, err := am.permissionsManager.GetPermissionsByRole(ctx, user.Role)
+	permissions, err := am.permissionsManager.GetPermissionsByRole(ctx, accountID, user.Role)
 	if err == nil {
 		userWithPermissions.Permissions = permissions
 	}
@@ -14,6 +14,7 @@ import (
 	nbcontext "github.com/netbirdio/netbird/management/server/context"
 	"github.com/netbirdio/netbird/management/server/permissions"
 	"github.com/netbirdio/netbird/management/server/permissions/modules"
+	"github.com/netbirdio/netbird/management/server/permissions/operations"
 	"github.com/netbirdio/netbird/management/server/permissions/roles"
 	"github.com/netbirdio/netbird/management/server/users"
 	"github.com/netbirdio/netbird/management/server/util"
@@ -360,6 +361,8 @@ func TestUser_Copy(t *testing.T) {
 			ID:              0,
 			IntegrationType: "test",
 		},
+		Email: "user@example.com",
+		Name:  "User",
 	}
 
 	err := validateStruct(user)
@@ -1331,6 +1334,103 @@ func TestDefaultAccountManager_SaveUser(t *testing.T) {
 	}
 }
 
+func TestDefaultAccountManager_SaveUser_CustomRoleInitiator(t *testing.T) {
+	manager, err := createManager(t)
+	require.NoError(t, err)
+
+	ownerUserID := "ownerUser"
+	userManagerID := "userManager"
+	regularUserID := "regularUser"
+	adminUserID := "adminUser"
+
+	account, err := manager.GetOrCreateAccountByUser(context.Background(), ownerUserID, "netbird.io")
+	require.NoError(t, err)
+
+	userManagerRole := roles.NewCustomRole(account.Id, "user manager", "", roles.Permissions{
+		modules.Users: {operations.Read: true, operations.Create: true, operations.Update: true},
+	})
+	require.NoError(t, manager.Store.SaveCustomRole(context.Background(), userManagerRole))
+
+	strongerRole := roles.NewCustomRole(account.Id, "network manager", "", roles.Permissions{
+		modules.Users:    {operations.Read: true, operations.Create: true, operations.Update: true},
+		modules.Policies: {operations.Read: true, operations.Update: true},
+	})
+	require.NoError(t, manager.Store.SaveCustomRole(context.Background(), strongerRole))
+
+	tt := []struct {
+		name        string
+		update      *types.User
+		expectedErr bool
+	}{
+		{
+			name:        "Should_Fail_To_Promote_Itself_To_Admin",
+			update:      &types.User{Id: userManagerID, Role: types.UserRoleAdmin},
+			expectedErr: true,
+		},
+		{
+			name:        "Should_Fail_To_Promote_Itself_To_Owner",
+			update:      &types.User{Id: userManagerID, Role: types.UserRoleOwner},
+			expectedErr: true,
+		},
+		{
+			name:        "Should_Fail_To_Assign_Itself_A_Stronger_Custom_Role",
+			update:      &types.User{Id: userManagerID, Role: strongerRole.UserRole()},
+			expectedErr: true,
+		},
+		{
+			name:        "Should_Fail_To_Promote_Other_User_To_Admin",
+			update:      &types.User{Id: regularUserID, Role: types.UserRoleAdmin},
+			expectedErr: true,
+		},
+		{
+			name:        "Should_Fail_To_Assign_Other_User_Its_Own_Custom_Role",
+			update:      &types.User{Id: regularUserID, Role: userManagerRole.UserRole()},
+			expectedErr: true,
+		},
+		{
+			name:        "Should_Fail_To_Assign_A_Role_With_More_Permissions",
+			update:      &types.User{Id: regularUserID, Role: types.UserRoleNetworkAdmin},
+			expectedErr: true,
+		},
+		{
+			name:        "Should_Fail_To_Demote_Admin",
+			update:      &types.User{Id: adminUserID, Role: types.UserRoleUser},
+			expectedErr: true,
+		},
+		{
+			name:        "Should_Fail_To_Block_Admin",
+			update:      &types.User{Id: adminUserID, Role: types.UserRoleAdmin, Blocked: true},
+			expectedErr: true,
+		},
+		{
+			name:   "Should_Assign_A_Role_Without_More_Permissions",
+			update: &types.User{Id: regularUserID, Role: types.UserRoleUser, Blocked: true},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			account.Users[userManagerID] = types.NewUser(userManagerID, userManagerRole.UserRole(), false, false, "", []string{}, types.UserIssuedAPI)
+			account.Users[regularUserID] = types.NewRegularUser(regularUserID)
+			account.Users[adminUserID] = types.NewAdminUser(adminUserID)
+			require.NoError(t, manager.Store.SaveAccount(context.Background(), account))
+
+			updated, err := manager.SaveUser(context.Background(), account.Id, userManagerID, tc.update)
+			if tc.expectedErr {
+				require.Error(t, err)
+				sErr, ok := status.FromError(err)
+				require.True(t, ok)
+				assert.Equal(t, status.PermissionDenied, sErr.Type())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, string(tc.update.Role), updated.Role)
+			assert.Equal(t, tc.update.IsBlocked(), updated.IsBlocked)
+		})
+	}
+}
+
 func TestUserAccountPeersUpdate(t *testing.T) {
 	// account groups propagation is enabled
 	manager, account, peer1, peer2, peer3 := setupNetworkMapTest(t)
@@ -0,0 +1,48 @@
+package util
+
+import (
+	"fmt"
+	"net/netip"
+	"syscall"
+)
+
+// sharedAddressSpace is the carrier-grade NAT range, it contains the NetBird network
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// IsPrivateAddr returns true for the loopback, private, link-local, e.g. cloud metadata services, shared, multicast
+// and unspecified addresses
+func IsPrivateAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsLoopback() || addr.IsPrivate() || addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() || addr.IsMulticast() || addr.IsUnspecified() || sharedAddressSpace.Contains(addr)
+}
+
+// IsPrivateHost returns true for localhost and the private address literals. Other names are only known to be
+// private after the name resolution, so connections to them have to be checked with PrivateAddrDialControl too.
+func IsPrivateHost(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	addr, err := netip.ParseAddr(host)
+	return err == nil && IsPrivateAddr(addr)
+}
+
+// PrivateAddrDialControl returns a net.Dialer Control function rejecting the connections to private addresses unless
+// allowed returns true. The control runs after the name resolution, so private destinations can't be reached through
+// a public name.
+func PrivateAddrDialControl(allowed func() bool) func(network, address string, c syscall.RawConn) error {
+	return func(_, address string, _ syscall.RawConn) error {
+		if allowed() {
+			return nil
+		}
+
+		addrPort, err := netip.ParseAddrPort(address)
+		if err != nil {
+			return fmt.Errorf("parse address %s: %w", address, err)
+		}
+		if IsPrivateAddr(addrPort.Addr()) {
+			return fmt.Errorf("address %s is in a private network", addrPort.Addr())
+		}
+		return nil
+	}
+}
@@ -0,0 +1,251 @@
+package zones
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/rs/xid"
+
+	"github.com/netbirdio/netbird/management/server/account"
+	"github.com/netbirdio/netbird/management/server/activity"
+	"github.com/netbirdio/netbird/management/server/permissions"
+	"github.com/netbirdio/netbird/management/server/permissions/modules"
+	"github.com/netbirdio/netbird/management/server/permissions/operations"
+	"github.com/netbirdio/netbird/management/server/store"
+	"github.com/netbirdio/netbird/management/server/zones/types"
+	"github.com/netbirdio/netbird/shared/management/status"
+)
+
+type Manager interface {
+	GetAllZones(ctx context.Context, accountID, userID string) ([]*types.Zone, error)
+	GetZone(ctx context.Context, accountID, userID, zoneID string) (*types.Zone, error)
+	CreateZone(ctx context.Context, userID string, zone *types.Zone) (*types.Zone, error)
+	UpdateZone(ctx context.Context, userID string, zone *types.Zone) (*types.Zone, error)
+	DeleteZone(ctx context.Context, accountID, userID, zoneID string) error
+}
+
+type managerImpl struct {
+	store              store.Store
+	accountManager     account.Manager
+	permissionsManager permissions.Manager
+}
+
+func NewManager(store store.Store, accountManager account.Manager, permissionsManager permissions.Manager) Manager {
+	return &managerImpl{
+		store:              store,
+		accountManager:     accountManager,
+		permissionsManager: permissionsManager,
+	}
+}
+
+func (m *managerImpl) GetAllZones(ctx context.Context, accountID, userID string) ([]*types.Zone, error) {
+	if err := m.validatePermissions(ctx, accountID, userID, operations.Read); err != nil {
+		return nil, err
+	}
+
+	return m.store.GetAccountDNSZones(ctx, store.LockingStrengthNone, accountID)
+}
+
+func (m *managerImpl) GetZone(ctx context.Context, accountID, userID, zoneID string) (*types.Zone, error) {
+	if err := m.validatePermissions(ctx, accountID, userID, operations.Read); err != nil {
+		return nil, err
+	}
+
+	return m.store.GetDNSZoneByID(ctx, store.LockingStrengthNone, accountID, zoneID)
+}
+
+func (m *managerImpl) CreateZone(ctx context.Context, userID string, zone *types.Zone) (*types.Zone, error) {
+	if err := m.validatePermissions(ctx, zone.AccountID, userID, operations.Create); err != nil {
+		return nil, err
+	}
+
+	zone.ID = xid.New().String()
+	zone.Normalize()
+
+	var updateAccountPeers bool
+	err := m.store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		if err := m.validateZone(ctx, transaction, zone); err != nil {
+			return err
+		}
+
+		var err error
+		if zone.Enabled {
+			updateAccountPeers, err = anyGroupHasPeers(ctx, transaction, zone.AccountID, zone.Groups)
+			if err != nil {
+				return err
+			}
+		}
+
+		if err = transaction.SaveDNSZone(ctx, zone); err != nil {
+			return fmt.Errorf("failed to save DNS zone: %w", err)
+		}
+
+		return transaction.IncrementNetworkSerial(ctx, zone.AccountID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	m.accountManager.StoreEvent(ctx, userID, zone.ID, zone.AccountID, activity.DNSZoneCreated, zone.EventMeta())
+
+	if updateAccountPeers {
+		go m.accountManager.UpdateAccountPeers(ctx, zone.AccountID)
+	}
+
+	return zone, nil
+}
+
+func (m *managerImpl) UpdateZone(ctx context.Context, userID string, zone *types.Zone) (*types.Zone, error) {
+	if err := m.validatePermissions(ctx, zone.AccountID, userID, operations.Update); err != nil {
+		return nil, err
+	}
+
+	zone.Normalize()
+
+	var updateAccountPeers bool
+	err := m.store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		oldZone, err := transaction.GetDNSZoneByID(ctx, store.LockingStrengthUpdate, zone.AccountID, zone.ID)
+		if err != nil {
+			return err
+		}
+
+		if err = m.validateZone(ctx, transaction, zone); err != nil {
+			return err
+		}
+
+		if oldZone.Enabled {
+			updateAccountPeers, err = anyGroupHasPeers(ctx, transaction, zone.AccountID, oldZone.Groups)
+			if err != nil {
+				return err
+			}
+		}
+		if !updateAccountPeers && zone.Enabled {
+			updateAccountPeers, err = anyGroupHasPeers(ctx, transaction, zone.AccountID, zone.Groups)
+			if err != nil {
+				return err
+			}
+		}
+
+		if err = transaction.SaveDNSZone(ctx, zone); err != nil {
+			return fmt.Errorf("failed to save DNS zone: %w", err)
+		}
+
+		return transaction.IncrementNetworkSerial(ctx, zone.AccountID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	m.accountManager.StoreEvent(ctx, userID, zone.ID, zone.AccountID, activity.DNSZoneUpdated, zone.EventMeta())
+
+	if updateAccountPeers {
+		go m.accountManager.UpdateAccountPeers(ctx, zone.AccountID)
+	}
+
+	return zone, nil
+}
+
+func (m *managerImpl) DeleteZone(ctx context.Context, accountID, userID, zoneID string) error {
+	if err := m.validatePermissions(ctx, accountID, userID, operations.Delete); err != nil {
+		return err
+	}
+
+	var zone *types.Zone
+	var updateAccountPeers bool
+	err := m.store.ExecuteInTransaction(ctx, func(transaction store.Store) error {
+		var err error
+		zone, err = transaction.GetDNSZoneByID(ctx, store.LockingStrengthUpdate, accountID, zoneID)
+		if err != nil {
+			return err
+		}
+
+		if zone.Enabled {
+			updateAccountPeers, err = anyGroupHasPeers(ctx, transaction, accountID, zone.Groups)
+			if err != nil {
+				return err
+			}
+		}
+
+		if err = transaction.DeleteDNSZone(ctx, accountID, zoneID); err != nil {
+			return err
+		}
+
+		return transaction.IncrementNetworkSerial(ctx, accountID)
+	})
+	if err != nil {
+		return err
+	}
+
+	m.accountManager.StoreEvent(ctx, userID, zone.ID, accountID, activity.DNSZoneDeleted, zone.EventMeta())
+
+	if updateAccountPeers {
+		go m.accountManager.UpdateAccountPeers(ctx, accountID)
+	}
+
+	return nil
+}
+
+func (m *managerImpl) validatePermissions(ctx context.Context, accountID, userID string, operation operations.Operation) error {
+	allowed, err := m.permissionsManager.ValidateUserPermissions(ctx, accountID, userID, modules.Dns, operation)
+	if err != nil {
+		return status.NewPermissionValidationError(err)
+	}
+	if !allowed {
+		return status.NewPermissionDeniedError()
+	}
+	return nil
+}
+
+// validateZone checks the zone, its groups and that its domain doesn't collide with the peers domain or another zone
+func (m *managerImpl) validateZone(ctx context.Context, transaction store.Store, zone *types.Zone) error {
+	if err := zone.Validate(); err != nil {
+		return err
+	}
+
+	groups, err := transaction.GetGroupsByIDs(ctx, store.LockingStrengthNone, zone.AccountID, zone.Groups)
+	if err != nil {
+		return err
+	}
+	for _, id := range zone.Groups {
+		if _, found := groups[id]; !found {
+			return status.Errorf(status.InvalidArgument, "group id %s not found", id)
+		}
+	}
+
+	settings, err := transaction.GetAccountSettings(ctx, store.LockingStrengthNone, zone.AccountID)
+	if err != nil {
+		return err
+	}
+	if peersDomain := m.accountManager.GetDNSDomain(settings); strings.EqualFold(zone.Domain, peersDomain) {
+		return status.Errorf(status.InvalidArgument, "zone domain %s is used by the peers zone", zone.Domain)
+	}
+
+	zones, err := transaction.GetAccountDNSZones(ctx, store.LockingStrengthNone, zone.AccountID)
+	if err != nil {
+		return err
+	}
+	for _, other := range zones {
+		if other.ID != zone.ID && other.Domain == zone.Domain {
+			return status.Errorf(status.AlreadyExists, "zone with domain %s already exists", zone.Domain)
+		}
+	}
+
+	return nil
+}
+
+// anyGroupHasPeers checks if any of the given groups in the account have peers.
+func anyGroupHasPeers(ctx context.Context, transaction store.Store, accountID string, groupIDs []string) (bool, error) {
+	groups, err := transaction.GetGroupsByIDs(ctx, store.LockingStrengthNone, accountID, groupIDs)
+	if err != nil {
+		return false, err
+	}
+
+	for _, group := range groups {
+		if group.HasPeers() {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,297 @@
+package types
+
+import (
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+
+	"github.com/miekg/dns"
+	"github.com/rs/xid"
+
+	nbdns "github.com/netbirdio/netbird/dns"
+	"github.com/netbirdio/netbird/shared/management/http/api"
+	"github.com/netbirdio/netbird/shared/management/status"
+)
+
+const (
+	// ApexRecordName is the record name that refers to the zone domain itself
+	ApexRecordName = "@"
+	// DefaultRecordTTL is used for records without a TTL
+	DefaultRecordTTL = 300
+
+	maxTXTChunkLength = 255
+)
+
+// supportedRecordTypes lists the record types that can be defined in a zone
+var supportedRecordTypes = map[string]uint16{
+	"A":     dns.TypeA,
+	"AAAA":  dns.TypeAAAA,
+	"CNAME": dns.TypeCNAME,
+	"TXT":   dns.TypeTXT,
+	"SRV":   dns.TypeSRV,
+	"MX":    dns.TypeMX,
+	"PTR":   dns.TypePTR,
+}
+
+// Zone is an account managed DNS zone that is resolved locally by the peers of its distribution groups
+type Zone struct {
+	ID          string `gorm:"primaryKey"`
+	AccountID   string `gorm:"index"`
+	Name        string
+	Description string
+	Domain      string
+	Enabled     bool
+	// Groups are the distribution groups of peers that resolve the zone
+	Groups  []string `gorm:"serializer:json"`
+	Records []Record `gorm:"serializer:json"`
+}
+
+// Record is a single resource record of a zone
+type Record struct {
+	// Name is relative to the zone domain, ApexRecordName refers to the domain itself
+	Name string
+	// Type is the record type mnemonic, e.g. A or SRV
+	Type string
+	TTL  int
+	// Value is the record data in zone file presentation format, e.g. "10 mail.example.com." for MX records.
+	// TXT values are the plain text without quotes.
+	Value string
+}
+
+func NewZone(accountID, name, description, domain string, enabled bool, groups []string, records []Record) *Zone {
+	return &Zone{
+		ID:          xid.New().String(),
+		AccountID:   accountID,
+		Name:        name,
+		Description: description,
+		Domain:      domain,
+		Enabled:     enabled,
+		Groups:      groups,
+		Records:     records,
+	}
+}
+
+// TableName returns the name of the table for the Zone model in the database.
+func (*Zone) TableName() string {
+	return "dns_zones"
+}
+
+func (z *Zone) FromAPIRequest(req *api.DNSZoneRequest) {
+	z.Name = req.Name
+	z.Description = ""
+	if req.Description != nil {
+		z.Description = *req.Description
+	}
+	z.Domain = req.Domain
+	z.Enabled = req.Enabled
+	z.Groups = req.Groups
+
+	z.Records = make([]Record, 0, len(req.Records))
+	for _, record := range req.Records {
+		ttl := DefaultRecordTTL
+		if record.Ttl != nil {
+			ttl = *record.Ttl
+		}
+		z.Records = append(z.Records, Record{
+			Name:  record.Name,
+			Type:  string(record.Type),
+			TTL:   ttl,
+			Value: record.Value,
+		})
+	}
+}
+
+func (z *Zone) ToAPIResponse() *api.DNSZone {
+	records := make([]api.DNSRecord, 0, len(z.Records))
+	for _, record := range z.Records {
+		ttl := record.TTL
+		records = append(records, api.DNSRecord{
+			Name:  record.Name,
+			Type:  api.DNSRecordType(record.Type),
+			Ttl:   &ttl,
+			Value: record.Value,
+		})
+	}
+
+	return &api.DNSZone{
+		Id:          z.ID,
+		Name:        z.Name,
+		Description: &z.Description,
+		Domain:      z.Domain,
+		Enabled:     z.Enabled,
+		Groups:      z.Groups,
+		Records:     records,
+	}
+}
+
+// Copy returns a copy of the zone.
+func (z *Zone) Copy() *Zone {
+	return &Zone{
+		ID:          z.ID,
+		AccountID:   z.AccountID,
+		Name:        z.Name,
+		Description: z.Description,
+		Domain:      z.Domain,
+		Enabled:     z.Enabled,
+		Groups:      slices.Clone(z.Groups),
+		Records:     slices.Clone(z.Records),
+	}
+}
+
+func (z *Zone) EventMeta() map[string]any {
+	return map[string]any{"name": z.Name, "domain": z.Domain}
+}
+
+// Normalize lowercases the domain and record names and sets the default TTL of records without one
+func (z *Zone) Normalize() {
+	z.Domain = strings.ToLower(strings.TrimSuffix(strings.TrimSpace(z.Domain), "."))
+	for i := range z.Records {
+		record := &z.Records[i]
+		record.Name = strings.ToLower(strings.TrimSuffix(strings.TrimSpace(record.Name), "."))
+		if record.Name == "" {
+			record.Name = ApexRecordName
+		}
+		record.Type = strings.ToUpper(record.Type)
+		if record.TTL == 0 {
+			record.TTL = DefaultRecordTTL
+		}
+	}
+}
+
+// Validate checks the zone domain and that every record can be served
+func (z *Zone) Validate() error {
+	if z.Name == "" {
+		return status.Errorf(status.InvalidArgument, "zone name shouldn't be empty")
+	}
+
+	if _, ok := dns.IsDomainName(z.Domain); !ok || z.Domain == "" || strings.HasPrefix(z.Domain, "*") {
+		return status.Errorf(status.InvalidArgument, "invalid zone domain %q", z.Domain)
+	}
+
+	if len(z.Groups) == 0 {
+		return status.Errorf(status.InvalidArgument, "zone should have at least one distribution group")
+	}
+
+	namesWithCNAME := make(map[string]struct{})
+	namesWithOther := make(map[string]struct{})
+	for _, record := range z.Records {
+		if err := record.validate(z.Domain); err != nil {
+			return status.Errorf(status.InvalidArgument, "invalid %s record %q: %v", record.Type, record.Name, err)
+		}
+
+		if record.Type == "CNAME" {
+			if record.Name == ApexRecordName {
+				return status.Errorf(status.InvalidArgument, "CNAME records aren't allowed at the zone apex")
+			}
+			if _, ok := namesWithCNAME[record.Name]; ok {
+				return status.Errorf(status.InvalidArgument, "record %q has more than one CNAME record", record.Name)
+			}
+			namesWithCNAME[record.Name] = struct{}{}
+		} else {
+			namesWithOther[record.Name] = struct{}{}
+		}
+	}
+
+	for name := range namesWithCNAME {
+		if _, ok := namesWithOther[name]; ok {
+			return status.Errorf(status.InvalidArgument, "record %q can't have a CNAME record and other records", name)
+		}
+	}
+
+	return nil
+}
+
+func (r Record) validate(domain string) error {
+	if _, ok := supportedRecordTypes[r.Type]; !ok {
+		return fmt.Errorf("unsupported record type")
+	}
+
+	if r.TTL < 0 {
+		return fmt.Errorf("TTL shouldn't be negative")
+	}
+
+	if r.Name != ApexRecordName {
+		if _, ok := dns.IsDomainName(r.Name); !ok || strings.Contains(r.Name, "*") {
+			return fmt.Errorf("invalid record name")
+		}
+	}
+
+	switch r.Type {
+	case "A":
+		ip, err := netip.ParseAddr(r.Value)
+		if err != nil || !ip.Is4() {
+			return fmt.Errorf("value should be an IPv4 address")
+		}
+	case "AAAA":
+		ip, err := netip.ParseAddr(r.Value)
+		if err != nil || !ip.Is6() || ip.Is4In6() {
+			return fmt.Errorf("value should be an IPv6 address")
+		}
+	case "TXT":
+		if r.Value == "" {
+			return fmt.Errorf("value shouldn't be empty")
+		}
+	}
+
+	simpleRecord := r.toSimpleRecord(domain)
+	if _, err := dns.NewRR(simpleRecord.String()); err != nil {
+		return fmt.Errorf("parse value: %w", err)
+	}
+
+	return nil
+}
+
+// FQDN returns the fully qualified name of the record in the zone domain
+func (r Record) FQDN(domain string) string {
+	if r.Name == ApexRecordName || r.Name == "" {
+		return dns.Fqdn(domain)
+	}
+	return dns.Fqdn(r.Name + "." + domain)
+}
+
+func (r Record) toSimpleRecord(domain string) nbdns.SimpleRecord {
+	value := r.Value
+	switch r.Type {
+	case "TXT":
+		value = quoteTXT(value)
+	case "CNAME", "PTR":
+		value = dns.Fqdn(value)
+	}
+
+	return nbdns.SimpleRecord{
+		Name:  r.FQDN(domain),
+		Type:  int(supportedRecordTypes[r.Type]),
+		Class: nbdns.DefaultClass,
+		TTL:   r.TTL,
+		RData: value,
+	}
+}
+
+// quoteTXT splits the text into quoted character strings of the maximum allowed length
+func quoteTXT(text string) string {
+	var chunks []string
+	for len(text) > maxTXTChunkLength {
+		chunks = append(chunks, text[:maxTXTChunkLength])
+		text = text[maxTXTChunkLength:]
+	}
+	chunks = append(chunks, text)
+
+	for i, chunk := range chunks {
+		chunk = strings.ReplaceAll(chunk, `\`, `\\`)
+		chunks[i] = `"` + strings.ReplaceAll(chunk, `"`, `\"`) + `"`
+	}
+	return strings.Join(chunks, " ")
+}
+
+// ToCustomZone returns the zone in the format that is sent to peers
+func (z *Zone) ToCustomZone() nbdns.CustomZone {
+	customZone := nbdns.CustomZone{
+		Domain:  dns.Fqdn(z.Domain),
+		Records: make([]nbdns.SimpleRecord, 0, len(z.Records)),
+	}
+	for _, record := range z.Records {
+		customZone.Records = append(customZone.Records, record.toSimpleRecord(z.Domain))
+	}
+	return customZone
+}
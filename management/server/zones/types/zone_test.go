@@ -0,0 +1,129 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	nbdns "github.com/netbirdio/netbird/dns"
+)
+
+func newTestZone(records ...Record) *Zone {
+	zone := NewZone("account", "corp", "", "Corp.Internal.", true, []string{"group"}, records)
+	zone.Normalize()
+	return zone
+}
+
+func TestZone_Validate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		records []Record
+		wantErr bool
+	}{
+		{
+			name: "all supported types",
+			records: []Record{
+				{Name: "@", Type: "TXT", Value: `v=spf1 include:"example.com" -all`},
+				{Name: "@", Type: "MX", Value: "10 mail.corp.internal."},
+				{Name: "www", Type: "A", Value: "100.64.0.10"},
+				{Name: "www", Type: "aaaa", Value: "fd00::10"},
+				{Name: "web", Type: "CNAME", Value: "www.corp.internal"},
+				{Name: "_sip._tcp", Type: "SRV", Value: "10 5 5060 sip.corp.internal."},
+				{Name: "10.0", Type: "PTR", Value: "www.corp.internal"},
+			},
+		},
+		{
+			name:    "unsupported type",
+			records: []Record{{Name: "@", Type: "NS", Value: "ns.corp.internal."}},
+			wantErr: true,
+		},
+		{
+			name:    "IPv6 address in A record",
+			records: []Record{{Name: "www", Type: "A", Value: "fd00::10"}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid MX value",
+			records: []Record{{Name: "@", Type: "MX", Value: "mail.corp.internal."}},
+			wantErr: true,
+		},
+		{
+			name:    "wildcard name",
+			records: []Record{{Name: "*", Type: "A", Value: "100.64.0.10"}},
+			wantErr: true,
+		},
+		{
+			name:    "CNAME at the apex",
+			records: []Record{{Name: "@", Type: "CNAME", Value: "www.corp.internal"}},
+			wantErr: true,
+		},
+		{
+			name: "CNAME with other records",
+			records: []Record{
+				{Name: "www", Type: "CNAME", Value: "web.corp.internal"},
+				{Name: "www", Type: "TXT", Value: "web"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := newTestZone(tc.records...).Validate()
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestZone_ValidateDomain(t *testing.T) {
+	zone := newTestZone()
+	zone.Domain = "*.corp.internal"
+	assert.Error(t, zone.Validate())
+
+	zone.Domain = ""
+	assert.Error(t, zone.Validate())
+
+	zone.Domain = "corp.internal"
+	zone.Groups = nil
+	assert.Error(t, zone.Validate(), "zone should have distribution groups")
+}
+
+func TestZone_ToCustomZone(t *testing.T) {
+	longText := strings.Repeat("a", 300)
+	zone := newTestZone(
+		Record{Name: "", Type: "txt", Value: longText},
+		Record{Name: "web", Type: "CNAME", TTL: 60, Value: "www.corp.internal"},
+		Record{Name: "_sip._tcp", Type: "SRV", Value: "10 5 5060 sip.corp.internal."},
+	)
+	require.NoError(t, zone.Validate())
+
+	customZone := zone.ToCustomZone()
+	assert.Equal(t, "corp.internal.", customZone.Domain)
+	require.Len(t, customZone.Records, 3)
+
+	assert.Equal(t, nbdns.SimpleRecord{
+		Name: "web.corp.internal.", Type: int(dns.TypeCNAME), Class: nbdns.DefaultClass, TTL: 60, RData: "www.corp.internal.",
+	}, customZone.Records[1])
+
+	rr, err := dns.NewRR(customZone.Records[0].String())
+	require.NoError(t, err)
+	txt, ok := rr.(*dns.TXT)
+	require.True(t, ok)
+	assert.Equal(t, "corp.internal.", txt.Hdr.Name)
+	assert.Equal(t, uint32(DefaultRecordTTL), txt.Hdr.Ttl)
+	assert.Equal(t, longText, strings.Join(txt.Txt, ""), "long texts should be split into character strings")
+
+	rr, err = dns.NewRR(customZone.Records[2].String())
+	require.NoError(t, err)
+	srv, ok := rr.(*dns.SRV)
+	require.True(t, ok)
+	assert.Equal(t, "_sip._tcp.corp.internal.", srv.Hdr.Name)
+	assert.Equal(t, uint16(5060), srv.Port)
+}
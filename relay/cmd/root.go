@@ -20,6 +20,8 @@ import (
 	"github.com/netbirdio/netbird/encryption"
 	"github.com/netbirdio/netbird/relay/healthcheck"
 	"github.com/netbirdio/netbird/relay/server"
+	"github.com/netbirdio/netbird/relay/server/cluster"
+	"github.com/netbirdio/netbird/relay/server/limiter"
 	"github.com/netbirdio/netbird/shared/relay/auth"
 	"github.com/netbirdio/netbird/signal/metrics"
 	"github.com/netbirdio/netbird/util"
@@ -43,6 +45,19 @@ type Config struct {
 	LogLevel                 string
 	LogFile                  string
 	HealthcheckListenAddress string
+	// ClusterListenAddress enables the clustering with the other relays and is the address of the inter-relay listener
+	ClusterListenAddress string
+	// ClusterAdvertiseAddress is the host:port address the other relays reach the inter-relay listener on
+	ClusterAdvertiseAddress string
+	ClusterMembers          []string
+	ClusterSecret           string
+	// PeerRateLimit and the other limits are in bytes, zero disables the limit
+	PeerRateLimit       int64
+	PeerBurst           int64
+	AccountRateLimit    int64
+	AccountBurst        int64
+	PeerMonthlyQuota    int64
+	AccountMonthlyQuota int64
 }
 
 func (c Config) Validate() error {
@@ -52,9 +67,21 @@ func (c Config) Validate() error {
 	if c.AuthSecret == "" {
 		return fmt.Errorf("auth secret is required")
 	}
+	if c.HasCluster() {
+		if c.ClusterAdvertiseAddress == "" {
+			return fmt.Errorf("cluster advertise address is required")
+		}
+		if c.ClusterSecret == "" {
+			return fmt.Errorf("cluster secret is required")
+		}
+	}
 	return nil
 }
 
+func (c Config) HasCluster() bool {
+	return c.ClusterListenAddress != ""
+}
+
 func (c Config) HasCertConfig() bool {
 	return c.TlsCertFile != "" && c.TlsKeyFile != ""
 }
@@ -91,6 +118,16 @@ func init() {
 	rootCmd.PersistentFlags().StringVar(&cobraConfig.LogLevel, "log-level", "info", "log level")
 	rootCmd.PersistentFlags().StringVar(&cobraConfig.LogFile, "log-file", "console", "log file")
 	rootCmd.PersistentFlags().StringVarP(&cobraConfig.HealthcheckListenAddress, "health-listen-address", "H", ":9000", "listen address of healthcheck server")
+	rootCmd.PersistentFlags().StringVar(&cobraConfig.ClusterListenAddress, "cluster-listen-address", "", "listen address of the inter-relay links. Enables the clustering with the relays of the cluster members")
+	rootCmd.PersistentFlags().StringVar(&cobraConfig.ClusterAdvertiseAddress, "cluster-advertise-address", "", "host:port address the other relays of the cluster reach the inter-relay listener of this relay on")
+	rootCmd.PersistentFlags().StringSliceVar(&cobraConfig.ClusterMembers, "cluster-members", nil, "advertise addresses of the other relays of the cluster")
+	rootCmd.PersistentFlags().StringVar(&cobraConfig.ClusterSecret, "cluster-secret", "", "shared secret that authenticates the inter-relay links")
+	rootCmd.PersistentFlags().Int64Var(&cobraConfig.PeerRateLimit, "peer-rate-limit", 0, "bytes per second a peer can send through the relay. 0 disables the limit")
+	rootCmd.PersistentFlags().Int64Var(&cobraConfig.PeerBurst, "peer-burst", 0, "bytes a peer can send at once above the rate limit. Defaults to one second of traffic")
+	rootCmd.PersistentFlags().Int64Var(&cobraConfig.AccountRateLimit, "account-rate-limit", 0, "bytes per second the peers of an account can send through this relay. Applies to the peers with account scoped relay tokens. 0 disables the limit")
+	rootCmd.PersistentFlags().Int64Var(&cobraConfig.AccountBurst, "account-burst", 0, "bytes the peers of an account can send at once above the rate limit. Defaults to one second of traffic")
+	rootCmd.PersistentFlags().Int64Var(&cobraConfig.PeerMonthlyQuota, "peer-monthly-quota", 0, "bytes a peer can send through this relay in a calendar month (UTC). 0 disables the quota")
+	rootCmd.PersistentFlags().Int64Var(&cobraConfig.AccountMonthlyQuota, "account-monthly-quota", 0, "bytes the peers of an account can send through this relay in a calendar month (UTC). 0 disables the quota")
 
 	setFlagsFromEnvVars(rootCmd)
 }
@@ -153,6 +190,28 @@ func execute(cmd *cobra.Command, args []string) error {
 		ExposedAddress: cobraConfig.ExposedAddress,
 		AuthValidator:  authenticator,
 		TLSSupport:     tlsSupport,
+		Limits: limiter.Config{
+			PeerRate:            cobraConfig.PeerRateLimit,
+			PeerBurst:           cobraConfig.PeerBurst,
+			AccountRate:         cobraConfig.AccountRateLimit,
+			AccountBurst:        cobraConfig.AccountBurst,
+			PeerMonthlyQuota:    cobraConfig.PeerMonthlyQuota,
+			AccountMonthlyQuota: cobraConfig.AccountMonthlyQuota,
+		},
+	}
+
+	if cobraConfig.HasCluster() {
+		relayCluster, err := cluster.New(cluster.Config{
+			NodeID:        cobraConfig.ClusterAdvertiseAddress,
+			ListenAddress: cobraConfig.ClusterListenAddress,
+			Members:       cobraConfig.ClusterMembers,
+			Secret:        cobraConfig.ClusterSecret,
+		})
+		if err != nil {
+			log.Debugf("failed to create relay cluster: %v", err)
+			return fmt.Errorf("failed to create relay cluster: %v", err)
+		}
+		cfg.Cluster = relayCluster
 	}
 
 	srv, err := server.NewServer(cfg)
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 )
 
@@ -18,9 +19,14 @@ type Metrics struct {
 
 	TransferBytesSent  metric.Int64Counter
 	TransferBytesRecv  metric.Int64Counter
+	ClusterBytesSent   metric.Int64Counter
+	ClusterBytesRecv   metric.Int64Counter
 	AuthenticationTime metric.Float64Histogram
 	PeerStoreTime      metric.Float64Histogram
 	peerReconnections  metric.Int64Counter
+	clusterFailures    metric.Int64Counter
+	limitedBytes       metric.Int64Counter
+	limitedMessages    metric.Int64Counter
 	peers              metric.Int64UpDownCounter
 	peerActivityChan   chan string
 	peerLastActive     map[string]time.Time
@@ -43,6 +49,41 @@ func NewMetrics(ctx context.Context, meter metric.Meter) (*Metrics, error) {
 		return nil, err
 	}
 
+	clusterBytesSent, err := meter.Int64Counter("relay_cluster_forwarded_bytes_total",
+		metric.WithDescription("Total number of bytes forwarded to peers connected to other relay nodes"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterBytesRecv, err := meter.Int64Counter("relay_cluster_received_bytes_total",
+		metric.WithDescription("Total number of bytes received from other relay nodes for the local peers"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterFailures, err := meter.Int64Counter("relay_cluster_forward_failures_total",
+		metric.WithDescription("Total number of transport messages that couldn't be forwarded to other relay nodes"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	limitedBytes, err := meter.Int64Counter("relay_limited_bytes_total",
+		metric.WithDescription("Total number of bytes dropped because of the rate limits or the monthly quotas"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	limitedMessages, err := meter.Int64Counter("relay_limited_messages_total",
+		metric.WithDescription("Total number of transport messages dropped because of the rate limits or the monthly quotas"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	peers, err := meter.Int64UpDownCounter("relay_peers",
 		metric.WithDescription("Number of connected peers"),
 	)
@@ -91,10 +132,15 @@ func NewMetrics(ctx context.Context, meter metric.Meter) (*Metrics, error) {
 		Meter:              meter,
 		TransferBytesSent:  bytesSent,
 		TransferBytesRecv:  bytesRecv,
+		ClusterBytesSent:   clusterBytesSent,
+		ClusterBytesRecv:   clusterBytesRecv,
 		AuthenticationTime: authTime,
 		PeerStoreTime:      peerStoreTime,
 		peers:              peers,
 		peerReconnections:  peerReconnections,
+		clusterFailures:    clusterFailures,
+		limitedBytes:       limitedBytes,
+		limitedMessages:    limitedMessages,
 
 		ctx:              ctx,
 		peerActivityChan: make(chan string, 10),
@@ -150,6 +196,18 @@ func (m *Metrics) RecordPeerReconnection() {
 	m.peerReconnections.Add(m.ctx, 1)
 }
 
+// RecordClusterForwardFailure counts a transport message that couldn't be forwarded to another relay node
+func (m *Metrics) RecordClusterForwardFailure() {
+	m.clusterFailures.Add(m.ctx, 1)
+}
+
+// RecordLimitedMessage counts a transport message dropped because of a limit, the reason is the limit it hit
+func (m *Metrics) RecordLimitedMessage(reason string, size int) {
+	attrs := metric.WithAttributes(attribute.String("reason", reason))
+	m.limitedMessages.Add(m.ctx, 1, attrs)
+	m.limitedBytes.Add(m.ctx, int64(size), attrs)
+}
+
 // PeerActivity increases the active connections
 func (m *Metrics) PeerActivity(peerID string) {
 	select {
@@ -0,0 +1,416 @@
+// Package cluster lets multiple relay instances serve the peers as one relay. The nodes share which node every peer is
+// connected to and forward the transport messages to the node of the destination peer over authenticated inter-relay
+// links. Every frame on the links carries a MAC keyed by the shared secret and the handshake, the frames aren't
+// encrypted as the transport messages are encrypted end to end by the peers.
+package cluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/shared/relay/messages"
+)
+
+const (
+	dialTimeout             = 5 * time.Second
+	memberReconnectInterval = 5 * time.Second
+)
+
+// ErrNoLink is returned when the node isn't linked yet, the link is being established in the background
+var ErrNoLink = errors.New("no link to relay node")
+
+// TransportHandler delivers a transport message forwarded by another node to the local destination peer
+type TransportHandler func(peerID messages.PeerID, msg []byte)
+
+type Config struct {
+	// NodeID identifies the node in the cluster. It is the host:port address that the other nodes reach the
+	// inter-relay listener of this node on.
+	NodeID string
+	// ListenAddress is the address of the inter-relay listener
+	ListenAddress string
+	// Members are the node IDs of the other nodes. The node keeps links to them, links to other nodes are established
+	// on demand when the presence backend points to them.
+	Members []string
+	// Secret authenticates the inter-relay links, it has to be the same on every node
+	Secret string
+	// Presence is the presence backend. If it is not set, the presence is shared over the links with the members.
+	Presence Presence
+}
+
+func (c *Config) validate() error {
+	if c.NodeID == "" {
+		return fmt.Errorf("node ID is required")
+	}
+	if len(c.NodeID) > maxNodeIDLen {
+		return fmt.Errorf("node ID is too long")
+	}
+	if _, _, err := net.SplitHostPort(c.NodeID); err != nil {
+		return fmt.Errorf("node ID should be a host:port address: %w", err)
+	}
+	if c.ListenAddress == "" {
+		return fmt.Errorf("listen address is required")
+	}
+	if c.Secret == "" {
+		return fmt.Errorf("secret is required")
+	}
+	return nil
+}
+
+// Cluster is the membership of the local relay node in a cluster of relays
+type Cluster struct {
+	nodeID        string
+	listenAddress string
+	members       []string
+	secret        []byte
+	presence      Presence
+	linked        linkedPresence
+
+	onTransport TransportHandler
+	listener    net.Listener
+
+	linksMu sync.RWMutex
+	links   map[string]*link
+	dialing map[string]struct{}
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// New creates the cluster membership of the local node. The links are established by Start.
+func New(config Config) (*Cluster, error) {
+	if err := config.validate(); err != nil {
+		return nil, fmt.Errorf("invalid cluster config: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	c := &Cluster{
+		nodeID:        config.NodeID,
+		listenAddress: config.ListenAddress,
+		secret:        []byte(config.Secret),
+		presence:      config.Presence,
+		links:         make(map[string]*link),
+		dialing:       make(map[string]struct{}),
+		ctx:           ctx,
+		cancel:        cancel,
+	}
+
+	for _, member := range config.Members {
+		if member != c.nodeID && !slices.Contains(c.members, member) {
+			c.members = append(c.members, member)
+		}
+	}
+
+	if c.presence == nil {
+		gossip := newGossipPresence(c.nodeID)
+		gossip.attach(c.broadcast)
+		c.presence = gossip
+	}
+	if linked, ok := c.presence.(linkedPresence); ok {
+		c.linked = linked
+	}
+
+	return c, nil
+}
+
+// NodeID returns the ID of the local node
+func (c *Cluster) NodeID() string {
+	return c.nodeID
+}
+
+// Start starts the inter-relay listener and the links to the members. The transport messages received from the other
+// nodes are passed to onTransport and the presence changes to handler.
+func (c *Cluster) Start(onTransport TransportHandler, handler Handler) error {
+	c.onTransport = onTransport
+	c.presence.SetHandler(handler)
+
+	listener, err := net.Listen("tcp", c.listenAddress)
+	if err != nil {
+		return fmt.Errorf("listen on %s: %w", c.listenAddress, err)
+	}
+	c.listener = listener
+	log.Infof("relay cluster node %s listening on: %s", c.nodeID, listener.Addr())
+
+	c.wg.Add(2)
+	go c.acceptLinks()
+	go c.maintainMembers()
+	return nil
+}
+
+// Announce registers the peer as connected to the local node
+func (c *Cluster) Announce(peerID messages.PeerID) {
+	c.presence.Announce(peerID)
+}
+
+// Withdraw removes the registration of the peer from the local node
+func (c *Cluster) Withdraw(peerID messages.PeerID) {
+	c.presence.Withdraw(peerID)
+}
+
+// Lookup returns the remote node the peer is connected to
+func (c *Cluster) Lookup(peerID messages.PeerID) (string, bool) {
+	return c.presence.Lookup(peerID)
+}
+
+// Forward sends a transport message to the peer on the given node. The message is sent as it is, so the source peer
+// has to be set in it already.
+func (c *Cluster) Forward(nodeID string, peerID messages.PeerID, msg []byte) error {
+	c.linksMu.RLock()
+	l, ok := c.links[nodeID]
+	c.linksMu.RUnlock()
+	if !ok {
+		c.connectAsync(nodeID)
+		return ErrNoLink
+	}
+
+	if err := l.writeFrame(frameTransport, peerID[:], msg); err != nil {
+		l.close()
+		return fmt.Errorf("forward to node %s: %w", nodeID, err)
+	}
+	return nil
+}
+
+// Close withdraws the local peers and closes the links
+func (c *Cluster) Close() error {
+	if err := c.presence.Close(); err != nil {
+		log.Errorf("failed to close relay cluster presence: %v", err)
+	}
+
+	c.cancel()
+	if c.listener != nil {
+		if err := c.listener.Close(); err != nil && !errors.Is(err, net.ErrClosed) {
+			log.Errorf("failed to close relay cluster listener: %v", err)
+		}
+	}
+
+	c.linksMu.Lock()
+	for _, l := range c.links {
+		l.close()
+	}
+	c.linksMu.Unlock()
+
+	c.wg.Wait()
+	return nil
+}
+
+func (c *Cluster) acceptLinks() {
+	defer c.wg.Done()
+
+	for {
+		conn, err := c.listener.Accept()
+		if err != nil {
+			if c.ctx.Err() == nil {
+				log.Errorf("failed to accept inter-relay link: %v", err)
+			}
+			return
+		}
+
+		c.wg.Add(1)
+		go func() {
+			defer c.wg.Done()
+
+			l, err := acceptHandshake(conn, c.secret, c.nodeID)
+			if err != nil {
+				log.Warnf("inter-relay link handshake with %s failed: %v", conn.RemoteAddr(), err)
+				_ = conn.Close()
+				return
+			}
+			c.addLink(l)
+		}()
+	}
+}
+
+func (c *Cluster) maintainMembers() {
+	defer c.wg.Done()
+
+	ticker := time.NewTicker(memberReconnectInterval)
+	defer ticker.Stop()
+
+	for {
+		for _, member := range c.members {
+			c.connectAsync(member)
+		}
+
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// connectAsync establishes the link to the node in the background unless it exists or is being established
+func (c *Cluster) connectAsync(nodeID string) {
+	if c.ctx.Err() != nil {
+		return
+	}
+
+	c.linksMu.Lock()
+	_, linked := c.links[nodeID]
+	_, dialing := c.dialing[nodeID]
+	if linked || dialing {
+		c.linksMu.Unlock()
+		return
+	}
+	c.dialing[nodeID] = struct{}{}
+	c.linksMu.Unlock()
+
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		defer func() {
+			c.linksMu.Lock()
+			delete(c.dialing, nodeID)
+			c.linksMu.Unlock()
+		}()
+
+		if err := c.connect(nodeID); err != nil {
+			log.Debugf("failed to link relay node %s: %v", nodeID, err)
+		}
+	}()
+}
+
+func (c *Cluster) connect(nodeID string) error {
+	dialer := net.Dialer{Timeout: dialTimeout}
+	conn, err := dialer.DialContext(c.ctx, "tcp", nodeID)
+	if err != nil {
+		return fmt.Errorf("dial: %w", err)
+	}
+
+	l, err := dialHandshake(conn, c.secret, c.nodeID)
+	if err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("handshake: %w", err)
+	}
+	if l.nodeID != nodeID {
+		_ = conn.Close()
+		return fmt.Errorf("node identifies itself as %s", l.nodeID)
+	}
+
+	c.addLink(l)
+	return nil
+}
+
+// addLink registers the link and starts reading it. If both nodes dialed each other at the same time, the link that
+// was dialed by the node with the lower ID is kept on both sides.
+func (c *Cluster) addLink(l *link) {
+	if l.nodeID == c.nodeID {
+		log.Warnf("inter-relay link to the local node %s, closing it", c.nodeID)
+		l.close()
+		return
+	}
+
+	c.linksMu.Lock()
+	if c.ctx.Err() != nil {
+		c.linksMu.Unlock()
+		l.close()
+		return
+	}
+	if existing, ok := c.links[l.nodeID]; ok {
+		if c.isPreferred(existing) {
+			c.linksMu.Unlock()
+			l.close()
+			return
+		}
+		existing.close()
+	}
+	c.links[l.nodeID] = l
+	c.linksMu.Unlock()
+
+	log.Infof("linked relay node %s", l.nodeID)
+
+	if c.linked != nil {
+		// the snapshot is built under the write lock, a change broadcast meanwhile is either included or sent after it
+		if err := l.writeFrameFunc(framePresence, c.linked.snapshot); err != nil {
+			log.Errorf("failed to send presence snapshot to relay node %s: %v", l.nodeID, err)
+			l.close()
+		}
+	}
+
+	c.wg.Add(1)
+	go c.readLink(l)
+}
+
+func (c *Cluster) isPreferred(l *link) bool {
+	return l.dialed == (c.nodeID < l.nodeID)
+}
+
+func (c *Cluster) readLink(l *link) {
+	defer c.wg.Done()
+	defer c.removeLink(l)
+
+	var buf []byte
+	for {
+		frameType, payload, newBuf, err := l.readFrame(buf)
+		buf = newBuf
+		if err != nil {
+			if c.ctx.Err() == nil && !errors.Is(err, net.ErrClosed) {
+				log.Debugf("inter-relay link to %s closed: %v", l.nodeID, err)
+			}
+			return
+		}
+
+		switch frameType {
+		case frameTransport:
+			if len(payload) < peerIDSize {
+				log.Warnf("invalid transport frame from relay node %s", l.nodeID)
+				continue
+			}
+			if c.onTransport != nil {
+				c.onTransport(messages.PeerID(payload[:peerIDSize]), payload[peerIDSize:])
+			}
+		case framePresence:
+			if c.linked == nil {
+				continue
+			}
+			if err := c.linked.handleFrame(l.nodeID, payload); err != nil {
+				log.Warnf("invalid presence frame from relay node %s: %v", l.nodeID, err)
+			}
+		default:
+			log.Warnf("unexpected frame type %d from relay node %s", frameType, l.nodeID)
+			return
+		}
+	}
+}
+
+func (c *Cluster) removeLink(l *link) {
+	l.close()
+
+	c.linksMu.Lock()
+	current, ok := c.links[l.nodeID]
+	if !ok || current != l {
+		c.linksMu.Unlock()
+		return
+	}
+	delete(c.links, l.nodeID)
+	c.linksMu.Unlock()
+
+	log.Infof("lost link to relay node %s", l.nodeID)
+	if c.linked != nil {
+		c.linked.nodeLost(l.nodeID)
+	}
+}
+
+// broadcast sends a presence frame to all the linked nodes
+func (c *Cluster) broadcast(payload []byte) {
+	c.linksMu.RLock()
+	links := make([]*link, 0, len(c.links))
+	for _, l := range c.links {
+		links = append(links, l)
+	}
+	c.linksMu.RUnlock()
+
+	for _, l := range links {
+		if err := l.writeFrame(framePresence, payload); err != nil {
+			log.Errorf("failed to send presence to relay node %s: %v", l.nodeID, err)
+			l.close()
+		}
+	}
+}
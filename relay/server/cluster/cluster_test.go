@@ -0,0 +1,352 @@
+package cluster
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/shared/relay/messages"
+)
+
+type presenceEvent struct {
+	nodeID string
+	peerID messages.PeerID
+	online bool
+}
+
+type recordingHandler struct {
+	events chan presenceEvent
+}
+
+func newRecordingHandler() *recordingHandler {
+	return &recordingHandler{events: make(chan presenceEvent, 16)}
+}
+
+func (h *recordingHandler) PeerOnline(nodeID string, peerID messages.PeerID) {
+	h.events <- presenceEvent{nodeID: nodeID, peerID: peerID, online: true}
+}
+
+func (h *recordingHandler) PeerOffline(nodeID string, peerID messages.PeerID) {
+	h.events <- presenceEvent{nodeID: nodeID, peerID: peerID, online: false}
+}
+
+func (h *recordingHandler) wait(t *testing.T) presenceEvent {
+	t.Helper()
+	select {
+	case e := <-h.events:
+		return e
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timeout waiting for presence event")
+		return presenceEvent{}
+	}
+}
+
+type forwardedMsg struct {
+	peerID messages.PeerID
+	msg    []byte
+}
+
+func freeAddress(t *testing.T) string {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := l.Addr().String()
+	require.NoError(t, l.Close())
+	return addr
+}
+
+func startNode(t *testing.T, nodeID string, members []string, secret string, presence Presence) (*Cluster, *recordingHandler, chan forwardedMsg) {
+	t.Helper()
+
+	c, err := New(Config{
+		NodeID:        nodeID,
+		ListenAddress: nodeID,
+		Members:       members,
+		Secret:        secret,
+		Presence:      presence,
+	})
+	require.NoError(t, err)
+
+	handler := newRecordingHandler()
+	received := make(chan forwardedMsg, 16)
+	err = c.Start(func(peerID messages.PeerID, msg []byte) {
+		received <- forwardedMsg{peerID: peerID, msg: append([]byte(nil), msg...)}
+	}, handler)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = c.Close()
+	})
+	return c, handler, received
+}
+
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  Config
+		wantErr bool
+	}{
+		{"valid", Config{NodeID: "relay-1:7000", ListenAddress: ":7000", Secret: "secret"}, false},
+		{"missing node ID", Config{ListenAddress: ":7000", Secret: "secret"}, true},
+		{"node ID without port", Config{NodeID: "relay-1", ListenAddress: ":7000", Secret: "secret"}, true},
+		{"missing listen address", Config{NodeID: "relay-1:7000", Secret: "secret"}, true},
+		{"missing secret", Config{NodeID: "relay-1:7000", ListenAddress: ":7000"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestHandshake(t *testing.T) {
+	tests := []struct {
+		name         string
+		dialerSecret string
+		wantErr      bool
+	}{
+		{"same secret", "secret", false},
+		{"different secret", "other", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dialerConn, acceptorConn := net.Pipe()
+			defer dialerConn.Close()
+			defer acceptorConn.Close()
+
+			var (
+				wg          sync.WaitGroup
+				accepted    *link
+				acceptorErr error
+			)
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				accepted, acceptorErr = acceptHandshake(acceptorConn, []byte("secret"), "relay-b:7000")
+				if acceptorErr != nil {
+					_ = acceptorConn.Close()
+				}
+			}()
+
+			dialed, dialerErr := dialHandshake(dialerConn, []byte(tt.dialerSecret), "relay-a:7000")
+			if dialerErr != nil {
+				_ = dialerConn.Close()
+			}
+			wg.Wait()
+
+			if tt.wantErr {
+				assert.Error(t, dialerErr)
+				assert.Error(t, acceptorErr)
+				return
+			}
+			require.NoError(t, dialerErr)
+			require.NoError(t, acceptorErr)
+			assert.Equal(t, "relay-b:7000", dialed.nodeID)
+			assert.Equal(t, "relay-a:7000", accepted.nodeID)
+		})
+	}
+}
+
+func TestLink_AuthenticatesFrames(t *testing.T) {
+	dialerConn, acceptorConn := net.Pipe()
+	defer dialerConn.Close()
+	defer acceptorConn.Close()
+
+	var (
+		wg       sync.WaitGroup
+		acceptor *link
+	)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		var err error
+		acceptor, err = acceptHandshake(acceptorConn, []byte("secret"), "relay-b:7000")
+		assert.NoError(t, err)
+	}()
+	dialer, err := dialHandshake(dialerConn, []byte("secret"), "relay-a:7000")
+	require.NoError(t, err)
+	wg.Wait()
+	require.NotNil(t, acceptor)
+
+	send := func(l *link, payload string) {
+		go func() {
+			_ = l.writeFrame(frameTransport, []byte(payload))
+		}()
+	}
+
+	send(dialer, "first")
+	frameType, payload, _, err := acceptor.readFrame(nil)
+	require.NoError(t, err)
+	assert.Equal(t, frameTransport, frameType)
+	assert.Equal(t, "first", string(payload))
+
+	send(acceptor, "reply")
+	_, payload, _, err = dialer.readFrame(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "reply", string(payload))
+
+	// a frame with the sequence number of a received frame is a replay
+	dialer.sendSeq = 0
+	send(dialer, "first")
+	_, _, _, err = acceptor.readFrame(nil)
+	assert.ErrorIs(t, err, errFrameForged)
+
+	// a frame injected without the session key is rejected
+	forger := &link{conn: dialerConn, sendMAC: hmac.New(sha256.New, []byte("secret"))}
+	send(forger, "forged")
+	_, _, _, err = acceptor.readFrame(nil)
+	assert.ErrorIs(t, err, errFrameForged)
+}
+
+func TestCluster_GossipPresenceAndForward(t *testing.T) {
+	addrA, addrB := freeAddress(t), freeAddress(t)
+	nodeA, handlerA, _ := startNode(t, addrA, []string{addrB}, "secret", nil)
+	nodeB, handlerB, receivedB := startNode(t, addrB, []string{addrA}, "secret", nil)
+
+	peerID := messages.HashID("bob")
+	nodeB.Announce(peerID)
+
+	e := handlerA.wait(t)
+	assert.Equal(t, presenceEvent{nodeID: addrB, peerID: peerID, online: true}, e)
+
+	nodeID, ok := nodeA.Lookup(peerID)
+	require.True(t, ok, "peer should be online on node B")
+	assert.Equal(t, addrB, nodeID)
+
+	_, ok = nodeB.Lookup(peerID)
+	assert.False(t, ok, "local peers shouldn't be looked up as remote")
+
+	msg, err := messages.MarshalTransportMsg(messages.HashID("alice"), []byte("hello bob"))
+	require.NoError(t, err)
+	require.NoError(t, nodeA.Forward(nodeID, peerID, msg))
+
+	select {
+	case forwarded := <-receivedB:
+		assert.Equal(t, peerID, forwarded.peerID)
+		assert.Equal(t, msg, forwarded.msg)
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timeout waiting for forwarded message")
+	}
+
+	nodeB.Withdraw(peerID)
+	e = handlerA.wait(t)
+	assert.Equal(t, presenceEvent{nodeID: addrB, peerID: peerID, online: false}, e)
+	_, ok = nodeA.Lookup(peerID)
+	assert.False(t, ok)
+
+	select {
+	case e := <-handlerB.events:
+		t.Fatalf("unexpected presence event on node B: %+v", e)
+	default:
+	}
+}
+
+func TestCluster_NodeLostDropsPeers(t *testing.T) {
+	addrA, addrB := freeAddress(t), freeAddress(t)
+	nodeA, handlerA, _ := startNode(t, addrA, []string{addrB}, "secret", nil)
+	nodeB, _, _ := startNode(t, addrB, nil, "secret", nil)
+
+	peerID := messages.HashID("bob")
+	nodeB.Announce(peerID)
+	assert.True(t, handlerA.wait(t).online)
+
+	require.NoError(t, nodeB.Close())
+
+	e := handlerA.wait(t)
+	assert.False(t, e.online)
+	_, ok := nodeA.Lookup(peerID)
+	assert.False(t, ok)
+}
+
+func TestCluster_RejectsWrongSecret(t *testing.T) {
+	addrA, addrB := freeAddress(t), freeAddress(t)
+	nodeA, _, _ := startNode(t, addrA, []string{addrB}, "secret", nil)
+	nodeB, _, _ := startNode(t, addrB, nil, "other", nil)
+
+	nodeB.Announce(messages.HashID("bob"))
+
+	time.Sleep(500 * time.Millisecond)
+	err := nodeA.Forward(addrB, messages.HashID("bob"), []byte("msg"))
+	assert.ErrorIs(t, err, ErrNoLink)
+}
+
+func TestCluster_MemoryPresenceLinksOnDemand(t *testing.T) {
+	backend := NewMemoryBackend()
+	addrA, addrB := freeAddress(t), freeAddress(t)
+	nodeA, handlerA, _ := startNode(t, addrA, nil, "secret", backend.Presence(addrA))
+	nodeB, _, receivedB := startNode(t, addrB, nil, "secret", backend.Presence(addrB))
+
+	peerID := messages.HashID("bob")
+	nodeB.Announce(peerID)
+	assert.Equal(t, presenceEvent{nodeID: addrB, peerID: peerID, online: true}, handlerA.wait(t))
+
+	nodeID, ok := nodeA.Lookup(peerID)
+	require.True(t, ok)
+
+	// the first message triggers the link to the node
+	err := nodeA.Forward(nodeID, peerID, []byte("first"))
+	assert.ErrorIs(t, err, ErrNoLink)
+
+	require.Eventually(t, func() bool {
+		return nodeA.Forward(nodeID, peerID, []byte("second")) == nil
+	}, 5*time.Second, 50*time.Millisecond)
+
+	select {
+	case forwarded := <-receivedB:
+		assert.Equal(t, []byte("second"), forwarded.msg)
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timeout waiting for forwarded message")
+	}
+}
+
+func TestMemoryBackend_LatestRegistrationWins(t *testing.T) {
+	backend := NewMemoryBackend()
+	presenceA := backend.Presence("relay-a:7000")
+	presenceB := backend.Presence("relay-b:7000")
+	presenceC := backend.Presence("relay-c:7000")
+
+	peerID := messages.HashID("bob")
+	presenceA.Announce(peerID)
+	presenceB.Announce(peerID)
+
+	nodeID, ok := presenceC.Lookup(peerID)
+	require.True(t, ok)
+	assert.Equal(t, "relay-b:7000", nodeID)
+
+	nodeID, ok = presenceB.Lookup(peerID)
+	require.True(t, ok)
+	assert.Equal(t, "relay-a:7000", nodeID, "the local registration should be ignored")
+
+	presenceB.Withdraw(peerID)
+	nodeID, ok = presenceC.Lookup(peerID)
+	require.True(t, ok)
+	assert.Equal(t, "relay-a:7000", nodeID)
+
+	require.NoError(t, presenceA.Close())
+	_, ok = presenceC.Lookup(peerID)
+	assert.False(t, ok)
+}
+
+func TestPresenceFrame(t *testing.T) {
+	peerIDs := []messages.PeerID{messages.HashID("alice"), messages.HashID("bob")}
+
+	op, decoded, err := unmarshalPresence(marshalPresence(presenceSnapshot, peerIDs))
+	require.NoError(t, err)
+	assert.Equal(t, presenceSnapshot, op)
+	assert.Equal(t, peerIDs, decoded)
+
+	_, _, err = unmarshalPresence([]byte{presenceOnline, 1, 2, 3})
+	assert.Error(t, err)
+}
@@ -0,0 +1,151 @@
+package cluster
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/netbirdio/netbird/shared/relay/messages"
+)
+
+const (
+	presenceOnline byte = iota
+	presenceOffline
+	presenceSnapshot
+)
+
+// gossipPresence shares the presence over the inter-relay links. Every node pushes the snapshot of its peers when a
+// link comes up and the changes after that, so the nodes have to be fully meshed via the cluster members. The peers of
+// a node are dropped when the link to it is lost.
+type gossipPresence struct {
+	nodeID string
+	table  *peerTable
+
+	mu        sync.RWMutex
+	handler   Handler
+	broadcast func(payload []byte)
+}
+
+func newGossipPresence(nodeID string) *gossipPresence {
+	return &gossipPresence{
+		nodeID: nodeID,
+		table:  newPeerTable(),
+	}
+}
+
+func (g *gossipPresence) Announce(peerID messages.PeerID) {
+	if g.table.add(g.nodeID, peerID) {
+		g.send(marshalPresence(presenceOnline, []messages.PeerID{peerID}))
+	}
+}
+
+func (g *gossipPresence) Withdraw(peerID messages.PeerID) {
+	if g.table.remove(g.nodeID, peerID) {
+		g.send(marshalPresence(presenceOffline, []messages.PeerID{peerID}))
+	}
+}
+
+func (g *gossipPresence) Lookup(peerID messages.PeerID) (string, bool) {
+	return g.table.lookup(peerID, g.nodeID)
+}
+
+func (g *gossipPresence) SetHandler(handler Handler) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.handler = handler
+}
+
+func (g *gossipPresence) Close() error {
+	g.table.removeNode(g.nodeID)
+	g.send(marshalPresence(presenceSnapshot, nil))
+	return nil
+}
+
+func (g *gossipPresence) attach(broadcast func(payload []byte)) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.broadcast = broadcast
+}
+
+func (g *gossipPresence) snapshot() []byte {
+	return marshalPresence(presenceSnapshot, g.table.nodePeers(g.nodeID))
+}
+
+func (g *gossipPresence) handleFrame(nodeID string, payload []byte) error {
+	op, peerIDs, err := unmarshalPresence(payload)
+	if err != nil {
+		return err
+	}
+
+	var added, removed []messages.PeerID
+	switch op {
+	case presenceOnline:
+		for _, peerID := range peerIDs {
+			if g.table.add(nodeID, peerID) {
+				added = append(added, peerID)
+			}
+		}
+	case presenceOffline:
+		for _, peerID := range peerIDs {
+			if g.table.remove(nodeID, peerID) {
+				removed = append(removed, peerID)
+			}
+		}
+	case presenceSnapshot:
+		added, removed = g.table.replaceNode(nodeID, peerIDs)
+	default:
+		return fmt.Errorf("unknown presence operation: %d", op)
+	}
+
+	g.notify(nodeID, added, removed)
+	return nil
+}
+
+func (g *gossipPresence) nodeLost(nodeID string) {
+	g.notify(nodeID, nil, g.table.removeNode(nodeID))
+}
+
+func (g *gossipPresence) notify(nodeID string, online, offline []messages.PeerID) {
+	g.mu.RLock()
+	handler := g.handler
+	g.mu.RUnlock()
+	if handler == nil {
+		return
+	}
+
+	for _, peerID := range online {
+		handler.PeerOnline(nodeID, peerID)
+	}
+	for _, peerID := range offline {
+		handler.PeerOffline(nodeID, peerID)
+	}
+}
+
+func (g *gossipPresence) send(payload []byte) {
+	g.mu.RLock()
+	broadcast := g.broadcast
+	g.mu.RUnlock()
+	if broadcast != nil {
+		broadcast(payload)
+	}
+}
+
+func marshalPresence(op byte, peerIDs []messages.PeerID) []byte {
+	payload := make([]byte, 1, 1+len(peerIDs)*peerIDSize)
+	payload[0] = op
+	for _, peerID := range peerIDs {
+		payload = append(payload, peerID[:]...)
+	}
+	return payload
+}
+
+func unmarshalPresence(payload []byte) (byte, []messages.PeerID, error) {
+	if len(payload) < 1 || (len(payload)-1)%peerIDSize != 0 {
+		return 0, nil, fmt.Errorf("invalid presence frame length: %d", len(payload))
+	}
+
+	peerIDs := make([]messages.PeerID, 0, (len(payload)-1)/peerIDSize)
+	for offset := 1; offset < len(payload); offset += peerIDSize {
+		peerIDs = append(peerIDs, messages.PeerID(payload[offset:offset+peerIDSize]))
+	}
+	return payload[0], peerIDs, nil
+}
@@ -0,0 +1,288 @@
+package cluster
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/netbirdio/netbird/shared/relay/messages"
+)
+
+const (
+	frameHello byte = iota + 1
+	frameChallenge
+	frameAuth
+	frameTransport
+	framePresence
+)
+
+// the session keys are derived with the handshake MAC using these steps, so they never match a handshake MAC
+const (
+	keyDialerToAcceptor byte = iota + 0x80
+	keyAcceptorToDialer
+)
+
+const (
+	peerIDSize      = len(messages.PeerID{})
+	nonceSize       = 32
+	frameHeaderSize = 5
+	frameMACSize    = sha256.Size
+	// maxFrameSize bounds the presence snapshots, the transport frames are limited by the relay message size
+	maxFrameSize = 16 * 1024 * 1024
+	maxNodeIDLen = 255
+
+	handshakeTimeout = 10 * time.Second
+	writeTimeout     = 5 * time.Second
+)
+
+var (
+	errAuthFailed  = errors.New("inter-relay link authentication failed")
+	errFrameForged = errors.New("inter-relay frame authentication failed")
+)
+
+// link is an authenticated connection to another node of the cluster. Frames are prefixed with the frame type and
+// the length of the payload. After the handshake every frame is followed by its MAC, computed with the session key of
+// the direction and a sequence number, so frames can't be injected, altered, replayed or reordered on the way.
+type link struct {
+	nodeID string
+	conn   net.Conn
+	// dialed is true if the local node opened the link
+	dialed bool
+
+	writeMu sync.Mutex
+	sendMAC hash.Hash
+	sendSeq uint64
+
+	// the frames are read by a single goroutine, so the receive state isn't locked
+	recvMAC hash.Hash
+	recvSeq uint64
+}
+
+// newLink returns the link with the session keys derived from the handshake nonces
+func newLink(conn net.Conn, secret []byte, dialed bool, dialerNonce, acceptorNonce []byte, dialerID, acceptorID string) *link {
+	nonces := make([]byte, 0, len(dialerNonce)+len(acceptorNonce))
+	nonces = append(nonces, dialerNonce...)
+	nonces = append(nonces, acceptorNonce...)
+
+	dialerKey := linkMAC(secret, keyDialerToAcceptor, nonces, dialerID, acceptorID)
+	acceptorKey := linkMAC(secret, keyAcceptorToDialer, nonces, dialerID, acceptorID)
+
+	l := &link{nodeID: acceptorID, conn: conn, dialed: dialed}
+	if dialed {
+		l.sendMAC, l.recvMAC = hmac.New(sha256.New, dialerKey), hmac.New(sha256.New, acceptorKey)
+	} else {
+		l.nodeID = dialerID
+		l.sendMAC, l.recvMAC = hmac.New(sha256.New, acceptorKey), hmac.New(sha256.New, dialerKey)
+	}
+	return l
+}
+
+func (l *link) writeFrame(frameType byte, parts ...[]byte) error {
+	l.writeMu.Lock()
+	defer l.writeMu.Unlock()
+	return l.writeFrameLocked(frameType, parts...)
+}
+
+// writeFrameFunc builds the payload while holding the write lock, so no frame built later can overtake it
+func (l *link) writeFrameFunc(frameType byte, payload func() []byte) error {
+	l.writeMu.Lock()
+	defer l.writeMu.Unlock()
+	return l.writeFrameLocked(frameType, payload())
+}
+
+func (l *link) writeFrameLocked(frameType byte, parts ...[]byte) error {
+	size := 0
+	for _, part := range parts {
+		size += len(part)
+	}
+	if size > maxFrameSize {
+		return fmt.Errorf("frame too large: %d", size)
+	}
+
+	header := make([]byte, frameHeaderSize)
+	header[0] = frameType
+	binary.BigEndian.PutUint32(header[1:], uint32(size))
+
+	buffers := make(net.Buffers, 0, len(parts)+2)
+	buffers = append(buffers, header)
+	buffers = append(buffers, parts...)
+	if l.sendMAC != nil {
+		buffers = append(buffers, frameMAC(l.sendMAC, l.sendSeq, header, parts...))
+		l.sendSeq++
+	}
+
+	if err := l.conn.SetWriteDeadline(time.Now().Add(writeTimeout)); err != nil {
+		return err
+	}
+	_, err := buffers.WriteTo(l.conn)
+	return err
+}
+
+func (l *link) close() {
+	_ = l.conn.Close()
+}
+
+// readFrame reads the next frame and verifies its MAC. The returned payload is only valid until the next call as the
+// buffer is reused.
+func (l *link) readFrame(buf []byte) (byte, []byte, []byte, error) {
+	header := make([]byte, frameHeaderSize)
+	frameType, payload, buf, err := readFrameHeader(l.conn, buf, header)
+	if err != nil {
+		return 0, nil, buf, err
+	}
+
+	mac := make([]byte, frameMACSize)
+	if _, err := io.ReadFull(l.conn, mac); err != nil {
+		return 0, nil, buf, err
+	}
+	if !hmac.Equal(mac, frameMAC(l.recvMAC, l.recvSeq, header, payload)) {
+		return 0, nil, buf, errFrameForged
+	}
+	l.recvSeq++
+
+	return frameType, payload, buf, nil
+}
+
+// frameMAC returns the MAC of the frame with the sequence number of the frame in its direction
+func frameMAC(mac hash.Hash, seq uint64, header []byte, parts ...[]byte) []byte {
+	mac.Reset()
+	_ = binary.Write(mac, binary.BigEndian, seq)
+	mac.Write(header)
+	for _, part := range parts {
+		mac.Write(part)
+	}
+	return mac.Sum(nil)
+}
+
+// readFrame reads the next handshake frame, it isn't followed by a MAC
+func readFrame(r io.Reader, buf []byte) (byte, []byte, []byte, error) {
+	return readFrameHeader(r, buf, make([]byte, frameHeaderSize))
+}
+
+// readFrameHeader reads the header into the given slice and the payload into the buffer, which is grown if needed
+func readFrameHeader(r io.Reader, buf, header []byte) (byte, []byte, []byte, error) {
+	if _, err := io.ReadFull(r, header); err != nil {
+		return 0, nil, buf, err
+	}
+
+	size := binary.BigEndian.Uint32(header[1:])
+	if size > maxFrameSize {
+		return 0, nil, buf, fmt.Errorf("frame too large: %d", size)
+	}
+	if int(size) > cap(buf) {
+		buf = make([]byte, size)
+	}
+	payload := buf[:size]
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return 0, nil, buf, err
+	}
+	return header[0], payload, buf, nil
+}
+
+// dialHandshake authenticates the link from the dialing side:
+// the dialer sends its node ID with a nonce, the acceptor answers with its node ID, its own nonce and the MAC of the
+// dialer nonce, then the dialer proves the knowledge of the secret with the MAC of the acceptor nonce. Both nonces
+// are mixed into the session keys of the link.
+func dialHandshake(conn net.Conn, secret []byte, localID string) (*link, error) {
+	if err := conn.SetDeadline(time.Now().Add(handshakeTimeout)); err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = conn.SetDeadline(time.Time{})
+	}()
+
+	l := &link{conn: conn}
+	localNonce, err := newNonce()
+	if err != nil {
+		return nil, err
+	}
+	if err := l.writeFrame(frameHello, localNonce, []byte(localID)); err != nil {
+		return nil, fmt.Errorf("send hello: %w", err)
+	}
+
+	frameType, payload, _, err := readFrame(conn, nil)
+	if err != nil {
+		return nil, fmt.Errorf("read challenge: %w", err)
+	}
+	if frameType != frameChallenge || len(payload) < nonceSize+sha256.Size+1 || len(payload) > nonceSize+sha256.Size+maxNodeIDLen {
+		return nil, fmt.Errorf("unexpected challenge frame")
+	}
+	remoteNonce := payload[:nonceSize]
+	remoteMAC := payload[nonceSize : nonceSize+sha256.Size]
+	remoteID := string(payload[nonceSize+sha256.Size:])
+
+	if !hmac.Equal(remoteMAC, linkMAC(secret, frameChallenge, localNonce, localID, remoteID)) {
+		return nil, errAuthFailed
+	}
+
+	if err := l.writeFrame(frameAuth, linkMAC(secret, frameAuth, remoteNonce, localID, remoteID)); err != nil {
+		return nil, fmt.Errorf("send auth: %w", err)
+	}
+	return newLink(conn, secret, true, localNonce, remoteNonce, localID, remoteID), nil
+}
+
+// acceptHandshake authenticates the link from the accepting side, see dialHandshake
+func acceptHandshake(conn net.Conn, secret []byte, localID string) (*link, error) {
+	if err := conn.SetDeadline(time.Now().Add(handshakeTimeout)); err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = conn.SetDeadline(time.Time{})
+	}()
+
+	frameType, payload, _, err := readFrame(conn, nil)
+	if err != nil {
+		return nil, fmt.Errorf("read hello: %w", err)
+	}
+	if frameType != frameHello || len(payload) < nonceSize+1 || len(payload) > nonceSize+maxNodeIDLen {
+		return nil, fmt.Errorf("unexpected hello frame")
+	}
+	remoteNonce := payload[:nonceSize]
+	remoteID := string(payload[nonceSize:])
+
+	l := &link{conn: conn}
+	localNonce, err := newNonce()
+	if err != nil {
+		return nil, err
+	}
+	mac := linkMAC(secret, frameChallenge, remoteNonce, remoteID, localID)
+	if err := l.writeFrame(frameChallenge, localNonce, mac, []byte(localID)); err != nil {
+		return nil, fmt.Errorf("send challenge: %w", err)
+	}
+
+	frameType, payload, _, err = readFrame(conn, nil)
+	if err != nil {
+		return nil, fmt.Errorf("read auth: %w", err)
+	}
+	if frameType != frameAuth || !hmac.Equal(payload, linkMAC(secret, frameAuth, localNonce, remoteID, localID)) {
+		return nil, errAuthFailed
+	}
+	return newLink(conn, secret, false, remoteNonce, localNonce, remoteID, localID), nil
+}
+
+// linkMAC binds the nonce to the handshake step and to the node IDs of the dialer and the acceptor
+func linkMAC(secret []byte, step byte, nonce []byte, dialerID, acceptorID string) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte{step})
+	mac.Write(nonce)
+	mac.Write([]byte(dialerID))
+	mac.Write([]byte{0})
+	mac.Write([]byte(acceptorID))
+	return mac.Sum(nil)
+}
+
+func newNonce() ([]byte, error) {
+	nonce := make([]byte, nonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("generate nonce: %w", err)
+	}
+	return nonce, nil
+}
@@ -0,0 +1,106 @@
+package cluster
+
+import (
+	"sync"
+
+	"github.com/netbirdio/netbird/shared/relay/messages"
+)
+
+// MemoryBackend is an in-process presence backend shared by the nodes of a cluster that run in the same process.
+// It is meant for tests and local setups.
+type MemoryBackend struct {
+	table *peerTable
+
+	mu    sync.RWMutex
+	nodes map[string]*memoryPresence
+}
+
+func NewMemoryBackend() *MemoryBackend {
+	return &MemoryBackend{
+		table: newPeerTable(),
+		nodes: make(map[string]*memoryPresence),
+	}
+}
+
+// Presence returns the presence view of a node
+func (b *MemoryBackend) Presence(nodeID string) Presence {
+	p := &memoryPresence{
+		backend: b,
+		nodeID:  nodeID,
+	}
+
+	b.mu.Lock()
+	b.nodes[nodeID] = p
+	b.mu.Unlock()
+	return p
+}
+
+func (b *MemoryBackend) notify(from string, peerIDs []messages.PeerID, online bool) {
+	b.mu.RLock()
+	handlers := make(map[string]Handler, len(b.nodes))
+	for nodeID, p := range b.nodes {
+		if nodeID == from {
+			continue
+		}
+		if handler := p.getHandler(); handler != nil {
+			handlers[nodeID] = handler
+		}
+	}
+	b.mu.RUnlock()
+
+	for _, handler := range handlers {
+		for _, peerID := range peerIDs {
+			if online {
+				handler.PeerOnline(from, peerID)
+			} else {
+				handler.PeerOffline(from, peerID)
+			}
+		}
+	}
+}
+
+type memoryPresence struct {
+	backend *MemoryBackend
+	nodeID  string
+
+	mu      sync.RWMutex
+	handler Handler
+}
+
+func (p *memoryPresence) Announce(peerID messages.PeerID) {
+	if p.backend.table.add(p.nodeID, peerID) {
+		p.backend.notify(p.nodeID, []messages.PeerID{peerID}, true)
+	}
+}
+
+func (p *memoryPresence) Withdraw(peerID messages.PeerID) {
+	if p.backend.table.remove(p.nodeID, peerID) {
+		p.backend.notify(p.nodeID, []messages.PeerID{peerID}, false)
+	}
+}
+
+func (p *memoryPresence) Lookup(peerID messages.PeerID) (string, bool) {
+	return p.backend.table.lookup(peerID, p.nodeID)
+}
+
+func (p *memoryPresence) SetHandler(handler Handler) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.handler = handler
+}
+
+func (p *memoryPresence) Close() error {
+	p.backend.mu.Lock()
+	delete(p.backend.nodes, p.nodeID)
+	p.backend.mu.Unlock()
+
+	removed := p.backend.table.removeNode(p.nodeID)
+	p.backend.notify(p.nodeID, removed, false)
+	return nil
+}
+
+func (p *memoryPresence) getHandler() Handler {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.handler
+}
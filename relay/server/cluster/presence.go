@@ -0,0 +1,40 @@
+package cluster
+
+import (
+	"github.com/netbirdio/netbird/shared/relay/messages"
+)
+
+// Presence shares which relay node the peers are connected to
+type Presence interface {
+	// Announce registers the peer as connected to the local node
+	Announce(peerID messages.PeerID)
+	// Withdraw removes the registration of the peer from the local node
+	Withdraw(peerID messages.PeerID)
+	// Lookup returns the remote node the peer is connected to. If the peer is connected to multiple remote nodes, the
+	// node of the most recent registration is returned.
+	Lookup(peerID messages.PeerID) (string, bool)
+	// SetHandler sets the handler of the presence changes on the remote nodes
+	SetHandler(handler Handler)
+	// Close withdraws all the peers of the local node
+	Close() error
+}
+
+// Handler is notified about the presence changes on the remote nodes. It is called after the change has been applied,
+// so Lookup already reflects it.
+type Handler interface {
+	PeerOnline(nodeID string, peerID messages.PeerID)
+	PeerOffline(nodeID string, peerID messages.PeerID)
+}
+
+// linkedPresence is a Presence that is shared over the inter-relay links instead of an external backend
+type linkedPresence interface {
+	Presence
+	// attach sets the function that sends a presence frame to all the linked nodes
+	attach(broadcast func(payload []byte))
+	// snapshot returns the presence frame with all the peers of the local node
+	snapshot() []byte
+	// handleFrame applies a presence frame received from a node
+	handleFrame(nodeID string, payload []byte) error
+	// nodeLost drops the peers of a node that is no longer linked
+	nodeLost(nodeID string)
+}
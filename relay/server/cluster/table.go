@@ -0,0 +1,150 @@
+package cluster
+
+import (
+	"sync"
+
+	"github.com/netbirdio/netbird/shared/relay/messages"
+)
+
+// peerTable tracks the nodes that the peers are connected to. A peer can be registered on multiple nodes for a short
+// time when it reconnects to another node before the old connection times out, in that case the most recent
+// registration wins.
+type peerTable struct {
+	mu    sync.RWMutex
+	seq   uint64
+	peers map[messages.PeerID]map[string]uint64
+	nodes map[string]map[messages.PeerID]struct{}
+}
+
+func newPeerTable() *peerTable {
+	return &peerTable{
+		peers: make(map[messages.PeerID]map[string]uint64),
+		nodes: make(map[string]map[messages.PeerID]struct{}),
+	}
+}
+
+// add registers the peer on the node and returns false if it was already registered there
+func (t *peerTable) add(nodeID string, peerID messages.PeerID) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.seq++
+	nodes, ok := t.peers[peerID]
+	if !ok {
+		nodes = make(map[string]uint64)
+		t.peers[peerID] = nodes
+	}
+	_, exists := nodes[nodeID]
+	nodes[nodeID] = t.seq
+
+	nodePeers, ok := t.nodes[nodeID]
+	if !ok {
+		nodePeers = make(map[messages.PeerID]struct{})
+		t.nodes[nodeID] = nodePeers
+	}
+	nodePeers[peerID] = struct{}{}
+
+	return !exists
+}
+
+// remove removes the registration of the peer from the node and returns false if it wasn't registered there
+func (t *peerTable) remove(nodeID string, peerID messages.PeerID) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.removeLocked(nodeID, peerID)
+}
+
+func (t *peerTable) removeLocked(nodeID string, peerID messages.PeerID) bool {
+	nodes, ok := t.peers[peerID]
+	if !ok {
+		return false
+	}
+	if _, ok := nodes[nodeID]; !ok {
+		return false
+	}
+
+	delete(nodes, nodeID)
+	if len(nodes) == 0 {
+		delete(t.peers, peerID)
+	}
+
+	delete(t.nodes[nodeID], peerID)
+	if len(t.nodes[nodeID]) == 0 {
+		delete(t.nodes, nodeID)
+	}
+	return true
+}
+
+// removeNode removes all the registrations of the node and returns the removed peers
+func (t *peerTable) removeNode(nodeID string) []messages.PeerID {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	removed := make([]messages.PeerID, 0, len(t.nodes[nodeID]))
+	for peerID := range t.nodes[nodeID] {
+		removed = append(removed, peerID)
+	}
+	for _, peerID := range removed {
+		t.removeLocked(nodeID, peerID)
+	}
+	return removed
+}
+
+// replaceNode sets the peers of the node and returns the added and the removed peers
+func (t *peerTable) replaceNode(nodeID string, peerIDs []messages.PeerID) ([]messages.PeerID, []messages.PeerID) {
+	current := t.nodePeers(nodeID)
+	currentSet := make(map[messages.PeerID]struct{}, len(current))
+	for _, peerID := range current {
+		currentSet[peerID] = struct{}{}
+	}
+
+	var added []messages.PeerID
+	newSet := make(map[messages.PeerID]struct{}, len(peerIDs))
+	for _, peerID := range peerIDs {
+		newSet[peerID] = struct{}{}
+		if _, ok := currentSet[peerID]; !ok && t.add(nodeID, peerID) {
+			added = append(added, peerID)
+		}
+	}
+
+	var removed []messages.PeerID
+	for _, peerID := range current {
+		if _, ok := newSet[peerID]; !ok && t.remove(nodeID, peerID) {
+			removed = append(removed, peerID)
+		}
+	}
+	return added, removed
+}
+
+// nodePeers returns the peers registered on the node
+func (t *peerTable) nodePeers(nodeID string) []messages.PeerID {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	peerIDs := make([]messages.PeerID, 0, len(t.nodes[nodeID]))
+	for peerID := range t.nodes[nodeID] {
+		peerIDs = append(peerIDs, peerID)
+	}
+	return peerIDs
+}
+
+// lookup returns the node of the most recent registration of the peer, ignoring the excluded node
+func (t *peerTable) lookup(peerID messages.PeerID, exclude string) (string, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	var (
+		latestNode string
+		latestSeq  uint64
+	)
+	for nodeID, seq := range t.peers[peerID] {
+		if nodeID == exclude {
+			continue
+		}
+		if seq > latestSeq {
+			latestNode, latestSeq = nodeID, seq
+		}
+	}
+	return latestNode, latestNode != ""
+}
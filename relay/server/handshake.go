@@ -19,6 +19,12 @@ type Validator interface {
 	ValidateHelloMsgType(any) error
 }
 
+// AccountValidator is implemented by the validators of the credentials that carry the account of the peer. The
+// account is used for the account limits.
+type AccountValidator interface {
+	ValidateAccount(any) (string, error)
+}
+
 // preparedMsg contains the marshalled success response messages
 type preparedMsg struct {
 	responseHelloMsg []byte
@@ -64,6 +70,7 @@ type handshake struct {
 
 	handshakeMethodAuth bool
 	peerID              *messages.PeerID
+	accountID           string
 }
 
 func (h *handshake) handshakeReceive() (*messages.PeerID, error) {
@@ -146,7 +153,12 @@ func (h *handshake) handleAuthMsg(buf []byte) (*messages.PeerID, error) {
 		return nil, fmt.Errorf("unmarshal hello message: %w", err)
 	}
 
-	if err := h.validator.Validate(authPayload); err != nil {
+	if accountValidator, ok := h.validator.(AccountValidator); ok {
+		h.accountID, err = accountValidator.ValidateAccount(authPayload)
+	} else {
+		err = h.validator.Validate(authPayload)
+	}
+	if err != nil {
 		return nil, fmt.Errorf("validate %s (%s): %w", rawPeerID.String(), h.conn.RemoteAddr(), err)
 	}
 
@@ -0,0 +1,295 @@
+// Package limiter implements the per-peer and per-account traffic limits of the relay. The limits are token buckets
+// of bytes per second with a burst, and optional monthly byte quotas that reset at the start of every UTC month.
+package limiter
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/netbirdio/netbird/shared/relay/messages"
+)
+
+// Verdict is the decision on a transport message
+type Verdict int
+
+const (
+	Allowed Verdict = iota
+	RateLimited
+	QuotaExceeded
+)
+
+func (v Verdict) String() string {
+	switch v {
+	case Allowed:
+		return "allowed"
+	case RateLimited:
+		return "rate_limited"
+	case QuotaExceeded:
+		return "quota_exceeded"
+	default:
+		return "unknown"
+	}
+}
+
+// Config holds the limits. A zero value disables the corresponding limit.
+type Config struct {
+	// PeerRate is the sustained number of bytes per second a peer can send through the relay
+	PeerRate int64
+	// PeerBurst is the number of bytes a peer can send at once above the rate. Defaults to one second of traffic.
+	PeerBurst int64
+	// AccountRate is the sustained number of bytes per second the peers of an account can send through the relay
+	AccountRate int64
+	// AccountBurst is the number of bytes the peers of an account can send at once above the rate. Defaults to one
+	// second of traffic.
+	AccountBurst int64
+	// PeerMonthlyQuota is the number of bytes a peer can send through the relay in a calendar month
+	PeerMonthlyQuota int64
+	// AccountMonthlyQuota is the number of bytes the peers of an account can send through the relay in a calendar
+	// month
+	AccountMonthlyQuota int64
+}
+
+// Enabled returns true if any of the limits is set
+func (c Config) Enabled() bool {
+	return c.PeerRate > 0 || c.AccountRate > 0 || c.PeerMonthlyQuota > 0 || c.AccountMonthlyQuota > 0
+}
+
+// Validate checks the limits and fills the default bursts
+func (c *Config) Validate() error {
+	for name, v := range map[string]int64{
+		"peer rate":             c.PeerRate,
+		"peer burst":            c.PeerBurst,
+		"account rate":          c.AccountRate,
+		"account burst":         c.AccountBurst,
+		"peer monthly quota":    c.PeerMonthlyQuota,
+		"account monthly quota": c.AccountMonthlyQuota,
+	} {
+		if v < 0 {
+			return fmt.Errorf("%s can't be negative", name)
+		}
+	}
+
+	var err error
+	if c.PeerBurst, err = burst(c.PeerRate, c.PeerBurst); err != nil {
+		return fmt.Errorf("peer burst: %w", err)
+	}
+	if c.AccountBurst, err = burst(c.AccountRate, c.AccountBurst); err != nil {
+		return fmt.Errorf("account burst: %w", err)
+	}
+	return nil
+}
+
+// burst returns the burst of the rate. A burst smaller than a message would drop the large messages forever.
+func burst(rate, burst int64) (int64, error) {
+	if rate == 0 {
+		return 0, nil
+	}
+	if burst == 0 {
+		burst = max(rate, messages.MaxMessageSize)
+	}
+	if burst < messages.MaxMessageSize {
+		return 0, fmt.Errorf("must be at least %d bytes", messages.MaxMessageSize)
+	}
+	return burst, nil
+}
+
+// sweepInterval is the minimum time between the sweeps of the released entries
+const sweepInterval = time.Minute
+
+// Limiter keeps the state of the limits. The state of a peer or an account is shared by its connections, so a
+// reconnection doesn't reset the bucket and the monthly usage is kept while the relay runs.
+type Limiter struct {
+	config Config
+	now    func() time.Time
+
+	mu        sync.Mutex
+	peers     map[messages.PeerID]*entry
+	accounts  map[string]*entry
+	lastSweep time.Time
+}
+
+// New creates a limiter. The config must be validated.
+func New(config Config) *Limiter {
+	return &Limiter{
+		config:   config,
+		now:      time.Now,
+		peers:    make(map[messages.PeerID]*entry),
+		accounts: make(map[string]*entry),
+	}
+}
+
+// Peer returns the limits of a new connection of the peer. The account ID is empty if the peer authenticated
+// without account, then only the peer limits apply. The Peer must be released when the connection closes.
+func (l *Limiter) Peer(peerID messages.PeerID, accountID string) *Peer {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.sweep()
+
+	p := &Peer{
+		limiter:   l,
+		peerID:    peerID,
+		accountID: accountID,
+		peer:      acquire(l.peers, peerID),
+	}
+	if accountID != "" && (l.config.AccountRate > 0 || l.config.AccountMonthlyQuota > 0) {
+		p.account = acquire(l.accounts, accountID)
+	}
+	return p
+}
+
+func (l *Limiter) release(p *Peer) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	p.peer.refs--
+	if p.account != nil {
+		p.account.refs--
+	}
+}
+
+// sweep drops the state of the peers and accounts without connection once dropping it loses nothing, that is their
+// bucket is full again and they have no usage of the current month. It runs at most every sweepInterval.
+func (l *Limiter) sweep() {
+	now := l.now()
+	if now.Sub(l.lastSweep) < sweepInterval {
+		return
+	}
+	l.lastSweep = now
+
+	sweepEntries(l.peers, now, l.config.PeerRate, l.config.PeerBurst, l.config.PeerMonthlyQuota)
+	sweepEntries(l.accounts, now, l.config.AccountRate, l.config.AccountBurst, l.config.AccountMonthlyQuota)
+}
+
+func sweepEntries[K comparable](entries map[K]*entry, now time.Time, rate, burst, quota int64) {
+	for key, e := range entries {
+		if e.refs == 0 && e.idle(now, rate, burst, quota) {
+			delete(entries, key)
+		}
+	}
+}
+
+func acquire[K comparable](entries map[K]*entry, key K) *entry {
+	e, ok := entries[key]
+	if !ok {
+		e = &entry{}
+		entries[key] = e
+	}
+	e.refs++
+	return e
+}
+
+// Peer holds the limits of a connection of a peer
+type Peer struct {
+	limiter   *Limiter
+	peerID    messages.PeerID
+	accountID string
+
+	peer    *entry
+	account *entry
+
+	releaseOnce sync.Once
+}
+
+// AccountID returns the account of the peer, it is empty if the peer authenticated without account
+func (p *Peer) AccountID() string {
+	return p.accountID
+}
+
+// Allow decides whether the peer can send a message of n bytes through the relay. The allowed bytes are taken from
+// the buckets and added to the monthly usage of the peer and its account.
+func (p *Peer) Allow(n int) Verdict {
+	cfg := p.limiter.config
+	now := p.limiter.now()
+
+	// the peer entry is always locked before the account entry
+	p.peer.mu.Lock()
+	defer p.peer.mu.Unlock()
+	if v := p.peer.check(now, n, cfg.PeerRate, cfg.PeerBurst, cfg.PeerMonthlyQuota); v != Allowed {
+		return v
+	}
+
+	if p.account != nil {
+		p.account.mu.Lock()
+		defer p.account.mu.Unlock()
+		if v := p.account.check(now, n, cfg.AccountRate, cfg.AccountBurst, cfg.AccountMonthlyQuota); v != Allowed {
+			return v
+		}
+		p.account.take(n)
+	}
+	p.peer.take(n)
+	return Allowed
+}
+
+// Release releases the limits of the connection
+func (p *Peer) Release() {
+	p.releaseOnce.Do(func() {
+		p.limiter.release(p)
+	})
+}
+
+// entry is the token bucket and the monthly usage of a peer or an account
+type entry struct {
+	// refs is the number of connections using the entry, guarded by the Limiter mutex
+	refs int
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+	period int
+	used   int64
+}
+
+func (e *entry) check(now time.Time, n int, rate, burst, quota int64) Verdict {
+	if quota > 0 {
+		if p := period(now); p != e.period {
+			e.period = p
+			e.used = 0
+		}
+		if e.used+int64(n) > quota {
+			return QuotaExceeded
+		}
+	}
+
+	if rate > 0 {
+		if e.last.IsZero() {
+			e.tokens = float64(burst)
+		} else if elapsed := now.Sub(e.last); elapsed > 0 {
+			e.tokens = min(float64(burst), e.tokens+elapsed.Seconds()*float64(rate))
+		}
+		e.last = now
+		if e.tokens < float64(n) {
+			return RateLimited
+		}
+	}
+	return Allowed
+}
+
+// idle returns true if the entry holds the same state as a new one: its bucket has been refilled and it has no
+// usage of the current month
+func (e *entry) idle(now time.Time, rate, burst, quota int64) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if rate > 0 && !e.last.IsZero() && e.tokens+now.Sub(e.last).Seconds()*float64(rate) < float64(burst) {
+		return false
+	}
+	if quota > 0 && e.used > 0 && e.period == period(now) {
+		return false
+	}
+	return true
+}
+
+func (e *entry) take(n int) {
+	e.used += int64(n)
+	if !e.last.IsZero() {
+		e.tokens -= float64(n)
+	}
+}
+
+// period returns the calendar month of the time, the quotas reset at the start of every UTC month
+func period(t time.Time) int {
+	t = t.UTC()
+	return t.Year()*12 + int(t.Month())
+}
@@ -0,0 +1,181 @@
+package limiter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/shared/relay/messages"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func newTestLimiter(t *testing.T, config Config) (*Limiter, *fakeClock) {
+	t.Helper()
+	require.NoError(t, config.Validate())
+	clock := &fakeClock{now: time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC)}
+	l := New(config)
+	l.now = clock.Now
+	return l, clock
+}
+
+func TestConfig_Validate(t *testing.T) {
+	cfg := Config{PeerRate: 1000}
+	require.NoError(t, cfg.Validate())
+	assert.Equal(t, int64(messages.MaxMessageSize), cfg.PeerBurst)
+	assert.Zero(t, cfg.AccountBurst)
+
+	cfg = Config{AccountRate: 1 << 20}
+	require.NoError(t, cfg.Validate())
+	assert.Equal(t, int64(1<<20), cfg.AccountBurst)
+
+	cfg = Config{PeerRate: 1 << 20, PeerBurst: 100}
+	assert.Error(t, cfg.Validate())
+
+	cfg = Config{PeerMonthlyQuota: -1}
+	assert.Error(t, cfg.Validate())
+
+	assert.False(t, Config{}.Enabled())
+	assert.True(t, Config{AccountMonthlyQuota: 1}.Enabled())
+}
+
+func TestPeer_RateLimit(t *testing.T) {
+	l, clock := newTestLimiter(t, Config{PeerRate: 10000, PeerBurst: 20000})
+	p := l.Peer(messages.HashID("peer"), "")
+	defer p.Release()
+
+	assert.Equal(t, Allowed, p.Allow(8000))
+	assert.Equal(t, Allowed, p.Allow(8000))
+	assert.Equal(t, RateLimited, p.Allow(8000))
+
+	// the rejected message doesn't take tokens
+	assert.Equal(t, Allowed, p.Allow(4000))
+
+	clock.now = clock.now.Add(500 * time.Millisecond)
+	assert.Equal(t, Allowed, p.Allow(5000))
+	assert.Equal(t, RateLimited, p.Allow(1))
+
+	// the bucket doesn't fill above the burst
+	clock.now = clock.now.Add(time.Hour)
+	assert.Equal(t, Allowed, p.Allow(20000))
+	assert.Equal(t, RateLimited, p.Allow(1))
+}
+
+func TestPeer_AccountRateLimitIsShared(t *testing.T) {
+	l, _ := newTestLimiter(t, Config{AccountRate: 10000, AccountBurst: 10000})
+	p1 := l.Peer(messages.HashID("peer1"), "account")
+	defer p1.Release()
+	p2 := l.Peer(messages.HashID("peer2"), "account")
+	defer p2.Release()
+	other := l.Peer(messages.HashID("peer3"), "other")
+	defer other.Release()
+	noAccount := l.Peer(messages.HashID("peer4"), "")
+	defer noAccount.Release()
+
+	assert.Equal(t, Allowed, p1.Allow(6000))
+	assert.Equal(t, RateLimited, p2.Allow(6000))
+	assert.Equal(t, Allowed, p2.Allow(4000))
+	assert.Equal(t, Allowed, other.Allow(10000))
+	assert.Equal(t, Allowed, noAccount.Allow(100000))
+}
+
+func TestPeer_PeerLimitDoesNotTakeAccountTokens(t *testing.T) {
+	l, _ := newTestLimiter(t, Config{PeerRate: 10000, PeerBurst: 10000, AccountRate: 15000, AccountBurst: 15000})
+	p1 := l.Peer(messages.HashID("peer1"), "account")
+	defer p1.Release()
+	p2 := l.Peer(messages.HashID("peer2"), "account")
+	defer p2.Release()
+
+	assert.Equal(t, Allowed, p1.Allow(10000))
+	assert.Equal(t, RateLimited, p1.Allow(5000))
+	assert.Equal(t, Allowed, p2.Allow(5000))
+}
+
+func TestPeer_MonthlyQuota(t *testing.T) {
+	l, clock := newTestLimiter(t, Config{PeerMonthlyQuota: 10000, AccountMonthlyQuota: 15000})
+	peerID := messages.HashID("peer1")
+	p := l.Peer(peerID, "account")
+
+	assert.Equal(t, Allowed, p.Allow(6000))
+	assert.Equal(t, Allowed, p.Allow(4000))
+	assert.Equal(t, QuotaExceeded, p.Allow(1))
+
+	// the usage survives a reconnection
+	p.Release()
+	p = l.Peer(peerID, "account")
+	assert.Equal(t, QuotaExceeded, p.Allow(1))
+
+	p2 := l.Peer(messages.HashID("peer2"), "account")
+	assert.Equal(t, Allowed, p2.Allow(5000))
+	assert.Equal(t, QuotaExceeded, p2.Allow(1))
+
+	// the quotas reset at the start of the next month
+	clock.now = time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, Allowed, p.Allow(10000))
+	assert.Equal(t, Allowed, p2.Allow(5000))
+
+	p.Release()
+	p2.Release()
+}
+
+func TestLimiter_SweepsReleasedEntries(t *testing.T) {
+	l, clock := newTestLimiter(t, Config{PeerMonthlyQuota: 10000, AccountMonthlyQuota: 10000})
+	p := l.Peer(messages.HashID("peer1"), "account")
+	require.Equal(t, Allowed, p.Allow(100))
+	p.Release()
+	p.Release()
+
+	connected := l.Peer(messages.HashID("peer2"), "other")
+	defer connected.Release()
+
+	assert.Len(t, l.peers, 2)
+	assert.Len(t, l.accounts, 2)
+
+	clock.now = clock.now.AddDate(0, 1, 0)
+	another := l.Peer(messages.HashID("peer3"), "")
+	defer another.Release()
+
+	assert.Len(t, l.peers, 2)
+	assert.Len(t, l.accounts, 1)
+	assert.Contains(t, l.accounts, "other")
+}
+
+func TestLimiter_SweepsIdleEntries(t *testing.T) {
+	l, clock := newTestLimiter(t, Config{PeerRate: messages.MaxMessageSize})
+
+	// released without traffic, the entry is the same as a new one
+	l.Peer(messages.HashID("idle"), "").Release()
+
+	busy := l.Peer(messages.HashID("busy"), "")
+	require.Equal(t, Allowed, busy.Allow(messages.MaxMessageSize))
+	busy.Release()
+
+	clock.now = clock.now.Add(sweepInterval / 2)
+	defer l.Peer(messages.HashID("trigger1"), "").Release()
+	assert.Contains(t, l.peers, messages.HashID("idle"), "the entries are swept at most every sweep interval")
+
+	clock.now = clock.now.Add(sweepInterval)
+	defer l.Peer(messages.HashID("trigger2"), "").Release()
+	assert.NotContains(t, l.peers, messages.HashID("idle"))
+	assert.NotContains(t, l.peers, messages.HashID("busy"), "the bucket of the busy peer has been refilled")
+}
+
+func TestLimiter_KeepsEntriesWithPartialBucket(t *testing.T) {
+	l, clock := newTestLimiter(t, Config{PeerRate: 1, PeerBurst: messages.MaxMessageSize})
+
+	busy := l.Peer(messages.HashID("busy"), "")
+	require.Equal(t, Allowed, busy.Allow(messages.MaxMessageSize))
+	busy.Release()
+
+	clock.now = clock.now.Add(sweepInterval)
+	defer l.Peer(messages.HashID("trigger"), "").Release()
+	assert.Contains(t, l.peers, messages.HashID("busy"), "dropping the entry would refill the bucket early")
+}
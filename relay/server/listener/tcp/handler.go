@@ -0,0 +1,71 @@
+// Package tcp serves the raw TLS transport of the relay. The clients negotiate it with ALPN on the TLS listener of the
+// WebSocket server, so it works where the WebSocket upgrade is mangled but needs no extra port.
+package tcp
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"slices"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/relay/protocol"
+	"github.com/netbirdio/netbird/shared/relay/stream"
+	nbtls "github.com/netbirdio/netbird/shared/relay/tls"
+)
+
+const Proto protocol.Protocol = "tcp"
+
+// Handler passes the raw TLS connections to the relay
+type Handler struct {
+	ctx      context.Context
+	cancel   context.CancelFunc
+	acceptFn func(conn net.Conn)
+}
+
+func NewHandler(acceptFn func(conn net.Conn)) *Handler {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Handler{
+		ctx:      ctx,
+		cancel:   cancel,
+		acceptFn: acceptFn,
+	}
+}
+
+// TLSConfig returns the TLS config of the listener extended with the ALPN of the raw transport. HTTP/2 is not offered,
+// the HTTP server doesn't serve it once the raw transport is registered and the WebSocket upgrade needs HTTP/1.1.
+func TLSConfig(tlsConfig *tls.Config) *tls.Config {
+	cfg := tlsConfig.Clone()
+	cfg.NextProtos = []string{nbtls.NBTCPalpn}
+	for _, proto := range tlsConfig.NextProtos {
+		if proto != "h2" && proto != nbtls.NBTCPalpn {
+			cfg.NextProtos = append(cfg.NextProtos, proto)
+		}
+	}
+	if !slices.Contains(cfg.NextProtos, "http/1.1") {
+		cfg.NextProtos = append(cfg.NextProtos, "http/1.1")
+	}
+	return cfg
+}
+
+// ServeTLS serves a connection that negotiated the raw transport. It has the signature of http.Server.TLSNextProto.
+// The HTTP server closes the connection when it returns, so it blocks until the connection is closed.
+func (h *Handler) ServeTLS(_ *http.Server, tlsConn *tls.Conn, _ http.Handler) {
+	log.Infof("TCP client connected from: %s", tlsConn.RemoteAddr())
+
+	conn := stream.NewConn(tlsConn)
+	h.acceptFn(conn)
+
+	select {
+	case <-conn.Done():
+	case <-h.ctx.Done():
+		_ = conn.Close()
+	}
+}
+
+// Close closes the connections that are still open
+func (h *Handler) Close() {
+	h.cancel()
+}
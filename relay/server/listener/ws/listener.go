@@ -12,7 +12,9 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	"github.com/netbirdio/netbird/relay/protocol"
+	"github.com/netbirdio/netbird/relay/server/listener/tcp"
 	"github.com/netbirdio/netbird/shared/relay"
+	nbtls "github.com/netbirdio/netbird/shared/relay/tls"
 )
 
 const (
@@ -26,8 +28,9 @@ type Listener struct {
 	// TLSConfig is the TLS configuration for the server.
 	TLSConfig *tls.Config
 
-	server   *http.Server
-	acceptFn func(conn net.Conn)
+	server     *http.Server
+	acceptFn   func(conn net.Conn)
+	tcpHandler *tcp.Handler
 }
 
 func (l *Listener) Listen(acceptFn func(conn net.Conn)) error {
@@ -41,6 +44,15 @@ func (l *Listener) Listen(acceptFn func(conn net.Conn)) error {
 		TLSConfig: l.TLSConfig,
 	}
 
+	// the TLS listener serves the raw TLS transport as well
+	if l.TLSConfig != nil {
+		l.tcpHandler = tcp.NewHandler(acceptFn)
+		l.server.TLSConfig = tcp.TLSConfig(l.TLSConfig)
+		l.server.TLSNextProto = map[string]func(*http.Server, *tls.Conn, http.Handler){
+			nbtls.NBTCPalpn: l.tcpHandler.ServeTLS,
+		}
+	}
+
 	log.Infof("WS server listening address: %s", l.Address)
 	var err error
 	if l.TLSConfig != nil {
@@ -64,6 +76,9 @@ func (l *Listener) Shutdown(ctx context.Context) error {
 	}
 
 	log.Infof("stop WS listener")
+	if l.tcpHandler != nil {
+		l.tcpHandler.Close()
+	}
 	if err := l.server.Shutdown(ctx); err != nil {
 		return fmt.Errorf("server shutdown failed: %v", err)
 	}
@@ -0,0 +1,134 @@
+package ws
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"math/big"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/shared/relay/stream"
+	nbtls "github.com/netbirdio/netbird/shared/relay/tls"
+)
+
+func selfSignedTLSConfig(t *testing.T) *tls.Config {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{{Certificate: [][]byte{der}, PrivateKey: key}},
+	}
+}
+
+// startEchoListener runs a TLS listener whose connections echo the messages
+func startEchoListener(t *testing.T) string {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := ln.Addr().String()
+	require.NoError(t, ln.Close())
+
+	l := &Listener{
+		Address:   address,
+		TLSConfig: selfSignedTLSConfig(t),
+	}
+	go func() {
+		_ = l.Listen(func(conn net.Conn) {
+			go func() {
+				defer conn.Close()
+				buf := make([]byte, 1024)
+				for {
+					n, err := conn.Read(buf)
+					if err != nil {
+						return
+					}
+					if _, err := conn.Write(buf[:n]); err != nil {
+						return
+					}
+				}
+			}()
+		})
+	}()
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		assert.NoError(t, l.Shutdown(ctx))
+	})
+
+	require.Eventually(t, func() bool {
+		conn, err := net.Dial("tcp", address)
+		if err != nil {
+			return false
+		}
+		_ = conn.Close()
+		return true
+	}, 5*time.Second, 10*time.Millisecond)
+	return address
+}
+
+func TestListener_RawTLSTransport(t *testing.T) {
+	address := startEchoListener(t)
+
+	tlsConn, err := tls.Dial("tcp", address, &tls.Config{
+		InsecureSkipVerify: true,
+		NextProtos:         []string{nbtls.NBTCPalpn},
+	})
+	require.NoError(t, err)
+	require.Equal(t, nbtls.NBTCPalpn, tlsConn.ConnectionState().NegotiatedProtocol)
+
+	conn := stream.NewConn(tlsConn)
+	defer conn.Close()
+
+	for _, msg := range []string{"first", "second"} {
+		_, err = conn.Write([]byte(msg))
+		require.NoError(t, err)
+
+		buf := make([]byte, 1024)
+		n, err := conn.Read(buf)
+		require.NoError(t, err)
+		assert.Equal(t, msg, string(buf[:n]))
+	}
+}
+
+func TestListener_WebSocketOnTLSListener(t *testing.T) {
+	address := startEchoListener(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	wsConn, _, err := websocket.Dial(ctx, "wss://"+address+URLPath, &websocket.DialOptions{
+		HTTPClient: &http.Client{Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		}},
+	})
+	require.NoError(t, err)
+	defer func() {
+		_ = wsConn.CloseNow()
+	}()
+
+	require.NoError(t, wsConn.Write(ctx, websocket.MessageBinary, []byte("hello")))
+	_, data, err := wsConn.Read(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(data))
+}
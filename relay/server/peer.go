@@ -12,6 +12,8 @@ import (
 	"github.com/netbirdio/netbird/shared/relay/healthcheck"
 	"github.com/netbirdio/netbird/shared/relay/messages"
 	"github.com/netbirdio/netbird/relay/metrics"
+	"github.com/netbirdio/netbird/relay/server/cluster"
+	"github.com/netbirdio/netbird/relay/server/limiter"
 	"github.com/netbirdio/netbird/relay/server/store"
 )
 
@@ -19,6 +21,9 @@ const (
 	bufferSize = messages.MaxMessageSize
 
 	errCloseConn = "failed to close connection to peer: %s"
+
+	// limitNotifyInterval is the minimum time between two limited messages sent to a peer while it is limited
+	limitNotifyInterval = 30 * time.Second
 )
 
 // Peer represents a peer connection
@@ -30,6 +35,12 @@ type Peer struct {
 	connMu   sync.RWMutex
 	store    *store.Store
 	notifier *store.PeerNotifier
+	cluster  *cluster.Cluster
+	limit    *limiter.Peer
+
+	// lastLimitNotify is the time the peer has been told last that its traffic is dropped, it is used by the read
+	// loop only
+	lastLimitNotify time.Time
 
 	peersListener *store.Listener
 
@@ -38,7 +49,7 @@ type Peer struct {
 }
 
 // NewPeer creates a new Peer instance and prepare custom logging
-func NewPeer(metrics *metrics.Metrics, id messages.PeerID, conn net.Conn, store *store.Store, notifier *store.PeerNotifier) *Peer {
+func NewPeer(metrics *metrics.Metrics, id messages.PeerID, conn net.Conn, store *store.Store, notifier *store.PeerNotifier, cluster *cluster.Cluster, limit *limiter.Peer) *Peer {
 	p := &Peer{
 		metrics:  metrics,
 		log:      log.WithField("peer_id", id.String()),
@@ -46,6 +57,8 @@ func NewPeer(metrics *metrics.Metrics, id messages.PeerID, conn net.Conn, store
 		conn:     conn,
 		store:    store,
 		notifier: notifier,
+		cluster:  cluster,
+		limit:    limit,
 	}
 
 	return p
@@ -112,6 +125,7 @@ func (p *Peer) handleMsgType(ctx context.Context, msgType messages.MsgType, hc *
 	switch msgType {
 	case messages.MsgTypeHealthCheck:
 		hc.OnHCResponse()
+		p.handleHealthcheckProbe(msg)
 	case messages.MsgTypeTransport:
 		p.metrics.TransferBytesRecv.Add(ctx, int64(n))
 		p.metrics.PeerActivity(p.String())
@@ -208,7 +222,22 @@ func (p *Peer) handleHealthcheckEvents(ctx context.Context, hc *healthcheck.Send
 	}
 }
 
+// handleHealthcheckProbe echoes the health check probes back, so the client can measure the round trip time
+func (p *Peer) handleHealthcheckProbe(msg []byte) {
+	if _, ok := messages.UnmarshalHealthcheckProbe(msg); !ok {
+		return
+	}
+
+	if _, err := p.Write(msg); err != nil {
+		p.log.Errorf("failed to echo healthcheck probe: %s", err)
+	}
+}
+
 func (p *Peer) handleTransportMsg(msg []byte) {
+	if !p.allowTransportMsg(len(msg)) {
+		return
+	}
+
 	peerID, err := messages.UnmarshalTransportID(msg)
 	if err != nil {
 		p.log.Errorf("failed to unmarshal transport message: %s", err)
@@ -217,7 +246,7 @@ func (p *Peer) handleTransportMsg(msg []byte) {
 
 	item, ok := p.store.Peer(*peerID)
 	if !ok {
-		p.log.Debugf("peer not found: %s", peerID)
+		p.forwardTransportMsg(*peerID, msg)
 		return
 	}
 	dp := item.(*Peer)
@@ -236,6 +265,62 @@ func (p *Peer) handleTransportMsg(msg []byte) {
 	p.metrics.TransferBytesSent.Add(context.Background(), int64(n))
 }
 
+// allowTransportMsg checks the message against the limits of the peer. A dropped message is counted and the peer is
+// told that it is limited, so it can prefer the direct connections.
+func (p *Peer) allowTransportMsg(size int) bool {
+	if p.limit == nil {
+		return true
+	}
+
+	verdict := p.limit.Allow(size)
+	if verdict == limiter.Allowed {
+		return true
+	}
+
+	p.metrics.RecordLimitedMessage(verdict.String(), size)
+	if time.Since(p.lastLimitNotify) < limitNotifyInterval {
+		return false
+	}
+	p.lastLimitNotify = time.Now()
+
+	reason := messages.LimitReasonRateLimited
+	if verdict == limiter.QuotaExceeded {
+		reason = messages.LimitReasonQuotaExceeded
+	}
+	p.log.Infof("dropping transport messages of the peer (account: %q): %s", p.limit.AccountID(), reason)
+	if _, err := p.Write(messages.MarshalLimitedMsg(reason)); err != nil {
+		p.log.Errorf("failed to send limited message: %s", err)
+	}
+	return false
+}
+
+// forwardTransportMsg sends the transport message to the relay node of the cluster that the destination peer is
+// connected to
+func (p *Peer) forwardTransportMsg(peerID messages.PeerID, msg []byte) {
+	if p.cluster == nil {
+		p.log.Debugf("peer not found: %s", peerID)
+		return
+	}
+
+	nodeID, ok := p.cluster.Lookup(peerID)
+	if !ok {
+		p.log.Debugf("peer not found in the cluster: %s", peerID)
+		return
+	}
+
+	if err := messages.UpdateTransportMsg(msg, p.id); err != nil {
+		p.log.Errorf("failed to update transport message: %s", err)
+		return
+	}
+
+	if err := p.cluster.Forward(nodeID, peerID, msg); err != nil {
+		p.metrics.RecordClusterForwardFailure()
+		p.log.Debugf("failed to forward transport message to %s on relay node %s: %s", peerID, nodeID, err)
+		return
+	}
+	p.metrics.ClusterBytesSent.Add(context.Background(), int64(len(msg)))
+}
+
 func (p *Peer) handleSubscribePeerState(msg []byte) {
 	peerIDs, err := messages.UnmarshalSubPeerStateMsg(msg)
 	if err != nil {
@@ -250,6 +335,7 @@ func (p *Peer) handleSubscribePeerState(msg []byte) {
 	defer p.notificationMutex.Unlock()
 
 	onlinePeers := p.store.GetOnlinePeersAndRegisterInterest(peerIDs, p.peersListener)
+	onlinePeers = append(onlinePeers, p.clusterOnlinePeers(peerIDs, onlinePeers)...)
 	if len(onlinePeers) == 0 {
 		return
 	}
@@ -258,6 +344,29 @@ func (p *Peer) handleSubscribePeerState(msg []byte) {
 	p.sendPeersOnline(onlinePeers)
 }
 
+// clusterOnlinePeers returns the peers that aren't connected to this relay but to another relay of the cluster
+func (p *Peer) clusterOnlinePeers(peerIDs, localPeers []messages.PeerID) []messages.PeerID {
+	if p.cluster == nil {
+		return nil
+	}
+
+	local := make(map[messages.PeerID]struct{}, len(localPeers))
+	for _, id := range localPeers {
+		local[id] = struct{}{}
+	}
+
+	var onlinePeers []messages.PeerID
+	for _, id := range peerIDs {
+		if _, ok := local[id]; ok {
+			continue
+		}
+		if _, ok := p.cluster.Lookup(id); ok {
+			onlinePeers = append(onlinePeers, id)
+		}
+	}
+	return onlinePeers
+}
+
 func (p *Peer) handleUnsubscribePeerState(msg []byte) {
 	peerIDs, err := messages.UnmarshalUnsubPeerStateMsg(msg)
 	if err != nil {
@@ -13,7 +13,10 @@ import (
 
 	//nolint:staticcheck
 	"github.com/netbirdio/netbird/relay/metrics"
+	"github.com/netbirdio/netbird/relay/server/cluster"
+	"github.com/netbirdio/netbird/relay/server/limiter"
 	"github.com/netbirdio/netbird/relay/server/store"
+	"github.com/netbirdio/netbird/shared/relay/messages"
 )
 
 type Config struct {
@@ -21,6 +24,11 @@ type Config struct {
 	ExposedAddress string
 	TLSSupport     bool
 	AuthValidator  Validator
+	// Cluster is the optional cluster membership of the relay. With it the peers can reach the peers connected to the
+	// other relays of the cluster.
+	Cluster *cluster.Cluster
+	// Limits are the optional rate limits and monthly quotas of the peers and the accounts
+	Limits limiter.Config
 
 	instanceURL string
 }
@@ -42,6 +50,10 @@ func (c *Config) validate() error {
 	if c.AuthValidator == nil {
 		return fmt.Errorf("auth validator is required")
 	}
+
+	if err := c.Limits.Validate(); err != nil {
+		return fmt.Errorf("invalid limits: %v", err)
+	}
 	return nil
 }
 
@@ -53,6 +65,8 @@ type Relay struct {
 
 	store       *store.Store
 	notifier    *store.PeerNotifier
+	cluster     *cluster.Cluster
+	limiter     *limiter.Limiter
 	instanceURL string
 	preparedMsg *preparedMsg
 
@@ -69,6 +83,8 @@ type Relay struct {
 //	  - ExposedAddress: The external address clients use to reach this relay. Required.
 //	  - TLSSupport: A boolean indicating if the relay uses TLS. Affects the generated instance URL.
 //	  - AuthValidator: A Validator implementation used to authenticate peers. Required.
+//	  - Cluster: The cluster membership of the relay. Optional, it is started by the relay.
+//	  - Limits: The rate limits and monthly quotas of the peers and the accounts. Optional.
 //
 // Returns:
 //
@@ -93,6 +109,11 @@ func NewRelay(config Config) (*Relay, error) {
 		instanceURL:   config.instanceURL,
 		store:         store.NewStore(),
 		notifier:      store.NewPeerNotifier(),
+		cluster:       config.Cluster,
+	}
+
+	if config.Limits.Enabled() {
+		r.limiter = limiter.New(config.Limits)
 	}
 
 	r.preparedMsg, err = newPreparedMsg(r.instanceURL)
@@ -101,6 +122,13 @@ func NewRelay(config Config) (*Relay, error) {
 		return nil, fmt.Errorf("prepare message: %v", err)
 	}
 
+	if r.cluster != nil {
+		if err := r.cluster.Start(r.deliverForwardedMsg, &clusterHandler{relay: r}); err != nil {
+			metricsCancel()
+			return nil, fmt.Errorf("start cluster: %v", err)
+		}
+	}
+
 	return r, nil
 }
 
@@ -127,20 +155,31 @@ func (r *Relay) Accept(conn net.Conn) {
 		return
 	}
 
-	peer := NewPeer(r.metrics, *peerID, conn, r.store, r.notifier)
+	var limit *limiter.Peer
+	if r.limiter != nil {
+		limit = r.limiter.Peer(*peerID, h.accountID)
+	}
+
+	peer := NewPeer(r.metrics, *peerID, conn, r.store, r.notifier, r.cluster, limit)
 	peer.log.Infof("peer connected from: %s", conn.RemoteAddr())
 	storeTime := time.Now()
 	if isReconnection := r.store.AddPeer(peer); isReconnection {
 		r.metrics.RecordPeerReconnection()
 	}
 	r.notifier.PeerCameOnline(peer.ID())
+	if r.cluster != nil {
+		r.cluster.Announce(peer.ID())
+	}
 
 	r.metrics.RecordPeerStoreTime(time.Since(storeTime))
 	r.metrics.PeerConnected(peer.String())
 	go func() {
 		peer.Work()
+		if limit != nil {
+			limit.Release()
+		}
 		if deleted := r.store.DeletePeer(peer); deleted {
-			r.notifier.PeerWentOffline(peer.ID())
+			r.peerDeleted(peer.ID())
 		}
 		peer.log.Debugf("relay connection closed")
 		r.metrics.PeerDisconnected(peer.String())
@@ -170,6 +209,11 @@ func (r *Relay) Shutdown(ctx context.Context) {
 		}(v.(*Peer))
 	}
 	wg.Wait()
+	if r.cluster != nil {
+		if err := r.cluster.Close(); err != nil {
+			log.Errorf("failed to close relay cluster: %s", err)
+		}
+	}
 	r.metricsCancel()
 	r.closed = true
 }
@@ -178,3 +222,60 @@ func (r *Relay) Shutdown(ctx context.Context) {
 func (r *Relay) InstanceURL() string {
 	return r.instanceURL
 }
+
+// peerDeleted withdraws the peer from the cluster and notifies the subscribers if the peer isn't connected to another
+// relay of the cluster either
+func (r *Relay) peerDeleted(peerID messages.PeerID) {
+	if r.cluster != nil {
+		r.cluster.Withdraw(peerID)
+		if nodeID, ok := r.cluster.Lookup(peerID); ok {
+			log.Debugf("peer %s is still connected to relay node %s", peerID, nodeID)
+			return
+		}
+	}
+	r.notifier.PeerWentOffline(peerID)
+}
+
+// deliverForwardedMsg writes a transport message forwarded by another relay of the cluster to the local peer
+func (r *Relay) deliverForwardedMsg(peerID messages.PeerID, msg []byte) {
+	r.metrics.ClusterBytesRecv.Add(context.Background(), int64(len(msg)))
+
+	item, ok := r.store.Peer(peerID)
+	if !ok {
+		log.Debugf("peer of forwarded message not found: %s", peerID)
+		return
+	}
+	dp := item.(*Peer)
+
+	n, err := dp.Write(msg)
+	if err != nil {
+		dp.log.Errorf("failed to write forwarded transport message: %s", err)
+		return
+	}
+	r.metrics.TransferBytesSent.Add(context.Background(), int64(n))
+}
+
+// clusterHandler passes the presence changes on the other relays of the cluster to the local subscribers
+type clusterHandler struct {
+	relay *Relay
+}
+
+func (h *clusterHandler) PeerOnline(nodeID string, peerID messages.PeerID) {
+	if _, ok := h.relay.store.Peer(peerID); ok {
+		// the peer reconnected to another relay while the old connection is still open. The local peers keep sending
+		// to the old connection as the local store is preferred over the cluster until it closes on its own.
+		log.Debugf("peer %s connected to relay node %s as well", peerID, nodeID)
+	}
+	h.relay.notifier.PeerCameOnline(peerID)
+}
+
+func (h *clusterHandler) PeerOffline(nodeID string, peerID messages.PeerID) {
+	if _, ok := h.relay.store.Peer(peerID); ok {
+		return
+	}
+	if _, ok := h.relay.cluster.Lookup(peerID); ok {
+		return
+	}
+	log.Debugf("peer %s disconnected from relay node %s", peerID, nodeID)
+	h.relay.notifier.PeerWentOffline(peerID)
+}
@@ -44,6 +44,11 @@ type GrpcClient struct {
 	conn                  *grpc.ClientConn
 	connStateCallback     ConnStateNotifier
 	connStateCallbackLock sync.RWMutex
+
+	// networkMap is the last network map received over the Sync stream and applied by the message handler.
+	// It is used as a base for NetworkMapDelta updates.
+	networkMap     *proto.NetworkMap
+	networkMapLock sync.Mutex
 }
 
 // NewClient creates a new client to Management service
@@ -144,7 +149,7 @@ func (c *GrpcClient) handleStream(ctx context.Context, serverPubKey wgtypes.Key,
 	ctx, cancelStream := context.WithCancel(ctx)
 	defer cancelStream()
 
-	stream, err := c.connectToStream(ctx, serverPubKey, sysInfo)
+	stream, err := c.connectToStream(ctx, serverPubKey, sysInfo, true)
 	if err != nil {
 		log.Debugf("failed to open Management Service stream: %s", err)
 		if s, ok := gstatus.FromError(err); ok && s.Code() == codes.PermissionDenied {
@@ -186,7 +191,7 @@ func (c *GrpcClient) GetNetworkMap(sysInfo *system.Info) (*proto.NetworkMap, err
 
 	ctx, cancelStream := context.WithCancel(c.ctx)
 	defer cancelStream()
-	stream, err := c.connectToStream(ctx, *serverPubKey, sysInfo)
+	stream, err := c.connectToStream(ctx, *serverPubKey, sysInfo, false)
 	if err != nil {
 		log.Debugf("failed to open Management Service stream: %s", err)
 		return nil, err
@@ -219,8 +224,14 @@ func (c *GrpcClient) GetNetworkMap(sysInfo *system.Info) (*proto.NetworkMap, err
 	return decryptedResp.GetNetworkMap(), nil
 }
 
-func (c *GrpcClient) connectToStream(ctx context.Context, serverPubKey wgtypes.Key, sysInfo *system.Info) (proto.ManagementService_SyncClient, error) {
+// connectToStream opens the Sync stream. If withDelta is set, the stream announces NetworkMapDelta support
+// and the serial of the last applied network map, otherwise the initial message contains a full network map.
+func (c *GrpcClient) connectToStream(ctx context.Context, serverPubKey wgtypes.Key, sysInfo *system.Info, withDelta bool) (proto.ManagementService_SyncClient, error) {
 	req := &proto.SyncRequest{Meta: infoToMetaData(sysInfo)}
+	if withDelta {
+		req.NetworkMapDeltaSupported = true
+		req.NetworkMapSerial = c.getNetworkMap().GetSerial()
+	}
 
 	myPrivateKey := c.key
 	myPublicKey := myPrivateKey.PublicKey()
@@ -258,12 +269,69 @@ func (c *GrpcClient) receiveEvents(stream proto.ManagementService_SyncClient, se
 			return err
 		}
 
+		if delta := decryptedResp.GetNetworkMapDelta(); delta != nil {
+			networkMap, err := proto.ApplyNetworkMapDelta(c.getNetworkMap(), delta)
+			if err != nil {
+				// reconnecting without a base network map makes management send a full snapshot
+				c.setNetworkMap(nil)
+				return fmt.Errorf("apply network map delta: %w", err)
+			}
+			decryptedResp.NetworkMap = networkMap
+			decryptedResp.NetworkMapDelta = nil
+		}
+
 		if err := msgHandler(decryptedResp); err != nil {
 			log.Errorf("failed handling an update message received from Management Service: %v", err.Error())
+			if decryptedResp.GetNetworkMap() != nil {
+				// the network map wasn't acknowledged, management will send a full snapshot with the next update
+				c.setNetworkMap(nil)
+			}
+			continue
+		}
+
+		if networkMap := decryptedResp.GetNetworkMap(); networkMap != nil {
+			c.setNetworkMap(networkMap)
+			if err := c.ackNetworkMap(serverPubKey, networkMap.GetSerial()); err != nil {
+				if s, ok := gstatus.FromError(err); ok && s.Code() == codes.Unimplemented {
+					// older management servers neither acknowledge nor send deltas
+					log.Tracef("management doesn't support network map acknowledgements")
+					continue
+				}
+				log.Warnf("failed to acknowledge network map with serial %d: %v", networkMap.GetSerial(), err)
+			}
 		}
 	}
 }
 
+// ackNetworkMap confirms to the Management Service that the network map with the given serial was applied
+func (c *GrpcClient) ackNetworkMap(serverPubKey wgtypes.Key, serial uint64) error {
+	ackReq, err := encryption.EncryptMessage(serverPubKey, c.key, &proto.NetworkMapAck{Serial: serial})
+	if err != nil {
+		return fmt.Errorf("encrypt network map ack: %w", err)
+	}
+
+	mgmCtx, cancel := context.WithTimeout(c.ctx, ConnectTimeout)
+	defer cancel()
+
+	_, err = c.realClient.AckNetworkMap(mgmCtx, &proto.EncryptedMessage{
+		WgPubKey: c.key.PublicKey().String(),
+		Body:     ackReq,
+	})
+	return err
+}
+
+func (c *GrpcClient) getNetworkMap() *proto.NetworkMap {
+	c.networkMapLock.Lock()
+	defer c.networkMapLock.Unlock()
+	return c.networkMap
+}
+
+func (c *GrpcClient) setNetworkMap(networkMap *proto.NetworkMap) {
+	c.networkMapLock.Lock()
+	defer c.networkMapLock.Unlock()
+	c.networkMap = networkMap
+}
+
 // GetServerPublicKey returns server's WireGuard public key (used later for encrypting messages sent to the server)
 func (c *GrpcClient) GetServerPublicKey() (*wgtypes.Key, error) {
 	if !c.ready() {
@@ -545,6 +613,31 @@ func infoToMetaData(info *system.Info) *proto.PeerSystemMeta {
 		})
 	}
 
+	volumes := make([]*proto.EncryptedVolume, 0, len(info.DiskEncryption.Volumes))
+	for _, volume := range info.DiskEncryption.Volumes {
+		volumes = append(volumes, &proto.EncryptedVolume{
+			Path:      volume.Path,
+			Encrypted: volume.Encrypted,
+		})
+	}
+
+	postureCheckResults := make([]*proto.PostureCheckResult, 0, len(info.PostureCheckResults))
+	for _, result := range info.PostureCheckResults {
+		postureCheckResults = append(postureCheckResults, &proto.PostureCheckResult{
+			Id:     result.ID,
+			Passed: result.Passed,
+			Reason: result.Reason,
+		})
+	}
+
+	relayLatencies := make([]*proto.RelayLatency, 0, len(info.RelayLatencies))
+	for _, latency := range info.RelayLatencies {
+		relayLatencies = append(relayLatencies, &proto.RelayLatency{
+			Url:   latency.URL,
+			RttMs: latency.RTT.Milliseconds(),
+		})
+	}
+
 	return &proto.PeerSystemMeta{
 		Hostname:         info.Hostname,
 		GoOS:             info.GoOS,
@@ -565,6 +658,14 @@ func infoToMetaData(info *system.Info) *proto.PeerSystemMeta {
 			Platform: info.Environment.Platform,
 		},
 		Files: files,
+		DiskEncryption: &proto.DiskEncryption{
+			Volumes: volumes,
+		},
+		HostFirewall: &proto.HostFirewall{
+			Active: info.HostFirewall.Active,
+		},
+		PostureCheckResults: postureCheckResults,
+		RelayLatencies:      relayLatencies,
 
 		Flags: &proto.Flags{
 			RosenpassEnabled:    info.RosenpassEnabled,
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"strconv"
 
 	"github.com/netbirdio/netbird/shared/management/http/api"
 )
@@ -58,3 +59,40 @@ func (a *AccountsAPI) Delete(ctx context.Context, accountID string) error {
 
 	return nil
 }
+
+// Export export groups, policies, posture checks, routes, networks, nameserver groups, DNS settings and setup keys of the account
+// See more: https://docs.netbird.io/api/resources/accounts#export-an-account
+func (a *AccountsAPI) Export(ctx context.Context, accountID string) (*api.AccountDocument, error) {
+	resp, err := a.c.NewRequest(ctx, "GET", "/api/accounts/"+accountID+"/export", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	ret, err := parseResponse[api.AccountDocument](resp)
+	return &ret, err
+}
+
+// Apply reconcile the account with the document, only plans the changes when dryRun is set.
+// When planHash is set the changes are rejected if the account was modified since the plan was made.
+// See more: https://docs.netbird.io/api/resources/accounts#apply-a-document-to-an-account
+func (a *AccountsAPI) Apply(ctx context.Context, accountID string, request api.PostApiAccountsAccountIdApplyJSONRequestBody, dryRun bool, planHash string) (*api.AccountPlan, error) {
+	requestBytes, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	query := map[string]string{"dry_run": strconv.FormatBool(dryRun)}
+	if planHash != "" {
+		query["plan_hash"] = planHash
+	}
+	resp, err := a.c.NewRequest(ctx, "POST", "/api/accounts/"+accountID+"/apply", bytes.NewReader(requestBytes), query)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	ret, err := parseResponse[api.AccountPlan](resp)
+	return &ret, err
+}
@@ -144,6 +144,61 @@ func TestAccounts_Delete_Err(t *testing.T) {
 	})
 }
 
+func TestAccounts_Export_200(t *testing.T) {
+	withMockClient(func(c *rest.Client, mux *http.ServeMux) {
+		document := api.AccountDocument{Groups: &[]api.AccountDocumentGroup{{Name: "devs", Peers: &[]string{"laptop"}}}}
+		mux.HandleFunc("/api/accounts/Test/export", func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "GET", r.Method)
+			retBytes, _ := json.Marshal(document)
+			_, err := w.Write(retBytes)
+			require.NoError(t, err)
+		})
+		ret, err := c.Accounts.Export(context.Background(), "Test")
+		require.NoError(t, err)
+		assert.Equal(t, document, *ret)
+	})
+}
+
+func TestAccounts_Apply_200(t *testing.T) {
+	withMockClient(func(c *rest.Client, mux *http.ServeMux) {
+		plan := api.AccountPlan{Changes: []api.AccountPlanChange{{Action: api.AccountPlanChangeActionCreate, Kind: api.AccountPlanChangeKindGroup, Name: "devs"}}}
+		mux.HandleFunc("/api/accounts/Test/apply", func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "POST", r.Method)
+			assert.Equal(t, "false", r.URL.Query().Get("dry_run"))
+			assert.Equal(t, "hash", r.URL.Query().Get("plan_hash"))
+			reqBytes, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			var req api.PostApiAccountsAccountIdApplyJSONRequestBody
+			err = json.Unmarshal(reqBytes, &req)
+			require.NoError(t, err)
+			assert.Equal(t, "devs", (*req.Groups)[0].Name)
+			retBytes, _ := json.Marshal(plan)
+			_, err = w.Write(retBytes)
+			require.NoError(t, err)
+		})
+		ret, err := c.Accounts.Apply(context.Background(), "Test", api.PostApiAccountsAccountIdApplyJSONRequestBody{
+			Groups: &[]api.AccountDocumentGroup{{Name: "devs"}},
+		}, false, "hash")
+		require.NoError(t, err)
+		assert.Equal(t, plan, *ret)
+	})
+}
+
+func TestAccounts_Apply_Err(t *testing.T) {
+	withMockClient(func(c *rest.Client, mux *http.ServeMux) {
+		mux.HandleFunc("/api/accounts/Test/apply", func(w http.ResponseWriter, r *http.Request) {
+			retBytes, _ := json.Marshal(util.ErrorResponse{Message: "No", Code: 422})
+			w.WriteHeader(422)
+			_, err := w.Write(retBytes)
+			require.NoError(t, err)
+		})
+		ret, err := c.Accounts.Apply(context.Background(), "Test", api.PostApiAccountsAccountIdApplyJSONRequestBody{}, false, "")
+		assert.Error(t, err)
+		assert.Equal(t, "No", err.Error())
+		assert.Nil(t, ret)
+	})
+}
+
 func TestAccounts_Integration_List(t *testing.T) {
 	withBlackBoxServer(t, func(c *rest.Client) {
 		accounts, err := c.Accounts.List(context.Background())
@@ -29,6 +29,9 @@ type Client struct {
 	// see more: https://docs.netbird.io/api/resources/tokens
 	Tokens *TokensAPI
 
+	// SCIMTokens NetBird SCIM provisioning tokens APIs
+	SCIMTokens *SCIMTokensAPI
+
 	// Peers NetBird peers APIs
 	// see more: https://docs.netbird.io/api/resources/peers
 	Peers *PeersAPI
@@ -104,6 +107,7 @@ func (c *Client) initialize() {
 	c.Accounts = &AccountsAPI{c}
 	c.Users = &UsersAPI{c}
 	c.Tokens = &TokensAPI{c}
+	c.SCIMTokens = &SCIMTokensAPI{c}
 	c.Peers = &PeersAPI{c}
 	c.SetupKeys = &SetupKeysAPI{c}
 	c.Groups = &GroupsAPI{c}
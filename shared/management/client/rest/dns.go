@@ -91,6 +91,152 @@ func (a *DNSAPI) DeleteNameserverGroup(ctx context.Context, nameserverGroupID st
 	return nil
 }
 
+// ListZones list all custom DNS zones
+func (a *DNSAPI) ListZones(ctx context.Context) ([]api.DNSZone, error) {
+	resp, err := a.c.NewRequest(ctx, "GET", "/api/dns/zones", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	ret, err := parseResponse[[]api.DNSZone](resp)
+	return ret, err
+}
+
+// GetZone get custom DNS zone info
+func (a *DNSAPI) GetZone(ctx context.Context, zoneID string) (*api.DNSZone, error) {
+	resp, err := a.c.NewRequest(ctx, "GET", "/api/dns/zones/"+zoneID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	ret, err := parseResponse[api.DNSZone](resp)
+	return &ret, err
+}
+
+// CreateZone create new custom DNS zone
+func (a *DNSAPI) CreateZone(ctx context.Context, request api.PostApiDnsZonesJSONRequestBody) (*api.DNSZone, error) {
+	requestBytes, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := a.c.NewRequest(ctx, "POST", "/api/dns/zones", bytes.NewReader(requestBytes), nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	ret, err := parseResponse[api.DNSZone](resp)
+	return &ret, err
+}
+
+// UpdateZone update custom DNS zone and its records
+func (a *DNSAPI) UpdateZone(ctx context.Context, zoneID string, request api.PutApiDnsZonesZoneIdJSONRequestBody) (*api.DNSZone, error) {
+	requestBytes, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := a.c.NewRequest(ctx, "PUT", "/api/dns/zones/"+zoneID, bytes.NewReader(requestBytes), nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	ret, err := parseResponse[api.DNSZone](resp)
+	return &ret, err
+}
+
+// DeleteZone delete custom DNS zone
+func (a *DNSAPI) DeleteZone(ctx context.Context, zoneID string) error {
+	resp, err := a.c.NewRequest(ctx, "DELETE", "/api/dns/zones/"+zoneID, nil, nil)
+	if err != nil {
+		return err
+	}
+	if resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// ListBlocklists list all DNS blocklists
+func (a *DNSAPI) ListBlocklists(ctx context.Context) ([]api.DNSBlocklist, error) {
+	resp, err := a.c.NewRequest(ctx, "GET", "/api/dns/blocklists", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	ret, err := parseResponse[[]api.DNSBlocklist](resp)
+	return ret, err
+}
+
+// GetBlocklist get DNS blocklist info
+func (a *DNSAPI) GetBlocklist(ctx context.Context, blocklistID string) (*api.DNSBlocklist, error) {
+	resp, err := a.c.NewRequest(ctx, "GET", "/api/dns/blocklists/"+blocklistID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	ret, err := parseResponse[api.DNSBlocklist](resp)
+	return &ret, err
+}
+
+// CreateBlocklist create new DNS blocklist
+func (a *DNSAPI) CreateBlocklist(ctx context.Context, request api.PostApiDnsBlocklistsJSONRequestBody) (*api.DNSBlocklist, error) {
+	requestBytes, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := a.c.NewRequest(ctx, "POST", "/api/dns/blocklists", bytes.NewReader(requestBytes), nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	ret, err := parseResponse[api.DNSBlocklist](resp)
+	return &ret, err
+}
+
+// UpdateBlocklist update DNS blocklist
+func (a *DNSAPI) UpdateBlocklist(ctx context.Context, blocklistID string, request api.PutApiDnsBlocklistsBlocklistIdJSONRequestBody) (*api.DNSBlocklist, error) {
+	requestBytes, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := a.c.NewRequest(ctx, "PUT", "/api/dns/blocklists/"+blocklistID, bytes.NewReader(requestBytes), nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	ret, err := parseResponse[api.DNSBlocklist](resp)
+	return &ret, err
+}
+
+// DeleteBlocklist delete DNS blocklist
+func (a *DNSAPI) DeleteBlocklist(ctx context.Context, blocklistID string) error {
+	resp, err := a.c.NewRequest(ctx, "DELETE", "/api/dns/blocklists/"+blocklistID, nil, nil)
+	if err != nil {
+		return err
+	}
+	if resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
 // GetSettings get DNS settings
 // See more: https://docs.netbird.io/api/resources/dns#retrieve-dns-settings
 func (a *DNSAPI) GetSettings(ctx context.Context) (*api.DNSSettings, error) {
@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -24,6 +25,19 @@ var (
 		Name: "wow",
 	}
 
+	testZone = api.DNSZone{
+		Id:     "Test",
+		Name:   "corp",
+		Domain: "corp.internal",
+	}
+
+	testBlocklist = api.DNSBlocklist{
+		Id:      "Test",
+		Name:    "ads",
+		Action:  api.DNSBlocklistActionNxdomain,
+		Domains: []string{"ads.example.com"},
+	}
+
 	testSettings = api.DNSSettings{
 		DisabledManagementGroups: []string{"gone"},
 	}
@@ -189,6 +203,143 @@ func TestDNSNameserverGroup_Delete_Err(t *testing.T) {
 	})
 }
 
+func TestDNSZone_List_200(t *testing.T) {
+	withMockClient(func(c *rest.Client, mux *http.ServeMux) {
+		mux.HandleFunc("/api/dns/zones", func(w http.ResponseWriter, r *http.Request) {
+			retBytes, _ := json.Marshal([]api.DNSZone{testZone})
+			_, err := w.Write(retBytes)
+			require.NoError(t, err)
+		})
+		ret, err := c.DNS.ListZones(context.Background())
+		require.NoError(t, err)
+		assert.Len(t, ret, 1)
+		assert.Equal(t, testZone, ret[0])
+	})
+}
+
+func TestDNSZone_Get_200(t *testing.T) {
+	withMockClient(func(c *rest.Client, mux *http.ServeMux) {
+		mux.HandleFunc("/api/dns/zones/Test", func(w http.ResponseWriter, r *http.Request) {
+			retBytes, _ := json.Marshal(testZone)
+			_, err := w.Write(retBytes)
+			require.NoError(t, err)
+		})
+		ret, err := c.DNS.GetZone(context.Background(), "Test")
+		require.NoError(t, err)
+		assert.Equal(t, testZone, *ret)
+	})
+}
+
+func TestDNSZone_Create_200(t *testing.T) {
+	withMockClient(func(c *rest.Client, mux *http.ServeMux) {
+		mux.HandleFunc("/api/dns/zones", func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "POST", r.Method)
+			reqBytes, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			var req api.PostApiDnsZonesJSONRequestBody
+			err = json.Unmarshal(reqBytes, &req)
+			require.NoError(t, err)
+			assert.Equal(t, "corp.internal", req.Domain)
+			retBytes, _ := json.Marshal(testZone)
+			_, err = w.Write(retBytes)
+			require.NoError(t, err)
+		})
+		ret, err := c.DNS.CreateZone(context.Background(), api.PostApiDnsZonesJSONRequestBody{
+			Domain: "corp.internal",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, testZone, *ret)
+	})
+}
+
+func TestDNSZone_Update_Err(t *testing.T) {
+	withMockClient(func(c *rest.Client, mux *http.ServeMux) {
+		mux.HandleFunc("/api/dns/zones/Test", func(w http.ResponseWriter, r *http.Request) {
+			retBytes, _ := json.Marshal(util.ErrorResponse{Message: "No", Code: 400})
+			w.WriteHeader(400)
+			_, err := w.Write(retBytes)
+			require.NoError(t, err)
+		})
+		ret, err := c.DNS.UpdateZone(context.Background(), "Test", api.PutApiDnsZonesZoneIdJSONRequestBody{})
+		assert.Error(t, err)
+		assert.Equal(t, "No", err.Error())
+		assert.Empty(t, ret)
+	})
+}
+
+func TestDNSZone_Delete_200(t *testing.T) {
+	withMockClient(func(c *rest.Client, mux *http.ServeMux) {
+		mux.HandleFunc("/api/dns/zones/Test", func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "DELETE", r.Method)
+			w.WriteHeader(200)
+		})
+		err := c.DNS.DeleteZone(context.Background(), "Test")
+		require.NoError(t, err)
+	})
+}
+
+func TestDNSBlocklist_List_200(t *testing.T) {
+	withMockClient(func(c *rest.Client, mux *http.ServeMux) {
+		mux.HandleFunc("/api/dns/blocklists", func(w http.ResponseWriter, r *http.Request) {
+			retBytes, _ := json.Marshal([]api.DNSBlocklist{testBlocklist})
+			_, err := w.Write(retBytes)
+			require.NoError(t, err)
+		})
+		ret, err := c.DNS.ListBlocklists(context.Background())
+		require.NoError(t, err)
+		assert.Len(t, ret, 1)
+		assert.Equal(t, testBlocklist, ret[0])
+	})
+}
+
+func TestDNSBlocklist_Create_200(t *testing.T) {
+	withMockClient(func(c *rest.Client, mux *http.ServeMux) {
+		mux.HandleFunc("/api/dns/blocklists", func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "POST", r.Method)
+			reqBytes, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			var req api.PostApiDnsBlocklistsJSONRequestBody
+			err = json.Unmarshal(reqBytes, &req)
+			require.NoError(t, err)
+			assert.Equal(t, "ads", req.Name)
+			retBytes, _ := json.Marshal(testBlocklist)
+			_, err = w.Write(retBytes)
+			require.NoError(t, err)
+		})
+		ret, err := c.DNS.CreateBlocklist(context.Background(), api.PostApiDnsBlocklistsJSONRequestBody{
+			Name: "ads",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, testBlocklist, *ret)
+	})
+}
+
+func TestDNSBlocklist_Get_Err(t *testing.T) {
+	withMockClient(func(c *rest.Client, mux *http.ServeMux) {
+		mux.HandleFunc("/api/dns/blocklists/Test", func(w http.ResponseWriter, r *http.Request) {
+			retBytes, _ := json.Marshal(util.ErrorResponse{Message: "No", Code: 404})
+			w.WriteHeader(404)
+			_, err := w.Write(retBytes)
+			require.NoError(t, err)
+		})
+		ret, err := c.DNS.GetBlocklist(context.Background(), "Test")
+		assert.Error(t, err)
+		assert.Equal(t, "No", err.Error())
+		assert.Empty(t, ret)
+	})
+}
+
+func TestDNSBlocklist_Delete_200(t *testing.T) {
+	withMockClient(func(c *rest.Client, mux *http.ServeMux) {
+		mux.HandleFunc("/api/dns/blocklists/Test", func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "DELETE", r.Method)
+			w.WriteHeader(200)
+		})
+		err := c.DNS.DeleteBlocklist(context.Background(), "Test")
+		require.NoError(t, err)
+	})
+}
+
 func TestDNSSettings_Get_200(t *testing.T) {
 	withMockClient(func(c *rest.Client, mux *http.ServeMux) {
 		mux.HandleFunc("/api/dns/settings", func(w http.ResponseWriter, r *http.Request) {
@@ -299,3 +450,94 @@ func TestDNS_Integration(t *testing.T) {
 		assert.Len(t, nsGroups, 0)
 	})
 }
+
+func TestDNSZone_Integration(t *testing.T) {
+	ttl := 60
+	zoneReq := api.DNSZoneRequest{
+		Name:    "corp",
+		Domain:  "Corp.Internal.",
+		Enabled: true,
+		Groups:  []string{"cs1tnh0hhcjnqoiuebeg"},
+		Records: []api.DNSRecord{
+			{Name: "@", Type: api.DNSRecordTypeTXT, Value: "v=spf1 -all"},
+			{Name: "www", Type: api.DNSRecordTypeA, Ttl: &ttl, Value: "100.64.0.10"},
+			{Name: "_sip._tcp", Type: api.DNSRecordTypeSRV, Value: "10 5 5060 sip.corp.internal."},
+		},
+	}
+	withBlackBoxServer(t, func(c *rest.Client) {
+		zone, err := c.DNS.CreateZone(context.Background(), zoneReq)
+		require.NoError(t, err)
+		assert.Equal(t, "corp.internal", zone.Domain)
+		require.Len(t, zone.Records, 3)
+		assert.Equal(t, 300, *zone.Records[0].Ttl)
+
+		_, err = c.DNS.CreateZone(context.Background(), zoneReq)
+		assert.Error(t, err, "zone domains should be unique")
+
+		zones, err := c.DNS.ListZones(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, *zone, zones[0])
+
+		zoneReq.Records = append(zoneReq.Records, api.DNSRecord{Name: "www", Type: api.DNSRecordTypeCNAME, Value: "web.corp.internal"})
+		_, err = c.DNS.UpdateZone(context.Background(), zone.Id, zoneReq)
+		assert.Error(t, err, "CNAME records shouldn't be mixed with other records")
+
+		zoneReq.Records = zoneReq.Records[:1]
+		zone, err = c.DNS.UpdateZone(context.Background(), zone.Id, zoneReq)
+		require.NoError(t, err)
+		assert.Len(t, zone.Records, 1)
+
+		err = c.DNS.DeleteZone(context.Background(), zone.Id)
+		require.NoError(t, err)
+
+		zones, err = c.DNS.ListZones(context.Background())
+		require.NoError(t, err)
+		assert.Len(t, zones, 0)
+	})
+}
+
+func TestDNSBlocklist_Integration(t *testing.T) {
+	source := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("# tracking\n0.0.0.0 tracker.example.com\n127.0.0.1 localhost\n0.0.0.0 ads.example.com\n"))
+	}))
+	defer source.Close()
+
+	sourceURL := source.URL
+	blocklistReq := api.DNSBlocklistRequest{
+		Name:         "ads",
+		Enabled:      true,
+		Action:       api.DNSBlocklistActionSinkhole,
+		Domains:      []string{"*.Ads.Example.com.", "ads.example.com"},
+		ExemptGroups: &[]string{"cs1tnh0hhcjnqoiuebeg"},
+	}
+	withBlackBoxServer(t, func(c *rest.Client) {
+		blocklist, err := c.DNS.CreateBlocklist(context.Background(), blocklistReq)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"ads.example.com"}, blocklist.Domains)
+		assert.Nil(t, blocklist.SourceUrl)
+
+		blocklistReq.Domains = []string{"bad domain"}
+		_, err = c.DNS.UpdateBlocklist(context.Background(), blocklist.Id, blocklistReq)
+		assert.Error(t, err, "invalid domains should be rejected")
+
+		blocklistReq.Domains = nil
+		blocklistReq.SourceUrl = &sourceURL
+		blocklist, err = c.DNS.UpdateBlocklist(context.Background(), blocklist.Id, blocklistReq)
+		require.NoError(t, err)
+		assert.Equal(t, 2, blocklist.SourceDomainsCount)
+		assert.Equal(t, api.DNSBlocklistSourceFormatHosts, *blocklist.SourceFormat)
+		assert.Nil(t, blocklist.SourceError)
+
+		blocklists, err := c.DNS.ListBlocklists(context.Background())
+		require.NoError(t, err)
+		require.Len(t, blocklists, 1)
+		assert.Equal(t, blocklist.Id, blocklists[0].Id)
+
+		err = c.DNS.DeleteBlocklist(context.Background(), blocklist.Id)
+		require.NoError(t, err)
+
+		blocklists, err = c.DNS.ListBlocklists(context.Background())
+		require.NoError(t, err)
+		assert.Len(t, blocklists, 0)
+	})
+}
@@ -2,6 +2,9 @@ package rest
 
 import (
 	"context"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/netbirdio/netbird/shared/management/http/api"
 )
@@ -24,3 +27,80 @@ func (a *EventsAPI) List(ctx context.Context) ([]api.Event, error) {
 	ret, err := parseResponse[[]api.Event](resp)
 	return ret, err
 }
+
+// AuditEventsListOption options for the audit events list API
+type AuditEventsListOption func() (string, string)
+
+func AuditEventActivityCodeFilter(codes ...api.EventActivityCode) AuditEventsListOption {
+	return func() (string, string) {
+		values := make([]string, len(codes))
+		for i, code := range codes {
+			values[i] = string(code)
+		}
+		return "activity_code", strings.Join(values, ",")
+	}
+}
+
+func AuditEventInitiatorFilter(initiatorID string) AuditEventsListOption {
+	return func() (string, string) {
+		return "initiator_id", initiatorID
+	}
+}
+
+func AuditEventTargetFilter(targetID string) AuditEventsListOption {
+	return func() (string, string) {
+		return "target_id", targetID
+	}
+}
+
+func AuditEventSearchFilter(search string) AuditEventsListOption {
+	return func() (string, string) {
+		return "search", search
+	}
+}
+
+func AuditEventStartDateFilter(start time.Time) AuditEventsListOption {
+	return func() (string, string) {
+		return "start_date", start.Format(time.RFC3339)
+	}
+}
+
+func AuditEventEndDateFilter(end time.Time) AuditEventsListOption {
+	return func() (string, string) {
+		return "end_date", end.Format(time.RFC3339)
+	}
+}
+
+func AuditEventCursor(cursor string) AuditEventsListOption {
+	return func() (string, string) {
+		return "cursor", cursor
+	}
+}
+
+func AuditEventPageSize(pageSize int) AuditEventsListOption {
+	return func() (string, string) {
+		return "page_size", strconv.Itoa(pageSize)
+	}
+}
+
+// ListAudit list a page of the audit events that match the options, the returned cursor is empty on the last page
+// See more: https://docs.netbird.io/api/resources/events#list-all-audit-events
+func (a *EventsAPI) ListAudit(ctx context.Context, opts ...AuditEventsListOption) ([]api.Event, string, error) {
+	query := make(map[string]string)
+	for _, o := range opts {
+		k, v := o()
+		query[k] = v
+	}
+	resp, err := a.c.NewRequest(ctx, "GET", "/api/events/audit", nil, query)
+	if err != nil {
+		return nil, "", err
+	}
+	if resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	ret, err := parseResponse[[]api.Event](resp)
+	if err != nil {
+		return nil, "", err
+	}
+	return ret, resp.Header.Get("X-Next-Cursor"), nil
+}
@@ -68,3 +68,24 @@ func TestEvents_Integration(t *testing.T) {
 		assert.NotEmpty(t, events)
 	})
 }
+
+func TestEvents_ListAudit_200(t *testing.T) {
+	withMockClient(func(c *rest.Client, mux *http.ServeMux) {
+		mux.HandleFunc("/api/events/audit", func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "account.create,user.join", r.URL.Query().Get("activity_code"))
+			assert.Equal(t, "10", r.URL.Query().Get("page_size"))
+			w.Header().Set("X-Next-Cursor", "next")
+			retBytes, _ := json.Marshal([]api.Event{testEvent})
+			_, err := w.Write(retBytes)
+			require.NoError(t, err)
+		})
+		ret, cursor, err := c.Events.ListAudit(context.Background(),
+			rest.AuditEventActivityCodeFilter(api.EventActivityCodeAccountCreate, api.EventActivityCodeUserJoin),
+			rest.AuditEventPageSize(10),
+		)
+		require.NoError(t, err)
+		assert.Len(t, ret, 1)
+		assert.Equal(t, testEvent, ret[0])
+		assert.Equal(t, "next", cursor)
+	})
+}
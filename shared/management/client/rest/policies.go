@@ -94,3 +94,39 @@ func (a *PoliciesAPI) Delete(ctx context.Context, policyID string) error {
 
 	return nil
 }
+
+// CheckAccess check whether the policies allow a connection and which policy rules decide it
+// See more: https://docs.netbird.io/api/resources/policies#check-access
+func (a *PoliciesAPI) CheckAccess(ctx context.Context, request api.PostApiPoliciesCheckJSONRequestBody) (*api.AccessCheckResponse, error) {
+	requestBytes, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := a.c.NewRequest(ctx, "POST", "/api/policies/check", bytes.NewReader(requestBytes), nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	ret, err := parseResponse[api.AccessCheckResponse](resp)
+	return &ret, err
+}
+
+// Evaluate evaluate the connections gained and lost by proposed policy changes without saving them
+// See more: https://docs.netbird.io/api/resources/policies#evaluate-policy-changes
+func (a *PoliciesAPI) Evaluate(ctx context.Context, request api.PostApiPoliciesEvaluateJSONRequestBody) (*api.PolicyEvaluation, error) {
+	requestBytes, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := a.c.NewRequest(ctx, "POST", "/api/policies/evaluate", bytes.NewReader(requestBytes), nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	ret, err := parseResponse[api.PolicyEvaluation](resp)
+	return &ret, err
+}
@@ -83,6 +83,87 @@ func TestPolicies_Get_Err(t *testing.T) {
 	})
 }
 
+func TestPolicies_Evaluate_200(t *testing.T) {
+	withMockClient(func(c *rest.Client, mux *http.ServeMux) {
+		mux.HandleFunc("/api/policies/evaluate", func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "POST", r.Method)
+			reqBytes, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			var req api.PostApiPoliciesEvaluateJSONRequestBody
+			err = json.Unmarshal(reqBytes, &req)
+			require.NoError(t, err)
+			require.Len(t, req.Changes, 1)
+			assert.Equal(t, "Test", *req.Changes[0].PolicyId)
+			retBytes, _ := json.Marshal(api.PolicyEvaluation{Added: []api.PolicyAccess{}, Removed: []api.PolicyAccess{{SourcePeerId: "peer"}}})
+			_, err = w.Write(retBytes)
+			require.NoError(t, err)
+		})
+		ret, err := c.Policies.Evaluate(context.Background(), api.PostApiPoliciesEvaluateJSONRequestBody{
+			Changes: []api.PolicyEvaluationChange{{PolicyId: ptr("Test")}},
+		})
+		require.NoError(t, err)
+		assert.Empty(t, ret.Added)
+		assert.Len(t, ret.Removed, 1)
+	})
+}
+
+func TestPolicies_Evaluate_Err(t *testing.T) {
+	withMockClient(func(c *rest.Client, mux *http.ServeMux) {
+		mux.HandleFunc("/api/policies/evaluate", func(w http.ResponseWriter, r *http.Request) {
+			retBytes, _ := json.Marshal(util.ErrorResponse{Message: "No", Code: 400})
+			w.WriteHeader(400)
+			_, err := w.Write(retBytes)
+			require.NoError(t, err)
+		})
+		ret, err := c.Policies.Evaluate(context.Background(), api.PostApiPoliciesEvaluateJSONRequestBody{})
+		assert.Error(t, err)
+		assert.Equal(t, "No", err.Error())
+		assert.Empty(t, ret)
+	})
+}
+
+func TestPolicies_CheckAccess_200(t *testing.T) {
+	withMockClient(func(c *rest.Client, mux *http.ServeMux) {
+		mux.HandleFunc("/api/policies/check", func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "POST", r.Method)
+			reqBytes, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			var req api.PostApiPoliciesCheckJSONRequestBody
+			err = json.Unmarshal(reqBytes, &req)
+			require.NoError(t, err)
+			assert.Equal(t, "peer", *req.SourcePeerId)
+			assert.Equal(t, 443, *req.Port)
+			retBytes, _ := json.Marshal(api.AccessCheckResponse{Allowed: true, Results: []api.AccessCheckResult{{SourcePeerId: "peer", Allowed: true}}})
+			_, err = w.Write(retBytes)
+			require.NoError(t, err)
+		})
+		ret, err := c.Policies.CheckAccess(context.Background(), api.PostApiPoliciesCheckJSONRequestBody{
+			SourcePeerId:  ptr("peer"),
+			DestinationIp: ptr("100.64.0.2"),
+			Protocol:      api.AccessCheckRequestProtocolTcp,
+			Port:          ptr(443),
+		})
+		require.NoError(t, err)
+		assert.True(t, ret.Allowed)
+		assert.Len(t, ret.Results, 1)
+	})
+}
+
+func TestPolicies_CheckAccess_Err(t *testing.T) {
+	withMockClient(func(c *rest.Client, mux *http.ServeMux) {
+		mux.HandleFunc("/api/policies/check", func(w http.ResponseWriter, r *http.Request) {
+			retBytes, _ := json.Marshal(util.ErrorResponse{Message: "No", Code: 400})
+			w.WriteHeader(400)
+			_, err := w.Write(retBytes)
+			require.NoError(t, err)
+		})
+		ret, err := c.Policies.CheckAccess(context.Background(), api.PostApiPoliciesCheckJSONRequestBody{})
+		assert.Error(t, err)
+		assert.Equal(t, "No", err.Error())
+		assert.Empty(t, ret)
+	})
+}
+
 func TestPolicies_Create_200(t *testing.T) {
 	withMockClient(func(c *rest.Client, mux *http.ServeMux) {
 		mux.HandleFunc("/api/policies", func(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,57 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/netbirdio/netbird/shared/management/http/api"
+)
+
+// SCIMTokensAPI APIs for SCIM provisioning tokens, do not use directly
+type SCIMTokensAPI struct {
+	c *Client
+}
+
+// List list SCIM tokens
+func (a *SCIMTokensAPI) List(ctx context.Context) ([]api.SCIMToken, error) {
+	resp, err := a.c.NewRequest(ctx, "GET", "/api/scim-tokens", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	ret, err := parseResponse[[]api.SCIMToken](resp)
+	return ret, err
+}
+
+// Create generate new SCIM token and the service user it provisions with
+func (a *SCIMTokensAPI) Create(ctx context.Context, request api.PostApiScimTokensJSONRequestBody) (*api.SCIMTokenGenerated, error) {
+	requestBytes, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := a.c.NewRequest(ctx, "POST", "/api/scim-tokens", bytes.NewReader(requestBytes), nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	ret, err := parseResponse[api.SCIMTokenGenerated](resp)
+	return &ret, err
+}
+
+// Delete delete SCIM token
+func (a *SCIMTokensAPI) Delete(ctx context.Context, tokenID string) error {
+	resp, err := a.c.NewRequest(ctx, "DELETE", "/api/scim-tokens/"+tokenID, nil, nil)
+	if err != nil {
+		return err
+	}
+	if resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
@@ -12,6 +12,85 @@ const (
 	TokenAuthScopes  = "TokenAuth.Scopes"
 )
 
+// Defines values for AccessCheckRequestProtocol.
+const (
+	AccessCheckRequestProtocolIcmp AccessCheckRequestProtocol = "icmp"
+	AccessCheckRequestProtocolTcp  AccessCheckRequestProtocol = "tcp"
+	AccessCheckRequestProtocolUdp  AccessCheckRequestProtocol = "udp"
+)
+
+// Defines values for AccessCheckResultDestinationType.
+const (
+	AccessCheckResultDestinationTypePeer     AccessCheckResultDestinationType = "peer"
+	AccessCheckResultDestinationTypeResource AccessCheckResultDestinationType = "resource"
+	AccessCheckResultDestinationTypeRoute    AccessCheckResultDestinationType = "route"
+)
+
+// Defines values for AccessCheckRuleAction.
+const (
+	AccessCheckRuleActionAccept AccessCheckRuleAction = "accept"
+	AccessCheckRuleActionDrop   AccessCheckRuleAction = "drop"
+)
+
+// Defines values for AccountDocumentPolicyRuleAction.
+const (
+	AccountDocumentPolicyRuleActionAccept AccountDocumentPolicyRuleAction = "accept"
+	AccountDocumentPolicyRuleActionDrop   AccountDocumentPolicyRuleAction = "drop"
+)
+
+// Defines values for AccountDocumentPolicyRuleProtocol.
+const (
+	AccountDocumentPolicyRuleProtocolAll  AccountDocumentPolicyRuleProtocol = "all"
+	AccountDocumentPolicyRuleProtocolIcmp AccountDocumentPolicyRuleProtocol = "icmp"
+	AccountDocumentPolicyRuleProtocolTcp  AccountDocumentPolicyRuleProtocol = "tcp"
+	AccountDocumentPolicyRuleProtocolUdp  AccountDocumentPolicyRuleProtocol = "udp"
+)
+
+// Defines values for AccountPlanChangeAction.
+const (
+	AccountPlanChangeActionCreate AccountPlanChangeAction = "create"
+	AccountPlanChangeActionDelete AccountPlanChangeAction = "delete"
+	AccountPlanChangeActionUpdate AccountPlanChangeAction = "update"
+)
+
+// Defines values for AccountPlanChangeKind.
+const (
+	AccountPlanChangeKindDnsSettings     AccountPlanChangeKind = "dns_settings"
+	AccountPlanChangeKindGroup           AccountPlanChangeKind = "group"
+	AccountPlanChangeKindNameserverGroup AccountPlanChangeKind = "nameserver_group"
+	AccountPlanChangeKindNetwork         AccountPlanChangeKind = "network"
+	AccountPlanChangeKindNetworkResource AccountPlanChangeKind = "network_resource"
+	AccountPlanChangeKindNetworkRouter   AccountPlanChangeKind = "network_router"
+	AccountPlanChangeKindPolicy          AccountPlanChangeKind = "policy"
+	AccountPlanChangeKindPostureCheck    AccountPlanChangeKind = "posture_check"
+	AccountPlanChangeKindRoute           AccountPlanChangeKind = "route"
+	AccountPlanChangeKindSetupKey        AccountPlanChangeKind = "setup_key"
+)
+
+// Defines values for DNSBlocklistAction.
+const (
+	DNSBlocklistActionNxdomain DNSBlocklistAction = "nxdomain"
+	DNSBlocklistActionSinkhole DNSBlocklistAction = "sinkhole"
+)
+
+// Defines values for DNSBlocklistSourceFormat.
+const (
+	DNSBlocklistSourceFormatAdblock DNSBlocklistSourceFormat = "adblock"
+	DNSBlocklistSourceFormatDomains DNSBlocklistSourceFormat = "domains"
+	DNSBlocklistSourceFormatHosts   DNSBlocklistSourceFormat = "hosts"
+)
+
+// Defines values for DNSRecordType.
+const (
+	DNSRecordTypeA     DNSRecordType = "A"
+	DNSRecordTypeAAAA  DNSRecordType = "AAAA"
+	DNSRecordTypeCNAME DNSRecordType = "CNAME"
+	DNSRecordTypeMX    DNSRecordType = "MX"
+	DNSRecordTypePTR   DNSRecordType = "PTR"
+	DNSRecordTypeSRV   DNSRecordType = "SRV"
+	DNSRecordTypeTXT   DNSRecordType = "TXT"
+)
+
 // Defines values for EventActivityCode.
 const (
 	EventActivityCodeAccountCreate                            EventActivityCode = "account.create"
@@ -63,6 +142,13 @@ const (
 	EventActivityCodeUserUnblock                              EventActivityCode = "user.unblock"
 )
 
+// Defines values for EventSinkType.
+const (
+	EventSinkTypeJsonl   EventSinkType = "jsonl"
+	EventSinkTypeSyslog  EventSinkType = "syslog"
+	EventSinkTypeWebhook EventSinkType = "webhook"
+)
+
 // Defines values for GeoLocationCheckAction.
 const (
 	GeoLocationCheckActionAllow GeoLocationCheckAction = "allow"
@@ -106,6 +192,8 @@ const (
 
 // Defines values for NameserverNsType.
 const (
+	NameserverNsTypeDoh NameserverNsType = "doh"
+	NameserverNsTypeDot NameserverNsType = "dot"
 	NameserverNsTypeUdp NameserverNsType = "udp"
 )
 
@@ -122,6 +210,21 @@ const (
 	PeerNetworkRangeCheckActionDeny  PeerNetworkRangeCheckAction = "deny"
 )
 
+// Defines values for PolicyAccessDestinationType.
+const (
+	PolicyAccessDestinationTypePeer     PolicyAccessDestinationType = "peer"
+	PolicyAccessDestinationTypeResource PolicyAccessDestinationType = "resource"
+	PolicyAccessDestinationTypeRoute    PolicyAccessDestinationType = "route"
+)
+
+// Defines values for PolicyAccessProtocol.
+const (
+	PolicyAccessProtocolAll  PolicyAccessProtocol = "all"
+	PolicyAccessProtocolIcmp PolicyAccessProtocol = "icmp"
+	PolicyAccessProtocolTcp  PolicyAccessProtocol = "tcp"
+	PolicyAccessProtocolUdp  PolicyAccessProtocol = "udp"
+)
+
 // Defines values for PolicyRuleAction.
 const (
 	PolicyRuleActionAccept PolicyRuleAction = "accept"
@@ -171,6 +274,17 @@ const (
 	ResourceTypeSubnet ResourceType = "subnet"
 )
 
+// Defines values for ScheduleWindowDays.
+const (
+	ScheduleWindowDaysFri ScheduleWindowDays = "fri"
+	ScheduleWindowDaysMon ScheduleWindowDays = "mon"
+	ScheduleWindowDaysSat ScheduleWindowDays = "sat"
+	ScheduleWindowDaysSun ScheduleWindowDays = "sun"
+	ScheduleWindowDaysThu ScheduleWindowDays = "thu"
+	ScheduleWindowDaysTue ScheduleWindowDays = "tue"
+	ScheduleWindowDaysWed ScheduleWindowDays = "wed"
+)
+
 // Defines values for UserStatus.
 const (
 	UserStatusActive  UserStatus = "active"
@@ -235,6 +349,96 @@ type AccessiblePeer struct {
 	UserId string `json:"user_id"`
 }
 
+// AccessCheckRequest Connection to check against the policies. Specify exactly one source and one destination.
+type AccessCheckRequest struct {
+	// DestinationIp Destination IP, resolved to a peer by its overlay IP, to a network resource or to a network route
+	DestinationIp *string `json:"destination_ip,omitempty"`
+
+	// DestinationPeerId ID of the destination peer
+	DestinationPeerId *string `json:"destination_peer_id,omitempty"`
+
+	// DestinationResourceId ID of the destination network resource
+	DestinationResourceId *string `json:"destination_resource_id,omitempty"`
+
+	// Port Destination port, required for tcp and udp
+	Port *int `json:"port,omitempty"`
+
+	// Protocol Protocol of the traffic
+	Protocol AccessCheckRequestProtocol `json:"protocol"`
+
+	// SourceIp Overlay IP of the source peer
+	SourceIp *string `json:"source_ip,omitempty"`
+
+	// SourcePeerId ID of the source peer
+	SourcePeerId *string `json:"source_peer_id,omitempty"`
+
+	// SourceUserId ID of a user, the connection is checked for all peers of the user
+	SourceUserId *string `json:"source_user_id,omitempty"`
+}
+
+// AccessCheckRequestProtocol Protocol of the traffic
+type AccessCheckRequestProtocol string
+
+// AccessCheckResponse defines model for AccessCheckResponse.
+type AccessCheckResponse struct {
+	// Allowed Whether the connection is allowed for at least one source peer
+	Allowed bool `json:"allowed"`
+
+	// Results Verdict for each source peer
+	Results []AccessCheckResult `json:"results"`
+}
+
+// AccessCheckResult Verdict for the connection from a source peer
+type AccessCheckResult struct {
+	// Allowed Whether the connection is allowed
+	Allowed bool `json:"allowed"`
+
+	// DestinationId ID of the destination peer, network resource or route
+	DestinationId string `json:"destination_id"`
+
+	// DestinationName Name of the destination peer, network resource or network identifier of the route
+	DestinationName string `json:"destination_name"`
+
+	// DestinationType Type of the destination
+	DestinationType AccessCheckResultDestinationType `json:"destination_type"`
+
+	// Reason Explanation of the verdict when no policy rule decides it
+	Reason *string `json:"reason,omitempty"`
+
+	// Rules Policy rules deciding the verdict, drop rules take precedence over accept rules
+	Rules []AccessCheckRule `json:"rules"`
+
+	// SourcePeerId Source peer ID
+	SourcePeerId string `json:"source_peer_id"`
+
+	// SourcePeerName Source peer name
+	SourcePeerName string `json:"source_peer_name"`
+}
+
+// AccessCheckResultDestinationType Type of the destination
+type AccessCheckResultDestinationType string
+
+// AccessCheckRule Policy rule deciding the verdict
+type AccessCheckRule struct {
+	// Action Policy rule accept or drops packets
+	Action AccessCheckRuleAction `json:"action"`
+
+	// PolicyId Policy ID
+	PolicyId string `json:"policy_id"`
+
+	// PolicyName Policy name
+	PolicyName string `json:"policy_name"`
+
+	// RuleId Policy rule ID
+	RuleId string `json:"rule_id"`
+
+	// RuleName Policy rule name
+	RuleName string `json:"rule_name"`
+}
+
+// AccessCheckRuleAction Policy rule accept or drops packets
+type AccessCheckRuleAction string
+
 // Account defines model for Account.
 type Account struct {
 	// CreatedAt Account creation date (UTC)
@@ -255,6 +459,273 @@ type Account struct {
 	Settings   AccountSettings   `json:"settings"`
 }
 
+// AccountDocument Declarative description of the account configuration referencing objects by name. Sections that are omitted are left unchanged when the document is applied
+type AccountDocument struct {
+	// DnsSettings DNS settings of the account
+	DnsSettings *AccountDocumentDNSSettings `json:"dns_settings,omitempty"`
+
+	// Groups Groups created through the API, the All group and groups issued by JWT or integrations aren't managed by the document
+	Groups *[]AccountDocumentGroup `json:"groups,omitempty"`
+
+	// NameserverGroups Nameserver groups of the account
+	NameserverGroups *[]AccountDocumentNameserverGroup `json:"nameserver_groups,omitempty"`
+
+	// Networks Networks of the account with their resources and routers
+	Networks *[]AccountDocumentNetwork `json:"networks,omitempty"`
+
+	// Policies Access control policies of the account
+	Policies *[]AccountDocumentPolicy `json:"policies,omitempty"`
+
+	// PostureChecks Posture checks of the account
+	PostureChecks *[]AccountDocumentPostureCheck `json:"posture_checks,omitempty"`
+
+	// Routes Network routes of the account
+	Routes *[]AccountDocumentRoute `json:"routes,omitempty"`
+
+	// SetupKeys Setup keys of the account
+	SetupKeys *[]AccountDocumentSetupKey `json:"setup_keys,omitempty"`
+}
+
+// AccountDocumentDNSSettings DNS settings of the account
+type AccountDocumentDNSSettings struct {
+	// DisabledManagementGroups Names of the groups whose DNS management is disabled
+	DisabledManagementGroups []string `json:"disabled_management_groups"`
+}
+
+// AccountDocumentGroup defines model for AccountDocumentGroup.
+type AccountDocumentGroup struct {
+	// Name Group name, unique within the account
+	Name string `json:"name"`
+
+	// Peers DNS labels of the peers in the group, the membership is left unchanged when omitted
+	Peers *[]string `json:"peers,omitempty"`
+}
+
+// AccountDocumentNameserverGroup defines model for AccountDocumentNameserverGroup.
+type AccountDocumentNameserverGroup struct {
+	// Description Description of the nameserver group
+	Description string `json:"description"`
+
+	// Domains Match domain list. It should be empty only if primary is true.
+	Domains []string `json:"domains"`
+
+	// Enabled Nameserver group status
+	Enabled bool `json:"enabled"`
+
+	// Groups Names of the distribution groups
+	Groups []string `json:"groups"`
+
+	// Name Nameserver group name, unique within the account
+	Name string `json:"name"`
+
+	// Nameservers Nameserver list
+	Nameservers []Nameserver `json:"nameservers"`
+
+	// Primary Defines if a nameserver group is primary that resolves all domains. It should be true only if domains list is empty.
+	Primary bool `json:"primary"`
+
+	// SearchDomainsEnabled Search domain status for match domains. It should be true only if domains list is not empty.
+	SearchDomainsEnabled bool `json:"search_domains_enabled"`
+}
+
+// AccountDocumentNetwork defines model for AccountDocumentNetwork.
+type AccountDocumentNetwork struct {
+	// Description Network description
+	Description string `json:"description"`
+
+	// Name Network name, unique within the account
+	Name string `json:"name"`
+
+	// Resources Resources of the network
+	Resources []AccountDocumentNetworkResource `json:"resources"`
+
+	// Routers Routers of the network
+	Routers []AccountDocumentNetworkRouter `json:"routers"`
+}
+
+// AccountDocumentNetworkResource defines model for AccountDocumentNetworkResource.
+type AccountDocumentNetworkResource struct {
+	// Address Network resource address (either a direct host like 1.1.1.1 or 1.1.1.1/32, or a subnet like 192.168.178.0/24, or domains like example.com and *.example.com)
+	Address string `json:"address"`
+
+	// Description Network resource description
+	Description string `json:"description"`
+
+	// Enabled Network resource status
+	Enabled bool `json:"enabled"`
+
+	// Groups Names of the groups the resource belongs to
+	Groups []string `json:"groups"`
+
+	// Name Network resource name, unique within the account
+	Name string `json:"name"`
+}
+
+// AccountDocumentNetworkRouter defines model for AccountDocumentNetworkRouter. A router is identified by its peer or its peer groups
+type AccountDocumentNetworkRouter struct {
+	// Enabled Network router status
+	Enabled bool `json:"enabled"`
+
+	// Masquerade Indicate if peer should masquerade traffic to this route's prefix
+	Masquerade bool `json:"masquerade"`
+
+	// Metric Route metric number. Lowest number has higher priority
+	Metric int `json:"metric"`
+
+	// Peer DNS label of the routing peer
+	Peer *string `json:"peer,omitempty"`
+
+	// PeerGroups Names of the routing peer groups
+	PeerGroups *[]string `json:"peer_groups,omitempty"`
+}
+
+// AccountDocumentPolicy defines model for AccountDocumentPolicy.
+type AccountDocumentPolicy struct {
+	// Description Policy friendly description
+	Description string `json:"description"`
+
+	// Enabled Policy status
+	Enabled bool `json:"enabled"`
+
+	// Name Policy name, unique within the account
+	Name string `json:"name"`
+
+	// Rules Policy rules
+	Rules []AccountDocumentPolicyRule `json:"rules"`
+
+	// SourcePostureChecks Names of the posture checks applied to policy source groups
+	SourcePostureChecks []string `json:"source_posture_checks"`
+}
+
+// AccountDocumentPolicyRule defines model for AccountDocumentPolicyRule.
+type AccountDocumentPolicyRule struct {
+	// Action Policy rule accept or drops packets
+	Action AccountDocumentPolicyRuleAction `json:"action"`
+
+	// Bidirectional Define if the rule is applicable in both directions, sources, and destinations.
+	Bidirectional bool `json:"bidirectional"`
+
+	// Description Policy rule friendly description
+	Description string `json:"description"`
+
+	// DestinationResource Name of the network resource the rule is applied to
+	DestinationResource *string `json:"destination_resource,omitempty"`
+
+	// Destinations Names of the destination groups
+	Destinations *[]string `json:"destinations,omitempty"`
+
+	// Enabled Policy rule status
+	Enabled bool `json:"enabled"`
+
+	// Name Policy rule name identifier
+	Name string `json:"name"`
+
+	// PortRanges Policy rule affected ports ranges list
+	PortRanges *[]RulePortRange `json:"port_ranges,omitempty"`
+
+	// Ports Policy rule affected ports
+	Ports *[]string `json:"ports,omitempty"`
+
+	// Protocol Policy rule type of the traffic
+	Protocol AccountDocumentPolicyRuleProtocol `json:"protocol"`
+
+	// Schedule Time windows in which a policy rule is applied
+	Schedule *PolicyRuleSchedule `json:"schedule,omitempty"`
+
+	// SourceResource Name of the network resource the rule is applied from
+	SourceResource *string `json:"source_resource,omitempty"`
+
+	// Sources Names of the source groups
+	Sources *[]string `json:"sources,omitempty"`
+}
+
+// AccountDocumentPolicyRuleAction Policy rule accept or drops packets
+type AccountDocumentPolicyRuleAction string
+
+// AccountDocumentPolicyRuleProtocol Policy rule type of the traffic
+type AccountDocumentPolicyRuleProtocol string
+
+// AccountDocumentPostureCheck defines model for AccountDocumentPostureCheck.
+type AccountDocumentPostureCheck struct {
+	// Checks List of objects that perform the actual checks
+	Checks Checks `json:"checks"`
+
+	// Description Posture checks description
+	Description string `json:"description"`
+
+	// Name Posture check name, unique within the account
+	Name string `json:"name"`
+}
+
+// AccountDocumentRoute defines model for AccountDocumentRoute. A route is identified by its network identifier and peer
+type AccountDocumentRoute struct {
+	// AccessControlGroups Names of the access control groups
+	AccessControlGroups *[]string `json:"access_control_groups,omitempty"`
+
+	// Description Route description
+	Description string `json:"description"`
+
+	// Domains Domain list to be dynamically resolved. Conflicts with network
+	Domains *[]string `json:"domains,omitempty"`
+
+	// Enabled Route status
+	Enabled bool `json:"enabled"`
+
+	// Groups Names of the distribution groups
+	Groups []string `json:"groups"`
+
+	// KeepRoute Indicate if the route should be kept after a domain doesn't resolve that IP anymore
+	KeepRoute bool `json:"keep_route"`
+
+	// Masquerade Indicate if peer should masquerade traffic to this route's prefix
+	Masquerade bool `json:"masquerade"`
+
+	// Metric Route metric number. Lowest number has higher priority
+	Metric int `json:"metric"`
+
+	// Network Network range in CIDR format, Conflicts with domains
+	Network *string `json:"network,omitempty"`
+
+	// NetworkId Route network identifier, to group HA routes
+	NetworkId string `json:"network_id"`
+
+	// Peer DNS label of the routing peer
+	Peer *string `json:"peer,omitempty"`
+
+	// PeerGroups Names of the routing peer groups
+	PeerGroups *[]string `json:"peer_groups,omitempty"`
+
+	// SkipAutoApply Indicate if this exit node route (0.0.0.0/0) should skip auto-application for client routing
+	SkipAutoApply bool `json:"skip_auto_apply"`
+}
+
+// AccountDocumentSetupKey defines model for AccountDocumentSetupKey. Only the auto groups and the revocation of existing keys can be changed
+type AccountDocumentSetupKey struct {
+	// AllowExtraDnsLabels Allow extra DNS labels to be added to the peer
+	AllowExtraDnsLabels bool `json:"allow_extra_dns_labels"`
+
+	// AutoGroups Names of the groups to auto-assign to peers registered with this key
+	AutoGroups []string `json:"auto_groups"`
+
+	// Ephemeral Indicate that the peer will be ephemeral or not
+	Ephemeral bool `json:"ephemeral"`
+
+	// ExpiresIn Expiration time in seconds, only used when the key is created
+	ExpiresIn *int `json:"expires_in,omitempty"`
+
+	// Name Setup key name, unique within the account
+	Name string `json:"name"`
+
+	// Revoked Setup key revocation status
+	Revoked bool `json:"revoked"`
+
+	// Type Setup key type, one-off for single time usage and reusable
+	Type string `json:"type"`
+
+	// UsageLimit A number of times this key can be used. The value of 0 indicates the unlimited usage.
+	UsageLimit int `json:"usage_limit"`
+}
+
 // AccountExtraSettings defines model for AccountExtraSettings.
 type AccountExtraSettings struct {
 	// NetworkTrafficLogsEnabled Enables or disables network traffic logging. If enabled, all network traffic events from peers will be stored.
@@ -282,6 +753,36 @@ type AccountOnboarding struct {
 	SignupFormPending bool `json:"signup_form_pending"`
 }
 
+// AccountPlan Changes required to reconcile the account with a document
+type AccountPlan struct {
+	// Applied Indicates whether the changes were applied or only planned
+	Applied bool `json:"applied"`
+
+	// Changes Changes in the order they are applied
+	Changes []AccountPlanChange `json:"changes"`
+
+	// PlanHash Hash of the account state and the planned changes, pass it to the apply request to reject the changes if the account was modified since
+	PlanHash string `json:"plan_hash"`
+}
+
+// AccountPlanChange defines model for AccountPlanChange.
+type AccountPlanChange struct {
+	// Action Action taken on the object
+	Action AccountPlanChangeAction `json:"action"`
+
+	// Kind Kind of the changed object
+	Kind AccountPlanChangeKind `json:"kind"`
+
+	// Name Name of the changed object
+	Name string `json:"name"`
+}
+
+// AccountPlanChangeAction Action taken on the object
+type AccountPlanChangeAction string
+
+// AccountPlanChangeKind Kind of the changed object
+type AccountPlanChangeKind string
+
 // AccountRequest defines model for AccountRequest.
 type AccountRequest struct {
 	Onboarding *AccountOnboarding `json:"onboarding,omitempty"`
@@ -291,8 +792,14 @@ type AccountRequest struct {
 // AccountSettings defines model for AccountSettings.
 type AccountSettings struct {
 	// DnsDomain Allows to define a custom dns domain for the account
-	DnsDomain *string               `json:"dns_domain,omitempty"`
-	Extra     *AccountExtraSettings `json:"extra,omitempty"`
+	DnsDomain *string `json:"dns_domain,omitempty"`
+
+	// EventArchiveEnabled Archives the activity events before the retention purges them. The events are kept when the management server has no archive configured.
+	EventArchiveEnabled *bool `json:"event_archive_enabled,omitempty"`
+
+	// EventRetentionDays Number of days the activity events are kept. The events are kept forever when it is 0.
+	EventRetentionDays *int                  `json:"event_retention_days,omitempty"`
+	Extra              *AccountExtraSettings `json:"extra,omitempty"`
 
 	// GroupsPropagationEnabled Allows propagate the new user auto groups to peers that belongs to the user
 	GroupsPropagationEnabled *bool `json:"groups_propagation_enabled,omitempty"`
@@ -327,6 +834,9 @@ type AccountSettings struct {
 	// RegularUsersViewBlocked Allows blocking regular users from viewing parts of the system.
 	RegularUsersViewBlocked bool `json:"regular_users_view_blocked"`
 
+	// RelayRegionPins Pins the peers of groups to the relay servers of regions. The pinned peers receive the relays of their regions with the next relay credentials refresh.
+	RelayRegionPins *[]RelayRegionPin `json:"relay_region_pins,omitempty"`
+
 	// RoutingPeerDnsResolutionEnabled Enables or disables DNS resolution on the routing peers
 	RoutingPeerDnsResolutionEnabled *bool `json:"routing_peer_dns_resolution_enabled,omitempty"`
 }
@@ -342,74 +852,224 @@ type AvailablePorts struct {
 
 // Checks List of objects that perform the actual checks
 type Checks struct {
+	// DiskEncryptionCheck Posture check for the encryption of the peer's volumes
+	DiskEncryptionCheck *DiskEncryptionCheck `json:"disk_encryption_check,omitempty"`
+
+	// ExternalCheck Posture check asking an external posture provider, e.g. an MDM, whether the peer is compliant. The peer identity is posted to the URL and a JSON object with a boolean compliant field is expected in response. The verdict is requested in the background, the peer fails the check until the first verdict is received unless fail_open is set
+	ExternalCheck *ExternalCheck `json:"external_check,omitempty"`
+
 	// GeoLocationCheck Posture check for geo location
 	GeoLocationCheck *GeoLocationCheck `json:"geo_location_check,omitempty"`
 
+	// HostFirewallCheck Posture check for an active host firewall on the peer
+	HostFirewallCheck *HostFirewallCheck `json:"host_firewall_check,omitempty"`
+
 	// NbVersionCheck Posture check for the version of operating system
 	NbVersionCheck *NBVersionCheck `json:"nb_version_check,omitempty"`
 
 	// OsVersionCheck Posture check for the version of operating system
 	OsVersionCheck *OSVersionCheck `json:"os_version_check,omitempty"`
 
-	// PeerNetworkRangeCheck Posture check for allow or deny access based on peer local network addresses
-	PeerNetworkRangeCheck *PeerNetworkRangeCheck `json:"peer_network_range_check,omitempty"`
+	// PeerNetworkRangeCheck Posture check for allow or deny access based on peer local network addresses
+	PeerNetworkRangeCheck *PeerNetworkRangeCheck `json:"peer_network_range_check,omitempty"`
+
+	// ProcessCheck Posture Check for binaries exist and are running in the peer’s system
+	ProcessCheck *ProcessCheck `json:"process_check,omitempty"`
+}
+
+// City Describe city geographical location information
+type City struct {
+	// CityName Commonly used English name of the city
+	CityName string `json:"city_name"`
+
+	// GeonameId Integer ID of the record in GeoNames database
+	GeonameId int `json:"geoname_id"`
+}
+
+// CityName Commonly used English name of the city
+type CityName = string
+
+// Country Describe country geographical location information
+type Country struct {
+	// CountryCode 2-letter ISO 3166-1 alpha-2 code that represents the country
+	CountryCode CountryCode `json:"country_code"`
+
+	// CountryName Commonly used English name of the country
+	CountryName string `json:"country_name"`
+}
+
+// CountryCode 2-letter ISO 3166-1 alpha-2 code that represents the country
+type CountryCode = string
+
+// CreateSetupKeyRequest defines model for CreateSetupKeyRequest.
+type CreateSetupKeyRequest struct {
+	// AllowExtraDnsLabels Allow extra DNS labels to be added to the peer
+	AllowExtraDnsLabels *bool `json:"allow_extra_dns_labels,omitempty"`
+
+	// AutoGroups List of group IDs to auto-assign to peers registered with this key
+	AutoGroups []string `json:"auto_groups"`
+
+	// Ephemeral Indicate that the peer will be ephemeral or not
+	Ephemeral *bool `json:"ephemeral,omitempty"`
+
+	// ExpiresIn Expiration time in seconds
+	ExpiresIn int `json:"expires_in"`
+
+	// Name Setup Key name
+	Name string `json:"name"`
+
+	// Type Setup key type, one-off for single time usage and reusable
+	Type string `json:"type"`
+
+	// UsageLimit A number of times this key can be used. The value of 0 indicates the unlimited usage.
+	UsageLimit int `json:"usage_limit"`
+}
+
+// DNSBlocklist defines model for DNSBlocklist.
+type DNSBlocklist struct {
+	// Action Answer to queries for blocked domains, NXDOMAIN or the unspecified address for A and AAAA queries
+	Action DNSBlocklistAction `json:"action"`
+
+	// Description Description of the blocklist
+	Description *string `json:"description,omitempty"`
+
+	// Domains Blocked domains, their subdomains are blocked as well
+	Domains []string `json:"domains"`
+
+	// Enabled Blocklist status
+	Enabled bool `json:"enabled"`
+
+	// ExemptGroups Group IDs of peers that don't enforce the blocklist
+	ExemptGroups *[]string `json:"exempt_groups,omitempty"`
+
+	// Id Blocklist ID
+	Id string `json:"id"`
+
+	// Name Blocklist name
+	Name string `json:"name"`
+
+	// SourceDomainsCount Number of domains of the last successful fetch of the source
+	SourceDomainsCount int `json:"source_domains_count"`
+
+	// SourceError Error of the last fetch of the source
+	SourceError *string `json:"source_error,omitempty"`
+
+	// SourceFormat Format of the blocklist source
+	SourceFormat *DNSBlocklistSourceFormat `json:"source_format,omitempty"`
+
+	// SourceUpdatedAt Time of the last successful fetch of the source
+	SourceUpdatedAt *time.Time `json:"source_updated_at,omitempty"`
+
+	// SourceUrl URL of a list of domains that is fetched periodically and mirrored to the peers
+	SourceUrl *string `json:"source_url,omitempty"`
+}
+
+// DNSBlocklistAction Answer to queries for blocked domains, NXDOMAIN or the unspecified address for A and AAAA queries
+type DNSBlocklistAction string
+
+// DNSBlocklistRequest defines model for DNSBlocklistRequest.
+type DNSBlocklistRequest struct {
+	// Action Answer to queries for blocked domains, NXDOMAIN or the unspecified address for A and AAAA queries
+	Action DNSBlocklistAction `json:"action"`
+
+	// Description Description of the blocklist
+	Description *string `json:"description,omitempty"`
+
+	// Domains Blocked domains, their subdomains are blocked as well
+	Domains []string `json:"domains"`
+
+	// Enabled Blocklist status
+	Enabled bool `json:"enabled"`
+
+	// ExemptGroups Group IDs of peers that don't enforce the blocklist
+	ExemptGroups *[]string `json:"exempt_groups,omitempty"`
+
+	// Name Blocklist name
+	Name string `json:"name"`
+
+	// SourceFormat Format of the blocklist source
+	SourceFormat *DNSBlocklistSourceFormat `json:"source_format,omitempty"`
+
+	// SourceUrl URL of a list of domains that is fetched periodically and mirrored to the peers
+	SourceUrl *string `json:"source_url,omitempty"`
+}
+
+// DNSBlocklistSourceFormat Format of the blocklist source
+type DNSBlocklistSourceFormat string
+
+// DNSRecord defines model for DNSRecord.
+type DNSRecord struct {
+	// Name Record name relative to the zone domain, @ refers to the zone domain itself
+	Name string `json:"name"`
+
+	// Ttl Record time-to-live in seconds, defaults to 300
+	Ttl *int `json:"ttl,omitempty"`
+
+	// Type Record type
+	Type DNSRecordType `json:"type"`
+
+	// Value Record data in zone file format, e.g. "10 mail.example.com." for MX or "10 5 5060 sip.example.com." for SRV records. TXT values are the plain text.
+	Value string `json:"value"`
+}
+
+// DNSRecordType Record type
+type DNSRecordType string
+
+// DNSSettings defines model for DNSSettings.
+type DNSSettings struct {
+	// DisabledManagementGroups Groups whose DNS management is disabled
+	DisabledManagementGroups []string `json:"disabled_management_groups"`
+}
+
+// DNSZone defines model for DNSZone.
+type DNSZone struct {
+	// Description Description of the zone
+	Description *string `json:"description,omitempty"`
 
-	// ProcessCheck Posture Check for binaries exist and are running in the peer’s system
-	ProcessCheck *ProcessCheck `json:"process_check,omitempty"`
-}
+	// Domain Zone domain, records are resolved under this domain
+	Domain string `json:"domain"`
 
-// City Describe city geographical location information
-type City struct {
-	// CityName Commonly used English name of the city
-	CityName string `json:"city_name"`
+	// Enabled Zone status
+	Enabled bool `json:"enabled"`
 
-	// GeonameId Integer ID of the record in GeoNames database
-	GeonameId int `json:"geoname_id"`
-}
+	// Groups Distribution group IDs that defines group of peers that will resolve this zone
+	Groups []string `json:"groups"`
 
-// CityName Commonly used English name of the city
-type CityName = string
+	// Id Zone ID
+	Id string `json:"id"`
 
-// Country Describe country geographical location information
-type Country struct {
-	// CountryCode 2-letter ISO 3166-1 alpha-2 code that represents the country
-	CountryCode CountryCode `json:"country_code"`
+	// Name Zone name
+	Name string `json:"name"`
 
-	// CountryName Commonly used English name of the country
-	CountryName string `json:"country_name"`
+	// Records Zone records
+	Records []DNSRecord `json:"records"`
 }
 
-// CountryCode 2-letter ISO 3166-1 alpha-2 code that represents the country
-type CountryCode = string
-
-// CreateSetupKeyRequest defines model for CreateSetupKeyRequest.
-type CreateSetupKeyRequest struct {
-	// AllowExtraDnsLabels Allow extra DNS labels to be added to the peer
-	AllowExtraDnsLabels *bool `json:"allow_extra_dns_labels,omitempty"`
+// DNSZoneRequest defines model for DNSZoneRequest.
+type DNSZoneRequest struct {
+	// Description Description of the zone
+	Description *string `json:"description,omitempty"`
 
-	// AutoGroups List of group IDs to auto-assign to peers registered with this key
-	AutoGroups []string `json:"auto_groups"`
+	// Domain Zone domain, records are resolved under this domain
+	Domain string `json:"domain"`
 
-	// Ephemeral Indicate that the peer will be ephemeral or not
-	Ephemeral *bool `json:"ephemeral,omitempty"`
+	// Enabled Zone status
+	Enabled bool `json:"enabled"`
 
-	// ExpiresIn Expiration time in seconds
-	ExpiresIn int `json:"expires_in"`
+	// Groups Distribution group IDs that defines group of peers that will resolve this zone
+	Groups []string `json:"groups"`
 
-	// Name Setup Key name
+	// Name Zone name
 	Name string `json:"name"`
 
-	// Type Setup key type, one-off for single time usage and reusable
-	Type string `json:"type"`
-
-	// UsageLimit A number of times this key can be used. The value of 0 indicates the unlimited usage.
-	UsageLimit int `json:"usage_limit"`
+	// Records Zone records
+	Records []DNSRecord `json:"records"`
 }
 
-// DNSSettings defines model for DNSSettings.
-type DNSSettings struct {
-	// DisabledManagementGroups Groups whose DNS management is disabled
-	DisabledManagementGroups []string `json:"disabled_management_groups"`
+// DiskEncryptionCheck Posture check for the encryption of the peer's volumes
+type DiskEncryptionCheck struct {
+	// Volumes Mount points on Linux and macOS or drive letters on Windows that must be encrypted. All volumes reported by the peer must be encrypted when empty
+	Volumes *[]string `json:"volumes,omitempty"`
 }
 
 // Event defines model for Event.
@@ -445,6 +1105,189 @@ type Event struct {
 // EventActivityCode The string code of the activity that occurred during the event
 type EventActivityCode string
 
+// EventSink defines model for EventSink.
+type EventSink struct {
+	// ActivityCodes Codes of the activities that are sent to the sink, all events are sent when empty
+	ActivityCodes []string `json:"activity_codes"`
+
+	// Enabled Defines if the events are sent to the sink
+	Enabled bool `json:"enabled"`
+
+	// Id Event sink ID
+	Id string `json:"id"`
+
+	// Jsonl Configuration of a jsonl event sink
+	Jsonl *EventSinkJSONL `json:"jsonl,omitempty"`
+
+	// Name Event sink name
+	Name string `json:"name"`
+
+	// Status Delivery status of an event sink since the management service started
+	Status EventSinkStatus `json:"status"`
+
+	// Syslog Configuration of a syslog event sink
+	Syslog *EventSinkSyslog `json:"syslog,omitempty"`
+
+	// Type Destination type of the event sink
+	Type EventSinkType `json:"type"`
+
+	// Webhook Configuration of a webhook event sink
+	Webhook *EventSinkWebhook `json:"webhook,omitempty"`
+}
+
+// EventSinkJSONL Configuration of a jsonl event sink
+type EventSinkJSONL struct {
+	// Path Path of the file the events are written to relative to the management data directory, it is named after the account and the sink by the server
+	Path *string `json:"path,omitempty"`
+}
+
+// EventSinkRequest defines model for EventSinkRequest.
+type EventSinkRequest struct {
+	// ActivityCodes Codes of the activities that are sent to the sink, all events are sent when empty
+	ActivityCodes *[]string `json:"activity_codes,omitempty"`
+
+	// Enabled Defines if the events are sent to the sink
+	Enabled bool `json:"enabled"`
+
+	// Jsonl Configuration of a jsonl event sink
+	Jsonl *EventSinkJSONL `json:"jsonl,omitempty"`
+
+	// Name Event sink name
+	Name string `json:"name"`
+
+	// Syslog Configuration of a syslog event sink
+	Syslog *EventSinkSyslog `json:"syslog,omitempty"`
+
+	// Type Destination type of the event sink
+	Type EventSinkType `json:"type"`
+
+	// Webhook Configuration of a webhook event sink
+	Webhook *EventSinkWebhook `json:"webhook,omitempty"`
+}
+
+// EventSinkStatus Delivery status of an event sink since the management service started
+type EventSinkStatus struct {
+	// Delivered Number of the delivered events
+	Delivered int64 `json:"delivered"`
+
+	// Dropped Number of the events dropped because the delivery queue was full
+	Dropped int64 `json:"dropped"`
+
+	// Failed Number of the events that couldn't be delivered after the retries
+	Failed int64 `json:"failed"`
+
+	// LastDeliveryAt Time of the last successful delivery
+	LastDeliveryAt *time.Time `json:"last_delivery_at,omitempty"`
+
+	// LastError Error of the last failed delivery
+	LastError *string `json:"last_error,omitempty"`
+}
+
+// EventSinkSyslog Configuration of a syslog event sink
+type EventSinkSyslog struct {
+	// Address Address of the syslog server in the host:port format, the messages are sent over TCP
+	Address string `json:"address"`
+
+	// Tls Defines if the connection to the syslog server uses TLS
+	Tls *bool `json:"tls,omitempty"`
+}
+
+// EventSinkType Destination type of the event sink
+type EventSinkType string
+
+// EventSinkWebhook Configuration of a webhook event sink
+type EventSinkWebhook struct {
+	// Headers Additional headers of the webhook requests. Their values are never returned, responses contain "********" instead and updates with that value keep the stored one.
+	Headers *map[string]string `json:"headers,omitempty"`
+
+	// Secret Secret that signs the requests with HMAC-SHA256, required on create and kept on update when not set. It is never returned.
+	Secret *string `json:"secret,omitempty"`
+
+	// Url URL the events are posted to
+	Url string `json:"url"`
+}
+
+// ExternalCheck Posture check asking an external posture provider, e.g. an MDM, whether the peer is compliant. The peer identity is posted to the URL and a JSON object with a boolean compliant field is expected in response. The verdict is requested in the background, the peer fails the check until the first verdict is received unless fail_open is set
+type ExternalCheck struct {
+	// CacheTtl Time in seconds the verdict is cached for, 300 seconds when 0
+	CacheTtl *int `json:"cache_ttl,omitempty"`
+
+	// FailOpen Makes the check pass when the endpoint can't be reached or returns an invalid response
+	FailOpen *bool `json:"fail_open,omitempty"`
+
+	// Token Bearer token sent to the endpoint. It is never returned and kept on updates when omitted
+	Token *string `json:"token,omitempty"`
+
+	// Url URL of the external posture provider endpoint. Endpoints in loopback, private and link-local networks are rejected unless the management service is started with NB_POSTURE_EXTERNAL_ALLOW_PRIVATE_NETWORKS=true
+	Url string `json:"url"`
+}
+
+// FlowEvent defines model for FlowEvent.
+type FlowEvent struct {
+	// DestinationIp Destination IP address
+	DestinationIp string `json:"destination_ip"`
+
+	// DestinationPort Destination port, not set for ICMP
+	DestinationPort *int `json:"destination_port,omitempty"`
+
+	// DestinationResourceId ID of the destination peer or resource, if known
+	DestinationResourceId string `json:"destination_resource_id"`
+
+	// Direction Direction of the traffic (e.g., INGRESS, EGRESS)
+	Direction string `json:"direction"`
+
+	// FlowId ID of the connection flow, shared by the events of the same connection (e.g., start and end)
+	FlowId string `json:"flow_id"`
+
+	// IcmpCode ICMP code, only set for ICMP
+	IcmpCode *int `json:"icmp_code,omitempty"`
+
+	// IcmpType ICMP type, only set for ICMP
+	IcmpType *int `json:"icmp_type,omitempty"`
+
+	// Id Event ID assigned by the peer
+	Id string `json:"id"`
+
+	// PeerId ID of the peer that reported the event
+	PeerId string `json:"peer_id"`
+
+	// Protocol Protocol number of the traffic (e.g. 1 = ICMP, 6 = TCP, 17 = UDP)
+	Protocol int `json:"protocol"`
+
+	// ReceivedAt Timestamp the event was received by the management service
+	ReceivedAt time.Time `json:"received_at"`
+
+	// RuleId ID of the policy rule that matched the traffic
+	RuleId string `json:"rule_id"`
+
+	// RxBytes Number of bytes received
+	RxBytes int `json:"rx_bytes"`
+
+	// RxPackets Number of packets received
+	RxPackets int `json:"rx_packets"`
+
+	// SourceIp Source IP address
+	SourceIp string `json:"source_ip"`
+
+	// SourcePort Source port, not set for ICMP
+	SourcePort *int `json:"source_port,omitempty"`
+
+	// SourceResourceId ID of the source peer or resource, if known
+	SourceResourceId string `json:"source_resource_id"`
+
+	// Timestamp Timestamp of the event as sent by the peer
+	Timestamp time.Time `json:"timestamp"`
+
+	// TxBytes Number of bytes transmitted
+	TxBytes int `json:"tx_bytes"`
+
+	// TxPackets Number of packets transmitted
+	TxPackets int `json:"tx_packets"`
+
+	// Type Type of the event (e.g., TYPE_START, TYPE_END, TYPE_DROP)
+	Type string `json:"type"`
+}
+
 // GeoLocationCheck Posture check for geo location
 type GeoLocationCheck struct {
 	// Action Action to take upon policy match
@@ -513,6 +1356,12 @@ type GroupRequest struct {
 	Resources *[]Resource `json:"resources,omitempty"`
 }
 
+// HostFirewallCheck Posture check for an active host firewall on the peer
+type HostFirewallCheck struct {
+	// Firewalls Names of the accepted host firewalls, e.g. ufw, firewalld, nftables, iptables, application firewall or windows firewall. Any active host firewall is accepted when empty
+	Firewalls *[]string `json:"firewalls,omitempty"`
+}
+
 // IngressPeer defines model for IngressPeer.
 type IngressPeer struct {
 	AvailablePorts AvailablePorts `json:"available_ports"`
@@ -612,6 +1461,9 @@ type IngressPortAllocationRequest struct {
 	// Enabled Indicates if an ingress port allocation is enabled
 	Enabled bool `json:"enabled"`
 
+	// IngressPeerId ID of the ingress peer that forwards the ports, an enabled ingress peer in the region of the peer or a fallback one is chosen when not set
+	IngressPeerId *string `json:"ingress_peer_id,omitempty"`
+
 	// Name Name of the ingress port allocation
 	Name string `json:"name"`
 
@@ -636,6 +1488,9 @@ type IngressPortAllocationRequestPortRange struct {
 	// End The ending port of the range of forwarded ports
 	End int `json:"end"`
 
+	// IngressStart The starting port of the range of ingress ports, free ports are allocated when not set
+	IngressStart *int `json:"ingress_start,omitempty"`
+
 	// Protocol The protocol accepted by the port range
 	Protocol IngressPortAllocationRequestPortRangeProtocol `json:"protocol"`
 
@@ -675,14 +1530,23 @@ type Nameserver struct {
 	// Ip Nameserver IP
 	Ip string `json:"ip"`
 
-	// NsType Nameserver Type
+	// NsType Nameserver Type, dot and doh are DNS-over-TLS and DNS-over-HTTPS nameservers
 	NsType NameserverNsType `json:"ns_type"`
 
+	// Path Query path of doh nameservers, defaults to /dns-query
+	Path *string `json:"path,omitempty"`
+
+	// Pin Base64 encoded SHA-256 hash of the public key of dot and doh nameservers. When set, certificates are trusted by this pin instead of the certificate authorities
+	Pin *string `json:"pin,omitempty"`
+
 	// Port Nameserver Port
 	Port int `json:"port"`
+
+	// ServerName Name the TLS certificate of dot and doh nameservers is verified against and sent as SNI, the IP is used when empty
+	ServerName *string `json:"server_name,omitempty"`
 }
 
-// NameserverNsType Nameserver Type
+// NameserverNsType Nameserver Type, dot and doh are DNS-over-TLS and DNS-over-HTTPS nameservers
 type NameserverNsType string
 
 // NameserverGroup defines model for NameserverGroup.
@@ -1280,6 +2144,39 @@ type Policy struct {
 	SourcePostureChecks []string `json:"source_posture_checks"`
 }
 
+// PolicyAccess Connection a source peer is allowed to open
+type PolicyAccess struct {
+	// DestinationId ID of the destination peer, network resource or route
+	DestinationId string `json:"destination_id"`
+
+	// DestinationName Name of the destination peer, network resource or network identifier of the route
+	DestinationName string `json:"destination_name"`
+
+	// DestinationType Type of the destination
+	DestinationType PolicyAccessDestinationType `json:"destination_type"`
+
+	// Port Destination port, set when the access is limited to a single port
+	Port *int `json:"port,omitempty"`
+
+	// PortRange Policy rule affected ports range
+	PortRange *RulePortRange `json:"port_range,omitempty"`
+
+	// Protocol Protocol of the traffic
+	Protocol PolicyAccessProtocol `json:"protocol"`
+
+	// SourcePeerId Source peer ID
+	SourcePeerId string `json:"source_peer_id"`
+
+	// SourcePeerName Source peer name
+	SourcePeerName string `json:"source_peer_name"`
+}
+
+// PolicyAccessDestinationType Type of the destination
+type PolicyAccessDestinationType string
+
+// PolicyAccessProtocol Protocol of the traffic
+type PolicyAccessProtocol string
+
 // PolicyCreate defines model for PolicyCreate.
 type PolicyCreate struct {
 	// Description Policy friendly description
@@ -1298,6 +2195,29 @@ type PolicyCreate struct {
 	SourcePostureChecks *[]string `json:"source_posture_checks,omitempty"`
 }
 
+// PolicyEvaluation Connections gained and lost if the proposed policy changes were saved
+type PolicyEvaluation struct {
+	// Added Connections allowed only after the changes
+	Added []PolicyAccess `json:"added"`
+
+	// Removed Connections allowed only before the changes
+	Removed []PolicyAccess `json:"removed"`
+}
+
+// PolicyEvaluationChange Proposed policy change. A change with only a policy creates it, with only a policy ID deletes the policy and with both updates the policy.
+type PolicyEvaluationChange struct {
+	Policy *PolicyUpdate `json:"policy,omitempty"`
+
+	// PolicyId ID of the policy to update or delete
+	PolicyId *string `json:"policy_id,omitempty"`
+}
+
+// PolicyEvaluationRequest defines model for PolicyEvaluationRequest.
+type PolicyEvaluationRequest struct {
+	// Changes Proposed policy changes, applied in order
+	Changes []PolicyEvaluationChange `json:"changes"`
+}
+
 // PolicyMinimum defines model for PolicyMinimum.
 type PolicyMinimum struct {
 	// Description Policy friendly description
@@ -1341,8 +2261,9 @@ type PolicyRule struct {
 	Ports *[]string `json:"ports,omitempty"`
 
 	// Protocol Policy rule type of the traffic
-	Protocol       PolicyRuleProtocol `json:"protocol"`
-	SourceResource *Resource          `json:"sourceResource,omitempty"`
+	Protocol       PolicyRuleProtocol  `json:"protocol"`
+	Schedule       *PolicyRuleSchedule `json:"schedule,omitempty"`
+	SourceResource *Resource           `json:"sourceResource,omitempty"`
 
 	// Sources Policy rule source group IDs
 	Sources *[]GroupMinimum `json:"sources,omitempty"`
@@ -1379,6 +2300,7 @@ type PolicyRuleMinimum struct {
 
 	// Protocol Policy rule type of the traffic
 	Protocol PolicyRuleMinimumProtocol `json:"protocol"`
+	Schedule *PolicyRuleSchedule       `json:"schedule,omitempty"`
 }
 
 // PolicyRuleMinimumAction Policy rule accept or drops packets
@@ -1387,6 +2309,21 @@ type PolicyRuleMinimumAction string
 // PolicyRuleMinimumProtocol Policy rule type of the traffic
 type PolicyRuleMinimumProtocol string
 
+// PolicyRuleSchedule Restricts the time in which an enabled policy rule is applied
+type PolicyRuleSchedule struct {
+	// ExpiresAt The rule isn't applied from this time on. Expired rules are disabled
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+
+	// StartsAt The rule isn't applied before this time
+	StartsAt *time.Time `json:"starts_at,omitempty"`
+
+	// Timezone IANA time zone name the windows are evaluated in, UTC when omitted
+	Timezone *string `json:"timezone,omitempty"`
+
+	// Windows Recurring time windows in which the rule is applied, the rule is applied at any time when omitted
+	Windows *[]ScheduleWindow `json:"windows,omitempty"`
+}
+
 // PolicyRuleUpdate defines model for PolicyRuleUpdate.
 type PolicyRuleUpdate struct {
 	// Action Policy rule accept or drops packets
@@ -1419,6 +2356,7 @@ type PolicyRuleUpdate struct {
 
 	// Protocol Policy rule type of the traffic
 	Protocol       PolicyRuleUpdateProtocol `json:"protocol"`
+	Schedule       *PolicyRuleSchedule      `json:"schedule,omitempty"`
 	SourceResource *Resource                `json:"sourceResource,omitempty"`
 
 	// Sources Policy rule source group IDs
@@ -1493,6 +2431,15 @@ type ProcessCheck struct {
 	Processes []Process `json:"processes"`
 }
 
+// RelayRegionPin defines model for RelayRegionPin.
+type RelayRegionPin struct {
+	// Groups Groups IDs whose peers are offered only the relay servers of the region
+	Groups []string `json:"groups"`
+
+	// Region Region of the relay servers, as configured on the management server
+	Region string `json:"region"`
+}
+
 // Resource defines model for Resource.
 type Resource struct {
 	// Id ID of the resource
@@ -1503,6 +2450,36 @@ type Resource struct {
 // ResourceType defines model for ResourceType.
 type ResourceType string
 
+// Role defines model for Role.
+type Role struct {
+	// Description Role description
+	Description string `json:"description"`
+
+	// Id Role ID
+	Id string `json:"id"`
+
+	// Name Role name
+	Name string `json:"name"`
+
+	// Permissions Operations allowed per module. Modules and operations that are not listed are denied.
+	Permissions map[string]map[string]bool `json:"permissions"`
+
+	// Role Value to set as the role of a user to assign this role
+	Role string `json:"role"`
+}
+
+// RoleRequest defines model for RoleRequest.
+type RoleRequest struct {
+	// Description Role description
+	Description string `json:"description"`
+
+	// Name Role name
+	Name string `json:"name"`
+
+	// Permissions Operations allowed per module. Modules and operations that are not listed are denied.
+	Permissions map[string]map[string]bool `json:"permissions"`
+}
+
 // Route defines model for Route.
 type Route struct {
 	// AccessControlGroups Access control group identifier associated with route.
@@ -1602,6 +2579,55 @@ type RulePortRange struct {
 	Start int `json:"start"`
 }
 
+// SCIMToken defines model for SCIMToken.
+type SCIMToken struct {
+	// CreatedAt Date the token was created
+	CreatedAt time.Time `json:"created_at"`
+
+	// CreatedBy User ID of the user who created the token
+	CreatedBy string `json:"created_by"`
+
+	// Id ID of a token
+	Id string `json:"id"`
+
+	// LastUsed Date the token was last used
+	LastUsed *time.Time `json:"last_used,omitempty"`
+
+	// Name Name of the token
+	Name string `json:"name"`
+
+	// UserId ID of the admin service user the users and groups are provisioned with
+	UserId string `json:"user_id"`
+}
+
+// SCIMTokenGenerated defines model for SCIMTokenGenerated.
+type SCIMTokenGenerated struct {
+	// PlainToken Plain text representation of the generated token
+	PlainToken string    `json:"plain_token"`
+	ScimToken  SCIMToken `json:"scim_token"`
+}
+
+// SCIMTokenRequest defines model for SCIMTokenRequest.
+type SCIMTokenRequest struct {
+	// Name Name of the token
+	Name string `json:"name"`
+}
+
+// ScheduleWindow Recurring daily time window of a policy rule schedule
+type ScheduleWindow struct {
+	// Days Week days the window opens on, every day when omitted
+	Days *[]ScheduleWindowDays `json:"days,omitempty"`
+
+	// End Time of the day the window closes at in HH:MM format. Windows ending before they start close on the next day
+	End string `json:"end"`
+
+	// Start Time of the day the window opens at in HH:MM format
+	Start string `json:"start"`
+}
+
+// ScheduleWindowDays defines model for ScheduleWindow.Days.
+type ScheduleWindowDays string
+
 // SetupKey defines model for SetupKey.
 type SetupKey struct {
 	// AllowExtraDnsLabels Allow extra DNS labels to be added to the peer
@@ -1832,6 +2858,69 @@ type UserRequest struct {
 	Role string `json:"role"`
 }
 
+// PostApiAccountsAccountIdApplyParams defines parameters for PostApiAccountsAccountIdApply.
+type PostApiAccountsAccountIdApplyParams struct {
+	// DryRun Only plan the changes without applying them
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+
+	// PlanHash Hash of a previously returned plan, the changes aren't applied if the account was modified since the plan was made
+	PlanHash *string `form:"plan_hash,omitempty" json:"plan_hash,omitempty"`
+}
+
+// GetApiEventsAuditParams defines parameters for GetApiEventsAudit.
+type GetApiEventsAuditParams struct {
+	// ActivityCode Comma-separated list of activity codes to filter by
+	ActivityCode *string `form:"activity_code,omitempty" json:"activity_code,omitempty"`
+
+	// InitiatorId Filter by initiator ID
+	InitiatorId *string `form:"initiator_id,omitempty" json:"initiator_id,omitempty"`
+
+	// TargetId Filter by target ID
+	TargetId *string `form:"target_id,omitempty" json:"target_id,omitempty"`
+
+	// Search Case-insensitive partial match on the event meta and on the names and emails of deleted users
+	Search *string `form:"search,omitempty" json:"search,omitempty"`
+
+	// StartDate Start date for filtering events (ISO 8601 format, e.g., 2024-01-01T00:00:00Z).
+	StartDate *time.Time `form:"start_date,omitempty" json:"start_date,omitempty"`
+
+	// EndDate End date for filtering events (ISO 8601 format, e.g., 2024-01-31T23:59:59Z).
+	EndDate *time.Time `form:"end_date,omitempty" json:"end_date,omitempty"`
+
+	// Cursor Cursor of the page to return, taken from the X-Next-Cursor header of the previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// PageSize Number of events per page
+	PageSize *int `form:"page_size,omitempty" json:"page_size,omitempty"`
+}
+
+// GetApiEventsFlowsParams defines parameters for GetApiEventsFlows.
+type GetApiEventsFlowsParams struct {
+	// PeerId Filter by the ID of the peer that reported the event
+	PeerId *string `form:"peer_id,omitempty" json:"peer_id,omitempty"`
+
+	// ResourceId Filter by the ID of the source or the destination peer or resource
+	ResourceId *string `form:"resource_id,omitempty" json:"resource_id,omitempty"`
+
+	// RuleId Filter by the ID of the policy rule
+	RuleId *string `form:"rule_id,omitempty" json:"rule_id,omitempty"`
+
+	// Protocol Filter by protocol number
+	Protocol *int `form:"protocol,omitempty" json:"protocol,omitempty"`
+
+	// StartDate Start date for filtering events (ISO 8601 format, e.g., 2024-01-01T00:00:00Z).
+	StartDate *time.Time `form:"start_date,omitempty" json:"start_date,omitempty"`
+
+	// EndDate End date for filtering events (ISO 8601 format, e.g., 2024-01-31T23:59:59Z).
+	EndDate *time.Time `form:"end_date,omitempty" json:"end_date,omitempty"`
+
+	// Cursor Cursor of the page to return, taken from the X-Next-Cursor header of the previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// PageSize Number of events per page
+	PageSize *int `form:"page_size,omitempty" json:"page_size,omitempty"`
+}
+
 // GetApiEventsNetworkTrafficParams defines parameters for GetApiEventsNetworkTraffic.
 type GetApiEventsNetworkTrafficParams struct {
 	// Page Page number
@@ -1901,6 +2990,15 @@ type GetApiUsersParams struct {
 // PutApiAccountsAccountIdJSONRequestBody defines body for PutApiAccountsAccountId for application/json ContentType.
 type PutApiAccountsAccountIdJSONRequestBody = AccountRequest
 
+// PostApiAccountsAccountIdApplyJSONRequestBody defines body for PostApiAccountsAccountIdApply for application/json ContentType.
+type PostApiAccountsAccountIdApplyJSONRequestBody = AccountDocument
+
+// PostApiDnsBlocklistsJSONRequestBody defines body for PostApiDnsBlocklists for application/json ContentType.
+type PostApiDnsBlocklistsJSONRequestBody = DNSBlocklistRequest
+
+// PutApiDnsBlocklistsBlocklistIdJSONRequestBody defines body for PutApiDnsBlocklistsBlocklistId for application/json ContentType.
+type PutApiDnsBlocklistsBlocklistIdJSONRequestBody = DNSBlocklistRequest
+
 // PostApiDnsNameserversJSONRequestBody defines body for PostApiDnsNameservers for application/json ContentType.
 type PostApiDnsNameserversJSONRequestBody = NameserverGroupRequest
 
@@ -1910,6 +3008,18 @@ type PutApiDnsNameserversNsgroupIdJSONRequestBody = NameserverGroupRequest
 // PutApiDnsSettingsJSONRequestBody defines body for PutApiDnsSettings for application/json ContentType.
 type PutApiDnsSettingsJSONRequestBody = DNSSettings
 
+// PostApiDnsZonesJSONRequestBody defines body for PostApiDnsZones for application/json ContentType.
+type PostApiDnsZonesJSONRequestBody = DNSZoneRequest
+
+// PutApiDnsZonesZoneIdJSONRequestBody defines body for PutApiDnsZonesZoneId for application/json ContentType.
+type PutApiDnsZonesZoneIdJSONRequestBody = DNSZoneRequest
+
+// PostApiEventsSinksJSONRequestBody defines body for PostApiEventsSinks for application/json ContentType.
+type PostApiEventsSinksJSONRequestBody = EventSinkRequest
+
+// PutApiEventsSinksSinkIdJSONRequestBody defines body for PutApiEventsSinksSinkId for application/json ContentType.
+type PutApiEventsSinksSinkIdJSONRequestBody = EventSinkRequest
+
 // PostApiGroupsJSONRequestBody defines body for PostApiGroups for application/json ContentType.
 type PostApiGroupsJSONRequestBody = GroupRequest
 
@@ -1952,6 +3062,12 @@ type PutApiPeersPeerIdIngressPortsAllocationIdJSONRequestBody = IngressPortAlloc
 // PostApiPoliciesJSONRequestBody defines body for PostApiPolicies for application/json ContentType.
 type PostApiPoliciesJSONRequestBody = PolicyUpdate
 
+// PostApiPoliciesCheckJSONRequestBody defines body for PostApiPoliciesCheck for application/json ContentType.
+type PostApiPoliciesCheckJSONRequestBody = AccessCheckRequest
+
+// PostApiPoliciesEvaluateJSONRequestBody defines body for PostApiPoliciesEvaluate for application/json ContentType.
+type PostApiPoliciesEvaluateJSONRequestBody = PolicyEvaluationRequest
+
 // PutApiPoliciesPolicyIdJSONRequestBody defines body for PutApiPoliciesPolicyId for application/json ContentType.
 type PutApiPoliciesPolicyIdJSONRequestBody = PolicyCreate
 
@@ -1961,12 +3077,21 @@ type PostApiPostureChecksJSONRequestBody = PostureCheckUpdate
 // PutApiPostureChecksPostureCheckIdJSONRequestBody defines body for PutApiPostureChecksPostureCheckId for application/json ContentType.
 type PutApiPostureChecksPostureCheckIdJSONRequestBody = PostureCheckUpdate
 
+// PostApiRolesJSONRequestBody defines body for PostApiRoles for application/json ContentType.
+type PostApiRolesJSONRequestBody = RoleRequest
+
+// PutApiRolesRoleIdJSONRequestBody defines body for PutApiRolesRoleId for application/json ContentType.
+type PutApiRolesRoleIdJSONRequestBody = RoleRequest
+
 // PostApiRoutesJSONRequestBody defines body for PostApiRoutes for application/json ContentType.
 type PostApiRoutesJSONRequestBody = RouteRequest
 
 // PutApiRoutesRouteIdJSONRequestBody defines body for PutApiRoutesRouteId for application/json ContentType.
 type PutApiRoutesRouteIdJSONRequestBody = RouteRequest
 
+// PostApiScimTokensJSONRequestBody defines body for PostApiScimTokens for application/json ContentType.
+type PostApiScimTokensJSONRequestBody = SCIMTokenRequest
+
 // PostApiSetupKeysJSONRequestBody defines body for PostApiSetupKeys for application/json ContentType.
 type PostApiSetupKeysJSONRequestBody = CreateSetupKeyRequest
 
@@ -0,0 +1,75 @@
+// Package posture contains the evaluation of the posture checks shared by the client, which reports the failure
+// reasons to the user, and the management service, which enforces the checks.
+package posture
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// EncryptedVolume is a mounted volume and its encryption state
+type EncryptedVolume struct {
+	// Path is the mount point of the volume on unix systems or the drive letter on windows
+	Path      string
+	Encrypted bool
+}
+
+// CheckDiskEncryption returns the reason the reported volumes don't satisfy the check, empty if they do. All the
+// reported volumes must be encrypted when no volumes are required. A peer that reported no volumes, e.g. a client
+// that doesn't support it yet, fails the check.
+func CheckDiskEncryption(goOS string, required []string, reported []EncryptedVolume) string {
+	if len(reported) == 0 {
+		return "disk encryption state is unknown"
+	}
+
+	if len(required) == 0 {
+		for _, volume := range reported {
+			if !volume.Encrypted {
+				return fmt.Sprintf("volume %s isn't encrypted", volume.Path)
+			}
+		}
+		return ""
+	}
+
+	for _, path := range required {
+		idx := slices.IndexFunc(reported, func(volume EncryptedVolume) bool {
+			return IsSameVolume(goOS, volume.Path, path)
+		})
+		if idx == -1 {
+			return fmt.Sprintf("volume %s isn't mounted", path)
+		}
+		if !reported[idx].Encrypted {
+			return fmt.Sprintf("volume %s isn't encrypted", path)
+		}
+	}
+	return ""
+}
+
+// CheckHostFirewall returns the reason the active host firewalls don't satisfy the check, empty if they do. Any
+// active host firewall is accepted when none is listed.
+func CheckHostFirewall(accepted, active []string) string {
+	if len(active) == 0 {
+		return "no host firewall is active"
+	}
+	if len(accepted) == 0 {
+		return ""
+	}
+
+	for _, firewall := range active {
+		if slices.ContainsFunc(accepted, func(name string) bool {
+			return strings.EqualFold(name, firewall)
+		}) {
+			return ""
+		}
+	}
+	return fmt.Sprintf("none of the host firewalls %s is active", strings.Join(accepted, ", "))
+}
+
+// IsSameVolume compares volume paths, drive letters on windows are case-insensitive and may end with a separator
+func IsSameVolume(goOS, reported, required string) bool {
+	if goOS != "windows" {
+		return reported == required
+	}
+	return strings.EqualFold(strings.TrimRight(reported, `\`), strings.TrimRight(required, `\`))
+}
@@ -0,0 +1,95 @@
+package posture
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckDiskEncryption(t *testing.T) {
+	tests := []struct {
+		name     string
+		goOS     string
+		required []string
+		reported []EncryptedVolume
+		expected string
+	}{
+		{
+			name:     "no volumes reported",
+			goOS:     "linux",
+			expected: "disk encryption state is unknown",
+		},
+		{
+			name:     "all reported volumes encrypted",
+			goOS:     "linux",
+			reported: []EncryptedVolume{{Path: "/", Encrypted: true}, {Path: "/home", Encrypted: true}},
+		},
+		{
+			name:     "unencrypted reported volume",
+			goOS:     "linux",
+			reported: []EncryptedVolume{{Path: "/", Encrypted: true}, {Path: "/data"}},
+			expected: "volume /data isn't encrypted",
+		},
+		{
+			name:     "required volume not mounted",
+			goOS:     "linux",
+			required: []string{"/home"},
+			reported: []EncryptedVolume{{Path: "/", Encrypted: true}},
+			expected: "volume /home isn't mounted",
+		},
+		{
+			name:     "windows drive letters are case-insensitive",
+			goOS:     "windows",
+			required: []string{"c:"},
+			reported: []EncryptedVolume{{Path: `C:\`, Encrypted: true}, {Path: `D:\`}},
+		},
+		{
+			name:     "paths are case-sensitive on unix",
+			goOS:     "darwin",
+			required: []string{"/Data"},
+			reported: []EncryptedVolume{{Path: "/data", Encrypted: true}},
+			expected: "volume /Data isn't mounted",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, CheckDiskEncryption(tt.goOS, tt.required, tt.reported))
+		})
+	}
+}
+
+func TestCheckHostFirewall(t *testing.T) {
+	tests := []struct {
+		name     string
+		accepted []string
+		active   []string
+		expected string
+	}{
+		{
+			name:     "no active firewall",
+			expected: "no host firewall is active",
+		},
+		{
+			name:   "any active firewall",
+			active: []string{"nftables"},
+		},
+		{
+			name:     "accepted firewall is case-insensitive",
+			accepted: []string{"Windows Firewall"},
+			active:   []string{"windows firewall"},
+		},
+		{
+			name:     "accepted firewall not active",
+			accepted: []string{"ufw", "firewalld"},
+			active:   []string{"nftables"},
+			expected: "none of the host firewalls ufw, firewalld is active",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, CheckHostFirewall(tt.accepted, tt.active))
+		})
+	}
+}
@@ -0,0 +1,435 @@
+package proto
+
+import (
+	"fmt"
+
+	gproto "google.golang.org/protobuf/proto"
+)
+
+// NewNetworkMapDelta computes the changes that turn the base network map into the target network map.
+// Remote peers are identified by their WireGuard public key, routes and DNS blocklists by their ID, every other list entry by its content.
+// The delta carries the order of a list only if applying the changes results in a different order than the one of the target, the
+// order of the firewall rules matters to the peer firewalls.
+func NewNetworkMapDelta(base, target *NetworkMap) *NetworkMapDelta {
+	delta := &NetworkMapDelta{
+		BaseSerial: base.GetSerial(),
+		Serial:     target.GetSerial(),
+	}
+
+	if !gproto.Equal(base.GetPeerConfig(), target.GetPeerConfig()) {
+		delta.PeerConfig = target.GetPeerConfig()
+	}
+
+	delta.UpsertedRemotePeers, delta.RemovedRemotePeers = diffByKey(base.GetRemotePeers(), target.GetRemotePeers(), remotePeerKey)
+	delta.UpsertedOfflinePeers, delta.RemovedOfflinePeers = diffByKey(base.GetOfflinePeers(), target.GetOfflinePeers(), remotePeerKey)
+	delta.UpsertedRoutes, delta.RemovedRoutes = diffByKey(base.GetRoutes(), target.GetRoutes(), routeKey)
+	delta.AddedFirewallRules, delta.RemovedFirewallRules = diffByContent(base.GetFirewallRules(), target.GetFirewallRules())
+	delta.AddedRoutesFirewallRules, delta.RemovedRoutesFirewallRules = diffByContent(base.GetRoutesFirewallRules(), target.GetRoutesFirewallRules())
+	delta.DNSConfig = newDNSConfigDelta(base.GetDNSConfig(), target.GetDNSConfig())
+
+	delta.RemotePeersOrder = listOrder(applyByKey(base.GetRemotePeers(), delta.UpsertedRemotePeers, delta.RemovedRemotePeers, remotePeerKey), target.GetRemotePeers())
+	delta.OfflinePeersOrder = listOrder(applyByKey(base.GetOfflinePeers(), delta.UpsertedOfflinePeers, delta.RemovedOfflinePeers, remotePeerKey), target.GetOfflinePeers())
+	delta.RoutesOrder = listOrder(applyByKey(base.GetRoutes(), delta.UpsertedRoutes, delta.RemovedRoutes, routeKey), target.GetRoutes())
+	delta.FirewallRulesOrder = listOrder(applyByContent(base.GetFirewallRules(), delta.AddedFirewallRules, delta.RemovedFirewallRules), target.GetFirewallRules())
+	delta.RoutesFirewallRulesOrder = listOrder(applyByContent(base.GetRoutesFirewallRules(), delta.AddedRoutesFirewallRules, delta.RemovedRoutesFirewallRules), target.GetRoutesFirewallRules())
+
+	if !equalLists(base.GetForwardingRules(), target.GetForwardingRules()) {
+		delta.ForwardingRulesChanged = true
+		delta.ForwardingRules = target.GetForwardingRules()
+	}
+
+	return delta
+}
+
+// ApplyNetworkMapDelta returns the network map resulting from applying the delta to the base network map.
+// The base network map is not modified. An error is returned if the delta wasn't computed for the base network map.
+func ApplyNetworkMapDelta(base *NetworkMap, delta *NetworkMapDelta) (*NetworkMap, error) {
+	if base == nil {
+		return nil, fmt.Errorf("no base network map to apply delta with base serial %d", delta.GetBaseSerial())
+	}
+	if base.GetSerial() != delta.GetBaseSerial() {
+		return nil, fmt.Errorf("delta base serial %d doesn't match network map serial %d", delta.GetBaseSerial(), base.GetSerial())
+	}
+
+	networkMap := &NetworkMap{
+		Serial:          delta.GetSerial(),
+		PeerConfig:      base.GetPeerConfig(),
+		ForwardingRules: base.GetForwardingRules(),
+	}
+
+	var err error
+	if networkMap.RemotePeers, err = reorder(applyByKey(base.GetRemotePeers(), delta.GetUpsertedRemotePeers(), delta.GetRemovedRemotePeers(), remotePeerKey), delta.GetRemotePeersOrder()); err != nil {
+		return nil, fmt.Errorf("remote peers: %w", err)
+	}
+	if networkMap.OfflinePeers, err = reorder(applyByKey(base.GetOfflinePeers(), delta.GetUpsertedOfflinePeers(), delta.GetRemovedOfflinePeers(), remotePeerKey), delta.GetOfflinePeersOrder()); err != nil {
+		return nil, fmt.Errorf("offline peers: %w", err)
+	}
+	if networkMap.Routes, err = reorder(applyByKey(base.GetRoutes(), delta.GetUpsertedRoutes(), delta.GetRemovedRoutes(), routeKey), delta.GetRoutesOrder()); err != nil {
+		return nil, fmt.Errorf("routes: %w", err)
+	}
+	if networkMap.FirewallRules, err = reorder(applyByContent(base.GetFirewallRules(), delta.GetAddedFirewallRules(), delta.GetRemovedFirewallRules()), delta.GetFirewallRulesOrder()); err != nil {
+		return nil, fmt.Errorf("firewall rules: %w", err)
+	}
+	if networkMap.RoutesFirewallRules, err = reorder(applyByContent(base.GetRoutesFirewallRules(), delta.GetAddedRoutesFirewallRules(), delta.GetRemovedRoutesFirewallRules()), delta.GetRoutesFirewallRulesOrder()); err != nil {
+		return nil, fmt.Errorf("routes firewall rules: %w", err)
+	}
+	if networkMap.DNSConfig, err = applyDNSConfigDelta(base.GetDNSConfig(), delta.GetDNSConfig()); err != nil {
+		return nil, fmt.Errorf("DNS config: %w", err)
+	}
+
+	if delta.GetPeerConfig() != nil {
+		networkMap.PeerConfig = delta.GetPeerConfig()
+	}
+	if delta.GetForwardingRulesChanged() {
+		networkMap.ForwardingRules = delta.GetForwardingRules()
+	}
+
+	// deltas are produced only by management servers that always set the empty flags
+	networkMap.RemotePeersIsEmpty = len(networkMap.RemotePeers) == 0
+	networkMap.FirewallRulesIsEmpty = len(networkMap.FirewallRules) == 0
+	networkMap.RoutesFirewallRulesIsEmpty = len(networkMap.RoutesFirewallRules) == 0
+
+	return networkMap, nil
+}
+
+func newDNSConfigDelta(base, target *DNSConfig) *DNSConfigDelta {
+	if gproto.Equal(base, target) {
+		return nil
+	}
+
+	delta := &DNSConfigDelta{
+		ServiceEnable: target.GetServiceEnable(),
+	}
+
+	if !equalLists(base.GetNameServerGroups(), target.GetNameServerGroups()) {
+		delta.NameServerGroupsChanged = true
+		delta.NameServerGroups = target.GetNameServerGroups()
+	}
+
+	baseZones := make(map[string]*CustomZone, len(base.GetCustomZones()))
+	for _, zone := range base.GetCustomZones() {
+		baseZones[zone.GetDomain()] = zone
+	}
+
+	targetZones := make(map[string]struct{}, len(target.GetCustomZones()))
+	for _, zone := range target.GetCustomZones() {
+		targetZones[zone.GetDomain()] = struct{}{}
+
+		baseZone, existed := baseZones[zone.GetDomain()]
+		added, removed := diffByContent(baseZone.GetRecords(), zone.GetRecords())
+		order := listOrder(applyByContent(baseZone.GetRecords(), added, removed), zone.GetRecords())
+		if existed && len(added) == 0 && len(removed) == 0 && order == nil {
+			continue
+		}
+
+		delta.CustomZones = append(delta.CustomZones, &CustomZoneDelta{
+			Domain:         zone.GetDomain(),
+			AddedRecords:   added,
+			RemovedRecords: removed,
+			RecordsOrder:   order,
+		})
+	}
+
+	for _, zone := range base.GetCustomZones() {
+		if _, ok := targetZones[zone.GetDomain()]; !ok {
+			delta.CustomZones = append(delta.CustomZones, &CustomZoneDelta{
+				Domain:  zone.GetDomain(),
+				Removed: true,
+			})
+		}
+	}
+
+	// the zones kept from base followed by the new ones, in the order applyDNSConfigDelta builds them
+	var zones []string
+	for _, zone := range base.GetCustomZones() {
+		if _, ok := targetZones[zone.GetDomain()]; ok {
+			zones = append(zones, zone.GetDomain())
+		}
+	}
+	for _, zone := range target.GetCustomZones() {
+		if _, ok := baseZones[zone.GetDomain()]; !ok {
+			zones = append(zones, zone.GetDomain())
+		}
+	}
+	delta.CustomZonesOrder = keyOrder(zones, target.GetCustomZones(), (*CustomZone).GetDomain)
+
+	delta.UpsertedBlocklists, delta.RemovedBlocklists = diffByKey(base.GetBlocklists(), target.GetBlocklists(), blocklistKey)
+	delta.BlocklistsOrder = listOrder(applyByKey(base.GetBlocklists(), delta.UpsertedBlocklists, delta.RemovedBlocklists, blocklistKey), target.GetBlocklists())
+
+	return delta
+}
+
+func applyDNSConfigDelta(base *DNSConfig, delta *DNSConfigDelta) (*DNSConfig, error) {
+	if delta == nil {
+		return base, nil
+	}
+
+	config := &DNSConfig{
+		ServiceEnable:    delta.GetServiceEnable(),
+		NameServerGroups: base.GetNameServerGroups(),
+	}
+
+	var err error
+	if config.Blocklists, err = reorder(applyByKey(base.GetBlocklists(), delta.GetUpsertedBlocklists(), delta.GetRemovedBlocklists(), blocklistKey), delta.GetBlocklistsOrder()); err != nil {
+		return nil, fmt.Errorf("blocklists: %w", err)
+	}
+
+	if delta.GetNameServerGroupsChanged() {
+		config.NameServerGroups = delta.GetNameServerGroups()
+	}
+
+	zoneDeltas := make(map[string]*CustomZoneDelta, len(delta.GetCustomZones()))
+	for _, zoneDelta := range delta.GetCustomZones() {
+		zoneDeltas[zoneDelta.GetDomain()] = zoneDelta
+	}
+
+	for _, zone := range base.GetCustomZones() {
+		zoneDelta, ok := zoneDeltas[zone.GetDomain()]
+		if !ok {
+			config.CustomZones = append(config.CustomZones, zone)
+			continue
+		}
+		delete(zoneDeltas, zone.GetDomain())
+
+		if zoneDelta.GetRemoved() {
+			continue
+		}
+
+		records, err := reorder(applyByContent(zone.GetRecords(), zoneDelta.GetAddedRecords(), zoneDelta.GetRemovedRecords()), zoneDelta.GetRecordsOrder())
+		if err != nil {
+			return nil, fmt.Errorf("records of zone %s: %w", zone.GetDomain(), err)
+		}
+		config.CustomZones = append(config.CustomZones, &CustomZone{
+			Domain:  zone.GetDomain(),
+			Records: records,
+		})
+	}
+
+	// remaining deltas describe zones that are new to the peer, keep the order in which they were sent
+	for _, zoneDelta := range delta.GetCustomZones() {
+		if _, ok := zoneDeltas[zoneDelta.GetDomain()]; !ok || zoneDelta.GetRemoved() {
+			continue
+		}
+		records, err := reorder(zoneDelta.GetAddedRecords(), zoneDelta.GetRecordsOrder())
+		if err != nil {
+			return nil, fmt.Errorf("records of zone %s: %w", zoneDelta.GetDomain(), err)
+		}
+		config.CustomZones = append(config.CustomZones, &CustomZone{
+			Domain:  zoneDelta.GetDomain(),
+			Records: records,
+		})
+	}
+
+	if config.CustomZones, err = reorder(config.CustomZones, delta.GetCustomZonesOrder()); err != nil {
+		return nil, fmt.Errorf("custom zones: %w", err)
+	}
+
+	return config, nil
+}
+
+func remotePeerKey(peer *RemotePeerConfig) string {
+	return peer.GetWgPubKey()
+}
+
+func routeKey(route *Route) string {
+	return route.GetID()
+}
+
+func blocklistKey(blocklist *DNSBlocklist) string {
+	return blocklist.GetID()
+}
+
+// contentKey returns a key identifying a message by its content
+func contentKey[T gproto.Message](msg T) string {
+	b, err := gproto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		// marshaling of generated messages can't fail, fall back to the text representation just in case
+		return fmt.Sprint(msg)
+	}
+	return string(b)
+}
+
+func equalLists[T gproto.Message](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !gproto.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// diffByKey returns the target entries that are new or changed compared to base and the keys of the base entries missing in target
+func diffByKey[T gproto.Message](base, target []T, key func(T) string) ([]T, []string) {
+	baseEntries := make(map[string]T, len(base))
+	for _, entry := range base {
+		baseEntries[key(entry)] = entry
+	}
+
+	var upserted []T
+	targetKeys := make(map[string]struct{}, len(target))
+	for _, entry := range target {
+		targetKeys[key(entry)] = struct{}{}
+		if baseEntry, ok := baseEntries[key(entry)]; ok && gproto.Equal(baseEntry, entry) {
+			continue
+		}
+		upserted = append(upserted, entry)
+	}
+
+	var removed []string
+	for _, entry := range base {
+		if _, ok := targetKeys[key(entry)]; !ok {
+			removed = append(removed, key(entry))
+		}
+	}
+
+	return upserted, removed
+}
+
+// applyByKey replaces the base entries with the upserted ones of the same key, drops the removed keys and appends the new entries
+func applyByKey[T gproto.Message](base, upserted []T, removed []string, key func(T) string) []T {
+	removedKeys := make(map[string]struct{}, len(removed))
+	for _, k := range removed {
+		removedKeys[k] = struct{}{}
+	}
+
+	upsertedEntries := make(map[string]T, len(upserted))
+	for _, entry := range upserted {
+		upsertedEntries[key(entry)] = entry
+	}
+
+	result := make([]T, 0, len(base)+len(upserted))
+	for _, entry := range base {
+		k := key(entry)
+		if _, ok := removedKeys[k]; ok {
+			continue
+		}
+		if upsertedEntry, ok := upsertedEntries[k]; ok {
+			result = append(result, upsertedEntry)
+			delete(upsertedEntries, k)
+			continue
+		}
+		result = append(result, entry)
+	}
+
+	for _, entry := range upserted {
+		if _, ok := upsertedEntries[key(entry)]; ok {
+			result = append(result, entry)
+		}
+	}
+
+	return result
+}
+
+// diffByContent returns the entries added to and removed from base to get target. Duplicated entries are counted.
+func diffByContent[T gproto.Message](base, target []T) ([]T, []T) {
+	counts := make(map[string]int, len(base))
+	for _, entry := range base {
+		counts[contentKey(entry)]++
+	}
+
+	var added []T
+	for _, entry := range target {
+		k := contentKey(entry)
+		if counts[k] > 0 {
+			counts[k]--
+			continue
+		}
+		added = append(added, entry)
+	}
+
+	var removed []T
+	for _, entry := range base {
+		k := contentKey(entry)
+		if counts[k] > 0 {
+			counts[k]--
+			removed = append(removed, entry)
+		}
+	}
+
+	return added, removed
+}
+
+// applyByContent drops one base entry for every removed entry of the same content and appends the added entries
+func applyByContent[T gproto.Message](base, added, removed []T) []T {
+	counts := make(map[string]int, len(removed))
+	for _, entry := range removed {
+		counts[contentKey(entry)]++
+	}
+
+	result := make([]T, 0, len(base)+len(added))
+	for _, entry := range base {
+		k := contentKey(entry)
+		if counts[k] > 0 {
+			counts[k]--
+			continue
+		}
+		result = append(result, entry)
+	}
+
+	return append(result, added...)
+}
+
+// listOrder returns the positions in applied of the target entries matched by content, nil if applied already has the order of target
+func listOrder[T gproto.Message](applied, target []T) []uint32 {
+	return keyOrder(mapList(applied, contentKey[T]), target, contentKey[T])
+}
+
+// keyOrder returns the positions in applied of the keys of the target entries, nil if applied already has the order of target.
+// Duplicated keys are matched in order.
+func keyOrder[T any](applied []string, target []T, key func(T) string) []uint32 {
+	positions := make(map[string][]uint32, len(applied))
+	for i, k := range applied {
+		positions[k] = append(positions[k], uint32(i))
+	}
+
+	ordered := len(applied) == len(target)
+	order := make([]uint32, 0, len(target))
+	for i, entry := range target {
+		k := key(entry)
+		candidates := positions[k]
+		if len(candidates) == 0 {
+			// applied misses an entry of target, the order can't describe it
+			return nil
+		}
+		positions[k] = candidates[1:]
+		order = append(order, candidates[0])
+		ordered = ordered && candidates[0] == uint32(i)
+	}
+
+	if ordered {
+		return nil
+	}
+	return order
+}
+
+// reorder returns the entries in the given order, an empty order keeps the entries as they are
+func reorder[T any](entries []T, order []uint32) ([]T, error) {
+	if len(order) == 0 {
+		return entries, nil
+	}
+	if len(order) != len(entries) {
+		return nil, fmt.Errorf("order of %d entries doesn't match the %d entries", len(order), len(entries))
+	}
+
+	result := make([]T, len(entries))
+	used := make([]bool, len(entries))
+	for i, position := range order {
+		if int(position) >= len(entries) || used[position] {
+			return nil, fmt.Errorf("invalid position %d in order", position)
+		}
+		used[position] = true
+		result[i] = entries[position]
+	}
+	return result, nil
+}
+
+func mapList[T any](entries []T, f func(T) string) []string {
+	result := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, f(entry))
+	}
+	return result
+}
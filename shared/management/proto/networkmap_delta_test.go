@@ -0,0 +1,237 @@
+package proto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gproto "google.golang.org/protobuf/proto"
+)
+
+func testNetworkMap() *NetworkMap {
+	return &NetworkMap{
+		Serial:     1,
+		PeerConfig: &PeerConfig{Address: "100.64.0.1/16", Fqdn: "peer1.netbird.cloud"},
+		RemotePeers: []*RemotePeerConfig{
+			{WgPubKey: "peer2", AllowedIps: []string{"100.64.0.2/32"}, Fqdn: "peer2.netbird.cloud"},
+			{WgPubKey: "peer3", AllowedIps: []string{"100.64.0.3/32"}, Fqdn: "peer3.netbird.cloud"},
+		},
+		OfflinePeers: []*RemotePeerConfig{
+			{WgPubKey: "peer4", AllowedIps: []string{"100.64.0.4/32"}},
+		},
+		Routes: []*Route{
+			{ID: "route1", Network: "10.0.0.0/24", Peer: "peer2"},
+		},
+		DNSConfig: &DNSConfig{
+			ServiceEnable: true,
+			CustomZones: []*CustomZone{
+				{
+					Domain: "netbird.cloud.",
+					Records: []*SimpleRecord{
+						{Name: "peer2.netbird.cloud.", Type: 1, Class: "IN", TTL: 300, RData: "100.64.0.2"},
+						{Name: "peer3.netbird.cloud.", Type: 1, Class: "IN", TTL: 300, RData: "100.64.0.3"},
+					},
+				},
+			},
+		},
+		FirewallRules: []*FirewallRule{
+			{PeerIP: "100.64.0.2", Direction: RuleDirection_IN, Action: RuleAction_ACCEPT, Protocol: RuleProtocol_ALL},
+			{PeerIP: "100.64.0.3", Direction: RuleDirection_IN, Action: RuleAction_ACCEPT, Protocol: RuleProtocol_ALL},
+		},
+		RoutesFirewallRules: []*RouteFirewallRule{
+			{SourceRanges: []string{"100.64.0.1/32"}, Destination: "10.0.0.0/24", Protocol: RuleProtocol_ALL, RouteID: "route1"},
+		},
+		FirewallRulesIsEmpty: false,
+	}
+}
+
+func TestNetworkMapDelta(t *testing.T) {
+	base := testNetworkMap()
+
+	target := testNetworkMap()
+	target.Serial = 2
+	target.RemotePeers = []*RemotePeerConfig{
+		{WgPubKey: "peer2", AllowedIps: []string{"100.64.0.2/32"}, Fqdn: "peer2-renamed.netbird.cloud"},
+		{WgPubKey: "peer5", AllowedIps: []string{"100.64.0.5/32"}, Fqdn: "peer5.netbird.cloud"},
+	}
+	target.OfflinePeers = nil
+	target.Routes = append(target.Routes, &Route{ID: "route2", Network: "10.0.1.0/24", Peer: "peer5"})
+	target.DNSConfig.CustomZones[0].Records = []*SimpleRecord{
+		{Name: "peer2-renamed.netbird.cloud.", Type: 1, Class: "IN", TTL: 300, RData: "100.64.0.2"},
+		{Name: "peer5.netbird.cloud.", Type: 1, Class: "IN", TTL: 300, RData: "100.64.0.5"},
+	}
+	target.FirewallRules = []*FirewallRule{
+		{PeerIP: "100.64.0.2", Direction: RuleDirection_IN, Action: RuleAction_ACCEPT, Protocol: RuleProtocol_ALL},
+		{PeerIP: "100.64.0.5", Direction: RuleDirection_IN, Action: RuleAction_ACCEPT, Protocol: RuleProtocol_ALL},
+	}
+	target.ForwardingRules = []*ForwardingRule{
+		{Protocol: RuleProtocol_TCP, TranslatedAddress: []byte{100, 64, 0, 5}},
+	}
+	target.RemotePeersIsEmpty = false
+	target.RoutesFirewallRulesIsEmpty = false
+
+	delta := NewNetworkMapDelta(base, target)
+
+	assert.Equal(t, uint64(1), delta.GetBaseSerial())
+	assert.Equal(t, uint64(2), delta.GetSerial())
+	assert.Nil(t, delta.GetPeerConfig(), "unchanged peer config shouldn't be sent")
+	assert.Len(t, delta.GetUpsertedRemotePeers(), 2)
+	assert.Equal(t, []string{"peer3"}, delta.GetRemovedRemotePeers())
+	assert.Equal(t, []string{"peer4"}, delta.GetRemovedOfflinePeers())
+	assert.Len(t, delta.GetUpsertedRoutes(), 1)
+	assert.Len(t, delta.GetAddedFirewallRules(), 1)
+	assert.Len(t, delta.GetRemovedFirewallRules(), 1)
+	assert.Empty(t, delta.GetAddedRoutesFirewallRules())
+	assert.Empty(t, delta.GetRemovedRoutesFirewallRules())
+	require.NotNil(t, delta.GetDNSConfig())
+	assert.False(t, delta.GetDNSConfig().GetNameServerGroupsChanged())
+	require.Len(t, delta.GetDNSConfig().GetCustomZones(), 1)
+	assert.Len(t, delta.GetDNSConfig().GetCustomZones()[0].GetAddedRecords(), 2)
+	assert.Len(t, delta.GetDNSConfig().GetCustomZones()[0].GetRemovedRecords(), 2)
+	assert.True(t, delta.GetForwardingRulesChanged())
+
+	applied, err := ApplyNetworkMapDelta(base, delta)
+	require.NoError(t, err)
+	assert.True(t, gproto.Equal(target, applied), "applied delta should result in the target network map")
+}
+
+func TestNetworkMapDelta_Unchanged(t *testing.T) {
+	base := testNetworkMap()
+	target := testNetworkMap()
+	target.Serial = 2
+
+	delta := NewNetworkMapDelta(base, target)
+	assert.Empty(t, delta.GetUpsertedRemotePeers())
+	assert.Empty(t, delta.GetRemovedRemotePeers())
+	assert.Empty(t, delta.GetAddedFirewallRules())
+	assert.Nil(t, delta.GetDNSConfig())
+	assert.False(t, delta.GetForwardingRulesChanged())
+
+	applied, err := ApplyNetworkMapDelta(base, delta)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), applied.GetSerial())
+	assert.True(t, gproto.Equal(base.GetDNSConfig(), applied.GetDNSConfig()))
+	assert.Len(t, applied.GetRemotePeers(), 2)
+}
+
+func TestNetworkMapDelta_DNSZones(t *testing.T) {
+	base := testNetworkMap()
+	target := testNetworkMap()
+	target.Serial = 2
+	target.DNSConfig.CustomZones = []*CustomZone{
+		{
+			Domain:  "other.zone.",
+			Records: []*SimpleRecord{{Name: "a.other.zone.", Type: 1, Class: "IN", TTL: 300, RData: "10.0.0.1"}},
+		},
+	}
+
+	delta := NewNetworkMapDelta(base, target)
+	require.NotNil(t, delta.GetDNSConfig())
+	require.Len(t, delta.GetDNSConfig().GetCustomZones(), 2)
+
+	applied, err := ApplyNetworkMapDelta(base, delta)
+	require.NoError(t, err)
+	assert.True(t, gproto.Equal(target.GetDNSConfig(), applied.GetDNSConfig()))
+}
+
+func TestNetworkMapDelta_DNSBlocklists(t *testing.T) {
+	base := testNetworkMap()
+	base.DNSConfig.Blocklists = []*DNSBlocklist{
+		{ID: "ads", Name: "Ads", Domains: []string{"ads.example.com"}},
+		{ID: "malware", Name: "Malware", Sinkhole: true, Domains: []string{"malware.example.com"}},
+	}
+
+	target := testNetworkMap()
+	target.Serial = 2
+	target.DNSConfig.Blocklists = []*DNSBlocklist{
+		{ID: "ads", Name: "Ads", Domains: []string{"ads.example.com", "tracker.example.com"}},
+		{ID: "phishing", Name: "Phishing", Domains: []string{"phishing.example.com"}},
+	}
+
+	delta := NewNetworkMapDelta(base, target)
+	require.NotNil(t, delta.GetDNSConfig())
+	assert.Empty(t, delta.GetDNSConfig().GetCustomZones(), "unchanged zones shouldn't be sent")
+	require.Len(t, delta.GetDNSConfig().GetUpsertedBlocklists(), 2)
+	assert.Equal(t, []string{"malware"}, delta.GetDNSConfig().GetRemovedBlocklists())
+
+	applied, err := ApplyNetworkMapDelta(base, delta)
+	require.NoError(t, err)
+	assert.True(t, gproto.Equal(target.GetDNSConfig(), applied.GetDNSConfig()))
+}
+
+func TestNetworkMapDelta_KeepsOrder(t *testing.T) {
+	base := testNetworkMap()
+	base.Routes = append(base.Routes, &Route{ID: "route2", Network: "10.0.1.0/24", Peer: "peer3"})
+	base.DNSConfig.CustomZones = append(base.DNSConfig.CustomZones, &CustomZone{Domain: "other.zone."})
+	base.DNSConfig.Blocklists = []*DNSBlocklist{{ID: "ads", Name: "Ads"}, {ID: "malware", Name: "Malware"}}
+
+	drop := &FirewallRule{PeerIP: "100.64.0.5", Direction: RuleDirection_IN, Action: RuleAction_DROP, Protocol: RuleProtocol_ALL}
+
+	target := testNetworkMap()
+	target.Serial = 2
+	// a drop rule inserted before the accept rules has to stay in front of them
+	target.FirewallRules = []*FirewallRule{drop, base.FirewallRules[1], base.FirewallRules[0]}
+	target.RemotePeers = []*RemotePeerConfig{
+		{WgPubKey: "peer5", AllowedIps: []string{"100.64.0.5/32"}},
+		base.RemotePeers[1],
+		base.RemotePeers[0],
+	}
+	target.Routes = []*Route{
+		{ID: "route3", Network: "10.0.2.0/24", Peer: "peer2"},
+		base.Routes[1],
+		base.Routes[0],
+	}
+	target.DNSConfig.CustomZones = []*CustomZone{
+		{Domain: "new.zone."},
+		base.DNSConfig.CustomZones[1],
+		{
+			Domain: "netbird.cloud.",
+			Records: []*SimpleRecord{
+				{Name: "peer5.netbird.cloud.", Type: 1, Class: "IN", TTL: 300, RData: "100.64.0.5"},
+				base.DNSConfig.CustomZones[0].Records[1],
+				base.DNSConfig.CustomZones[0].Records[0],
+			},
+		},
+	}
+	target.DNSConfig.Blocklists = []*DNSBlocklist{{ID: "phishing", Name: "Phishing"}, base.DNSConfig.Blocklists[1], base.DNSConfig.Blocklists[0]}
+
+	delta := NewNetworkMapDelta(base, target)
+	assert.NotEmpty(t, delta.GetFirewallRulesOrder())
+	assert.NotEmpty(t, delta.GetRoutesOrder())
+
+	applied, err := ApplyNetworkMapDelta(base, delta)
+	require.NoError(t, err)
+	assert.True(t, gproto.Equal(target, applied), "applied delta should result in the order of the full network map")
+
+	// the order is sent only when applying the changes doesn't result in the order of the full network map
+	appended := testNetworkMap()
+	appended.Serial = 2
+	appended.FirewallRules = append(appended.FirewallRules, drop)
+	delta = NewNetworkMapDelta(base, appended)
+	assert.Empty(t, delta.GetFirewallRulesOrder())
+	assert.Empty(t, delta.GetRemotePeersOrder())
+}
+
+func TestApplyNetworkMapDelta_InvalidOrder(t *testing.T) {
+	base := testNetworkMap()
+
+	_, err := ApplyNetworkMapDelta(base, &NetworkMapDelta{BaseSerial: 1, Serial: 2, FirewallRulesOrder: []uint32{0}})
+	assert.Error(t, err, "order should cover every firewall rule")
+
+	_, err = ApplyNetworkMapDelta(base, &NetworkMapDelta{BaseSerial: 1, Serial: 2, FirewallRulesOrder: []uint32{1, 1}})
+	assert.Error(t, err, "order should be a permutation")
+
+	applied, err := ApplyNetworkMapDelta(base, &NetworkMapDelta{BaseSerial: 1, Serial: 2, FirewallRulesOrder: []uint32{1, 0}})
+	require.NoError(t, err)
+	assert.True(t, gproto.Equal(base.FirewallRules[1], applied.FirewallRules[0]))
+}
+
+func TestApplyNetworkMapDelta_BaseMismatch(t *testing.T) {
+	base := testNetworkMap()
+
+	_, err := ApplyNetworkMapDelta(base, &NetworkMapDelta{BaseSerial: 5, Serial: 6})
+	assert.Error(t, err)
+
+	_, err = ApplyNetworkMapDelta(nil, &NetworkMapDelta{BaseSerial: 1, Serial: 2})
+	assert.Error(t, err)
+}
@@ -192,6 +192,41 @@ func NewNetworkNotFoundError(networkID string) error {
 	return Errorf(NotFound, "network: %s not found", networkID)
 }
 
+// NewDNSZoneNotFoundError creates a new Error with NotFound type for a missing DNS zone.
+func NewDNSZoneNotFoundError(zoneID string) error {
+	return Errorf(NotFound, "DNS zone: %s not found", zoneID)
+}
+
+// NewDNSBlocklistNotFoundError creates a new Error with NotFound type for a missing DNS blocklist.
+func NewDNSBlocklistNotFoundError(blocklistID string) error {
+	return Errorf(NotFound, "DNS blocklist: %s not found", blocklistID)
+}
+
+// NewIngressPeerNotFoundError creates a new Error with NotFound type for a missing ingress peer.
+func NewIngressPeerNotFoundError(ingressPeerID string) error {
+	return Errorf(NotFound, "ingress peer: %s not found", ingressPeerID)
+}
+
+// NewIngressPortAllocationNotFoundError creates a new Error with NotFound type for a missing ingress port allocation.
+func NewIngressPortAllocationNotFoundError(allocationID string) error {
+	return Errorf(NotFound, "ingress port allocation: %s not found", allocationID)
+}
+
+// NewEventSinkNotFoundError creates a new Error with NotFound type for a missing event sink.
+func NewEventSinkNotFoundError(sinkID string) error {
+	return Errorf(NotFound, "event sink: %s not found", sinkID)
+}
+
+// NewCustomRoleNotFoundError creates a new Error with NotFound type for a missing custom role.
+func NewCustomRoleNotFoundError(roleID string) error {
+	return Errorf(NotFound, "role: %s not found", roleID)
+}
+
+// NewSCIMTokenNotFoundError creates a new Error with NotFound type for a missing SCIM token.
+func NewSCIMTokenNotFoundError(tokenID string) error {
+	return Errorf(NotFound, "SCIM token: %s not found", tokenID)
+}
+
 // NewNetworkRouterNotFoundError creates a new Error with NotFound type for a missing network router.
 func NewNetworkRouterNotFoundError(routerID string) error {
 	return Errorf(NotFound, "network router: %s not found", routerID)
@@ -29,9 +29,32 @@ func NewGenerator(algo AuthAlgo, secret []byte, timeToLive time.Duration) (*Gene
 }
 
 func (g *Generator) GenerateToken() (*Token, error) {
+	return g.GenerateAccountToken("")
+}
+
+// GenerateAccountToken generates a token that carries the account of the peer, so the relay can apply the account
+// limits. Relays that predate the account tokens reject them.
+func (g *Generator) GenerateAccountToken(accountID string) (*Token, error) {
+	return g.generate(accountID)
+}
+
+// GeneratePeerToken generates a token that carries the account and the public key of the peer it is issued for
+func (g *Generator) GeneratePeerToken(accountID, peerKey string) (*Token, error) {
+	if accountID == "" || peerKey == "" {
+		return nil, fmt.Errorf("account and peer key are required")
+	}
+	return g.generate(accountID, peerKey)
+}
+
+func (g *Generator) generate(claims ...string) (*Token, error) {
 	expirationTime := time.Now().Add(g.timeToLive).Unix()
 
 	payload := []byte(strconv.FormatInt(expirationTime, 10))
+	for _, claim := range claims {
+		if claim != "" {
+			payload = append(append(payload, payloadSeparator), claim...)
+		}
+	}
 
 	h := hmac.New(g.algo, g.secret)
 	h.Write(payload)
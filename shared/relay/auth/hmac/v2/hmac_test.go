@@ -108,3 +108,78 @@ func TestInvalidPayload(t *testing.T) {
 		t.Fatalf("expected invalid token due to invalid payload")
 	}
 }
+
+func TestValidateAccountCredentials(t *testing.T) {
+	secret := "supersecret"
+	g, err := NewGenerator(AuthAlgoHMACSHA256, []byte(secret), time.Hour)
+	if err != nil {
+		t.Fatalf("failed to create generator: %v", err)
+	}
+
+	token, err := g.GenerateAccountToken("account1")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	v := NewValidator([]byte(secret))
+	accountID, err := v.ValidateAccount(token.Marshal())
+	if err != nil {
+		t.Fatalf("expected valid token: %s", err)
+	}
+	if accountID != "account1" {
+		t.Fatalf("expected account1, got %q", accountID)
+	}
+
+	token, err = g.GenerateToken()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	accountID, err = v.ValidateAccount(token.Marshal())
+	if err != nil {
+		t.Fatalf("expected valid token: %s", err)
+	}
+	if accountID != "" {
+		t.Fatalf("expected no account, got %q", accountID)
+	}
+}
+
+func TestValidatePeerCredentials(t *testing.T) {
+	secret := "supersecret"
+	g, err := NewGenerator(AuthAlgoHMACSHA256, []byte(secret), time.Hour)
+	if err != nil {
+		t.Fatalf("failed to create generator: %v", err)
+	}
+
+	token, err := g.GeneratePeerToken("account1", "peerKey+/=")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	v := NewValidator([]byte(secret))
+	claims, err := v.ValidatePeer(token.Marshal())
+	if err != nil {
+		t.Fatalf("expected valid token: %s", err)
+	}
+	if claims.AccountID != "account1" || claims.PeerKey != "peerKey+/=" {
+		t.Fatalf("unexpected claims %+v", claims)
+	}
+	if time.Until(claims.ExpiresAt) <= 0 || time.Until(claims.ExpiresAt) > time.Hour {
+		t.Fatalf("unexpected expiration %s", claims.ExpiresAt)
+	}
+
+	accountID, err := v.ValidateAccount(token.Marshal())
+	if err != nil {
+		t.Fatalf("expected valid token: %s", err)
+	}
+	if accountID != "account1" {
+		t.Fatalf("expected account1, got %q", accountID)
+	}
+
+	token, err = g.GenerateAccountToken("account1")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err = v.ValidatePeer(token.Marshal()); err == nil {
+		t.Fatalf("expected tokens without peer to be rejected")
+	}
+}
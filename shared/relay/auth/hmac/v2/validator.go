@@ -5,10 +5,22 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 )
 
-const minLengthUnixTimestamp = 10
+const (
+	minLengthUnixTimestamp = 10
+	// payloadSeparator separates the expiration time, the optional account ID and peer key in the payload
+	payloadSeparator = ':'
+)
+
+// PeerClaims are carried by the tokens issued for a peer
+type PeerClaims struct {
+	AccountID string
+	PeerKey   string
+	ExpiresAt time.Time
+}
 
 type Validator struct {
 	secret []byte
@@ -19,23 +31,50 @@ func NewValidator(secret []byte) *Validator {
 }
 
 func (v *Validator) Validate(data any) error {
+	_, err := v.ValidateAccount(data)
+	return err
+}
+
+// ValidateAccount validates the token and returns the account ID it carries. The account ID is empty for the tokens
+// without account.
+func (v *Validator) ValidateAccount(data any) (string, error) {
+	claims, err := v.validate(data)
+	if err != nil {
+		return "", err
+	}
+	return claims.AccountID, nil
+}
+
+// ValidatePeer validates the token and returns its claims, the tokens without peer key are rejected
+func (v *Validator) ValidatePeer(data any) (*PeerClaims, error) {
+	claims, err := v.validate(data)
+	if err != nil {
+		return nil, err
+	}
+	if claims.AccountID == "" || claims.PeerKey == "" {
+		return nil, errors.New("token without peer")
+	}
+	return claims, nil
+}
+
+func (v *Validator) validate(data any) (*PeerClaims, error) {
 	d, ok := data.([]byte)
 	if !ok {
-		return fmt.Errorf("invalid data type")
+		return nil, fmt.Errorf("invalid data type")
 	}
 
 	token, err := UnmarshalToken(d)
 	if err != nil {
-		return fmt.Errorf("unmarshal token: %w", err)
+		return nil, fmt.Errorf("unmarshal token: %w", err)
 	}
 
 	if len(token.Payload) < minLengthUnixTimestamp {
-		return errors.New("invalid payload: insufficient length")
+		return nil, errors.New("invalid payload: insufficient length")
 	}
 
 	hashFunc := token.AuthAlgo.New()
 	if hashFunc == nil {
-		return fmt.Errorf("unsupported auth algorithm: %s", token.AuthAlgo)
+		return nil, fmt.Errorf("unsupported auth algorithm: %s", token.AuthAlgo)
 	}
 
 	h := hmac.New(hashFunc, v.secret)
@@ -43,17 +82,20 @@ func (v *Validator) Validate(data any) error {
 	expectedMAC := h.Sum(nil)
 
 	if !hmac.Equal(token.Signature, expectedMAC) {
-		return errors.New("invalid signature")
+		return nil, errors.New("invalid signature")
 	}
 
-	timestamp, err := strconv.ParseInt(string(token.Payload), 10, 64)
+	expiration, subject, _ := strings.Cut(string(token.Payload), string(payloadSeparator))
+	timestamp, err := strconv.ParseInt(expiration, 10, 64)
 	if err != nil {
-		return fmt.Errorf("invalid payload: %w", err)
+		return nil, fmt.Errorf("invalid payload: %w", err)
 	}
 
 	if time.Now().Unix() > timestamp {
-		return fmt.Errorf("expired token")
+		return nil, fmt.Errorf("expired token")
 	}
 
-	return nil
+	claims := &PeerClaims{ExpiresAt: time.Unix(timestamp, 0)}
+	claims.AccountID, claims.PeerKey, _ = strings.Cut(subject, string(payloadSeparator))
+	return claims, nil
 }
@@ -23,6 +23,11 @@ func (a *TimedHMACValidator) Validate(credentials any) error {
 	return a.authenticatorV2.Validate(credentials)
 }
 
+// ValidateAccount validates the credentials and returns the account of the peer if the credentials carry one
+func (a *TimedHMACValidator) ValidateAccount(credentials any) (string, error) {
+	return a.authenticatorV2.ValidateAccount(credentials)
+}
+
 func (a *TimedHMACValidator) ValidateHelloMsgType(credentials any) error {
 	return a.authenticator.Validate(credentials)
 }
@@ -4,15 +4,20 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	log "github.com/sirupsen/logrus"
 
 	"github.com/netbirdio/netbird/client/iface"
+	"github.com/netbirdio/netbird/client/net/proxy"
 	auth "github.com/netbirdio/netbird/shared/relay/auth/hmac"
 	"github.com/netbirdio/netbird/shared/relay/client/dialer"
+	dialernet "github.com/netbirdio/netbird/shared/relay/client/dialer/net"
 	"github.com/netbirdio/netbird/shared/relay/client/dialer/quic"
+	"github.com/netbirdio/netbird/shared/relay/client/dialer/tcp"
 	"github.com/netbirdio/netbird/shared/relay/client/dialer/ws"
 	"github.com/netbirdio/netbird/shared/relay/healthcheck"
 	"github.com/netbirdio/netbird/shared/relay/messages"
@@ -25,6 +30,8 @@ const (
 
 var (
 	ErrConnAlreadyExists = fmt.Errorf("connection already exists")
+	// ErrProbeNotSupported is returned by MeasureRTT when the server does not echo the health check probes
+	ErrProbeNotSupported = fmt.Errorf("health check probe is not supported by the relay server")
 )
 
 type internalStopFlag struct {
@@ -141,10 +148,15 @@ type Client struct {
 	muInstanceURL    sync.Mutex
 
 	onDisconnectListener func(string)
+	onLimitedListener    func(string, messages.LimitReason)
 	listenerMutex        sync.Mutex
 
 	stateSubscription *PeersStateSubscription
 
+	probeNonce atomic.Uint64
+	probes     map[uint64]chan struct{}
+	probesMu   sync.Mutex
+
 	mtu uint16
 }
 
@@ -165,7 +177,8 @@ func NewClient(serverURL string, authTokenStore *auth.TokenStore, peerID string,
 				return &buf
 			},
 		},
-		conns: make(map[messages.PeerID]*connContainer),
+		conns:  make(map[messages.PeerID]*connContainer),
+		probes: make(map[uint64]chan struct{}),
 	}
 
 	c.log.Infof("create new relay connection: local peerID: %s, local peer hashedID: %s", peerID, hashedID)
@@ -270,6 +283,41 @@ func (c *Client) ServerInstanceURL() (string, error) {
 	return c.instanceURL.String(), nil
 }
 
+// MeasureRTT measures the round trip time to the relay server with a health check probe that the server echoes back.
+// It returns ErrProbeNotSupported if the server does not answer before the context is done.
+func (c *Client) MeasureRTT(ctx context.Context) (time.Duration, error) {
+	c.mu.Lock()
+	if !c.serviceIsRunning {
+		c.mu.Unlock()
+		return 0, fmt.Errorf("relay connection is not established")
+	}
+	relayConn := c.relayConn
+	c.mu.Unlock()
+
+	nonce := c.probeNonce.Add(1)
+	echo := make(chan struct{})
+	c.probesMu.Lock()
+	c.probes[nonce] = echo
+	c.probesMu.Unlock()
+	defer func() {
+		c.probesMu.Lock()
+		delete(c.probes, nonce)
+		c.probesMu.Unlock()
+	}()
+
+	start := time.Now()
+	if _, err := relayConn.Write(messages.MarshalHealthcheckProbe(nonce)); err != nil {
+		return 0, fmt.Errorf("send health check probe: %w", err)
+	}
+
+	select {
+	case <-echo:
+		return time.Since(start), nil
+	case <-ctx.Done():
+		return 0, ErrProbeNotSupported
+	}
+}
+
 // SetOnDisconnectListener sets a function that will be called when the connection to the relay server is closed.
 func (c *Client) SetOnDisconnectListener(fn func(string)) {
 	c.listenerMutex.Lock()
@@ -277,6 +325,14 @@ func (c *Client) SetOnDisconnectListener(fn func(string)) {
 	c.onDisconnectListener = fn
 }
 
+// SetOnLimitedListener sets a function that will be called when the relay server drops the traffic of the peer because
+// of a rate limit or a quota.
+func (c *Client) SetOnLimitedListener(fn func(string, messages.LimitReason)) {
+	c.listenerMutex.Lock()
+	defer c.listenerMutex.Unlock()
+	c.onLimitedListener = fn
+}
+
 // HasConns returns true if there are connections.
 func (c *Client) HasConns() bool {
 	c.mu.Lock()
@@ -296,17 +352,8 @@ func (c *Client) Close() error {
 }
 
 func (c *Client) connect(ctx context.Context) (*RelayAddr, error) {
-	// Force WebSocket for MTUs larger than default to avoid QUIC DATAGRAM frame size issues
-	var dialers []dialer.DialeFn
-	if c.mtu > 0 && c.mtu > iface.DefaultMTU {
-		c.log.Infof("MTU %d exceeds default (%d), forcing WebSocket transport to avoid DATAGRAM frame size issues", c.mtu, iface.DefaultMTU)
-		dialers = []dialer.DialeFn{ws.Dialer{}}
-	} else {
-		dialers = []dialer.DialeFn{quic.Dialer{}, ws.Dialer{}}
-	}
-
-	rd := dialer.NewRaceDial(c.log, dialer.DefaultConnectionTimeout, c.connectionURL, dialers...)
-	conn, err := rd.Dial()
+	rd := dialer.NewRaceDial(c.log, dialer.DefaultConnectionTimeout, c.connectionURL, c.dialers()...)
+	conn, err := rd.DialContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -324,6 +371,28 @@ func (c *Client) connect(ctx context.Context) (*RelayAddr, error) {
 	return instanceURL, nil
 }
 
+// dialers returns the transports to race for the relay server
+func (c *Client) dialers() []dialer.DialeFn {
+	dialers := []dialer.DialeFn{ws.Dialer{}}
+	if strings.HasPrefix(c.connectionURL, "rels://") {
+		dialers = append(dialers, tcp.Dialer{})
+	}
+
+	// Force TCP transports for MTUs larger than default to avoid QUIC DATAGRAM frame size issues
+	if c.mtu > 0 && c.mtu > iface.DefaultMTU {
+		c.log.Infof("MTU %d exceeds default (%d), forcing TCP based transports to avoid DATAGRAM frame size issues", c.mtu, iface.DefaultMTU)
+		return dialers
+	}
+
+	// QUIC can't go through the HTTP proxy
+	if serverAddress, err := dialernet.ServerAddress(c.connectionURL); err == nil && proxy.Enabled(serverAddress) {
+		c.log.Infof("using proxy for the relay server, skipping QUIC transport")
+		return dialers
+	}
+
+	return append([]dialer.DialeFn{quic.Dialer{}}, dialers...)
+}
+
 func (c *Client) handShake(ctx context.Context) (*RelayAddr, error) {
 	msg, err := messages.MarshalAuthMsg(c.hashedID, c.authTokenStore.TokenBinary())
 	if err != nil {
@@ -419,7 +488,11 @@ func (c *Client) readLoop(hc *healthcheck.Receiver, relayConn net.Conn, internal
 func (c *Client) handleMsg(msgType messages.MsgType, buf []byte, bufPtr *[]byte, hc *healthcheck.Receiver, internallyStoppedFlag *internalStopFlag) (continueLoop bool) {
 	switch msgType {
 	case messages.MsgTypeHealthCheck:
-		c.handleHealthCheck(hc, internallyStoppedFlag)
+		if nonce, ok := messages.UnmarshalHealthcheckProbe(buf); ok {
+			c.handleHealthCheckProbe(nonce)
+		} else {
+			c.handleHealthCheck(hc, internallyStoppedFlag)
+		}
 		c.bufPool.Put(bufPtr)
 	case messages.MsgTypeTransport:
 		return c.handleTransportMsg(buf, bufPtr, internallyStoppedFlag)
@@ -431,6 +504,10 @@ func (c *Client) handleMsg(msgType messages.MsgType, buf []byte, bufPtr *[]byte,
 		c.handlePeersWentOfflineMsg(buf)
 		c.bufPool.Put(bufPtr)
 		return true
+	case messages.MsgTypeLimited:
+		c.handleLimitedMsg(buf)
+		c.bufPool.Put(bufPtr)
+		return true
 	case messages.MsgTypeClose:
 		c.log.Debugf("relay connection close by server")
 		c.bufPool.Put(bufPtr)
@@ -451,6 +528,19 @@ func (c *Client) handleHealthCheck(hc *healthcheck.Receiver, internallyStoppedFl
 	hc.Heartbeat()
 }
 
+// handleHealthCheckProbe completes the RTT measurement the echoed probe belongs to
+func (c *Client) handleHealthCheckProbe(nonce uint64) {
+	c.probesMu.Lock()
+	defer c.probesMu.Unlock()
+
+	echo, ok := c.probes[nonce]
+	if !ok {
+		return
+	}
+	close(echo)
+	delete(c.probes, nonce)
+}
+
 func (c *Client) handleTransportMsg(buf []byte, bufPtr *[]byte, internallyStoppedFlag *internalStopFlag) bool {
 	peerID, payload, err := messages.UnmarshalTransportMsg(buf)
 	if err != nil {
@@ -676,3 +766,19 @@ func (c *Client) handlePeersWentOfflineMsg(buf []byte) {
 	}
 	c.stateSubscription.OnPeersWentOffline(peersID)
 }
+
+func (c *Client) handleLimitedMsg(buf []byte) {
+	reason, err := messages.UnmarshalLimitedMsg(buf)
+	if err != nil {
+		c.log.Errorf("failed to unmarshal limited msg: %s", err)
+		return
+	}
+	c.log.Warnf("relay server drops the traffic of this peer: %s", reason)
+
+	c.listenerMutex.Lock()
+	defer c.listenerMutex.Unlock()
+	if c.onLimitedListener == nil {
+		return
+	}
+	go c.onLimitedListener(c.connectionURL, reason)
+}
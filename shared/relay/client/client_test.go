@@ -16,6 +16,9 @@ import (
 	"github.com/netbirdio/netbird/util"
 
 	"github.com/netbirdio/netbird/relay/server"
+	"github.com/netbirdio/netbird/relay/server/cluster"
+	"github.com/netbirdio/netbird/relay/server/limiter"
+	"github.com/netbirdio/netbird/shared/relay/messages"
 )
 
 var (
@@ -280,6 +283,117 @@ func TestEcho(t *testing.T) {
 	}
 }
 
+func TestClusterEcho(t *testing.T) {
+	ctx := context.Background()
+	idAlice := "alice"
+	idBob := "bob"
+
+	clusterAddr1 := "127.0.0.1:7235"
+	clusterAddr2 := "127.0.0.1:7236"
+	servers := []struct {
+		listenAddr  string
+		clusterAddr string
+		members     []string
+	}{
+		{"127.0.0.1:1235", clusterAddr1, []string{clusterAddr2}},
+		{"127.0.0.1:2235", clusterAddr2, []string{clusterAddr1}},
+	}
+
+	for _, s := range servers {
+		relayCluster, err := cluster.New(cluster.Config{
+			NodeID:        s.clusterAddr,
+			ListenAddress: s.clusterAddr,
+			Members:       s.members,
+			Secret:        "cluster-secret",
+		})
+		if err != nil {
+			t.Fatalf("failed to create cluster: %s", err)
+		}
+
+		srv, err := server.NewServer(server.Config{
+			Meter:          otel.Meter(""),
+			ExposedAddress: s.listenAddr,
+			TLSSupport:     false,
+			AuthValidator:  &allow.Auth{},
+			Cluster:        relayCluster,
+		})
+		if err != nil {
+			t.Fatalf("failed to create server: %s", err)
+		}
+		errChan := make(chan error, 1)
+		listenCfg := server.ListenerConfig{Address: s.listenAddr}
+		go func() {
+			if err := srv.Listen(listenCfg); err != nil {
+				errChan <- err
+			}
+		}()
+
+		defer func() {
+			if err := srv.Shutdown(ctx); err != nil {
+				t.Errorf("failed to close server: %s", err)
+			}
+		}()
+
+		if err := waitForServerToStart(errChan); err != nil {
+			t.Fatalf("failed to start server: %s", err)
+		}
+	}
+
+	clientAlice := NewClient("rel://"+servers[0].listenAddr, hmacTokenStore, idAlice, iface.DefaultMTU)
+	if err := clientAlice.Connect(ctx); err != nil {
+		t.Fatalf("failed to connect to server: %s", err)
+	}
+	defer func() {
+		if err := clientAlice.Close(); err != nil {
+			t.Errorf("failed to close Alice client: %s", err)
+		}
+	}()
+
+	clientBob := NewClient("rel://"+servers[1].listenAddr, hmacTokenStore, idBob, iface.DefaultMTU)
+	if err := clientBob.Connect(ctx); err != nil {
+		t.Fatalf("failed to connect to server: %s", err)
+	}
+	defer func() {
+		if err := clientBob.Close(); err != nil {
+			t.Errorf("failed to close Bob client: %s", err)
+		}
+	}()
+
+	connAliceToBob, err := clientAlice.OpenConn(ctx, idBob)
+	if err != nil {
+		t.Fatalf("failed to bind channel: %s", err)
+	}
+
+	connBobToAlice, err := clientBob.OpenConn(ctx, idAlice)
+	if err != nil {
+		t.Fatalf("failed to bind channel: %s", err)
+	}
+
+	payload := "hello bob, I am alice"
+	if _, err := connAliceToBob.Write([]byte(payload)); err != nil {
+		t.Fatalf("failed to write to channel: %s", err)
+	}
+
+	buf := make([]byte, 65535)
+	n, err := connBobToAlice.Read(buf)
+	if err != nil {
+		t.Fatalf("failed to read from channel: %s", err)
+	}
+
+	if _, err := connBobToAlice.Write(buf[:n]); err != nil {
+		t.Fatalf("failed to write to channel: %s", err)
+	}
+
+	n, err = connAliceToBob.Read(buf)
+	if err != nil {
+		t.Fatalf("failed to read from channel: %s", err)
+	}
+
+	if payload != string(buf[:n]) {
+		t.Fatalf("expected %s, got %s", payload, string(buf[:n]))
+	}
+}
+
 func TestBindToUnavailabePeer(t *testing.T) {
 	ctx := context.Background()
 
@@ -731,6 +845,68 @@ func TestCloseNotDrainedChannel(t *testing.T) {
 	}
 }
 
+func TestLimited(t *testing.T) {
+	ctx := context.Background()
+
+	cfg := serverCfg
+	cfg.Limits = limiter.Config{PeerMonthlyQuota: 100}
+	srv, err := server.NewServer(cfg)
+	if err != nil {
+		t.Fatalf("failed to create server: %s", err)
+	}
+	errChan := make(chan error, 1)
+	go func() {
+		if err := srv.Listen(server.ListenerConfig{Address: serverListenAddr}); err != nil {
+			errChan <- err
+		}
+	}()
+
+	defer func() {
+		if err := srv.Shutdown(ctx); err != nil {
+			t.Errorf("failed to close server: %s", err)
+		}
+	}()
+
+	if err := waitForServerToStart(errChan); err != nil {
+		t.Fatalf("failed to start server: %s", err)
+	}
+
+	clientAlice := NewClient(serverURL, hmacTokenStore, "alice", iface.DefaultMTU)
+	if err := clientAlice.Connect(ctx); err != nil {
+		t.Fatalf("failed to connect to server: %s", err)
+	}
+	defer clientAlice.Close()
+
+	limited := make(chan messages.LimitReason, 1)
+	clientAlice.SetOnLimitedListener(func(_ string, reason messages.LimitReason) {
+		limited <- reason
+	})
+
+	clientBob := NewClient(serverURL, hmacTokenStore, "bob", iface.DefaultMTU)
+	if err := clientBob.Connect(ctx); err != nil {
+		t.Fatalf("failed to connect to server: %s", err)
+	}
+	defer clientBob.Close()
+
+	connAliceToBob, err := clientAlice.OpenConn(ctx, "bob")
+	if err != nil {
+		t.Fatalf("failed to bind channel: %s", err)
+	}
+
+	if _, err := connAliceToBob.Write(make([]byte, 200)); err != nil {
+		t.Fatalf("failed to write to channel: %s", err)
+	}
+
+	select {
+	case reason := <-limited:
+		if reason != messages.LimitReasonQuotaExceeded {
+			t.Fatalf("expected %s, got %s", messages.LimitReasonQuotaExceeded, reason)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("limited message not received")
+	}
+}
+
 func waitForServerToStart(errChan chan error) error {
 	select {
 	case err := <-errChan:
@@ -742,3 +918,44 @@ func waitForServerToStart(errChan chan error) error {
 	}
 	return nil
 }
+
+func TestMeasureRTT(t *testing.T) {
+	ctx := context.Background()
+
+	srv, err := server.NewServer(serverCfg)
+	if err != nil {
+		t.Fatalf("failed to create server: %s", err)
+	}
+	errChan := make(chan error, 1)
+	go func() {
+		if err := srv.Listen(server.ListenerConfig{Address: serverListenAddr}); err != nil {
+			errChan <- err
+		}
+	}()
+
+	defer func() {
+		if err := srv.Shutdown(ctx); err != nil {
+			t.Errorf("failed to close server: %s", err)
+		}
+	}()
+
+	if err := waitForServerToStart(errChan); err != nil {
+		t.Fatalf("failed to start server: %s", err)
+	}
+
+	clientAlice := NewClient(serverURL, hmacTokenStore, "alice", iface.DefaultMTU)
+	if err := clientAlice.Connect(ctx); err != nil {
+		t.Fatalf("failed to connect to server: %s", err)
+	}
+	defer clientAlice.Close()
+
+	probeCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	rtt, err := clientAlice.MeasureRTT(probeCtx)
+	if err != nil {
+		t.Fatalf("failed to measure rtt: %s", err)
+	}
+	if rtt <= 0 {
+		t.Errorf("unexpected rtt: %s", rtt)
+	}
+}
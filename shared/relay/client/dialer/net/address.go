@@ -0,0 +1,30 @@
+package net
+
+import (
+	"fmt"
+	"net"
+	"strings"
+)
+
+// ServerAddress returns the host:port address of the relay server URL, the port defaults to the port of the scheme
+func ServerAddress(serverURL string) (string, error) {
+	var host, defaultPort string
+	switch {
+	case strings.HasPrefix(serverURL, "rels://"):
+		host = strings.TrimPrefix(serverURL, "rels://")
+		defaultPort = "443"
+	case strings.HasPrefix(serverURL, "rel://"):
+		host = strings.TrimPrefix(serverURL, "rel://")
+		defaultPort = "80"
+	default:
+		return "", fmt.Errorf("unsupported scheme: %s", serverURL)
+	}
+
+	if _, _, err := net.SplitHostPort(host); err != nil {
+		if strings.Contains(err.Error(), "missing port") {
+			return net.JoinHostPort(host, defaultPort), nil
+		}
+		return "", err
+	}
+	return host, nil
+}
@@ -41,9 +41,14 @@ func NewRaceDial(log *log.Entry, connectionTimeout time.Duration, serverURL stri
 }
 
 func (r *RaceDial) Dial() (net.Conn, error) {
+	return r.DialContext(context.Background())
+}
+
+// DialContext races the dialers until the first connection is established, the connection timeout or the context ends
+func (r *RaceDial) DialContext(ctx context.Context) (net.Conn, error) {
 	connChan := make(chan dialResult, len(r.dialerFns))
 	winnerConn := make(chan net.Conn, 1)
-	abortCtx, abort := context.WithCancel(context.Background())
+	abortCtx, abort := context.WithCancel(ctx)
 	defer abort()
 
 	for _, dfn := range r.dialerFns {
@@ -0,0 +1,61 @@
+// Package tcp dials the raw TLS transport of the relay server. It is an alternative to WebSocket where the upgrade is
+// mangled by middleboxes, and like WebSocket it can go through an HTTP CONNECT proxy.
+package tcp
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"strings"
+
+	nbnet "github.com/netbirdio/netbird/client/net"
+	"github.com/netbirdio/netbird/client/net/proxy"
+	dialernet "github.com/netbirdio/netbird/shared/relay/client/dialer/net"
+	"github.com/netbirdio/netbird/shared/relay/stream"
+	nbtls "github.com/netbirdio/netbird/shared/relay/tls"
+)
+
+const Network = "tcp"
+
+type Dialer struct {
+}
+
+func (d Dialer) Protocol() string {
+	return "TCP"
+}
+
+func (d Dialer) Dial(ctx context.Context, address string) (net.Conn, error) {
+	if !strings.HasPrefix(address, "rels://") {
+		return nil, fmt.Errorf("raw transport requires TLS: %s", address)
+	}
+
+	serverAddress, err := dialernet.ServerAddress(address)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := proxy.NewDialer(nbnet.NewDialer()).DialContext(ctx, Network, serverAddress)
+	if err != nil {
+		return nil, fmt.Errorf("dial %s: %w", serverAddress, err)
+	}
+
+	tlsConfig := nbtls.ClientTCPTLSConfig()
+	// Set ServerName to hostname if not an IP address
+	if host, _, err := net.SplitHostPort(serverAddress); err == nil && net.ParseIP(host) == nil {
+		tlsConfig.ServerName = host
+	}
+
+	tlsConn := tls.Client(conn, tlsConfig)
+	if err := tlsConn.HandshakeContext(ctx); err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("tls handshake with %s: %w", serverAddress, err)
+	}
+
+	if tlsConn.ConnectionState().NegotiatedProtocol != nbtls.NBTCPalpn {
+		_ = tlsConn.Close()
+		return nil, fmt.Errorf("relay server %s doesn't support the raw transport", serverAddress)
+	}
+
+	return stream.NewConn(tlsConn), nil
+}
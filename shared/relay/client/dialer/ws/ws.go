@@ -17,6 +17,7 @@ import (
 	"github.com/netbirdio/netbird/shared/relay"
 	"github.com/netbirdio/netbird/util/embeddedroots"
 	nbnet "github.com/netbirdio/netbird/client/net"
+	"github.com/netbirdio/netbird/client/net/proxy"
 )
 
 type Dialer struct {
@@ -67,7 +68,8 @@ func prepareURL(address string) (string, error) {
 }
 
 func httpClientNbDialer() *http.Client {
-	customDialer := nbnet.NewDialer()
+	// the connection is tunneled through the proxy with CONNECT for the plain WebSocket too
+	customDialer := proxy.NewDialer(nbnet.NewDialer())
 
 	certPool, err := x509.SystemCertPool()
 	if err != nil || certPool == nil {
@@ -12,6 +12,7 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	relayAuth "github.com/netbirdio/netbird/shared/relay/auth/hmac"
+	"github.com/netbirdio/netbird/shared/relay/messages"
 )
 
 var (
@@ -60,8 +61,14 @@ type Manager struct {
 	relayClients      map[string]*RelayTrack
 	relayClientsMutex sync.RWMutex
 
+	// servers are the relay servers with the placement metadata, probes are their latest measurements
+	servers  []Server
+	probes   map[string]probeResult
+	probesMu sync.Mutex
+
 	onDisconnectedListeners map[string]*list.List
 	onReconnectedListenerFn func()
+	onLimitedListenerFn     func()
 	listenerLock            sync.Mutex
 
 	mtu uint16
@@ -86,7 +93,7 @@ func NewManager(ctx context.Context, serverURLs []string, peerID string, mtu uin
 		relayClients:            make(map[string]*RelayTrack),
 		onDisconnectedListeners: make(map[string]*list.List),
 	}
-	m.serverPicker.ServerURLs.Store(serverURLs)
+	m.UpdateServerURLs(serverURLs)
 	m.reconnectGuard = NewGuard(m.serverPicker)
 	return m
 }
@@ -165,6 +172,15 @@ func (m *Manager) SetOnReconnectedListener(f func()) {
 	m.onReconnectedListenerFn = f
 }
 
+// SetOnLimitedListener sets a function that will be called when a relay server drops the traffic of the peer because
+// of a rate limit or a quota. The peer connections should prefer P2P while the relay limits the traffic.
+func (m *Manager) SetOnLimitedListener(f func()) {
+	m.listenerLock.Lock()
+	defer m.listenerLock.Unlock()
+
+	m.onLimitedListenerFn = f
+}
+
 // AddCloseListener adds a listener to the given server instance address. The listener will be called if the connection
 // closed.
 func (m *Manager) AddCloseListener(serverAddress string, onClosedListener OnServerCloseListener) error {
@@ -213,9 +229,13 @@ func (m *Manager) HasRelayAddress() bool {
 	return len(m.serverPicker.ServerURLs.Load().([]string)) > 0
 }
 
+// UpdateServerURLs updates the relay servers without placement metadata
 func (m *Manager) UpdateServerURLs(serverURLs []string) {
-	log.Infof("update relay server URLs: %v", serverURLs)
-	m.serverPicker.ServerURLs.Store(serverURLs)
+	servers := make([]Server, 0, len(serverURLs))
+	for _, url := range serverURLs {
+		servers = append(servers, Server{URL: url})
+	}
+	m.UpdateServers(servers)
 }
 
 // UpdateToken updates the token in the token store.
@@ -270,6 +290,7 @@ func (m *Manager) openConnVia(ctx context.Context, serverAddress, peerKey string
 	}
 	// if connection closed then delete the relay client from the list
 	relayClient.SetOnDisconnectListener(m.onServerDisconnected)
+	relayClient.SetOnLimitedListener(m.onServerLimited)
 	rt.relayClient = relayClient
 	rt.Unlock()
 
@@ -290,6 +311,18 @@ func (m *Manager) onServerConnected() {
 	go m.onReconnectedListenerFn()
 }
 
+func (m *Manager) onServerLimited(serverAddress string, reason messages.LimitReason) {
+	log.Infof("relay server %s limits the traffic of this peer: %s", serverAddress, reason)
+
+	m.listenerLock.Lock()
+	defer m.listenerLock.Unlock()
+
+	if m.onLimitedListenerFn == nil {
+		return
+	}
+	go m.onLimitedListenerFn()
+}
+
 // onServerDisconnected start to reconnection for home server only
 func (m *Manager) onServerDisconnected(serverAddress string) {
 	m.relayClientMu.Lock()
@@ -323,6 +356,7 @@ func (m *Manager) storeClient(client *Client) {
 
 	m.relayClient = client
 	m.relayClient.SetOnDisconnectListener(m.onServerDisconnected)
+	m.relayClient.SetOnLimitedListener(m.onServerLimited)
 }
 
 func (m *Manager) isForeignServer(address string) (bool, error) {
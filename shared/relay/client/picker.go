@@ -24,8 +24,11 @@ type connResult struct {
 }
 
 type ServerPicker struct {
-	TokenStore        *auth.TokenStore
-	ServerURLs        atomic.Value
+	TokenStore *auth.TokenStore
+	ServerURLs atomic.Value
+	// Ranking is the order of preference of the server URLs by their measured latency. When it is set, the best ranked
+	// server that accepts the connection is picked instead of the first one that connects.
+	Ranking           atomic.Value
 	PeerID            string
 	MTU               uint16
 	ConnectionTimeout time.Duration
@@ -53,7 +56,7 @@ func (sp *ServerPicker) PickServer(parentCtx context.Context) (*Client, error) {
 		}(url)
 	}
 
-	go sp.processConnResults(connResultChan, successChan)
+	go sp.processConnResults(connResultChan, successChan, sp.ranking())
 
 	select {
 	case cr, ok := <-successChan:
@@ -78,26 +81,79 @@ func (sp *ServerPicker) startConnection(ctx context.Context, resultChan chan con
 	}
 }
 
-func (sp *ServerPicker) processConnResults(resultChan chan connResult, successChan chan connResult) {
+func (sp *ServerPicker) processConnResults(resultChan chan connResult, successChan chan connResult, ranking []string) {
 	var hasSuccess bool
+	// the successful connections that wait for the results of the better ranked servers
+	pending := make(map[string]connResult)
+	failed := make(map[string]bool)
 	for numOfResults := 0; numOfResults < cap(resultChan); numOfResults++ {
 		cr := <-resultChan
 		if cr.Err != nil {
 			log.Tracef("failed to connect to Relay server: %s: %v", cr.Url, cr.Err)
-			continue
+			failed[cr.Url] = true
+		} else {
+			log.Infof("connected to Relay server: %s", cr.Url)
+			pending[cr.Url] = cr
 		}
-		log.Infof("connected to Relay server: %s", cr.Url)
 
 		if hasSuccess {
-			log.Infof("closing unnecessary Relay connection to: %s", cr.Url)
-			if err := cr.RelayClient.Close(); err != nil {
-				log.Errorf("failed to close connection to %s: %v", cr.Url, err)
-			}
+			sp.closeUnnecessary(pending)
 			continue
 		}
 
+		chosen, ok := chooseRanked(ranking, pending, failed)
+		if !ok {
+			continue
+		}
+		delete(pending, chosen.Url)
 		hasSuccess = true
-		successChan <- cr
+		successChan <- chosen
+		sp.closeUnnecessary(pending)
 	}
+
+	// the ranked servers have all failed, fall back to any successful connection
+	if !hasSuccess {
+		for _, cr := range pending {
+			delete(pending, cr.Url)
+			successChan <- cr
+			break
+		}
+	}
+	sp.closeUnnecessary(pending)
 	close(successChan)
 }
+
+func (sp *ServerPicker) ranking() []string {
+	ranking, _ := sp.Ranking.Load().([]string)
+	return ranking
+}
+
+// chooseRanked returns the successful connection of the best ranked server. It waits for the result of a better
+// ranked server before accepting a worse one. Without ranking the first successful connection is chosen.
+func chooseRanked(ranking []string, pending map[string]connResult, failed map[string]bool) (connResult, bool) {
+	if len(ranking) == 0 {
+		for _, cr := range pending {
+			return cr, true
+		}
+		return connResult{}, false
+	}
+
+	for _, url := range ranking {
+		if failed[url] {
+			continue
+		}
+		cr, ok := pending[url]
+		return cr, ok
+	}
+	return connResult{}, false
+}
+
+func (sp *ServerPicker) closeUnnecessary(pending map[string]connResult) {
+	for url, cr := range pending {
+		log.Infof("closing unnecessary Relay connection to: %s", url)
+		if err := cr.RelayClient.Close(); err != nil {
+			log.Errorf("failed to close connection to %s: %v", url, err)
+		}
+		delete(pending, url)
+	}
+}
@@ -0,0 +1,229 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultServerWeight is the weight of the relay servers without placement metadata
+	DefaultServerWeight = 100
+
+	probeTimeout = 5 * time.Second
+	// probePeerIDSuffix makes the probe connections independent of the permanent connections of the peer
+	probePeerIDSuffix = "/probe"
+)
+
+// Server is a relay server with the placement metadata attached by management
+type Server struct {
+	URL    string
+	Region string
+	// Weight is the preference of the server relative to DefaultServerWeight. The measured latency is divided by the
+	// weight, so a server with a higher weight is chosen over the ones with a similar latency.
+	Weight uint32
+}
+
+// ServerLatency is the measured round trip time to a relay server
+type ServerLatency struct {
+	URL string
+	RTT time.Duration
+}
+
+// probeResult is the latest measurement of a relay server
+type probeResult struct {
+	rtt time.Duration
+	// address is the instance address the server reported in the probe, the peers use it to meet on the server
+	address string
+}
+
+// weightedLatency returns the latency scaled by the preference of the server
+func weightedLatency(rtt time.Duration, weight uint32) time.Duration {
+	if weight == 0 {
+		weight = DefaultServerWeight
+	}
+	return rtt * DefaultServerWeight / time.Duration(weight)
+}
+
+func serverWeights(servers []Server) map[string]uint32 {
+	weights := make(map[string]uint32, len(servers))
+	for _, s := range servers {
+		weights[s.URL] = s.Weight
+	}
+	return weights
+}
+
+// PreferredServer returns the URL of the relay server that minimizes the combined latency of two peers. Only the
+// servers that both peers could measure are considered. Ties are broken by the URL, so both peers choose the same
+// server from the same measurements.
+func PreferredServer(servers []Server, local, remote []ServerLatency) (string, bool) {
+	weights := serverWeights(servers)
+
+	remoteRTTs := make(map[string]time.Duration, len(remote))
+	for _, l := range remote {
+		remoteRTTs[l.URL] = l.RTT
+	}
+
+	var (
+		best      string
+		bestScore time.Duration
+	)
+	for _, l := range local {
+		remoteRTT, ok := remoteRTTs[l.URL]
+		if !ok {
+			continue
+		}
+		weight, ok := weights[l.URL]
+		if !ok {
+			continue
+		}
+
+		score := weightedLatency(l.RTT+remoteRTT, weight)
+		if best == "" || score < bestScore || (score == bestScore && l.URL < best) {
+			best, bestScore = l.URL, score
+		}
+	}
+	return best, best != ""
+}
+
+// rankServers orders the server URLs by their weighted latency. The servers without a measurement keep their order
+// after the measured ones.
+func rankServers(servers []Server, latencies map[string]probeResult) []string {
+	ranked := make([]Server, 0, len(servers))
+	for _, s := range servers {
+		if _, ok := latencies[s.URL]; ok {
+			ranked = append(ranked, s)
+		}
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return weightedLatency(latencies[ranked[i].URL].rtt, ranked[i].Weight) <
+			weightedLatency(latencies[ranked[j].URL].rtt, ranked[j].Weight)
+	})
+
+	urls := make([]string, 0, len(servers))
+	for _, s := range ranked {
+		urls = append(urls, s.URL)
+	}
+	for _, s := range servers {
+		if !slices.Contains(urls, s.URL) {
+			urls = append(urls, s.URL)
+		}
+	}
+	return urls
+}
+
+// UpdateServers updates the relay servers with their placement metadata
+func (m *Manager) UpdateServers(servers []Server) {
+	urls := make([]string, 0, len(servers))
+	for _, s := range servers {
+		urls = append(urls, s.URL)
+	}
+	log.Infof("update relay server URLs: %v", urls)
+
+	m.probesMu.Lock()
+	defer m.probesMu.Unlock()
+
+	m.servers = slices.Clone(servers)
+	m.serverPicker.ServerURLs.Store(urls)
+	m.serverPicker.Ranking.Store(rankServers(m.servers, m.probes))
+}
+
+// ProbeServers measures the round trip time to every relay server with a health check probe and returns the
+// latencies of the reachable ones. The home server is picked by these measurements when it is chosen again.
+func (m *Manager) ProbeServers(ctx context.Context) []ServerLatency {
+	m.probesMu.Lock()
+	servers := slices.Clone(m.servers)
+	m.probesMu.Unlock()
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		results = make(map[string]probeResult, len(servers))
+	)
+	for _, s := range servers {
+		wg.Add(1)
+		go func(url string) {
+			defer wg.Done()
+			result, err := m.probeServer(ctx, url)
+			if err != nil {
+				log.Debugf("failed to probe relay server %s: %v", url, err)
+				return
+			}
+			mu.Lock()
+			results[url] = result
+			mu.Unlock()
+		}(s.URL)
+	}
+	wg.Wait()
+
+	m.probesMu.Lock()
+	m.probes = results
+	m.serverPicker.Ranking.Store(rankServers(m.servers, m.probes))
+	m.probesMu.Unlock()
+
+	latencies := make([]ServerLatency, 0, len(results))
+	for url, result := range results {
+		latencies = append(latencies, ServerLatency{URL: url, RTT: result.rtt})
+	}
+	sort.Slice(latencies, func(i, j int) bool {
+		return latencies[i].URL < latencies[j].URL
+	})
+	return latencies
+}
+
+// PreferredServerAddress returns the instance address of the relay server that minimizes the combined latency with
+// the remote peer, based on the latest probes of both peers.
+func (m *Manager) PreferredServerAddress(remote []ServerLatency) (string, bool) {
+	if len(remote) == 0 {
+		return "", false
+	}
+
+	m.probesMu.Lock()
+	defer m.probesMu.Unlock()
+
+	local := make([]ServerLatency, 0, len(m.probes))
+	for url, result := range m.probes {
+		local = append(local, ServerLatency{URL: url, RTT: result.rtt})
+	}
+
+	url, ok := PreferredServer(m.servers, local, remote)
+	if !ok {
+		return "", false
+	}
+	return m.probes[url].address, true
+}
+
+func (m *Manager) probeServer(ctx context.Context, url string) (probeResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, probeTimeout)
+	defer cancel()
+
+	// the connection is bound to the probe context, so an unreachable server can't stall the probe round and the
+	// connection is closed when the probe returns
+	relayClient := NewClient(url, m.tokenStore, m.peerID+probePeerIDSuffix, m.mtu)
+	start := time.Now()
+	if err := relayClient.Connect(ctx); err != nil {
+		return probeResult{}, err
+	}
+	connectTime := time.Since(start)
+
+	address, err := relayClient.ServerInstanceURL()
+	if err != nil {
+		return probeResult{}, err
+	}
+
+	rtt, err := relayClient.MeasureRTT(ctx)
+	if errors.Is(err, ErrProbeNotSupported) {
+		// the older servers don't echo the probes, the connection setup takes a few round trips but is comparable
+		log.Debugf("relay server %s doesn't support health check probes, using the connection time", url)
+		rtt = connectTime
+	} else if err != nil {
+		return probeResult{}, err
+	}
+
+	return probeResult{rtt: rtt, address: address}, nil
+}
@@ -0,0 +1,162 @@
+package client
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPreferredServer(t *testing.T) {
+	servers := []Server{
+		{URL: "rels://fra.relay", Region: "eu"},
+		{URL: "rels://sgp.relay", Region: "ap"},
+		{URL: "rels://nyc.relay", Region: "us"},
+	}
+
+	tests := []struct {
+		name     string
+		servers  []Server
+		local    []ServerLatency
+		remote   []ServerLatency
+		expected string
+	}{
+		{
+			name:    "combined latency",
+			servers: servers,
+			local: []ServerLatency{
+				{URL: "rels://fra.relay", RTT: 180 * time.Millisecond},
+				{URL: "rels://sgp.relay", RTT: 5 * time.Millisecond},
+				{URL: "rels://nyc.relay", RTT: 220 * time.Millisecond},
+			},
+			remote: []ServerLatency{
+				{URL: "rels://fra.relay", RTT: 10 * time.Millisecond},
+				{URL: "rels://sgp.relay", RTT: 170 * time.Millisecond},
+				{URL: "rels://nyc.relay", RTT: 90 * time.Millisecond},
+			},
+			expected: "rels://sgp.relay",
+		},
+		{
+			name:    "only servers measured by both peers",
+			servers: servers,
+			local: []ServerLatency{
+				{URL: "rels://sgp.relay", RTT: 5 * time.Millisecond},
+				{URL: "rels://nyc.relay", RTT: 220 * time.Millisecond},
+			},
+			remote: []ServerLatency{
+				{URL: "rels://fra.relay", RTT: 10 * time.Millisecond},
+				{URL: "rels://nyc.relay", RTT: 90 * time.Millisecond},
+			},
+			expected: "rels://nyc.relay",
+		},
+		{
+			name: "weight",
+			servers: []Server{
+				{URL: "rels://fra.relay", Weight: 200},
+				{URL: "rels://sgp.relay"},
+			},
+			local: []ServerLatency{
+				{URL: "rels://fra.relay", RTT: 30 * time.Millisecond},
+				{URL: "rels://sgp.relay", RTT: 20 * time.Millisecond},
+			},
+			remote: []ServerLatency{
+				{URL: "rels://fra.relay", RTT: 30 * time.Millisecond},
+				{URL: "rels://sgp.relay", RTT: 20 * time.Millisecond},
+			},
+			expected: "rels://fra.relay",
+		},
+		{
+			name:    "tie",
+			servers: servers,
+			local: []ServerLatency{
+				{URL: "rels://sgp.relay", RTT: 10 * time.Millisecond},
+				{URL: "rels://fra.relay", RTT: 10 * time.Millisecond},
+			},
+			remote: []ServerLatency{
+				{URL: "rels://fra.relay", RTT: 10 * time.Millisecond},
+				{URL: "rels://sgp.relay", RTT: 10 * time.Millisecond},
+			},
+			expected: "rels://fra.relay",
+		},
+		{
+			name:    "no common server",
+			servers: servers,
+			local:   []ServerLatency{{URL: "rels://sgp.relay", RTT: 5 * time.Millisecond}},
+			remote:  []ServerLatency{{URL: "rels://fra.relay", RTT: 5 * time.Millisecond}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			url, ok := PreferredServer(tc.servers, tc.local, tc.remote)
+			assert.Equal(t, tc.expected != "", ok)
+			assert.Equal(t, tc.expected, url)
+
+			// the remote peer chooses the same server
+			url, _ = PreferredServer(tc.servers, tc.remote, tc.local)
+			assert.Equal(t, tc.expected, url)
+		})
+	}
+}
+
+func TestRankServers(t *testing.T) {
+	servers := []Server{
+		{URL: "rels://fra.relay"},
+		{URL: "rels://sgp.relay"},
+		{URL: "rels://nyc.relay", Weight: 50},
+		{URL: "rels://syd.relay"},
+	}
+	latencies := map[string]probeResult{
+		"rels://fra.relay": {rtt: 180 * time.Millisecond},
+		"rels://sgp.relay": {rtt: 5 * time.Millisecond},
+		"rels://nyc.relay": {rtt: 60 * time.Millisecond},
+	}
+
+	assert.Equal(t, []string{"rels://sgp.relay", "rels://nyc.relay", "rels://fra.relay", "rels://syd.relay"}, rankServers(servers, latencies))
+	assert.Equal(t, []string{"rels://fra.relay", "rels://sgp.relay", "rels://nyc.relay", "rels://syd.relay"}, rankServers(servers, nil))
+}
+
+func TestChooseRanked(t *testing.T) {
+	ranking := []string{"rels://sgp.relay", "rels://fra.relay"}
+	fra := connResult{Url: "rels://fra.relay"}
+
+	_, ok := chooseRanked(ranking, map[string]connResult{fra.Url: fra}, map[string]bool{})
+	assert.False(t, ok, "the worse ranked server must wait for the better ranked one")
+
+	chosen, ok := chooseRanked(ranking, map[string]connResult{fra.Url: fra}, map[string]bool{"rels://sgp.relay": true})
+	assert.True(t, ok)
+	assert.Equal(t, fra.Url, chosen.Url)
+
+	chosen, ok = chooseRanked(nil, map[string]connResult{fra.Url: fra}, map[string]bool{})
+	assert.True(t, ok)
+	assert.Equal(t, fra.Url, chosen.Url)
+}
+
+func TestManager_ProbeServerHonorsProbeContext(t *testing.T) {
+	// the listener accepts the connections but never answers, so the connection setup only ends with the context
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+		}
+	}()
+
+	m := NewManager(context.Background(), nil, "alice", 1280)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err = m.probeServer(ctx, "rel://"+listener.Addr().String())
+	assert.Error(t, err)
+	assert.Less(t, time.Since(start), probeTimeout, "the probe should end with its context")
+}
@@ -0,0 +1,43 @@
+package messages
+
+import (
+	"fmt"
+)
+
+const sizeOfLimitReason = 1
+
+// LimitReason is the reason the relay drops the traffic of a peer
+type LimitReason byte
+
+const (
+	LimitReasonUnknown LimitReason = iota
+	// LimitReasonRateLimited means the peer or its account sends faster than the relay allows
+	LimitReasonRateLimited
+	// LimitReasonQuotaExceeded means the peer or its account used up its monthly traffic quota on the relay
+	LimitReasonQuotaExceeded
+)
+
+func (r LimitReason) String() string {
+	switch r {
+	case LimitReasonRateLimited:
+		return "rate limited"
+	case LimitReasonQuotaExceeded:
+		return "quota exceeded"
+	default:
+		return "unknown"
+	}
+}
+
+// MarshalLimitedMsg creates the message that tells the peer that the relay drops its traffic. The peer should prefer
+// a direct connection to its remote peers while it is limited.
+func MarshalLimitedMsg(reason LimitReason) []byte {
+	return []byte{byte(CurrentProtocolVersion), byte(MsgTypeLimited), byte(reason)}
+}
+
+// UnmarshalLimitedMsg extracts the reason from the limited message
+func UnmarshalLimitedMsg(msg []byte) (LimitReason, error) {
+	if len(msg) < sizeOfProtoHeader+sizeOfLimitReason {
+		return LimitReasonUnknown, fmt.Errorf("%w: %d", ErrInvalidMessageLength, len(msg))
+	}
+	return LimitReason(msg[sizeOfProtoHeader]), nil
+}
@@ -2,6 +2,7 @@ package messages
 
 import (
 	"bytes"
+	"encoding/binary"
 	"errors"
 	"fmt"
 )
@@ -30,6 +31,9 @@ const (
 	MsgTypePeersOnline          = 10
 	MsgTypePeersWentOffline     = 11
 
+	// MsgTypeLimited tells the peer that the relay drops its traffic because of a rate limit or a quota
+	MsgTypeLimited = 12
+
 	// base size of the message
 	sizeOfVersionByte = 1
 	sizeOfMsgType     = 1
@@ -50,6 +54,9 @@ const (
 	headerSizeTransport      = peerIDSize
 	offsetTransportID        = sizeOfProtoHeader
 	headerTotalSizeTransport = sizeOfProtoHeader + headerSizeTransport
+
+	// health check probe
+	sizeOfHealthcheckNonce = 8
 )
 
 var (
@@ -87,6 +94,8 @@ func (m MsgType) String() string {
 		return "peers online"
 	case MsgTypePeersWentOffline:
 		return "peers went offline"
+	case MsgTypeLimited:
+		return "limited"
 	default:
 		return "unknown"
 	}
@@ -141,7 +150,8 @@ func DetermineServerMessageType(msg []byte) (MsgType, error) {
 		MsgTypeClose,
 		MsgTypeHealthCheck,
 		MsgTypePeersOnline,
-		MsgTypePeersWentOffline:
+		MsgTypePeersWentOffline,
+		MsgTypeLimited:
 		return msgType, nil
 	default:
 		return MsgTypeUnknown, fmt.Errorf("invalid msg type %d", msgType)
@@ -335,3 +345,20 @@ func UpdateTransportMsg(msg []byte, peerID PeerID) error {
 func MarshalHealthcheck() []byte {
 	return healthCheckMsg
 }
+
+// MarshalHealthcheckProbe creates a health check message with a nonce. The client sends it to measure the round trip
+// time to the server, the server echoes the message back unchanged instead of treating it only as a response.
+func MarshalHealthcheckProbe(nonce uint64) []byte {
+	msg := make([]byte, sizeOfProtoHeader+sizeOfHealthcheckNonce)
+	copy(msg, healthCheckMsg)
+	binary.BigEndian.PutUint64(msg[sizeOfProtoHeader:], nonce)
+	return msg
+}
+
+// UnmarshalHealthcheckProbe returns the nonce of a health check probe. It returns false for a plain health check.
+func UnmarshalHealthcheckProbe(msg []byte) (uint64, bool) {
+	if len(msg) < sizeOfProtoHeader+sizeOfHealthcheckNonce {
+		return 0, false
+	}
+	return binary.BigEndian.Uint64(msg[sizeOfProtoHeader:]), true
+}
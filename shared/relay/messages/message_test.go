@@ -136,3 +136,49 @@ func TestMarshalHealthcheck(t *testing.T) {
 		t.Errorf("expected %d, got %d", MsgTypeHealthCheck, msgType)
 	}
 }
+
+func TestMarshalHealthcheckProbe(t *testing.T) {
+	msg := MarshalHealthcheckProbe(42)
+
+	msgType, err := DetermineClientMessageType(msg)
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+	if msgType != MsgTypeHealthCheck {
+		t.Errorf("expected %d, got %d", MsgTypeHealthCheck, msgType)
+	}
+
+	nonce, ok := UnmarshalHealthcheckProbe(msg)
+	if !ok || nonce != 42 {
+		t.Errorf("expected probe nonce 42, got %d (%t)", nonce, ok)
+	}
+
+	if _, ok := UnmarshalHealthcheckProbe(MarshalHealthcheck()); ok {
+		t.Errorf("plain health check parsed as a probe")
+	}
+}
+
+func TestMarshalLimitedMsg(t *testing.T) {
+	msg := MarshalLimitedMsg(LimitReasonQuotaExceeded)
+
+	msgType, err := DetermineServerMessageType(msg)
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+
+	if msgType != MsgTypeLimited {
+		t.Errorf("expected %d, got %d", MsgTypeLimited, msgType)
+	}
+
+	reason, err := UnmarshalLimitedMsg(msg)
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+	if reason != LimitReasonQuotaExceeded {
+		t.Errorf("expected %s, got %s", LimitReasonQuotaExceeded, reason)
+	}
+
+	if _, err := UnmarshalLimitedMsg(msg[:sizeOfProtoHeader]); err == nil {
+		t.Errorf("expected error for truncated message")
+	}
+}
@@ -0,0 +1,82 @@
+// Package stream carries the relay messages over a byte stream, e.g. a raw TLS connection. The relay protocol
+// expects every read to return one message, so every message is prefixed with its length.
+package stream
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+)
+
+const (
+	sizeOfLength = 2
+	// MaxMessageSize is the largest message the framing can carry
+	MaxMessageSize = 1<<16 - 1
+)
+
+// Conn is a message oriented connection over a byte stream
+type Conn struct {
+	net.Conn
+
+	readMu   sync.Mutex
+	writeMu  sync.Mutex
+	header   [sizeOfLength]byte
+	done     chan struct{}
+	doneOnce sync.Once
+}
+
+func NewConn(conn net.Conn) *Conn {
+	return &Conn{
+		Conn: conn,
+		done: make(chan struct{}),
+	}
+}
+
+// Read reads one message. The buffer must fit the message.
+func (c *Conn) Read(b []byte) (int, error) {
+	c.readMu.Lock()
+	defer c.readMu.Unlock()
+
+	if _, err := io.ReadFull(c.Conn, c.header[:]); err != nil {
+		return 0, err
+	}
+
+	size := int(binary.BigEndian.Uint16(c.header[:]))
+	if size > len(b) {
+		return 0, fmt.Errorf("message of %d bytes doesn't fit the buffer of %d bytes", size, len(b))
+	}
+
+	return io.ReadFull(c.Conn, b[:size])
+}
+
+// Write writes the buffer as one message
+func (c *Conn) Write(b []byte) (int, error) {
+	if len(b) > MaxMessageSize {
+		return 0, fmt.Errorf("message of %d bytes exceeds the maximum size", len(b))
+	}
+
+	msg := make([]byte, sizeOfLength+len(b))
+	binary.BigEndian.PutUint16(msg, uint16(len(b)))
+	copy(msg[sizeOfLength:], b)
+
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	if _, err := c.Conn.Write(msg); err != nil {
+		return 0, err
+	}
+	return len(b), nil
+}
+
+func (c *Conn) Close() error {
+	c.doneOnce.Do(func() {
+		close(c.done)
+	})
+	return c.Conn.Close()
+}
+
+// Done is closed when the connection is closed
+func (c *Conn) Done() <-chan struct{} {
+	return c.done
+}
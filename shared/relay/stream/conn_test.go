@@ -0,0 +1,62 @@
+package stream
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConn_Messages(t *testing.T) {
+	client, server := net.Pipe()
+	clientConn, serverConn := NewConn(client), NewConn(server)
+	defer clientConn.Close()
+	defer serverConn.Close()
+
+	messages := [][]byte{[]byte("first"), make([]byte, 8820), []byte("third")}
+	go func() {
+		for _, msg := range messages {
+			_, _ = clientConn.Write(msg)
+		}
+	}()
+
+	buf := make([]byte, 9000)
+	for _, msg := range messages {
+		n, err := serverConn.Read(buf)
+		require.NoError(t, err)
+		assert.Equal(t, msg, buf[:n])
+	}
+
+	_, err := clientConn.Write(make([]byte, MaxMessageSize+1))
+	assert.Error(t, err)
+}
+
+func TestConn_ReadBufferTooSmall(t *testing.T) {
+	client, server := net.Pipe()
+	clientConn, serverConn := NewConn(client), NewConn(server)
+	defer clientConn.Close()
+	defer serverConn.Close()
+
+	go func() {
+		_, _ = clientConn.Write([]byte("too long"))
+	}()
+
+	_, err := serverConn.Read(make([]byte, 4))
+	assert.Error(t, err)
+}
+
+func TestConn_Done(t *testing.T) {
+	client, _ := net.Pipe()
+	conn := NewConn(client)
+
+	select {
+	case <-conn.Done():
+		t.Fatal("done before close")
+	default:
+	}
+
+	require.NoError(t, conn.Close())
+	_ = conn.Close()
+	<-conn.Done()
+}
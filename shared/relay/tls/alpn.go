@@ -1,3 +1,6 @@
 package tls
 
 const NBalpn = "nb-quic"
+
+// NBTCPalpn selects the raw TLS transport on the TLS listener of the relay
+const NBTCPalpn = "nb-tcp"
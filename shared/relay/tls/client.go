@@ -0,0 +1,10 @@
+package tls
+
+import "crypto/tls"
+
+// ClientTCPTLSConfig returns the client TLS configuration of the raw TLS transport
+func ClientTCPTLSConfig() *tls.Config {
+	cfg := ClientQUICTLSConfig()
+	cfg.NextProtos = []string{NBTCPalpn}
+	return cfg
+}
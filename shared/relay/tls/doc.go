@@ -18,6 +18,10 @@
 //     for QUIC protocol. The configuration differs between development
 //     (insecure testing) and production (strict verification).
 //
+//   - `ClientTCPTLSConfig`: Provides the same client-side TLS configuration
+//     for the raw TLS transport, negotiated with its own ALPN on the TLS
+//     listener of the WebSocket server.
+//
 //   - `generateTestTLSConfig`: Generates a self-signed TLS configuration for
 //     use in local development and testing scenarios.
 //
@@ -0,0 +1,103 @@
+// Package bus connects the signal server instances, so a message can reach a peer connected to any of them.
+package bus
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	gproto "google.golang.org/protobuf/proto"
+
+	"github.com/netbirdio/netbird/shared/signal/proto"
+)
+
+// Handler handles the events that other instances send to the local one
+type Handler interface {
+	// HandleMessage delivers a message to a peer connected to the local instance
+	HandleMessage(ctx context.Context, msg *proto.EncryptedMessage)
+	// HandleEviction closes the stream of a peer that has registered on another instance since
+	HandleEviction(peerID string, streamID int64)
+}
+
+// Bus tracks which instance holds the stream of every peer and routes the messages between the instances
+type Bus interface {
+	// NodeID returns the ID of the local instance
+	NodeID() string
+	// Register makes the local instance the owner of the peer. The latest registration wins, the instance that held
+	// the peer before is asked to evict its stream.
+	Register(ctx context.Context, peerID string, streamID int64) error
+	// Deregister removes the ownership of the peer if the stream is still the registered one
+	Deregister(ctx context.Context, peerID string, streamID int64) error
+	// Lookup returns the instance that holds the stream of the peer
+	Lookup(ctx context.Context, peerID string) (string, bool, error)
+	// Send sends a message to the instance that holds the stream of the destination peer
+	Send(ctx context.Context, nodeID string, msg *proto.EncryptedMessage) error
+	// Start starts receiving the events of the local instance
+	Start(handler Handler) error
+	// Close stops receiving the events and releases the registrations of the local instance
+	Close() error
+}
+
+const (
+	eventMessage byte = iota + 1
+	eventEviction
+)
+
+var errInvalidEvent = errors.New("invalid bus event")
+
+// event is a message or an eviction sent to an instance
+type event struct {
+	msg *proto.EncryptedMessage
+
+	peerID   string
+	streamID int64
+}
+
+func marshalMessageEvent(msg *proto.EncryptedMessage) ([]byte, error) {
+	payload, err := gproto.Marshal(msg)
+	if err != nil {
+		return nil, fmt.Errorf("marshal message: %w", err)
+	}
+	return append([]byte{eventMessage}, payload...), nil
+}
+
+func marshalEvictionEvent(peerID string, streamID int64) []byte {
+	payload := make([]byte, 9, 9+len(peerID))
+	payload[0] = eventEviction
+	binary.BigEndian.PutUint64(payload[1:], uint64(streamID))
+	return append(payload, peerID...)
+}
+
+func unmarshalEvent(payload []byte) (*event, error) {
+	if len(payload) == 0 {
+		return nil, errInvalidEvent
+	}
+
+	switch payload[0] {
+	case eventMessage:
+		msg := &proto.EncryptedMessage{}
+		if err := gproto.Unmarshal(payload[1:], msg); err != nil {
+			return nil, fmt.Errorf("unmarshal message: %w", err)
+		}
+		return &event{msg: msg}, nil
+	case eventEviction:
+		if len(payload) < 10 {
+			return nil, errInvalidEvent
+		}
+		return &event{
+			peerID:   string(payload[9:]),
+			streamID: int64(binary.BigEndian.Uint64(payload[1:9])),
+		}, nil
+	default:
+		return nil, fmt.Errorf("%w: unknown type %d", errInvalidEvent, payload[0])
+	}
+}
+
+func (e *event) dispatch(ctx context.Context, handler Handler) {
+	if e.msg != nil {
+		handler.HandleMessage(ctx, e.msg)
+		return
+	}
+	handler.HandleEviction(e.peerID, e.streamID)
+}
@@ -0,0 +1,166 @@
+package bus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/netbirdio/netbird/shared/signal/proto"
+)
+
+// memoryQueueSize is the number of events that can be queued for an instance before Send blocks
+const memoryQueueSize = 1000
+
+var errNodeNotFound = errors.New("signal instance not found")
+
+type owner struct {
+	nodeID   string
+	streamID int64
+}
+
+// MemoryNetwork is an in-process Bus backend shared by the instances that run in the same process.
+// It is meant for tests and local setups.
+type MemoryNetwork struct {
+	mu     sync.Mutex
+	owners map[string]owner
+	nodes  map[string]*Memory
+}
+
+func NewMemoryNetwork() *MemoryNetwork {
+	return &MemoryNetwork{
+		owners: make(map[string]owner),
+		nodes:  make(map[string]*Memory),
+	}
+}
+
+// Bus returns the bus of an instance of the network
+func (n *MemoryNetwork) Bus(nodeID string) *Memory {
+	return &Memory{
+		network: n,
+		nodeID:  nodeID,
+		events:  make(chan []byte, memoryQueueSize),
+		done:    make(chan struct{}),
+	}
+}
+
+func (n *MemoryNetwork) deliver(ctx context.Context, nodeID string, payload []byte) error {
+	n.mu.Lock()
+	node, ok := n.nodes[nodeID]
+	n.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("%w: %s", errNodeNotFound, nodeID)
+	}
+
+	select {
+	case node.events <- payload:
+		return nil
+	case <-node.done:
+		return fmt.Errorf("%w: %s", errNodeNotFound, nodeID)
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Memory is the bus of an instance of a MemoryNetwork. The events are delivered in order.
+type Memory struct {
+	network *MemoryNetwork
+	nodeID  string
+
+	events    chan []byte
+	done      chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+func (m *Memory) NodeID() string {
+	return m.nodeID
+}
+
+func (m *Memory) Register(ctx context.Context, peerID string, streamID int64) error {
+	m.network.mu.Lock()
+	previous, ok := m.network.owners[peerID]
+	m.network.owners[peerID] = owner{nodeID: m.nodeID, streamID: streamID}
+	m.network.mu.Unlock()
+
+	if !ok || previous.nodeID == m.nodeID {
+		return nil
+	}
+
+	if err := m.network.deliver(ctx, previous.nodeID, marshalEvictionEvent(peerID, previous.streamID)); err != nil {
+		log.Debugf("failed to evict peer [%s] from signal instance %s: %v", peerID, previous.nodeID, err)
+	}
+	return nil
+}
+
+func (m *Memory) Deregister(_ context.Context, peerID string, streamID int64) error {
+	m.network.mu.Lock()
+	defer m.network.mu.Unlock()
+
+	if m.network.owners[peerID] == (owner{nodeID: m.nodeID, streamID: streamID}) {
+		delete(m.network.owners, peerID)
+	}
+	return nil
+}
+
+func (m *Memory) Lookup(_ context.Context, peerID string) (string, bool, error) {
+	m.network.mu.Lock()
+	defer m.network.mu.Unlock()
+
+	o, ok := m.network.owners[peerID]
+	return o.nodeID, ok, nil
+}
+
+func (m *Memory) Send(ctx context.Context, nodeID string, msg *proto.EncryptedMessage) error {
+	payload, err := marshalMessageEvent(msg)
+	if err != nil {
+		return err
+	}
+	return m.network.deliver(ctx, nodeID, payload)
+}
+
+func (m *Memory) Start(handler Handler) error {
+	m.network.mu.Lock()
+	m.network.nodes[m.nodeID] = m
+	m.network.mu.Unlock()
+
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		for {
+			select {
+			case <-m.done:
+				return
+			case payload := <-m.events:
+				e, err := unmarshalEvent(payload)
+				if err != nil {
+					log.Errorf("failed to read signal bus event: %v", err)
+					continue
+				}
+				e.dispatch(context.Background(), handler)
+			}
+		}
+	}()
+	return nil
+}
+
+func (m *Memory) Close() error {
+	m.closeOnce.Do(func() {
+		m.network.mu.Lock()
+		if m.network.nodes[m.nodeID] == m {
+			delete(m.network.nodes, m.nodeID)
+		}
+		for peerID, o := range m.network.owners {
+			if o.nodeID == m.nodeID {
+				delete(m.network.owners, peerID)
+			}
+		}
+		m.network.mu.Unlock()
+
+		close(m.done)
+		m.wg.Wait()
+	})
+	return nil
+}
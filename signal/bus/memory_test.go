@@ -0,0 +1,163 @@
+package bus
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/netbirdio/netbird/shared/signal/proto"
+)
+
+type eviction struct {
+	peerID   string
+	streamID int64
+}
+
+type recordingHandler struct {
+	messages  chan *proto.EncryptedMessage
+	evictions chan eviction
+}
+
+func newRecordingHandler() *recordingHandler {
+	return &recordingHandler{
+		messages:  make(chan *proto.EncryptedMessage, 10),
+		evictions: make(chan eviction, 10),
+	}
+}
+
+func (h *recordingHandler) HandleMessage(_ context.Context, msg *proto.EncryptedMessage) {
+	h.messages <- msg
+}
+
+func (h *recordingHandler) HandleEviction(peerID string, streamID int64) {
+	h.evictions <- eviction{peerID: peerID, streamID: streamID}
+}
+
+func startMemoryBus(t *testing.T, network *MemoryNetwork, nodeID string) (*Memory, *recordingHandler) {
+	t.Helper()
+	b := network.Bus(nodeID)
+	handler := newRecordingHandler()
+	require.NoError(t, b.Start(handler))
+	t.Cleanup(func() {
+		_ = b.Close()
+	})
+	return b, handler
+}
+
+func TestMemory_SendInOrder(t *testing.T) {
+	ctx := context.Background()
+	network := NewMemoryNetwork()
+	busA, _ := startMemoryBus(t, network, "a")
+	busB, handlerB := startMemoryBus(t, network, "b")
+
+	require.NoError(t, busB.Register(ctx, "peer-b", 1))
+
+	nodeID, found, err := busA.Lookup(ctx, "peer-b")
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, "b", nodeID)
+
+	for _, body := range []string{"first", "second", "third"} {
+		require.NoError(t, busA.Send(ctx, nodeID, &proto.EncryptedMessage{Key: "peer-a", RemoteKey: "peer-b", Body: []byte(body)}))
+	}
+
+	for _, body := range []string{"first", "second", "third"} {
+		select {
+		case msg := <-handlerB.messages:
+			assert.Equal(t, body, string(msg.Body))
+			assert.Equal(t, "peer-b", msg.RemoteKey)
+		case <-time.After(time.Second):
+			t.Fatalf("timeout waiting for message %s", body)
+		}
+	}
+
+	assert.Error(t, busA.Send(ctx, "unknown", &proto.EncryptedMessage{RemoteKey: "peer-b"}))
+}
+
+func TestMemory_RegisterEvictsPreviousNode(t *testing.T) {
+	ctx := context.Background()
+	network := NewMemoryNetwork()
+	busA, handlerA := startMemoryBus(t, network, "a")
+	busB, handlerB := startMemoryBus(t, network, "b")
+
+	require.NoError(t, busA.Register(ctx, "peer", 1))
+	require.NoError(t, busB.Register(ctx, "peer", 2))
+
+	select {
+	case e := <-handlerA.evictions:
+		assert.Equal(t, eviction{peerID: "peer", streamID: 1}, e)
+	case <-time.After(time.Second):
+		t.Fatalf("timeout waiting for eviction")
+	}
+
+	// the deregistration of the evicted stream doesn't remove the new registration
+	require.NoError(t, busA.Deregister(ctx, "peer", 1))
+	nodeID, found, err := busA.Lookup(ctx, "peer")
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, "b", nodeID)
+
+	// a reconnection to the same node doesn't evict anything
+	require.NoError(t, busB.Register(ctx, "peer", 3))
+	select {
+	case e := <-handlerB.evictions:
+		t.Fatalf("unexpected eviction: %+v", e)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	require.NoError(t, busB.Deregister(ctx, "peer", 3))
+	_, found, err = busA.Lookup(ctx, "peer")
+	require.NoError(t, err)
+	assert.False(t, found)
+}
+
+func TestMemory_CloseReleasesRegistrations(t *testing.T) {
+	ctx := context.Background()
+	network := NewMemoryNetwork()
+	busA, _ := startMemoryBus(t, network, "a")
+	busB, _ := startMemoryBus(t, network, "b")
+
+	require.NoError(t, busB.Register(ctx, "peer", 1))
+	require.NoError(t, busB.Close())
+
+	_, found, err := busA.Lookup(ctx, "peer")
+	require.NoError(t, err)
+	assert.False(t, found)
+	assert.Error(t, busA.Send(ctx, "b", &proto.EncryptedMessage{RemoteKey: "peer"}))
+}
+
+func TestEvent_Marshal(t *testing.T) {
+	payload, err := marshalMessageEvent(&proto.EncryptedMessage{Key: "a", RemoteKey: "b", Body: []byte("body")})
+	require.NoError(t, err)
+
+	e, err := unmarshalEvent(payload)
+	require.NoError(t, err)
+	require.NotNil(t, e.msg)
+	assert.Equal(t, "a", e.msg.Key)
+	assert.Equal(t, "b", e.msg.RemoteKey)
+	assert.Equal(t, []byte("body"), e.msg.Body)
+
+	e, err = unmarshalEvent(marshalEvictionEvent("peer", 42))
+	require.NoError(t, err)
+	assert.Nil(t, e.msg)
+	assert.Equal(t, "peer", e.peerID)
+	assert.Equal(t, int64(42), e.streamID)
+
+	_, err = unmarshalEvent([]byte{eventEviction, 1})
+	assert.Error(t, err)
+	_, err = unmarshalEvent(nil)
+	assert.Error(t, err)
+}
+
+func TestParseOwnerValue(t *testing.T) {
+	nodeID, streamID, err := parseOwnerValue(ownerValue("node|1", 1234))
+	require.NoError(t, err)
+	assert.Equal(t, "node|1", nodeID)
+	assert.Equal(t, int64(1234), streamID)
+
+	_, _, err = parseOwnerValue("invalid")
+	assert.Error(t, err)
+}
@@ -21,6 +21,11 @@ type AppMetrics struct {
 	MessageForwardFailures metric.Int64Counter
 	MessageForwardLatency  metric.Float64Histogram
 
+	CrossNodeMessagesForwarded      metric.Int64Counter
+	CrossNodeMessagesReceived       metric.Int64Counter
+	CrossNodeMessageForwardFailures metric.Int64Counter
+	CrossNodeEvictions              metric.Int64Counter
+
 	MessageSize metric.Int64Histogram
 }
 
@@ -99,6 +104,34 @@ func NewAppMetrics(meter metric.Meter) (*AppMetrics, error) {
 		return nil, err
 	}
 
+	crossNodeMessagesForwarded, err := meter.Int64Counter("cross_node_messages_forwarded_total",
+		metric.WithDescription("Total number of messages forwarded to peers connected to other signal instances"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	crossNodeMessagesReceived, err := meter.Int64Counter("cross_node_messages_received_total",
+		metric.WithDescription("Total number of messages received from other signal instances"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	crossNodeMessageForwardFailures, err := meter.Int64Counter("cross_node_message_forward_failures_total",
+		metric.WithDescription("Total number of failures forwarding messages to other signal instances"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	crossNodeEvictions, err := meter.Int64Counter("cross_node_evictions_total",
+		metric.WithDescription("Total number of peer streams closed because the peer registered on another signal instance"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	messageSize, err := meter.Int64Histogram(
 		"message.size.bytes",
 		metric.WithUnit("bytes"),
@@ -125,6 +158,11 @@ func NewAppMetrics(meter metric.Meter) (*AppMetrics, error) {
 		MessageForwardFailures: messageForwardFailures,
 		MessageForwardLatency:  messageForwardLatency,
 
+		CrossNodeMessagesForwarded:      crossNodeMessagesForwarded,
+		CrossNodeMessagesReceived:       crossNodeMessagesReceived,
+		CrossNodeMessageForwardFailures: crossNodeMessageForwardFailures,
+		CrossNodeEvictions:              crossNodeEvictions,
+
 		MessageSize: messageSize,
 	}, nil
 }
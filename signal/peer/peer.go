@@ -10,6 +10,7 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	"github.com/netbirdio/netbird/shared/signal/proto"
+	"github.com/netbirdio/netbird/signal/bus"
 	"github.com/netbirdio/netbird/signal/metrics"
 )
 
@@ -51,13 +52,22 @@ type Registry struct {
 	// regMutex ensures that registration and de-registrations are safe
 	regMutex sync.Mutex
 	metrics  *metrics.AppMetrics
+	// bus shares the registrations with the other signal instances, nil when running a single instance
+	bus bus.Bus
 }
 
 // NewRegistry creates a new connected Peer registry
 func NewRegistry(metrics *metrics.AppMetrics) *Registry {
+	return NewRegistryWithBus(metrics, nil)
+}
+
+// NewRegistryWithBus creates a new connected Peer registry that shares the registrations over the bus, so the peers
+// connected to the other signal instances can be looked up
+func NewRegistryWithBus(metrics *metrics.AppMetrics, bus bus.Bus) *Registry {
 	return &Registry{
 		regMutex: sync.Mutex{},
 		metrics:  metrics,
+		bus:      bus,
 	}
 }
 
@@ -93,12 +103,14 @@ func (registry *Registry) Register(peer *Peer) error {
 			}
 			pp.Cancel()
 			log.Debugf("peer re-registered [%s]", peer.Id)
+			registry.registerOnBus(peer)
 			return nil
 		}
 		return ErrPeerAlreadyRegistered
 	}
 
 	log.Debugf("peer registered [%s]", peer.Id)
+	registry.registerOnBus(peer)
 	registry.metrics.ActivePeers.Add(context.Background(), 1)
 
 	// record time as milliseconds
@@ -115,5 +127,53 @@ func (registry *Registry) Deregister(peer *Peer) {
 		registry.metrics.ActivePeers.Add(context.Background(), -1)
 		log.Debugf("peer deregistered [%s]", peer.Id)
 		registry.metrics.Deregistrations.Add(context.Background(), 1)
+
+		if registry.bus != nil {
+			if err := registry.bus.Deregister(context.Background(), peer.Id, peer.StreamID); err != nil {
+				log.Errorf("failed to deregister peer [%s] from the signal bus: %v", peer.Id, err)
+			}
+		}
+	}
+}
+
+// Lookup returns the signal instance that holds the stream of a peer that isn't connected to this instance
+func (registry *Registry) Lookup(ctx context.Context, peerId string) (string, bool) {
+	if registry.bus == nil {
+		return "", false
+	}
+
+	nodeID, found, err := registry.bus.Lookup(ctx, peerId)
+	if err != nil {
+		log.Errorf("failed to look up peer [%s] on the signal bus: %v", peerId, err)
+		return "", false
+	}
+	if !found || nodeID == registry.bus.NodeID() {
+		return "", false
+	}
+	return nodeID, true
+}
+
+// Evict closes the stream of the peer if it is still the given one. It is called when the peer has registered on
+// another signal instance since.
+func (registry *Registry) Evict(peerId string, streamID int64) {
+	p, found := registry.Get(peerId)
+	if !found || p.StreamID != streamID {
+		return
+	}
+
+	log.Debugf("peer [%s] registered on another signal instance, closing stream [streamID %d]", peerId, streamID)
+	registry.metrics.CrossNodeEvictions.Add(context.Background(), 1)
+	p.Cancel()
+}
+
+// registerOnBus makes this instance the owner of the peer on the bus. The local registration is kept if the bus is
+// unavailable, so the peers connected to this instance can still reach each other.
+func (registry *Registry) registerOnBus(peer *Peer) {
+	if registry.bus == nil {
+		return
+	}
+
+	if err := registry.bus.Register(context.Background(), peer.Id, peer.StreamID); err != nil {
+		log.Errorf("failed to register peer [%s] on the signal bus: %v", peer.Id, err)
 	}
 }
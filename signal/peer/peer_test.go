@@ -13,6 +13,7 @@ import (
 	"google.golang.org/grpc/metadata"
 
 	"github.com/netbirdio/netbird/shared/signal/proto"
+	"github.com/netbirdio/netbird/signal/bus"
 	"github.com/netbirdio/netbird/signal/metrics"
 )
 
@@ -321,3 +322,28 @@ func TestReconnectHandling(t *testing.T) {
 	default:
 	}
 }
+
+func TestRegistry_LookupOnBus(t *testing.T) {
+	metrics, err := metrics.NewAppMetrics(otel.Meter(""))
+	require.NoError(t, err)
+
+	network := bus.NewMemoryNetwork()
+	registry1 := NewRegistryWithBus(metrics, network.Bus("signal-1"))
+	registry2 := NewRegistryWithBus(metrics, network.Bus("signal-2"))
+
+	_, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	peer := NewPeer("peer", nil, cancel)
+	require.NoError(t, registry2.Register(peer))
+
+	nodeID, found := registry1.Lookup(context.Background(), "peer")
+	require.True(t, found, "peer should be found on the other instance")
+	assert.Equal(t, "signal-2", nodeID)
+
+	_, found = registry2.Lookup(context.Background(), "peer")
+	assert.False(t, found, "local peers shouldn't be looked up on the bus")
+
+	registry2.Deregister(peer)
+	_, found = registry1.Lookup(context.Background(), "peer")
+	assert.False(t, found, "peer should be removed from the bus after deregistration")
+}
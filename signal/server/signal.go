@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"sync"
 	"time"
 
 	log "github.com/sirupsen/logrus"
@@ -18,6 +19,7 @@ import (
 	"github.com/netbirdio/signal-dispatcher/dispatcher"
 
 	"github.com/netbirdio/netbird/shared/signal/proto"
+	"github.com/netbirdio/netbird/signal/bus"
 	"github.com/netbirdio/netbird/signal/metrics"
 	"github.com/netbirdio/netbird/signal/peer"
 )
@@ -31,6 +33,7 @@ const (
 	labelTypeMessage       = "message"
 	labelTypeTimeout       = "timeout"
 	labelTypeDisconnected  = "disconnected"
+	labelTypeBus           = "bus"
 
 	labelError                   = "error"
 	labelErrorMissingId          = "missing_id"
@@ -43,6 +46,9 @@ const (
 	labelRegistrationNotFound = "not_found"
 
 	sendTimeout = 10 * time.Second
+
+	// busPeerQueueSize is the number of messages received over the bus that can wait for delivery to a peer
+	busPeerQueueSize = 100
 )
 
 var (
@@ -54,6 +60,7 @@ type Server struct {
 	registry *peer.Registry
 	proto.UnimplementedSignalExchangeServer
 	dispatcher *dispatcher.Dispatcher
+	bus        bus.Bus
 	metrics    *metrics.AppMetrics
 
 	successHeader metadata.MD
@@ -63,6 +70,12 @@ type Server struct {
 
 // NewServer creates a new Signal server
 func NewServer(ctx context.Context, meter metric.Meter) (*Server, error) {
+	return NewServerWithBus(ctx, meter, nil)
+}
+
+// NewServerWithBus creates a new Signal server that runs as one of multiple instances connected by the bus. Messages
+// to the peers connected to the other instances are forwarded over the bus. The bus is started by the server.
+func NewServerWithBus(ctx context.Context, meter metric.Meter, messageBus bus.Bus) (*Server, error) {
 	appMetrics, err := metrics.NewAppMetrics(meter)
 	if err != nil {
 		return nil, fmt.Errorf("creating app metrics: %v", err)
@@ -82,12 +95,19 @@ func NewServer(ctx context.Context, meter metric.Meter) (*Server, error) {
 
 	s := &Server{
 		dispatcher:    d,
-		registry:      peer.NewRegistry(appMetrics),
+		bus:           messageBus,
+		registry:      peer.NewRegistryWithBus(appMetrics, messageBus),
 		metrics:       appMetrics,
 		successHeader: metadata.Pairs(proto.HeaderRegistered, "1"),
 		sendTimeout:   sTimeout,
 	}
 
+	if messageBus != nil {
+		if err := messageBus.Start(newBusHandler(s)); err != nil {
+			return nil, fmt.Errorf("starting bus: %v", err)
+		}
+	}
+
 	return s, nil
 }
 
@@ -100,6 +120,11 @@ func (s *Server) Send(ctx context.Context, msg *proto.EncryptedMessage) (*proto.
 		return &proto.EncryptedMessage{}, nil
 	}
 
+	if nodeID, found := s.registry.Lookup(ctx, msg.RemoteKey); found {
+		s.forwardMessageToNode(ctx, nodeID, msg)
+		return &proto.EncryptedMessage{}, nil
+	}
+
 	return s.dispatcher.SendMessage(ctx, msg)
 }
 
@@ -207,3 +232,86 @@ func (s *Server) forwardMessageToPeer(ctx context.Context, msg *proto.EncryptedM
 		s.metrics.MessageForwardFailures.Add(ctx, 1, metric.WithAttributes(attribute.String(labelType, labelTypeTimeout)))
 	}
 }
+
+// forwardMessageToNode forwards a message over the bus to the signal instance that holds the stream of the destination peer
+func (s *Server) forwardMessageToNode(ctx context.Context, nodeID string, msg *proto.EncryptedMessage) {
+	log.Tracef("forwarding a new message from peer [%s] to peer [%s] on signal instance %s", msg.Key, msg.RemoteKey, nodeID)
+	start := time.Now()
+
+	if err := s.bus.Send(ctx, nodeID, msg); err != nil {
+		log.Tracef("error while forwarding message from peer [%s] to peer [%s] on signal instance %s: %v", msg.Key, msg.RemoteKey, nodeID, err)
+		s.metrics.CrossNodeMessageForwardFailures.Add(ctx, 1)
+		s.metrics.MessageForwardFailures.Add(ctx, 1, metric.WithAttributes(attribute.String(labelType, labelTypeBus)))
+		return
+	}
+
+	s.metrics.MessageForwardLatency.Record(ctx, float64(time.Since(start).Nanoseconds())/1e6, metric.WithAttributes(attribute.String(labelType, labelTypeBus)))
+	s.metrics.CrossNodeMessagesForwarded.Add(ctx, 1)
+}
+
+// Close stops the bus and releases the registrations of this instance
+func (s *Server) Close() error {
+	if s.bus == nil {
+		return nil
+	}
+	return s.bus.Close()
+}
+
+// busHandler handles the events that the other signal instances send to this instance. The messages are queued per
+// destination peer, so a slow peer doesn't hold up the messages to the others and the order is kept.
+type busHandler struct {
+	server *Server
+
+	mu     sync.Mutex
+	queues map[string]chan *proto.EncryptedMessage
+}
+
+func newBusHandler(server *Server) *busHandler {
+	return &busHandler{
+		server: server,
+		queues: make(map[string]chan *proto.EncryptedMessage),
+	}
+}
+
+func (h *busHandler) HandleMessage(ctx context.Context, msg *proto.EncryptedMessage) {
+	h.server.metrics.CrossNodeMessagesReceived.Add(ctx, 1)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	queue, ok := h.queues[msg.RemoteKey]
+	if !ok {
+		queue = make(chan *proto.EncryptedMessage, busPeerQueueSize)
+		h.queues[msg.RemoteKey] = queue
+		go h.deliver(msg.RemoteKey, queue)
+	}
+
+	select {
+	case queue <- msg:
+	default:
+		log.Tracef("message from peer [%s] to peer [%s] dropped: too many queued messages", msg.Key, msg.RemoteKey)
+		h.server.metrics.MessageForwardFailures.Add(ctx, 1, metric.WithAttributes(attribute.String(labelType, labelTypeBus)))
+	}
+}
+
+// deliver forwards the queued messages of a peer until the queue is empty
+func (h *busHandler) deliver(peerID string, queue chan *proto.EncryptedMessage) {
+	for {
+		select {
+		case msg := <-queue:
+			h.server.forwardMessageToPeer(context.Background(), msg)
+		default:
+			h.mu.Lock()
+			if len(queue) == 0 {
+				delete(h.queues, peerID)
+				h.mu.Unlock()
+				return
+			}
+			h.mu.Unlock()
+		}
+	}
+}
+
+func (h *busHandler) HandleEviction(peerID string, streamID int64) {
+	h.server.registry.Evict(peerID, streamID)
+}
@@ -0,0 +1,132 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/netbirdio/netbird/shared/signal/proto"
+	"github.com/netbirdio/netbird/signal/bus"
+)
+
+type mockConnectStreamServer struct {
+	grpc.ServerStream
+	ctx      context.Context
+	received chan *proto.EncryptedMessage
+}
+
+func newMockConnectStreamServer(ctx context.Context, peerID string) *mockConnectStreamServer {
+	return &mockConnectStreamServer{
+		ctx:      metadata.NewIncomingContext(ctx, metadata.Pairs(proto.HeaderId, peerID)),
+		received: make(chan *proto.EncryptedMessage, 10),
+	}
+}
+
+func (m *mockConnectStreamServer) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockConnectStreamServer) SendHeader(metadata.MD) error {
+	return nil
+}
+
+func (m *mockConnectStreamServer) Send(msg *proto.EncryptedMessage) error {
+	m.received <- msg
+	return nil
+}
+
+func (m *mockConnectStreamServer) Recv() (*proto.EncryptedMessage, error) {
+	<-m.ctx.Done()
+	return nil, m.ctx.Err()
+}
+
+func newBusServer(t *testing.T, network *bus.MemoryNetwork, nodeID string) *Server {
+	t.Helper()
+	srv, err := NewServerWithBus(context.Background(), otel.Meter(""), network.Bus(nodeID))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = srv.Close()
+	})
+	return srv
+}
+
+// connect runs the stream of the peer on the server and waits until the peer is registered
+func connect(t *testing.T, ctx context.Context, srv *Server, peerID string) (*mockConnectStreamServer, chan error) {
+	t.Helper()
+	stream := newMockConnectStreamServer(ctx, peerID)
+	done := make(chan error, 1)
+	go func() {
+		done <- srv.ConnectStream(stream)
+	}()
+
+	require.Eventually(t, func() bool {
+		p, found := srv.registry.Get(peerID)
+		return found && p.Stream == stream
+	}, time.Second, 10*time.Millisecond)
+	return stream, done
+}
+
+func TestServer_ForwardsToPeerOnOtherInstance(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	network := bus.NewMemoryNetwork()
+	srv1 := newBusServer(t, network, "signal-1")
+	srv2 := newBusServer(t, network, "signal-2")
+
+	streamB, _ := connect(t, ctx, srv2, "peer-b")
+
+	msg := &proto.EncryptedMessage{Key: "peer-a", RemoteKey: "peer-b", Body: []byte("offer")}
+	_, err := srv1.Send(ctx, msg)
+	require.NoError(t, err)
+
+	select {
+	case received := <-streamB.received:
+		assert.Equal(t, "peer-a", received.Key)
+		assert.Equal(t, []byte("offer"), received.Body)
+	case <-time.After(time.Second):
+		t.Fatal("message wasn't forwarded to the peer on the other instance")
+	}
+}
+
+func TestServer_DuplicateRegistrationAcrossInstances(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	network := bus.NewMemoryNetwork()
+	srv1 := newBusServer(t, network, "signal-1")
+	srv2 := newBusServer(t, network, "signal-2")
+
+	_, oldDone := connect(t, ctx, srv1, "peer-b")
+	time.Sleep(time.Millisecond)
+	newStream, _ := connect(t, ctx, srv2, "peer-b")
+
+	select {
+	case err := <-oldDone:
+		assert.ErrorIs(t, err, ErrPeerRegisteredAgain)
+	case <-time.After(time.Second):
+		t.Fatal("old stream wasn't closed after the peer registered on another instance")
+	}
+
+	require.Eventually(t, func() bool {
+		_, found := srv1.registry.Get("peer-b")
+		return !found
+	}, time.Second, 10*time.Millisecond)
+
+	// the deregistration of the old stream keeps the new registration, so messages still reach the peer
+	_, err := srv1.Send(ctx, &proto.EncryptedMessage{Key: "peer-a", RemoteKey: "peer-b", Body: []byte("offer")})
+	require.NoError(t, err)
+
+	select {
+	case received := <-newStream.received:
+		assert.Equal(t, []byte("offer"), received.Body)
+	case <-time.After(time.Second):
+		t.Fatal("message wasn't forwarded to the new stream")
+	}
+}